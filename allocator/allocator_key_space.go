@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"go.etcd.io/etcd/api/v3/mvccpb"
 
@@ -29,6 +30,11 @@ const (
 	Sep, SepByte = "#", '#'
 )
 
+// HistoryPrefix prefixes keys of recorded Item revisions, eg
+// "root/history/item-id#16d3a4c2b1e0a000". It's the IgnorePrefix of the
+// KeySpace: recorded revisions are not mirrored or decoded by members.
+const HistoryPrefix = "/history/"
+
 // MemberValue is a user-defined Member representation which also supports
 // required APIs for use by Allocator.
 type MemberValue interface {
@@ -133,8 +139,11 @@ func NewAllocatorKeyValueDecoder(prefix string, decode Decoder) keyspace.KeyValu
 
 // NewAllocatorKeySpace is a convenience for
 // `NewKeySpace(prefix, NewAllocatorKeyValueDecoder(prefix, decode))`.
+// The KeySpace ignores keys under HistoryPrefix.
 func NewAllocatorKeySpace(prefix string, decode Decoder) *keyspace.KeySpace {
-	return keyspace.NewKeySpace(prefix, NewAllocatorKeyValueDecoder(prefix, decode))
+	var ks = keyspace.NewKeySpace(prefix, NewAllocatorKeyValueDecoder(prefix, decode))
+	ks.IgnorePrefix = prefix + HistoryPrefix
+	return ks
 }
 
 // MemberKey returns the unique key for a Member with |zone| and |suffix| under the KeySpace.
@@ -156,6 +165,23 @@ func ItemAssignmentsPrefix(ks *keyspace.KeySpace, itemID string) string {
 	return ks.Root + AssignmentsPrefix + itemID + Sep
 }
 
+// RevisionsPrefix returns the unique key prefix for all recorded revisions of Items under the KeySpace.
+func RevisionsPrefix(ks *keyspace.KeySpace) string {
+	return ks.Root + HistoryPrefix
+}
+
+// ItemRevisionsPrefix returns the unique key prefix for all recorded revisions of |itemID| under the KeySpace.
+func ItemRevisionsPrefix(ks *keyspace.KeySpace, itemID string) string {
+	assertAboveSep(itemID)
+	return RevisionsPrefix(ks) + itemID + Sep
+}
+
+// ItemRevisionKey returns the key for a revision of |itemID| recorded at time
+// |t| under the KeySpace. Keys of an Item order on ascending |t|.
+func ItemRevisionKey(ks *keyspace.KeySpace, itemID string, t time.Time) string {
+	return ItemRevisionsPrefix(ks, itemID) + fmt.Sprintf("%016x", t.UnixNano())
+}
+
 // AssignmentKey returns the unique key for Assignment |assignment| under the KeySpace.
 func AssignmentKey(ks *keyspace.KeySpace, a Assignment) string {
 	assertAboveSep(a.MemberZone)
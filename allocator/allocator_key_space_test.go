@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -82,6 +84,20 @@ func (s *AllocKeySpaceSuite) TestKeyOrderingEdgeCases(c *gc.C) {
 	for i := 1; i != len(cases); i++ {
 		c.Check(cases[i-1] < cases[i], gc.Equals, true)
 	}
+
+	cases = []string{
+		ItemRevisionKey(ks, "11", time.Unix(100, 0)),
+		ItemRevisionKey(ks, "11", time.Unix(100, 1)),
+		ItemRevisionKey(ks, "11", time.Unix(200, 0)),
+		ItemRevisionKey(ks, "111", time.Unix(100, 0)),
+		ItemRevisionKey(ks, "22", time.Unix(100, 0)),
+	}
+	for i := 1; i != len(cases); i++ {
+		c.Check(cases[i-1] < cases[i], gc.Equals, true)
+	}
+	// Revisions are under the KeySpace IgnorePrefix.
+	c.Check(cases[0], gc.Equals, "/root/history/11#000000174876e800")
+	c.Check(strings.HasPrefix(cases[0], ks.IgnorePrefix), gc.Equals, true)
 }
 
 func (s *AllocKeySpaceSuite) TestKeyConstructorsAssertSepOrdering(c *gc.C) {
@@ -92,6 +108,7 @@ func (s *AllocKeySpaceSuite) TestKeyConstructorsAssertSepOrdering(c *gc.C) {
 		func() { MemberKey(ks, "zone", "suffix\x00") },
 
 		func() { ItemKey(ks, "item\x00") },
+		func() { ItemRevisionKey(ks, "item\x00", time.Unix(0, 0)) },
 
 		func() { AssignmentKey(ks, Assignment{ItemID: "item\x00", MemberZone: "zone", MemberSuffix: "suffix"}) },
 		func() { AssignmentKey(ks, Assignment{ItemID: "item", MemberZone: "zone\x00", MemberSuffix: "suffix"}) },
@@ -0,0 +1,55 @@
+package allocator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.gazette.dev/core/keyspace"
+)
+
+// ListItemRevisions returns up to |limit| recorded revisions of Item |itemID|,
+// or of all Items if |itemID| is empty, in key order. Listing begins from
+// |pageToken|, which is empty or the token returned by a previous call. The
+// returned token is non-empty if further revisions remain to be listed.
+func ListItemRevisions(ctx context.Context, kv clientv3.KV, ks *keyspace.KeySpace,
+	itemID, pageToken string, limit int) ([]*mvccpb.KeyValue, string, error) {
+
+	var prefix = RevisionsPrefix(ks)
+	if itemID != "" {
+		prefix = ItemRevisionsPrefix(ks, itemID)
+	}
+	var from = prefix
+
+	if pageToken != "" {
+		if !strings.HasPrefix(pageToken, prefix) {
+			return nil, "", fmt.Errorf("invalid page token %q", pageToken)
+		}
+		from = pageToken
+	}
+
+	var resp, err = kv.Get(ctx, from,
+		clientv3.WithRange(clientv3.GetPrefixRangeEnd(prefix)),
+		clientv3.WithLimit(int64(limit)))
+	if err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if resp.More && len(resp.Kvs) != 0 {
+		// The next page begins immediately after the last returned key.
+		next = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+	return resp.Kvs, next, nil
+}
+
+// PruneItemRevisions deletes revisions of Item |itemID| which were recorded
+// before time |t|.
+func PruneItemRevisions(ctx context.Context, kv clientv3.KV, ks *keyspace.KeySpace, itemID string, t time.Time) error {
+	var _, err = kv.Delete(ctx, ItemRevisionsPrefix(ks, itemID),
+		clientv3.WithRange(ItemRevisionKey(ks, itemID, t)))
+	return err
+}
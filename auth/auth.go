@@ -0,0 +1,192 @@
+// Package auth implements the verification and issuance of authorizations
+// for Gazette services, as bearer tokens attached to gRPC request metadata.
+//
+// Tokens are JSON Web Tokens signed with HMAC-SHA256 using a shared secret
+// key. In addition to registered claims ("sub", "exp", "iat"), token claims
+// include the protocol.Capability bit-mask granted by the token ("cap"),
+// and the canonical string form of a protocol.LabelSelector ("sel") which
+// scopes the resources to which the capability applies. For example:
+//
+//	{"sub": "my-service", "cap": 6, "sel": "prefix=examples/foobar/", "exp": 1735689600}
+//
+// grants READ and APPEND capabilities to journals which are prefixed by
+// "examples/foobar/".
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+	pb "go.gazette.dev/core/broker/protocol"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// KeyedAuth implements protocol.Auth using HMAC-SHA256 signed tokens.
+// Tokens are signed using the first of its keys, and may be verified
+// using any of its keys, which allows for the rotation of keys.
+type KeyedAuth struct {
+	keys [][]byte
+}
+
+// NewKeyedAuth returns a KeyedAuth of the whitespace-separated,
+// base64-encoded secret keys of |encodedKeys|.
+func NewKeyedAuth(encodedKeys string) (*KeyedAuth, error) {
+	var a = new(KeyedAuth)
+
+	for i, encoded := range strings.Fields(encodedKeys) {
+		if key, err := base64.StdEncoding.DecodeString(encoded); err != nil {
+			return nil, errors.WithMessagef(err, "decoding key at index %d", i)
+		} else if len(key) == 0 {
+			return nil, fmt.Errorf("key at index %d is empty", i)
+		} else {
+			a.keys = append(a.keys, key)
+		}
+	}
+	if len(a.keys) == 0 {
+		return nil, fmt.Errorf("at least one key must be provided")
+	}
+	return a, nil
+}
+
+// NewKeyedAuthFromFile returns a KeyedAuth of the whitespace-separated,
+// base64-encoded secret keys of the file at |path|.
+func NewKeyedAuthFromFile(path string) (*KeyedAuth, error) {
+	var b, err = ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithMessage(err, "reading keys file")
+	}
+	a, err := NewKeyedAuth(string(b))
+	if err != nil {
+		return nil, errors.WithMessagef(err, "keys file %s", path)
+	}
+	return a, nil
+}
+
+// Sign returns a token of the Claims, which expires after |ttl|
+// or at Claims.ExpiresAt, whichever is sooner. If |ttl| is zero,
+// the token expires at Claims.ExpiresAt (if set).
+func (a *KeyedAuth) Sign(claims pb.Claims, ttl time.Duration) (string, error) {
+	if err := claims.Validate(); err != nil {
+		return "", err
+	}
+	var now = time.Now()
+
+	var expiresAt = claims.ExpiresAt
+	if ttl != 0 && (expiresAt.IsZero() || now.Add(ttl).Before(expiresAt)) {
+		expiresAt = now.Add(ttl)
+	}
+
+	var tc = tokenClaims{
+		Capability: uint32(claims.Capability),
+		Selector:   claims.Selector.String(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:  claims.Subject,
+			IssuedAt: jwt.NewNumericDate(now),
+		},
+	}
+	if !expiresAt.IsZero() {
+		tc.ExpiresAt = jwt.NewNumericDate(expiresAt)
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, tc).SignedString(a.keys[0])
+}
+
+// Authorize attaches a signed token of the Claims to the outgoing
+// metadata of the Context.
+func (a *KeyedAuth) Authorize(ctx context.Context, claims pb.Claims, ttl time.Duration) (context.Context, error) {
+	var token, err = a.Sign(claims, ttl)
+	if err != nil {
+		return nil, err
+	}
+	return WithBearerToken(ctx, token), nil
+}
+
+// Verify the bearer token of the incoming Context, returning its Claims.
+func (a *KeyedAuth) Verify(ctx context.Context) (pb.Claims, error) {
+	var token, err = bearerToken(ctx)
+	if err != nil {
+		return pb.Claims{}, status.Error(codes.Unauthenticated, err.Error())
+	}
+	claims, err := a.parse(token)
+	if err != nil {
+		return pb.Claims{}, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return claims, nil
+}
+
+func (a *KeyedAuth) parse(token string) (pb.Claims, error) {
+	var tc tokenClaims
+	var err error
+
+	for _, key := range a.keys {
+		var key = key
+		tc = tokenClaims{}
+		_, err = jwt.ParseWithClaims(token, &tc, func(t *jwt.Token) (interface{}, error) {
+			if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("unexpected token signing method %s", t.Header["alg"])
+			}
+			return key, nil
+		})
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return pb.Claims{}, errors.WithMessage(err, "verifying authorization token")
+	}
+
+	var out = pb.Claims{
+		Subject:    tc.Subject,
+		Capability: pb.Capability(tc.Capability),
+	}
+	if tc.ExpiresAt != nil {
+		out.ExpiresAt = tc.ExpiresAt.Time
+	}
+	if out.Selector, err = pb.ParseLabelSelector(tc.Selector); err != nil {
+		return pb.Claims{}, errors.WithMessage(err, "parsing authorization token selector")
+	}
+	return out, nil
+}
+
+// tokenClaims is the JSON representation of Claims within a token.
+type tokenClaims struct {
+	Capability uint32 `json:"cap"`
+	Selector   string `json:"sel,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// WithBearerToken returns a Context having outgoing metadata which
+// authorizes RPCs with the bearer |token|, replacing any other
+// authorization already present.
+func WithBearerToken(ctx context.Context, token string) context.Context {
+	var md, _ = metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set(authorizationKey, "Bearer "+token)
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// bearerToken extracts the bearer token of the incoming Context's metadata.
+func bearerToken(ctx context.Context) (string, error) {
+	var md, _ = metadata.FromIncomingContext(ctx)
+	var values = md.Get(authorizationKey)
+
+	if len(values) == 0 {
+		return "", fmt.Errorf("missing authorization")
+	} else if len(values) != 1 {
+		return "", fmt.Errorf("expected a single authorization (not %d)", len(values))
+	} else if !strings.HasPrefix(values[0], "Bearer ") {
+		return "", fmt.Errorf("expected a Bearer authorization")
+	}
+	return strings.TrimPrefix(values[0], "Bearer "), nil
+}
+
+const authorizationKey = "authorization"
+
+var _ pb.Auth = (*KeyedAuth)(nil)
@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestKeyedAuthRoundTrip(t *testing.T) {
+	var a, err = NewKeyedAuth(encode("key-one") + "\n" + encode("key-two"))
+	require.NoError(t, err)
+
+	var claims = pb.Claims{
+		Subject:    "a-subject",
+		Capability: pb.Capability_READ | pb.Capability_APPEND,
+		Selector: pb.LabelSelector{
+			Include: pb.MustLabelSet("prefix", "foo/", "bar", "baz"),
+			Exclude: pb.MustLabelSet("bing", ""),
+		},
+		ExpiresAt: time.Now().Add(time.Hour).Truncate(time.Second),
+	}
+
+	// Claims pass through an authorized outgoing Context,
+	// to a verified incoming Context.
+	ctx, err := a.Authorize(context.Background(), claims, 0)
+	require.NoError(t, err)
+
+	out, err := a.Verify(outgoingToIncoming(ctx))
+	require.NoError(t, err)
+	require.Equal(t, claims, out)
+
+	// A |ttl| sooner than ExpiresAt is used instead.
+	ctx, err = a.Authorize(context.Background(), claims, time.Minute)
+	require.NoError(t, err)
+
+	out, err = a.Verify(outgoingToIncoming(ctx))
+	require.NoError(t, err)
+	require.True(t, out.ExpiresAt.Before(claims.ExpiresAt))
+
+	// Tokens signed with a key which is later rotated are still verified.
+	rotated, err := NewKeyedAuth(encode("key-three") + " " + encode("key-one"))
+	require.NoError(t, err)
+	_, err = rotated.Verify(outgoingToIncoming(ctx))
+	require.NoError(t, err)
+}
+
+func TestKeyedAuthVerificationErrors(t *testing.T) {
+	var a, err = NewKeyedAuth(encode("a-key"))
+	require.NoError(t, err)
+	other, err := NewKeyedAuth(encode("other-key"))
+	require.NoError(t, err)
+
+	var verify = func(ctx context.Context) error {
+		var _, err = a.Verify(outgoingToIncoming(ctx))
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		return err
+	}
+	var ctx = context.Background()
+
+	require.Regexp(t, "missing authorization", verify(ctx))
+	require.Regexp(t, "expected a Bearer authorization",
+		verify(metadata.AppendToOutgoingContext(ctx, "authorization", "Basic abc")))
+	require.Regexp(t, "verifying authorization token: token contains an invalid number of segments",
+		verify(WithBearerToken(ctx, "not-a-token")))
+
+	// Token was signed with an unknown key.
+	authCtx, err := other.Authorize(ctx, pb.Claims{Capability: pb.Capability_ALL}, time.Minute)
+	require.NoError(t, err)
+	require.Regexp(t, "signature is invalid", verify(authCtx))
+
+	// Token is expired.
+	authCtx, err = a.Authorize(ctx, pb.Claims{Capability: pb.Capability_ALL}, -time.Minute)
+	require.NoError(t, err)
+	require.Regexp(t, "token is expired", verify(authCtx))
+}
+
+func TestNewKeyedAuthValidation(t *testing.T) {
+	var _, err = NewKeyedAuth(" \n ")
+	require.EqualError(t, err, "at least one key must be provided")
+	_, err = NewKeyedAuth(encode("ok") + " !!!")
+	require.Regexp(t, "decoding key at index 1: illegal base64 data .*", err)
+
+	var path = filepath.Join(t.TempDir(), "keys")
+	_, err = NewKeyedAuthFromFile(path)
+	require.Regexp(t, "reading keys file: .*", err)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(encode("a-key")+"\n"), 0600))
+	_, err = NewKeyedAuthFromFile(path)
+	require.NoError(t, err)
+}
+
+func TestTokenFile(t *testing.T) {
+	var path = filepath.Join(t.TempDir(), "token")
+
+	var f, err = NewTokenFile("")
+	require.NoError(t, err)
+	require.Nil(t, f)
+
+	_, err = NewTokenFile(path)
+	require.Regexp(t, "stat of token file: .*", err)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("first-token\n"), 0600))
+	f, err = NewTokenFile(path)
+	require.NoError(t, err)
+
+	md, err := f.GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"authorization": "Bearer first-token"}, md)
+
+	// Refresh the token. Ensure its modification time is observably different.
+	require.NoError(t, ioutil.WriteFile(path, []byte("second-token"), 0600))
+	var later = time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, later, later))
+
+	md, err = f.GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"authorization": "Bearer second-token"}, md)
+}
+
+func encode(key string) string { return base64.StdEncoding.EncodeToString([]byte(key)) }
+
+// outgoingToIncoming maps outgoing metadata of the Context to incoming metadata,
+// as would happen in the course of an RPC.
+func outgoingToIncoming(ctx context.Context) context.Context {
+	var md, _ = metadata.FromOutgoingContext(ctx)
+	return metadata.NewIncomingContext(context.Background(), md)
+}
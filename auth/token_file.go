@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/credentials"
+)
+
+// TokenFile is the path of a file holding a bearer token, which is presented
+// with each RPC. The file is re-read as it's modified, which allows tokens
+// to be refreshed without a restart.
+type TokenFile struct {
+	Path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	token   string
+}
+
+// NewTokenFile returns a TokenFile of the given path, or nil if |path| is
+// empty. It returns an error if the file cannot be read.
+func NewTokenFile(path string) (*TokenFile, error) {
+	if path == "" {
+		return nil, nil
+	}
+	var f = &TokenFile{Path: path}
+
+	if _, err := f.load(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials.
+func (f *TokenFile) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	var token, err = f.load()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{authorizationKey: "Bearer " + token}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials.
+// Tokens may be presented over insecure connections (which is common
+// when TLS is terminated by a proxy or service mesh), though this is
+// discouraged.
+func (f *TokenFile) RequireTransportSecurity() bool { return false }
+
+// load returns the current token, first re-reading the TokenFile
+// if it's been modified since it was last loaded.
+func (f *TokenFile) load() (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var info, err = os.Stat(f.Path)
+	if err != nil {
+		return "", errors.WithMessage(err, "stat of token file")
+	} else if info.ModTime().Equal(f.modTime) && f.token != "" {
+		return f.token, nil // Unchanged since last load.
+	}
+
+	b, err := ioutil.ReadFile(f.Path)
+	if err != nil {
+		return "", errors.WithMessage(err, "reading token file")
+	} else if f.token = strings.TrimSpace(string(b)); f.token == "" {
+		return "", errors.Errorf("token file %s is empty", f.Path)
+	}
+	f.modTime = info.ModTime()
+	return f.token, nil
+}
+
+var _ credentials.PerRPCCredentials = (*TokenFile)(nil)
@@ -37,17 +37,29 @@ func (svc *Service) Append(stream pb.Journal_AppendServer) (err error) {
 		return err
 	}
 
+	var claims pb.Claims
+	if claims, err = svc.verify(stream.Context()); err != nil {
+		return err
+	}
+
 	fsm = appendFSM{
-		svc: svc,
-		ctx: stream.Context(),
-		req: *req,
+		svc:       svc,
+		ctx:       stream.Context(),
+		claims:    claims,
+		req:       *req,
+		mayResume: true,
 	}
 	fsm.run(stream.Recv)
 
 	switch fsm.state {
 	case stateProxy:
 		req.Header = &fsm.resolved.Header // Attach resolved Header to |req|, which we'll forward.
-		return proxyAppend(stream, *req, svc.jc)
+
+		var ctx context.Context
+		if ctx, err = svc.authorize(stream.Context(), claims); err != nil {
+			return err
+		}
+		return proxyAppend(ctx, stream, *req, svc.jc)
 	case stateFinished:
 		writeHeadGauge.WithLabelValues(fsm.clientFragment.Journal.String()).
 			Set(float64(fsm.clientFragment.End))
@@ -83,8 +95,9 @@ func (svc *Service) Append(stream pb.Journal_AppendServer) (err error) {
 
 // proxyAppend forwards an AppendRequest to a resolved peer broker.
 // Pass request by value as we'll later mutate it (via RecvMsg).
-func proxyAppend(stream grpc.ServerStream, req pb.AppendRequest, jc pb.JournalClient) error {
-	var ctx = pb.WithDispatchRoute(stream.Context(), req.Header.Route, req.Header.ProcessId)
+// |ctx| is derived from the |stream| Context, and authorizes the peer RPC.
+func proxyAppend(ctx context.Context, stream grpc.ServerStream, req pb.AppendRequest, jc pb.JournalClient) error {
+	ctx = pb.WithDispatchRoute(ctx, req.Header.Route, req.Header.ProcessId)
 
 	var client, err = jc.Append(ctx)
 	if err != nil {
@@ -11,6 +11,8 @@ import (
 	log "github.com/sirupsen/logrus"
 	"go.gazette.dev/core/broker/fragment"
 	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/broker/schema"
+	"go.gazette.dev/core/labels"
 )
 
 // appendFSM is a state machine which models the steps, constraints and
@@ -19,9 +21,10 @@ import (
 // typically awaiting a future KeySpace state, as it converges towards the
 // distributed consistency required for the execution of appends.
 type appendFSM struct {
-	svc *Service
-	ctx context.Context
-	req pb.AppendRequest
+	svc    *Service
+	ctx    context.Context
+	claims pb.Claims // Claims of the caller, or zero-valued if made by the broker.
+	req    pb.AppendRequest
 
 	resolved            *resolution      // Current journal resolution.
 	pln                 *pipeline        // Current replication pipeline.
@@ -34,6 +37,12 @@ type appendFSM struct {
 	clientSummer        hash.Hash        // Summer over the client's content.
 	clientTotalChunks   int64            // Total number of append chunks.
 	clientDelayedChunks int64            // Number of flow-controlled chunks.
+	clientFrames        FrameValidator   // Validator of client content, if ValidateFraming or ValidateSchema.
+	schema              *schema.Schema   // Schema of validated client messages, if ValidateSchema.
+	schemaErr           error            // Schema violation of a validated client message.
+	mayResume           bool             // May a suspended journal be resumed to serve the request?
+	flush               bool             // Should the current Fragment be flushed ahead of this append?
+	resync              bool             // Should a quorum pipeline having dropped peers be re-built?
 	state               appendState      // Current FSM state.
 	err                 error            // Error encountered during FSM execution.
 }
@@ -79,6 +88,10 @@ func (b *appendFSM) run(recv func() (*pb.AppendRequest, error)) {
 	b.clientDelayedChunks = fc.delayedChunks
 
 	b.onReadAcknowledgements()
+
+	if b.state == stateFinished {
+		b.resolved.replica.appendRate.add(b.clientFragment.ContentLength(), timeNow())
+	}
 }
 
 // runTo evaluates appendFSM until |state| is reached and returns true.
@@ -123,8 +136,9 @@ func (b *appendFSM) returnPipeline() {
 
 // onResolve performs resolution (or re-resolution) of the AppendRequest. If
 // the request specifies a future Etcd revision, first block until that
-// revision has been applied to the local KeySpace. This state may be
-// re-entered multiple times.
+// revision has been applied to the local KeySpace. If |mayResume| and the
+// journal is suspended, it's first resumed. This state may be re-entered
+// multiple times.
 func (b *appendFSM) onResolve() {
 	b.mustState(stateResolve)
 
@@ -135,9 +149,16 @@ func (b *appendFSM) onResolve() {
 		requirePrimary:  true,
 		minEtcdRevision: b.readThroughRev,
 		proxyHeader:     b.req.Header,
+		claims:          b.claims,
+		require:         pb.Capability_APPEND,
+	}
+
+	var mayResume func(*pb.JournalSpec) bool
+	if b.mayResume {
+		mayResume = func(*pb.JournalSpec) bool { return true }
 	}
 
-	if b.resolved, b.err = b.svc.resolver.resolve(args); b.err != nil {
+	if b.resolved, b.err = b.svc.resolveOrResume(args, mayResume); b.err != nil {
 		b.state = stateError
 		b.err = errors.WithMessage(b.err, "resolve")
 	} else if b.resolved.status != pb.Status_OK {
@@ -208,8 +229,12 @@ func (b *appendFSM) onStartPipeline() {
 
 	// Do we have an extant pipeline matching our resolved Route? If so, by
 	// construction we also know that it's been synchronized. Otherwise tear
-	// down an older pipeline and start anew.
-	if b.pln != nil && b.pln.Route.Equivalent(&b.resolved.Route) {
+	// down an older pipeline (or one of a different commit mode) and start anew.
+	// If we're to |resync|, a quorum pipeline which has dropped peers is also
+	// re-built, which re-synchronizes the dropped peers with the primary.
+	if b.pln != nil && b.pln.Route.Equivalent(&b.resolved.Route) &&
+		b.pln.quorum == commitQuorum(len(b.resolved.Route.Members), b.resolved.journalSpec.QuorumCommit) &&
+		!(b.resync && b.pln.hasDroppedPeers()) {
 		b.registers.Assign(&b.pln.spool.Registers) // Init from spool registers.
 		b.state = stateUpdateAssignments
 		return
@@ -220,6 +245,20 @@ func (b *appendFSM) onStartPipeline() {
 
 	addTrace(b.ctx, " ... must start new pipeline")
 
+	// Authorize the pipeline's Replicate RPCs to peers of the journal.
+	var plnCtx, err = b.svc.authorize(b.resolved.replica.ctx, pb.Claims{
+		Subject:    b.resolved.localID.Suffix,
+		Capability: pb.Capability_REPLICATE,
+		Selector: pb.LabelSelector{Include: pb.LabelSet{
+			Labels: []pb.Label{{Name: "name", Value: b.req.Journal.StripMeta().String()}},
+		}},
+	})
+	if err != nil {
+		b.err = errors.WithMessage(err, "authorizing pipeline")
+		b.state = stateError
+		return
+	}
+
 	// Attempt to obtain exclusive ownership of the replica's Spool.
 	var spool fragment.Spool
 	select {
@@ -238,7 +277,8 @@ func (b *appendFSM) onStartPipeline() {
 
 	// Build a pipeline around |spool|. Note the pipeline Context is bound
 	// to the replica (rather than our |b.args.ctx|).
-	b.pln = newPipeline(b.resolved.replica.ctx, b.resolved.Header, spool, b.resolved.replica.spoolCh, b.svc.jc)
+	b.pln = newPipeline(plnCtx, b.resolved.Header, spool, b.resolved.replica.spoolCh, b.svc.jc,
+		b.resolved.journalSpec.QuorumCommit)
 	b.state = stateSendPipelineSync
 }
 
@@ -274,8 +314,9 @@ func (b *appendFSM) onRecvPipelineSync() {
 	var rollToRegisters *pb.LabelSet
 	b.rollToOffset, rollToRegisters, b.readThroughRev = b.pln.gatherSync()
 
-	if b.err = b.pln.recvErr(); b.err == nil {
-		b.err = b.pln.sendErr()
+	// Failed peers are tolerated if the pipeline commits on a quorum.
+	if b.err = b.pln.quorumRecvErr(); b.err == nil {
+		b.err = b.pln.quorumSendErr()
 	}
 	addTrace(b.ctx, "gatherSync() => %d, %v, %d, err: %v",
 		b.rollToOffset, rollToRegisters, b.readThroughRev, b.err)
@@ -423,6 +464,13 @@ func (b *appendFSM) onValidatePreconditions() {
 
 		b.resolved.status = pb.Status_REGISTER_MISMATCH
 		b.state = stateError
+	} else if suspend := b.resolved.journalSpec.Suspend; suspend.Level == pb.JournalSpec_Suspend_NONE &&
+		b.pln.spool.End < suspend.Offset && maxOffset <= suspend.Offset && b.req.Offset == 0 {
+		// The journal was suspended at |suspend.Offset| and has since been
+		// resumed. All content through that offset was persisted prior to
+		// suspension, and we may safely re-sync the pipeline at the offset.
+		b.rollToOffset = suspend.Offset
+		b.state = stateSendPipelineSync
 	} else if b.pln.spool.End != maxOffset && b.req.Offset == 0 && b.resolved.journalSpec.Flags.MayWrite() {
 		b.resolved.status = pb.Status_INDEX_HAS_GREATER_OFFSET
 		b.state = stateError
@@ -456,8 +504,13 @@ func (b *appendFSM) onStreamContent(req *pb.AppendRequest, err error) {
 
 		// Potentially roll the Fragment forward ahead of this append. Our
 		// pipeline is synchronized, so we expect this will always succeed
-		// and don't ask for an acknowledgement.
-		var proposal = maybeRollFragment(b.pln.spool, 0, b.resolved.journalSpec.Fragment)
+		// and don't ask for an acknowledgement. If |flush|, we roll in-place
+		// to the current End, which persists the present Fragment.
+		var rollToOffset int64
+		if b.flush {
+			rollToOffset = b.pln.spool.End
+		}
+		var proposal = maybeRollFragment(b.pln.spool, rollToOffset, b.resolved.journalSpec.Fragment)
 
 		if b.pln.spool.Fragment.Fragment != proposal {
 			b.pln.scatter(&pb.ReplicateRequest{
@@ -468,10 +521,11 @@ func (b *appendFSM) onStreamContent(req *pb.AppendRequest, err error) {
 		}
 
 		b.clientFragment = &pb.Fragment{
-			Journal:          b.pln.spool.Journal,
-			Begin:            b.pln.spool.End,
-			End:              b.pln.spool.End,
-			CompressionCodec: b.pln.spool.CompressionCodec,
+			Journal:               b.pln.spool.Journal,
+			Begin:                 b.pln.spool.End,
+			End:                   b.pln.spool.End,
+			CompressionCodec:      b.pln.spool.CompressionCodec,
+			CompressionDictionary: b.pln.spool.CompressionDictionary,
 		}
 		b.clientSummer = sha1.New()
 	}
@@ -497,6 +551,9 @@ func (b *appendFSM) onStreamContent(req *pb.AppendRequest, err error) {
 	} else if err == nil && !b.resolved.journalSpec.Flags.MayWrite() {
 		// Non-empty appends cannot be made to non-writable journals.
 		b.resolved.status = pb.Status_NOT_ALLOWED
+	} else if err == nil && b.validatesContent() && !b.validateContent(req.Content) {
+		// Malformed content is refused before it's replicated.
+		// validateContent has set the status.
 	} else if err == nil {
 		// Regular content chunk. Forward it through the pipeline.
 		b.pln.scatter(&pb.ReplicateRequest{
@@ -506,7 +563,7 @@ func (b *appendFSM) onStreamContent(req *pb.AppendRequest, err error) {
 		_, _ = b.clientSummer.Write(req.Content) // Cannot error.
 		b.clientFragment.End += int64(len(req.Content))
 
-		if b.pln.sendErr() == nil {
+		if b.pln.quorumSendErr() == nil {
 			return
 		}
 	}
@@ -514,6 +571,12 @@ func (b *appendFSM) onStreamContent(req *pb.AppendRequest, err error) {
 	// We've errored, or reached end-of-input for this Append stream.
 	b.clientFragment.Sum = pb.SHA1SumFromDigest(b.clientSummer.Sum(nil))
 
+	// Appended content may not end with a partial message frame.
+	if err == io.EOF && b.clientFrames != nil && b.clientFrames.Partial() != 0 && b.resolved.status == pb.Status_OK {
+		addTrace(b.ctx, "append ends with a partial frame of %d bytes", b.clientFrames.Partial())
+		b.resolved.status = pb.Status_INVALID_FRAMING
+	}
+
 	// Treat a requested register modification without any bytes appended as an error.
 	if err != io.EOF || b.clientFragment.ContentLength() != 0 {
 		// Pass.
@@ -523,7 +586,7 @@ func (b *appendFSM) onStreamContent(req *pb.AppendRequest, err error) {
 
 	var proposal pb.Fragment
 
-	if err == io.EOF && b.pln.sendErr() == nil && b.resolved.status == pb.Status_OK {
+	if err == io.EOF && b.pln.quorumSendErr() == nil && b.resolved.status == pb.Status_OK {
 		if !b.clientCommit {
 			panic("invariant violated: reqCommit = true")
 		}
@@ -566,7 +629,8 @@ func (b *appendFSM) onReadAcknowledgements() {
 	b.mustState(stateReadAcknowledgements)
 
 	// Retain sendErr(), as we cannot safely access it upon sending to |releaseCh|.
-	var sendErr = b.pln.sendErr()
+	// Send errors of peers are tolerated if the pipeline commits on a quorum.
+	var sendErr = b.pln.quorumSendErr()
 	var waitFor, closeAfter = b.pln.barrier()
 
 	if sendErr == nil {
@@ -590,11 +654,18 @@ func (b *appendFSM) onReadAcknowledgements() {
 	// that they may in turn read their responses.
 	defer func() { close(closeAfter) }()
 
-	// We expect an acknowledgement from each peer. If we encountered a send
-	// error, we also expect an EOF from remaining non-broken peers.
+	// We expect an acknowledgement from each peer (or from a quorum of peers).
+	// If we encountered a send error, we also expect an EOF from remaining
+	// non-broken peers.
+	var dropped = b.pln.dropped
 	if b.pln.gatherOK(); sendErr != nil {
 		b.pln.gatherEOF()
 	}
+	// If peers were dropped, pulse the journal to promptly re-build the
+	// pipeline and re-synchronize them.
+	if b.pln.dropped != dropped {
+		b.resolved.replica.requestPulse()
+	}
 
 	// recvErr()s are generally more informational that sendErr()s:
 	// gRPC SendMsg returns io.EOF on remote stream breaks, while RecvMsg
@@ -602,7 +673,7 @@ func (b *appendFSM) onReadAcknowledgements() {
 
 	if b.err != nil || b.resolved.status != pb.Status_OK {
 		b.state = stateError
-	} else if b.err = b.pln.recvErr(); b.err != nil {
+	} else if b.err = b.pln.quorumRecvErr(); b.err != nil {
 		b.state = stateError
 	} else if b.err = sendErr; b.err != nil {
 		b.state = stateError
@@ -611,6 +682,79 @@ func (b *appendFSM) onReadAcknowledgements() {
 	}
 }
 
+// validatesContent returns whether appended content of the journal must be
+// validated, due to its ValidateFraming or ValidateSchema.
+func (b *appendFSM) validatesContent() bool {
+	return b.resolved.journalSpec.ValidateFraming || b.resolved.journalSpec.ValidateSchema
+}
+
+// validateContent returns whether |content| continues a well-formed sequence
+// of message frames of the journal's content-type, having messages which
+// conform to the journal's schema if it enables ValidateSchema. A trailing
+// partial frame is retained by the FrameValidator, to be completed by the
+// client's next content chunk. If |content| is invalid, the status of the
+// resolution is updated to INVALID_FRAMING or SCHEMA_VIOLATION.
+func (b *appendFSM) validateContent(content []byte) bool {
+	var spec = b.resolved.journalSpec
+	var contentType = spec.LabelSet.ValueOf(labels.ContentType)
+
+	var n int
+	var err error
+
+	if b.clientFrames == nil {
+		var validate func([]byte) error
+
+		if spec.ValidateSchema {
+			b.schema = b.lookupSchema(spec.LabelSet.ValueOf(labels.MessageType))
+
+			validate = func(message []byte) error {
+				if b.schema == nil {
+					b.schemaErr = errors.Errorf("schema %s is not registered",
+						spec.LabelSet.ValueOf(labels.MessageType))
+				} else {
+					b.schemaErr = b.schema.Validate(message)
+				}
+				return b.schemaErr
+			}
+		}
+		if b.svc.NewFrameValidator == nil {
+			err = errors.New("broker doesn't support framing validation")
+		} else {
+			b.clientFrames, err = b.svc.NewFrameValidator(contentType, validate)
+		}
+	}
+	if err == nil {
+		n, err = b.clientFrames.Validate(content)
+	}
+	if err != nil {
+		var status, msg = pb.Status_INVALID_FRAMING, "refusing append with invalid framing"
+		if b.schemaErr != nil {
+			status, msg = pb.Status_SCHEMA_VIOLATION, "refusing append which violates its schema"
+		}
+		log.WithFields(log.Fields{
+			"journal":     spec.Name,
+			"contentType": contentType,
+			"offset":      b.clientFragment.End + int64(n),
+			"err":         err,
+		}).Warn(msg)
+
+		b.resolved.status = status
+		return false
+	}
+	return true
+}
+
+// lookupSchema returns the compiled Schema |name| of the KeySpace,
+// or nil if it's not registered.
+func (b *appendFSM) lookupSchema(name string) *schema.Schema {
+	var ks = b.svc.resolver.state.KS
+	defer ks.Mu.RUnlock()
+	ks.Mu.RLock()
+
+	var s, _ = LookupSchema(ks, name)
+	return s
+}
+
 func (b *appendFSM) mustState(s appendState) {
 	if b.state != s {
 		var sHeap = s
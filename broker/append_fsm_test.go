@@ -1,10 +1,12 @@
 package broker
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -702,6 +704,67 @@ func TestFSMStreamAndReadAcknowledgements(t *testing.T) {
 	require.NoError(t, fsm.err)
 	require.Equal(t, pb.Status_OK, fsm.resolved.status)
 
+	// Case: journal framing is validated, and a content chunk is malformed.
+	// Frames of this fake are newline-terminated, and may not contain "!".
+	broker.svc.NewFrameValidator = func(_ string, validate func([]byte) error) (FrameValidator, error) {
+		return &newlineFrameValidator{validate: validate}, nil
+	}
+
+	fsm = appendFSM{svc: broker.svc, ctx: ctx, req: pb.AppendRequest{Journal: "a/journal"}}
+	fsm.runTo(stateStreamContent)
+
+	fsm.resolved.journalSpec.Flags = pb.JournalSpec_O_RDWR // Reset.
+	fsm.resolved.journalSpec.ValidateFraming = true
+
+	fsm.onStreamContent(&pb.AppendRequest{Content: []byte("a\nb")}, nil) // Partial frame.
+	require.Equal(t, 1, fsm.clientFrames.Partial())
+	fsm.onStreamContent(&pb.AppendRequest{Content: []byte("c!\n")}, nil) // Malformed.
+
+	peerRecv(pb.ReplicateRequest{Content: []byte("a\nb")}) // 1st chunk.
+	peerRecv(expect)                                       // Rollback.
+	peerSend(pb.ReplicateResponse{Status: pb.Status_OK})   // Send & read ACK.
+	fsm.onReadAcknowledgements()
+
+	require.Equal(t, stateError, fsm.state)
+	require.NoError(t, fsm.err)
+	require.Equal(t, pb.Status_INVALID_FRAMING, fsm.resolved.status)
+
+	// Case: content is well-formed, but ends with a partial frame.
+	fsm = appendFSM{svc: broker.svc, ctx: ctx, req: pb.AppendRequest{Journal: "a/journal"}}
+	fsm.runTo(stateStreamContent)
+
+	fsm.onStreamContent(&pb.AppendRequest{Content: []byte("a\nb")}, nil) // Partial frame.
+	fsm.onStreamContent(&pb.AppendRequest{}, nil)                        // Intent to commit.
+	fsm.onStreamContent(nil, io.EOF)                                     // Commit.
+
+	peerRecv(pb.ReplicateRequest{Content: []byte("a\nb")}) // 1st chunk.
+	peerRecv(expect)                                       // Rollback.
+	peerSend(pb.ReplicateResponse{Status: pb.Status_OK})   // Send & read ACK.
+	fsm.onReadAcknowledgements()
+
+	require.Equal(t, stateError, fsm.state)
+	require.NoError(t, fsm.err)
+	require.Equal(t, pb.Status_INVALID_FRAMING, fsm.resolved.status)
+
+	fsm.resolved.journalSpec.ValidateFraming = false // Reset.
+
+	// Case: journal messages are validated against a schema which isn't registered.
+	fsm = appendFSM{svc: broker.svc, ctx: ctx, req: pb.AppendRequest{Journal: "a/journal"}}
+	fsm.runTo(stateStreamContent)
+
+	fsm.resolved.journalSpec.ValidateSchema = true
+	fsm.onStreamContent(&pb.AppendRequest{Content: []byte("a\nb")}, nil)
+
+	peerRecv(expect)                                     // Rollback.
+	peerSend(pb.ReplicateResponse{Status: pb.Status_OK}) // Send & read ACK.
+	fsm.onReadAcknowledgements()
+
+	require.Equal(t, stateError, fsm.state)
+	require.NoError(t, fsm.err)
+	require.Equal(t, pb.Status_SCHEMA_VIOLATION, fsm.resolved.status)
+
+	fsm.resolved.journalSpec.ValidateSchema = false // Reset.
+
 	// Case: Writes are allowed again, but pipeline is broken.
 	fsm = appendFSM{svc: broker.svc, ctx: ctx, req: pb.AppendRequest{Journal: "a/journal"}}
 	fsm.runTo(stateStreamContent)
@@ -743,6 +806,234 @@ func TestFSMStreamAndReadAcknowledgements(t *testing.T) {
 	peerB.Cleanup()
 }
 
+// newlineFrameValidator is a FrameValidator of newline-terminated frames,
+// which may not contain "!".
+type newlineFrameValidator struct {
+	validate func([]byte) error
+	partial  []byte
+}
+
+func (v *newlineFrameValidator) Validate(content []byte) (int, error) {
+	if ind := bytes.IndexByte(content, '!'); ind != -1 {
+		return ind, errors.New("invalid frame")
+	}
+	var buf = append(v.partial, content...)
+	var n = bytes.LastIndexByte(buf, '\n') + 1
+
+	if v.validate != nil && n != 0 {
+		if err := v.validate(buf[:n-1]); err != nil {
+			return 0, err
+		}
+	}
+	v.partial = append([]byte(nil), buf[n:]...)
+	return 0, nil
+}
+
+func (v *newlineFrameValidator) Partial() int { return len(v.partial) }
+
+func TestFSMQuorumDropAndRejoin(t *testing.T) {
+	var ctx, etcd = context.Background(), etcdtest.TestClient()
+	defer etcdtest.Cleanup()
+
+	var broker = newTestBroker(t, etcd, pb.ProcessSpec_ID{Zone: "local", Suffix: "broker"})
+	var peerA = newMockBroker(t, etcd, pb.ProcessSpec_ID{Zone: "A", Suffix: "peer"})
+	var peerB = newMockBroker(t, etcd, pb.ProcessSpec_ID{Zone: "B", Suffix: "peer"})
+
+	setTestJournal(broker, pb.JournalSpec{Name: "a/journal", Replication: 3, QuorumCommit: true},
+		broker.id, peerA.id, peerB.id)
+	broker.initialFragmentLoad()
+
+	var fragment = func(begin, end int64, content string) *pb.Fragment {
+		var f = &pb.Fragment{
+			Journal:          "a/journal",
+			Begin:            begin,
+			End:              end,
+			CompressionCodec: pb.CompressionCodec_SNAPPY,
+		}
+		if content != "" {
+			f.Sum = pb.SHA1SumOf(content)
+		}
+		return f
+	}
+	var appendContent = func(fsm *appendFSM, content string) {
+		fsm.onStreamContent(&pb.AppendRequest{Content: []byte(content)}, nil)
+		fsm.onStreamContent(&pb.AppendRequest{}, nil) // Intent to commit.
+		fsm.onStreamContent(nil, io.EOF)              // Commit.
+	}
+
+	// Build and synchronize a quorum pipeline with both peers.
+	var fsm = appendFSM{svc: broker.svc, ctx: ctx, req: pb.AppendRequest{Journal: "a/journal"}}
+	fsm.onResolve()
+	var hdr = fsm.resolved.Header
+
+	go func() {
+		for _, p := range []mockBroker{peerA, peerB} {
+			require.Equal(t, pb.ReplicateRequest{
+				DeprecatedJournal: "a/journal",
+				Header:            boxHeaderProcessID(hdr, p.id),
+				Proposal:          fragment(0, 0, ""),
+				Registers:         boxLabels(),
+				Acknowledge:       true,
+			}, <-p.ReplReqCh)
+			p.ReplRespCh <- pb.ReplicateResponse{Status: pb.Status_OK}
+		}
+	}()
+	require.True(t, fsm.runTo(stateStreamContent))
+
+	// Case: |peerB| stalls, but the append commits with |peerA| and the primary.
+	appendContent(&fsm, "foo")
+
+	for _, p := range []mockBroker{peerA, peerB} {
+		require.Equal(t, pb.ReplicateRequest{Content: []byte("foo")}, <-p.ReplReqCh)
+		require.Equal(t, pb.ReplicateRequest{
+			Proposal:    fragment(0, 3, "foo"),
+			Registers:   boxLabels(),
+			Acknowledge: true,
+		}, <-p.ReplReqCh)
+	}
+	peerA.ReplRespCh <- pb.ReplicateResponse{Status: pb.Status_OK}
+	fsm.onReadAcknowledgements()
+
+	require.Equal(t, stateFinished, fsm.state)
+	require.NoError(t, fsm.err)
+	require.Equal(t, []int{0, 1, 0}, fsm.pln.owed)
+
+	// Case: |peerB| fails. The next append drops it from the pipeline, commits
+	// with a remaining quorum, and requests a pulse to re-synchronize |peerB|.
+	peerB.WriteLoopErrCh <- errors.New("error!")
+	require.Equal(t, context.Canceled, <-peerB.ReadLoopErrCh)
+
+	fsm = appendFSM{svc: broker.svc, ctx: ctx, req: pb.AppendRequest{Journal: "a/journal"}}
+	require.True(t, fsm.runTo(stateStreamContent))
+	appendContent(&fsm, "bar")
+
+	require.Equal(t, pb.ReplicateRequest{Content: []byte("bar")}, <-peerA.ReplReqCh)
+	require.Equal(t, pb.ReplicateRequest{
+		Proposal:    fragment(0, 6, "foobar"),
+		Registers:   boxLabels(),
+		Acknowledge: true,
+	}, <-peerA.ReplReqCh)
+	peerA.ReplRespCh <- pb.ReplicateResponse{Status: pb.Status_OK}
+	fsm.onReadAcknowledgements()
+
+	require.Equal(t, stateFinished, fsm.state)
+	require.NoError(t, fsm.err)
+	require.EqualError(t, fsm.pln.recvErrs[1], `rpc error: code = Unknown desc = error!`)
+	require.Equal(t, 1, fsm.pln.dropped)
+	require.True(t, fsm.pln.hasDroppedPeers())
+	require.Len(t, fsm.resolved.replica.pulseCh, 1)
+
+	// Case: a regular append continues to use the degraded pipeline.
+	fsm = appendFSM{svc: broker.svc, ctx: ctx, req: pb.AppendRequest{Journal: "a/journal"}}
+	require.True(t, fsm.runTo(stateStartPipeline))
+	fsm.onStartPipeline()
+	require.Equal(t, stateUpdateAssignments, fsm.state)
+	require.True(t, fsm.pln.hasDroppedPeers())
+	fsm.returnPipeline()
+
+	// Case: the requested pulse re-builds the pipeline. |peerB| is missing
+	// "bar" and rolls its Spool forward to the proposal End, which is a
+	// PROPOSAL_MISMATCH. The pipeline rolls forward to re-admit |peerB|.
+	<-fsm.resolved.replica.pulseCh
+
+	fsm = appendFSM{
+		svc:    broker.svc,
+		ctx:    ctx,
+		req:    pb.AppendRequest{Journal: "a/journal", DoNotProxy: true},
+		resync: true,
+	}
+	require.True(t, fsm.runTo(stateStartPipeline))
+	fsm.onStartPipeline()
+	require.Equal(t, stateSendPipelineSync, fsm.state)
+	require.Equal(t, io.EOF, <-peerA.ReadLoopErrCh) // EOF sent to peer on prior pipeline.
+	peerA.WriteLoopErrCh <- nil                     // Peer closes.
+
+	fsm.onSendPipelineSync()
+	for _, p := range []mockBroker{peerA, peerB} {
+		require.Equal(t, pb.ReplicateRequest{
+			DeprecatedJournal: "a/journal",
+			Header:            boxHeaderProcessID(fsm.resolved.Header, p.id),
+			Proposal:          fragment(0, 6, "foobar"),
+			Registers:         boxLabels(),
+			Acknowledge:       true,
+		}, <-p.ReplReqCh)
+	}
+	peerA.ReplRespCh <- pb.ReplicateResponse{Status: pb.Status_OK}
+	peerB.ReplRespCh <- pb.ReplicateResponse{
+		Status:    pb.Status_PROPOSAL_MISMATCH,
+		Fragment:  fragment(6, 6, ""),
+		Registers: boxLabels(),
+	}
+	fsm.onRecvPipelineSync()
+
+	require.Equal(t, stateSendPipelineSync, fsm.state)
+	require.Equal(t, int64(6), fsm.rollToOffset)
+	fsm.onSendPipelineSync()
+
+	for _, p := range []mockBroker{peerA, peerB} {
+		require.Equal(t, pb.ReplicateRequest{
+			Proposal:    fragment(6, 6, ""),
+			Registers:   boxLabels(),
+			Acknowledge: true,
+		}, <-p.ReplReqCh)
+		p.ReplRespCh <- pb.ReplicateResponse{Status: pb.Status_OK}
+	}
+	fsm.onRecvPipelineSync()
+
+	require.Equal(t, stateUpdateAssignments, fsm.state)
+	require.NoError(t, fsm.err)
+	require.False(t, fsm.pln.hasDroppedPeers())
+	fsm.returnPipeline()
+
+	// Case: a re-built pipeline drops a peer which fails to synchronize,
+	// and proceeds with a quorum.
+	defer func(d time.Duration) { quorumSyncTimeout = d }(quorumSyncTimeout)
+	quorumSyncTimeout = time.Millisecond
+
+	fsm = appendFSM{
+		svc:    broker.svc,
+		ctx:    ctx,
+		req:    pb.AppendRequest{Journal: "a/journal", DoNotProxy: true},
+		resync: true,
+	}
+	require.True(t, fsm.runTo(stateStartPipeline))
+	fsm.pln.recvErrs[1] = errors.New("fixture") // Mark |peerB| as dropped.
+	fsm.onStartPipeline()
+
+	require.Equal(t, stateSendPipelineSync, fsm.state)
+	for _, p := range []mockBroker{peerA, peerB} {
+		require.Equal(t, io.EOF, <-p.ReadLoopErrCh) // EOF sent to peer on prior pipeline.
+		p.WriteLoopErrCh <- nil                     // Peer closes.
+	}
+
+	fsm.onSendPipelineSync()
+	for _, p := range []mockBroker{peerA, peerB} {
+		require.Equal(t, fragment(6, 6, ""), (<-p.ReplReqCh).Proposal)
+	}
+	peerA.ReplRespCh <- pb.ReplicateResponse{Status: pb.Status_OK} // |peerB| stalls.
+	fsm.onRecvPipelineSync()
+
+	require.Equal(t, stateUpdateAssignments, fsm.state)
+	require.NoError(t, fsm.err)
+	require.Equal(t, errReplicaSyncTimeout, fsm.pln.recvErrs[1])
+	require.Equal(t, 1, fsm.pln.dropped)
+	require.Equal(t, context.Canceled, <-peerB.ReadLoopErrCh) // |peerB| reads its cancellation.
+	peerB.WriteLoopErrCh <- nil
+
+	// Tear down the pipeline.
+	go func() {
+		require.Equal(t, io.EOF, <-peerA.ReadLoopErrCh)
+		peerA.WriteLoopErrCh <- nil
+	}()
+	fsm.pln.shutdown(false)
+	fsm.pln = nil
+	fsm.returnPipeline()
+
+	broker.cleanup()
+	peerA.Cleanup()
+	peerB.Cleanup()
+}
+
 func TestFSMRunBasicCases(t *testing.T) {
 	var etcd = etcdtest.TestClient()
 	defer etcdtest.Cleanup()
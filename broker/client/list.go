@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"io"
+	"sort"
 	"sync/atomic"
 	"time"
 
@@ -9,12 +11,15 @@ import (
 	log "github.com/sirupsen/logrus"
 	pb "go.gazette.dev/core/broker/protocol"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// PolledList periodically polls the List RPC with a given ListRequest, making
-// its most recent result available via List. It's a building block for
-// applications which interact with dynamic journal sets and wish to react
-// to changes in their set membership over time.
+// PolledList watches the Watch RPC with a given ListRequest, making its most
+// recent result available via List. It's a building block for applications
+// which interact with dynamic journal sets and wish to react to changes in
+// their set membership over time. If brokers don't implement Watch,
+// PolledList instead periodically polls the List RPC.
 //
 //      var partitions, _ = protocol.ParseLabelSelector("logs=clicks, source=mobile")
 //      var pl, err = NewPolledList(ctx, client, time.Minute, protocol.ListRequest{
@@ -30,28 +35,36 @@ type PolledList struct {
 }
 
 // NewPolledList returns a PolledList of the ListRequest which is initialized and
-// ready for immediate use, and which will regularly refresh with the given Duration.
-// An error encountered in the first List RPC is returned. Subsequent RPC errors
-// will be logged as warnings and retried as part of regular refreshes.
+// ready for immediate use. An error encountered in the initial Watch (or List)
+// RPC is returned. Subsequent RPC errors will be logged as warnings and the
+// Watch retried after the given Duration. If brokers don't implement Watch,
+// the PolledList instead refreshes by polling List with the given Duration.
 func NewPolledList(ctx context.Context, client pb.JournalClient, dur time.Duration, req pb.ListRequest) (*PolledList, error) {
-	var resp, err = ListAllJournals(ctx, client, req)
-	if err != nil {
-		return nil, err
-	}
 	var pl = &PolledList{
 		ctx:      ctx,
 		client:   client,
 		req:      req,
 		updateCh: make(chan struct{}, 1),
 	}
-	pl.resp.Store(resp)
-	pl.updateCh <- struct{}{}
+	var stream, resp, err = pl.openWatch()
 
-	go pl.periodicRefresh(dur)
+	if status.Code(err) == codes.Unimplemented {
+		// Brokers don't implement Watch. Fall back to polling List.
+		if resp, err = ListAllJournals(ctx, client, req); err != nil {
+			return nil, err
+		}
+		pl.store(resp)
+		go pl.periodicRefresh(dur)
+		return pl, nil
+	} else if err != nil {
+		return nil, err
+	}
+	pl.store(resp)
+	go pl.watch(stream, dur)
 	return pl, nil
 }
 
-// List returns the most recent polled & merged ListResponse (see ListAllJournals).
+// List returns the most recent watched or polled ListResponse (see ListAllJournals).
 func (pl *PolledList) List() *pb.ListResponse { return pl.resp.Load().(*pb.ListResponse) }
 
 // UpdateCh returns a channel which is signaled with each update of the
@@ -59,6 +72,15 @@ func (pl *PolledList) List() *pb.ListResponse { return pl.resp.Load().(*pb.ListR
 // so if multiple goroutines select from UpdateCh only one will wake.
 func (pl *PolledList) UpdateCh() <-chan struct{} { return pl.updateCh }
 
+func (pl *PolledList) store(resp *pb.ListResponse) {
+	pl.resp.Store(resp)
+
+	select {
+	case pl.updateCh <- struct{}{}:
+	default: // Don't block if nobody's reading.
+	}
+}
+
 func (pl *PolledList) periodicRefresh(dur time.Duration) {
 	var ticker = time.NewTicker(dur)
 	for {
@@ -69,12 +91,7 @@ func (pl *PolledList) periodicRefresh(dur time.Duration) {
 				log.WithFields(log.Fields{"err": err, "req": pl.req.String()}).
 					Warn("periodic List refresh failed (will retry)")
 			} else {
-				pl.resp.Store(resp)
-
-				select {
-				case pl.updateCh <- struct{}{}:
-				default: // Don't block if nobody's reading.
-				}
+				pl.store(resp)
 			}
 		case <-pl.ctx.Done():
 			ticker.Stop()
@@ -83,6 +100,111 @@ func (pl *PolledList) periodicRefresh(dur time.Duration) {
 	}
 }
 
+func (pl *PolledList) watch(stream pb.Journal_WatchClient, dur time.Duration) {
+	for {
+		var resp, err = recvWatch(pl.ctx, pl.client, stream)
+		for err == nil {
+			pl.store(applyWatchResponse(pl.List(), resp))
+			resp, err = recvWatch(pl.ctx, pl.client, stream)
+		}
+
+		// Re-open the Watch, after a delay if it failed. The initial snapshot
+		// of the new Watch replaces the current ListResponse.
+		for {
+			if pl.ctx.Err() != nil {
+				return
+			} else if err != io.EOF {
+				log.WithFields(log.Fields{"err": err, "req": pl.req.String()}).
+					Warn("journal Watch failed (will retry)")
+
+				select {
+				case <-time.After(dur):
+				case <-pl.ctx.Done():
+					return
+				}
+			}
+
+			var lr *pb.ListResponse
+			if stream, lr, err = pl.openWatch(); err == nil {
+				pl.store(lr)
+				break
+			}
+		}
+	}
+}
+
+// openWatch starts a Watch RPC, and returns its initial snapshot
+// as a ListResponse.
+func (pl *PolledList) openWatch() (pb.Journal_WatchClient, *pb.ListResponse, error) {
+	// Watch RPCs may be dispatched to any broker.
+	var stream, err = pl.client.Watch(pb.WithDispatchDefault(pl.ctx), &pl.req, grpc.FailFast(false))
+	if err != nil {
+		return nil, nil, mapGRPCCtxErr(pl.ctx, err)
+	}
+	resp, err := recvWatch(pl.ctx, pl.client, stream)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return stream, &pb.ListResponse{
+		Status:   resp.Status,
+		Header:   resp.Header,
+		Journals: resp.Upserts,
+	}, nil
+}
+
+// recvWatch receives the next WatchResponse of the |stream|, and updates the
+// routes of upserted journals if |client| is a DispatchRouter.
+func recvWatch(ctx context.Context, client pb.JournalClient, stream pb.Journal_WatchClient) (*pb.WatchResponse, error) {
+	var resp, err = stream.Recv()
+	if err != nil {
+		return nil, mapGRPCCtxErr(ctx, err)
+	} else if err = resp.Validate(); err != nil {
+		return nil, err
+	} else if resp.Status != pb.Status_OK {
+		return nil, errors.New(resp.Status.String())
+	}
+
+	if dr, ok := client.(pb.DispatchRouter); ok {
+		for _, j := range resp.Upserts {
+			dr.UpdateRoute(j.Spec.Name.String(), &j.Route)
+		}
+	}
+	return resp, nil
+}
+
+// applyWatchResponse returns a new ListResponse which applies the upserts
+// and deletions of the WatchResponse to |prior|, which is not modified.
+// Journals of the returned ListResponse remain ordered on name.
+func applyWatchResponse(prior *pb.ListResponse, resp *pb.WatchResponse) *pb.ListResponse {
+	var skip = make(map[pb.Journal]struct{}, len(resp.Upserts)+len(resp.Deletes))
+	for _, j := range resp.Upserts {
+		skip[j.Spec.Name] = struct{}{}
+	}
+	for _, name := range resp.Deletes {
+		skip[name] = struct{}{}
+	}
+
+	var out = &pb.ListResponse{
+		Status:   resp.Status,
+		Header:   resp.Header,
+		Journals: make([]pb.ListResponse_Journal, 0, len(prior.Journals)+len(resp.Upserts)),
+	}
+	for _, j := range prior.Journals {
+		if _, ok := skip[j.Spec.Name]; !ok {
+			out.Journals = append(out.Journals, j)
+		}
+	}
+	out.Journals = append(out.Journals, resp.Upserts...)
+
+	sort.Slice(out.Journals, func(i, j int) bool {
+		return out.Journals[i].Spec.Name < out.Journals[j].Spec.Name
+	})
+	return out
+}
+
 // ListAllJournals performs a broker journal listing.
 // Any encountered error is returned.
 func ListAllJournals(ctx context.Context, client pb.JournalClient, req pb.ListRequest) (*pb.ListResponse, error) {
@@ -129,9 +251,10 @@ func ApplyJournals(ctx context.Context, jc pb.JournalClient, req *pb.ApplyReques
 }
 
 // ApplyJournalsInBatches is like ApplyJournals, but chunks the ApplyRequest
-// into batches of the given size, which should be less than Etcd's maximum
-// configured transaction size (usually 128). If size is 0 all changes will
-// be attempted in a single transaction. Be aware that ApplyJournalsInBatches
+// into batches of the given size. Each change is applied and recorded in the
+// journal's history as two Etcd operations, and the size should be at most half
+// of Etcd's maximum configured transaction size (usually 128). If size is 0
+// all changes will be attempted in a single transaction. Be aware that ApplyJournalsInBatches
 // may only partially succeed, with some batches having applied and others not.
 // The final ApplyResponse is returned, unless an error occurs.
 // ApplyResponse statuses other than OK are mapped to an error.
@@ -144,9 +267,9 @@ func ApplyJournalsInBatches(ctx context.Context, jc pb.JournalClient, req *pb.Ap
 	for {
 		var r *pb.ApplyRequest
 		if len(req.Changes[offset:]) > size {
-			r = &pb.ApplyRequest{Changes: req.Changes[offset : offset+size]}
+			r = &pb.ApplyRequest{Changes: req.Changes[offset : offset+size], DryRun: req.DryRun}
 		} else {
-			r = &pb.ApplyRequest{Changes: req.Changes[offset:]}
+			r = &pb.ApplyRequest{Changes: req.Changes[offset:], DryRun: req.DryRun}
 		}
 
 		var resp, err = jc.Apply(pb.WithDispatchDefault(ctx), r, grpc.WaitForReady(true))
@@ -164,6 +287,84 @@ func ApplyJournalsInBatches(ctx context.Context, jc pb.JournalClient, req *pb.Ap
 	}
 }
 
+// JournalHistory retrieves recorded JournalSpecRevisions of the named journal
+// via the broker History RPC, or revisions of all journals if |journal| is
+// empty. All pages of revisions are retrieved, and the returned revisions are
+// ordered on ascending revision. HistoryResponse statuses other than OK are
+// mapped to an error.
+func JournalHistory(ctx context.Context, jc pb.JournalClient, journal pb.Journal) (*pb.HistoryResponse, error) {
+	var req = &pb.HistoryRequest{Journal: journal}
+	var out *pb.HistoryResponse
+
+	for {
+		var resp, err = jc.History(pb.WithDispatchDefault(ctx), req, grpc.WaitForReady(true))
+
+		if err != nil {
+			return resp, err
+		} else if err = resp.Validate(); err != nil {
+			return resp, err
+		} else if resp.Status != pb.Status_OK {
+			return resp, errors.New(resp.Status.String())
+		}
+
+		if out == nil {
+			out = resp
+		} else {
+			out.Revisions = append(out.Revisions, resp.Revisions...)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	out.NextPageToken = ""
+
+	sort.SliceStable(out.Revisions, func(i, j int) bool {
+		var ri, rj = out.Revisions[i], out.Revisions[j]
+		if ri.Revision != rj.Revision {
+			return ri.Revision < rj.Revision
+		}
+		return ri.Journal < rj.Journal
+	})
+	return out, nil
+}
+
+// ListSchemas retrieves the SchemaSpecs having the given names via the broker
+// ListSchemas RPC, or all SchemaSpecs if no names are given.
+// ListSchemasResponse statuses other than OK are mapped to an error.
+func ListSchemas(ctx context.Context, jc pb.JournalClient, names ...string) (*pb.ListSchemasResponse, error) {
+	var resp, err = jc.ListSchemas(pb.WithDispatchDefault(ctx),
+		&pb.ListSchemasRequest{Names: names}, grpc.WaitForReady(true))
+
+	if err != nil {
+		return resp, err
+	} else if err = resp.Validate(); err != nil {
+		return resp, err
+	} else if resp.Status != pb.Status_OK {
+		return resp, errors.New(resp.Status.String())
+	}
+	return resp, nil
+}
+
+// ApplySchemas applies schema changes detailed in the ApplySchemasRequest via
+// the broker ApplySchemas RPC. Changes are applied as a single Etcd transaction.
+// ApplySchemasResponse statuses other than OK are mapped to an error, which
+// describes the incompatibility of an INCOMPATIBLE_SCHEMA status.
+func ApplySchemas(ctx context.Context, jc pb.JournalClient, req *pb.ApplySchemasRequest) (*pb.ApplySchemasResponse, error) {
+	var resp, err = jc.ApplySchemas(pb.WithDispatchDefault(ctx), req, grpc.WaitForReady(true))
+
+	if err != nil {
+		return resp, err
+	} else if err = resp.Validate(); err != nil {
+		return resp, err
+	} else if resp.Status == pb.Status_INCOMPATIBLE_SCHEMA {
+		return resp, errors.Errorf("%s: %s", resp.Status, resp.Error)
+	} else if resp.Status != pb.Status_OK {
+		return resp, errors.New(resp.Status.String())
+	}
+	return resp, nil
+}
+
 // ListAllFragments performs multiple Fragments RPCs, as required to join across multiple
 // FragmentsResponse pages, and returns the completed FragmentResponse.
 // Any encountered error is returned.
@@ -193,3 +394,39 @@ func ListAllFragments(ctx context.Context, client pb.RoutedJournalClient, req pb
 	}
 	return resp, nil
 }
+
+// TruncateJournal advances the minimum readable offset of the journal to
+// |offset| via the broker Truncate RPC, and returns the effective minimum
+// offset (which may be larger than |offset| if the journal was previously
+// truncated further). TruncateResponse statuses other than OK are mapped
+// to an error.
+func TruncateJournal(ctx context.Context, client pb.RoutedJournalClient, journal pb.Journal, offset pb.Offset) (pb.Offset, error) {
+	var routedCtx = pb.WithDispatchItemRoute(ctx, client, journal.String(), true)
+
+	if r, err := client.Truncate(routedCtx, &pb.TruncateRequest{Journal: journal, Offset: offset}); err != nil {
+		return 0, mapGRPCCtxErr(ctx, err)
+	} else if err = r.Validate(); err != nil {
+		return 0, err
+	} else if r.Status != pb.Status_OK {
+		return 0, errors.New(r.Status.String())
+	} else {
+		return r.Offset, nil
+	}
+}
+
+// StatJournal returns the status of the journal via the broker Stat RPC,
+// which is served by the journal's primary broker. StatResponse statuses
+// other than OK are mapped to an error.
+func StatJournal(ctx context.Context, client pb.RoutedJournalClient, journal pb.Journal) (*pb.StatResponse, error) {
+	var routedCtx = pb.WithDispatchItemRoute(ctx, client, journal.String(), true)
+
+	if r, err := client.Stat(routedCtx, &pb.StatRequest{Journal: journal}); err != nil {
+		return r, mapGRPCCtxErr(ctx, err)
+	} else if err = r.Validate(); err != nil {
+		return r, err
+	} else if r.Status != pb.Status_OK {
+		return r, errors.New(r.Status.String())
+	} else {
+		return r, nil
+	}
+}
@@ -140,6 +140,59 @@ func (s *ListSuite) TestPolledList(c *gc.C) {
 	c.Check(pl.List(), gc.DeepEquals, &fixture)
 }
 
+func (s *ListSuite) TestPolledListWatch(c *gc.C) {
+	var broker = teststub.NewBroker(c)
+	defer broker.Cleanup()
+
+	var mk = buildListResponseFixture // Alias.
+	var hdr = *buildHeaderFixture(broker)
+
+	// Responses of |respCh| are sent to the current Watch. A nil response
+	// instead fails the Watch.
+	var respCh = make(chan *pb.WatchResponse)
+
+	broker.WatchFunc = func(req *pb.ListRequest, stream pb.Journal_WatchServer) error {
+		for {
+			select {
+			case resp := <-respCh:
+				if resp == nil {
+					return errors.New("whoops")
+				} else if err := stream.Send(resp); err != nil {
+					return err
+				}
+			case <-stream.Context().Done():
+				return nil
+			}
+		}
+	}
+
+	var ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	// Expect NewPolledList returns after receiving an initial snapshot.
+	go func() { respCh <- &pb.WatchResponse{Header: hdr, Upserts: mk("part-one", "part-two")} }()
+
+	var pl, err = NewPolledList(ctx, broker.Client(), 5*time.Millisecond, pb.ListRequest{})
+	c.Check(err, gc.IsNil)
+	c.Check(pl.List(), gc.DeepEquals, &pb.ListResponse{Header: hdr, Journals: mk("part-one", "part-two")})
+	<-pl.UpdateCh() // Expect UpdateCh is initially ready to select.
+
+	// Expect upserts and deletions are applied, and journals remain ordered on name.
+	var updated = mk("part-two")
+	updated[0].ModRevision = 5678
+	respCh <- &pb.WatchResponse{Header: hdr, Upserts: append(mk("part-three"), updated...), Deletes: []pb.Journal{"part-one"}}
+	<-pl.UpdateCh()
+
+	c.Check(pl.List(), gc.DeepEquals, &pb.ListResponse{Header: hdr, Journals: append(mk("part-three"), updated...)})
+
+	// Fail the Watch. Expect it's retried, and its snapshot replaces the PolledList.
+	respCh <- nil
+	respCh <- &pb.WatchResponse{Header: hdr, Upserts: mk("part-four")}
+	<-pl.UpdateCh()
+
+	c.Check(pl.List(), gc.DeepEquals, &pb.ListResponse{Header: hdr, Journals: mk("part-four")})
+}
+
 func (s *ListSuite) TestListAllFragments(c *gc.C) {
 	var broker = teststub.NewBroker(c)
 	defer broker.Cleanup()
@@ -210,6 +263,78 @@ func (s *ListSuite) TestListAllFragments(c *gc.C) {
 	c.Check(err, gc.ErrorMatches, `Status: invalid status \(1000\)`)
 }
 
+func (s *ListSuite) TestTruncateJournal(c *gc.C) {
+	var broker = teststub.NewBroker(c)
+	defer broker.Cleanup()
+
+	var hdr = buildHeaderFixture(broker)
+	var ctx = context.Background()
+	var rjc = pb.NewRoutedJournalClient(broker.Client(), pb.NoopDispatchRouter{})
+
+	// Case: the effective offset is returned.
+	broker.TruncateFunc = func(_ context.Context, req *pb.TruncateRequest) (*pb.TruncateResponse, error) {
+		c.Check(req, gc.DeepEquals, &pb.TruncateRequest{Journal: "a/journal", Offset: 1234})
+		return &pb.TruncateResponse{Header: *hdr, Offset: 5678}, nil
+	}
+	var offset, err = TruncateJournal(ctx, rjc, "a/journal", 1234)
+	c.Check(err, gc.IsNil)
+	c.Check(offset, gc.Equals, int64(5678))
+
+	// Case: broker non-OK status
+	broker.TruncateFunc = func(_ context.Context, req *pb.TruncateRequest) (*pb.TruncateResponse, error) {
+		return &pb.TruncateResponse{Header: *hdr, Status: pb.Status_OFFSET_NOT_YET_AVAILABLE}, nil
+	}
+	_, err = TruncateJournal(ctx, rjc, "a/journal", 1234)
+	c.Check(err, gc.ErrorMatches, pb.Status_OFFSET_NOT_YET_AVAILABLE.String())
+
+	// Case: broker error
+	broker.TruncateFunc = func(_ context.Context, req *pb.TruncateRequest) (*pb.TruncateResponse, error) {
+		return nil, errors.New("something has gone wrong")
+	}
+	_, err = TruncateJournal(ctx, rjc, "a/journal", 1234)
+	c.Check(err, gc.ErrorMatches, `rpc error: code = Unknown desc = something has gone wrong`)
+}
+
+func (s *ListSuite) TestStatJournal(c *gc.C) {
+	var broker = teststub.NewBroker(c)
+	defer broker.Cleanup()
+
+	var hdr = buildHeaderFixture(broker)
+	var ctx = context.Background()
+	var rjc = pb.NewRoutedJournalClient(broker.Client(), pb.NoopDispatchRouter{})
+
+	// Case: the response is returned.
+	var expect = &pb.StatResponse{
+		Header:    *hdr,
+		WriteHead: 1234,
+		Replicas: []pb.StatResponse_Replica{
+			{Id: pb.ProcessSpec_ID{Zone: "a", Suffix: "broker"}, InSync: true},
+		},
+		SpooledBytes: 56,
+	}
+	broker.StatFunc = func(_ context.Context, req *pb.StatRequest) (*pb.StatResponse, error) {
+		c.Check(req, gc.DeepEquals, &pb.StatRequest{Journal: "a/journal"})
+		return expect, nil
+	}
+	var resp, err = StatJournal(ctx, rjc, "a/journal")
+	c.Check(err, gc.IsNil)
+	c.Check(resp, gc.DeepEquals, expect)
+
+	// Case: broker non-OK status
+	broker.StatFunc = func(_ context.Context, req *pb.StatRequest) (*pb.StatResponse, error) {
+		return &pb.StatResponse{Header: *hdr, Status: pb.Status_NO_JOURNAL_PRIMARY_BROKER}, nil
+	}
+	_, err = StatJournal(ctx, rjc, "a/journal")
+	c.Check(err, gc.ErrorMatches, pb.Status_NO_JOURNAL_PRIMARY_BROKER.String())
+
+	// Case: broker error
+	broker.StatFunc = func(_ context.Context, req *pb.StatRequest) (*pb.StatResponse, error) {
+		return nil, errors.New("something has gone wrong")
+	}
+	_, err = StatJournal(ctx, rjc, "a/journal")
+	c.Check(err, gc.ErrorMatches, `rpc error: code = Unknown desc = something has gone wrong`)
+}
+
 func (s *ListSuite) TestApplyJournalsInBatches(c *gc.C) {
 	var broker = teststub.NewBroker(c)
 	defer broker.Cleanup()
@@ -252,6 +377,24 @@ func (s *ListSuite) TestApplyJournalsInBatches(c *gc.C) {
 	c.Check(err, gc.IsNil)
 	c.Check(resp, gc.DeepEquals, expected)
 
+	// Case: batches of a dry-run are also dry-runs.
+	var dryRun = *fixture
+	dryRun.DryRun, iter = true, 0
+
+	broker.ApplyFunc = func(ctx context.Context, req *pb.ApplyRequest) (*pb.ApplyResponse, error) {
+		c.Check(req, gc.DeepEquals, &pb.ApplyRequest{
+			Changes: []pb.ApplyRequest_Change{
+				{Upsert: fixture.Changes[iter].Upsert, ExpectModRevision: 1},
+			},
+			DryRun: true,
+		})
+		iter++
+		return expected, nil
+	}
+	resp, err = ApplyJournalsInBatches(ctx, rjc, &dryRun, 1)
+	c.Check(err, gc.IsNil)
+	c.Check(iter, gc.Equals, len(fixture.Changes))
+
 	// Case: empty list of changes.
 	broker.ApplyFunc = func(ctx context.Context, req *pb.ApplyRequest) (*pb.ApplyResponse, error) {
 		return expected, nil
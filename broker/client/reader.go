@@ -11,6 +11,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.gazette.dev/core/broker/codecs"
+	"go.gazette.dev/core/broker/envelope"
 	pb "go.gazette.dev/core/broker/protocol"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -21,7 +22,9 @@ import (
 //
 // If DoNotProxy is true then the broker may close the RPC after sending a signed
 // Fragment URL, and Reader will directly open the Fragment (decompressing if needed),
-// seek to the requested offset, and read its content.
+// seek to the requested offset, and read its content. If the Fragment is compressed
+// with a dictionary, the broker also signs a URL of the dictionary, which Reader
+// loads before opening the Fragment.
 //
 // Reader returns EOF if:
 //  * The broker closes the RPC, eg because its assignment has change or it's shutting down.
@@ -34,8 +37,8 @@ import (
 // A Reader is invalidated by its first returned error, with the exception of
 // ErrOffsetJump: this error is returned to notify the client that the next Journal
 // offset to be Read is not the offset that was requested (eg, because a portion of
-// the Journal was deleted), but the Reader is prepared to continue at the updated,
-// strictly larger offset.
+// the Journal was deleted, or was skipped due to the ReadRequest BeginModTime),
+// but the Reader is prepared to continue at the updated, strictly larger offset.
 type Reader struct {
 	Request  pb.ReadRequest  // ReadRequest of the Reader.
 	Response pb.ReadResponse // Most recent ReadResponse from broker.
@@ -132,7 +135,7 @@ func (r *Reader) Read(p []byte) (n int, err error) {
 
 		if r.Request.Offset < r.Response.Offset {
 			// Offset jumps are uncommon, but possible if fragments were removed,
-			// or if the requested offset was -1.
+			// were skipped due to BeginModTime, or if the requested offset was -1.
 			r.Request.Offset = r.Response.Offset
 			err = ErrOffsetJump
 		}
@@ -163,7 +166,14 @@ func (r *Reader) Read(p []byte) (n int, err error) {
 	// We read a graceful stream closure (err == io.EOF).
 
 	// If the frame preceding EOF provided a fragment URL, open it directly.
+	// Load its compression dictionary first, if the broker provided one.
 	if !r.Request.MetadataOnly && r.Response.Status == pb.Status_OK && r.Response.FragmentUrl != "" {
+		if r.Response.DictionaryUrl != "" {
+			if err = loadDictionaryURL(r.ctx, r.Response.Fragment.CompressionDictionary,
+				r.Response.DictionaryUrl); err != nil {
+				return
+			}
+		}
 		if r.direct, err = OpenFragmentURL(r.ctx, *r.Response.Fragment,
 			r.Request.Offset, r.Response.FragmentUrl); err == nil {
 			n, err = r.Read(p) // Recurse to attempt read against opened |r.direct|.
@@ -183,6 +193,8 @@ func (r *Reader) Read(p []byte) (n int, err error) {
 		err = ErrInsufficientJournalBrokers
 	case pb.Status_OFFSET_NOT_YET_AVAILABLE:
 		err = ErrOffsetNotYetAvailable
+	case pb.Status_OFFSET_TRUNCATED:
+		err = ErrOffsetTruncated
 	default:
 		err = errors.New(r.Response.Status.String())
 	}
@@ -224,12 +236,17 @@ func (r *Reader) Seek(offset int64, whence int) (int64, error) {
 
 // OpenFragmentURL directly opens the Fragment, which must be available at the
 // given URL, and returns a *FragmentReader which has been pre-seeked to the
-// given offset.
+// given offset. If the Fragment is ZSTANDARD_SEEKABLE and the store supports
+// HTTP range requests, only content beginning with the compressed frame which
+// covers |offset| is fetched. If the Fragment is encrypted, it's decrypted
+// using the envelope.DefaultKMS.
 func OpenFragmentURL(ctx context.Context, fragment pb.Fragment, offset int64, url string) (*FragmentReader, error) {
 	var req, err = http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
+	// Journal offset at which the content of the response begins.
+	var begin = fragment.Begin
 
 	if fragment.CompressionCodec == pb.CompressionCodec_GZIP_OFFLOAD_DECOMPRESSION {
 		// Require that the server send us un-encoded content, offloading
@@ -243,12 +260,26 @@ func OpenFragmentURL(ctx context.Context, fragment pb.Fragment, offset int64, ur
 		// "Content-Encoding: gzip", and it instead directly surfaces the compressed
 		// bytes to us.
 		req.Header.Set("Accept-Encoding", "gzip")
+	} else if fragment.CompressionCodec == pb.CompressionCodec_ZSTANDARD_SEEKABLE && offset > fragment.Begin &&
+		!fragment.Encrypted {
+		// Fetch the Fragment's seek table, and request only the content
+		// which begins with the frame covering |offset|.
+		var table, err = fetchSeekTable(ctx, url)
+		if err != nil {
+			return nil, err
+		} else if table != nil {
+			var compressed, decompressed = table.Locate(offset - fragment.Begin)
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-", compressed))
+			begin += decompressed
+		}
 	}
 
 	resp, err := httpClient.Do(req.WithContext(ctx))
 	if err != nil {
 		return nil, err
-	} else if resp.StatusCode != http.StatusOK {
+	} else if resp.StatusCode == http.StatusOK {
+		begin = fragment.Begin // Range was not requested, or not honored.
+	} else if resp.StatusCode != http.StatusPartialContent || req.Header.Get("Range") == "" {
 		_ = resp.Body.Close()
 		return nil, fmt.Errorf("!OK fetching (%s, %q)", resp.Status, url)
 	}
@@ -264,18 +295,40 @@ func OpenFragmentURL(ctx context.Context, fragment pb.Fragment, offset int64, ur
 	// Record metrics related to opening the fragment.
 	var labels = fragmentLabels(fragment)
 	fragmentOpen.With(labels).Inc()
-	fragmentOpenBytes.With(labels).Add(float64(fragment.End - fragment.Begin))
+	fragmentOpenBytes.With(labels).Add(float64(fragment.End - begin))
 	if resp.ContentLength != -1 {
 		fragmentOpenContentLength.With(labels).Add(float64(resp.ContentLength))
 	}
 
-	return NewFragmentReader(resp.Body, fragment, offset)
+	var body io.ReadCloser = resp.Body
+	if fragment.Encrypted {
+		var dec, err = envelope.NewReader(ctx, resp.Body, envelope.DefaultKMS)
+		if err != nil {
+			_ = resp.Body.Close()
+			return nil, err
+		}
+		body = struct {
+			io.Reader
+			io.Closer
+		}{dec, resp.Body}
+	}
+
+	return NewFragmentReaderAt(body, fragment, begin, offset)
 }
 
 // NewFragmentReader wraps a io.ReadCloser of raw Fragment bytes with a
 // returned *FragmentReader which has been pre-seeked to the given offset.
 func NewFragmentReader(rc io.ReadCloser, fragment pb.Fragment, offset int64) (*FragmentReader, error) {
-	var decomp, err = codecs.NewCodecReader(rc, fragment.CompressionCodec)
+	return NewFragmentReaderAt(rc, fragment, fragment.Begin, offset)
+}
+
+// NewFragmentReaderAt is like NewFragmentReader, but |rc| holds raw Fragment
+// bytes beginning at journal offset |begin|, which is the Fragment Begin or,
+// for a ZSTANDARD_SEEKABLE Fragment, the beginning of one of its frames
+// (as returned by fragment.OpenAt).
+func NewFragmentReaderAt(rc io.ReadCloser, fragment pb.Fragment, begin, offset int64) (*FragmentReader, error) {
+	var decomp, err = codecs.NewCodecReaderWithDictionary(rc, fragment.CompressionCodec,
+		codecs.Dictionary{Name: fragment.CompressionDictionary, Store: fragment.BackingStore})
 	if err != nil {
 		_ = rc.Close()
 		return nil, err
@@ -285,12 +338,12 @@ func NewFragmentReader(rc io.ReadCloser, fragment pb.Fragment, offset int64) (*F
 		decomp:   decomp,
 		raw:      rc,
 		Fragment: fragment,
-		Offset:   fragment.Begin,
+		Offset:   begin,
 		counter:  discardFragmentBytes.With(fragmentLabels(fragment)),
 	}
 
 	// Attempt to seek to |offset| within the fragment.
-	var delta = offset - fragment.Begin
+	var delta = offset - begin
 	if _, err = io.CopyN(ioutil.Discard, fr, delta); err != nil {
 		_ = fr.Close()
 		return nil, err
@@ -368,6 +421,62 @@ func InstallFileTransport(root string) (remove func()) {
 	return func() { httpClient = prevClient }
 }
 
+// fetchSeekTable fetches the SeekTable of the ZSTANDARD_SEEKABLE Fragment at
+// |url| using HTTP range requests. It returns a nil SeekTable if the server
+// doesn't support range requests.
+func fetchSeekTable(ctx context.Context, url string) (codecs.SeekTable, error) {
+	return codecs.LoadSeekTable(func(n int64) ([]byte, error) {
+		return fetchSuffix(ctx, url, n)
+	})
+}
+
+// fetchSuffix fetches the final |n| bytes of |url|. It returns nil
+// if the server doesn't support range requests.
+func fetchSuffix(ctx context.Context, url string, n int64) ([]byte, error) {
+	var req, err = http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=-%d", n))
+
+	resp, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		return ioutil.ReadAll(resp.Body)
+	case http.StatusOK:
+		return nil, nil // Range requests are not supported.
+	default:
+		return nil, fmt.Errorf("!OK fetching seek table (%s, %q)", resp.Status, url)
+	}
+}
+
+// loadDictionaryURL loads the named compression dictionary from |url| into
+// the codecs dictionary cache, if it's not already cached.
+func loadDictionaryURL(ctx context.Context, name, url string) error {
+	var _, err = codecs.LoadDictionaryWith(name, func() ([]byte, error) {
+		var req, err = http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := httpClient.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("!OK fetching dictionary (%s, %q)", resp.Status, url)
+		}
+		return ioutil.ReadAll(resp.Body)
+	})
+	return err
+}
+
 // mapGRPCCtxErr returns ctx.Err() iff |err| represents a gRPC error with a
 // status code matching ctx.Err(). Otherwise, it returns |err| unmodified.
 // In other words, this routine "unwraps" gRPC errors which have their root cause
@@ -396,6 +505,7 @@ var (
 	ErrNotJournalBroker           = errors.New(pb.Status_NOT_JOURNAL_BROKER.String())
 	ErrNotJournalPrimaryBroker    = errors.New(pb.Status_NOT_JOURNAL_PRIMARY_BROKER.String())
 	ErrOffsetNotYetAvailable      = errors.New(pb.Status_OFFSET_NOT_YET_AVAILABLE.String())
+	ErrOffsetTruncated            = errors.New(pb.Status_OFFSET_TRUNCATED.String())
 	ErrRegisterMismatch           = errors.New(pb.Status_REGISTER_MISMATCH.String())
 	ErrWrongAppendOffset          = errors.New(pb.Status_WRONG_APPEND_OFFSET.String())
 
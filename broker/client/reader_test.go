@@ -77,6 +77,62 @@ func (s *ReaderSuite) TestOpenFragmentURLCases(c *gc.C) {
 	c.Check(err, gc.ErrorMatches, `snappy: corrupt input`)
 }
 
+func (s *ReaderSuite) TestSeekableFragmentCases(c *gc.C) {
+	defer func(n int) { codecs.SeekableFrameSize = n }(codecs.SeekableFrameSize)
+	codecs.SeekableFrameSize = 8
+
+	const data = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+	var dir, err = ioutil.TempDir("", "ReaderSuite")
+	c.Assert(err, gc.IsNil)
+	defer func() { c.Check(os.RemoveAll(dir), gc.IsNil) }()
+	defer InstallFileTransport(dir)()
+
+	var frag = pb.Fragment{
+		Journal:          "a/journal",
+		Begin:            100,
+		End:              100 + int64(len(data)),
+		Sum:              pb.SHA1SumOf(data),
+		CompressionCodec: pb.CompressionCodec_ZSTANDARD_SEEKABLE,
+		BackingStore:     pb.FragmentStore("file:///"),
+	}
+	var url = string(frag.BackingStore) + frag.ContentName()
+	var path = filepath.Join(dir, frag.ContentName())
+
+	file, err := os.Create(path)
+	c.Assert(err, gc.IsNil)
+	comp, err := codecs.NewCodecWriter(file, frag.CompressionCodec)
+	c.Assert(err, gc.IsNil)
+	_, err = comp.Write([]byte(data))
+	c.Assert(err, gc.IsNil)
+	c.Assert(comp.Close(), gc.IsNil)
+
+	// Corrupt the magic number of the first frame. Reads which must decode it
+	// fail, while reads which seek past it succeed.
+	_, err = file.WriteAt([]byte{0, 0, 0, 0}, 0)
+	c.Assert(err, gc.IsNil)
+	c.Assert(file.Close(), gc.IsNil)
+
+	var ctx = context.Background()
+
+	// Case: read from the beginning of the fragment.
+	rc, err := OpenFragmentURL(ctx, frag, frag.Begin, url)
+	c.Assert(err, gc.IsNil)
+	_, err = ioutil.ReadAll(rc)
+	c.Check(err, gc.ErrorMatches, `.*magic number mismatch`)
+	c.Check(rc.Close(), gc.IsNil)
+
+	// Case: read from within a later frame, via a URL.
+	rc, err = OpenFragmentURL(ctx, frag, frag.Begin+10, url)
+	c.Assert(err, gc.IsNil)
+	c.Check(rc.Offset, gc.Equals, frag.Begin+10)
+
+	b, err := ioutil.ReadAll(rc)
+	c.Check(err, gc.IsNil)
+	c.Check(string(b), gc.Equals, data[10:])
+	c.Check(rc.Close(), gc.IsNil)
+}
+
 func (s *ReaderSuite) TestReaderCases(c *gc.C) {
 	var frag, url, dir, cleanup = buildFragmentFixture(c)
 	defer cleanup()
@@ -111,6 +167,8 @@ func (s *ReaderSuite) TestReaderCases(c *gc.C) {
 		readFixture{fragment: &pb.Fragment{End: 1, Begin: 0}},
 		// Case 11: fixture returns fragment metadata & URL, then EOF.
 		readFixture{fragment: &frag, fragmentUrl: url},
+		// Case 12: OFFSET_TRUNCATED, with the minimum readable offset.
+		readFixture{status: pb.Status_OFFSET_TRUNCATED, offset: 150},
 	)
 
 	// Case 1: fragment metadata & URL.
@@ -253,6 +311,43 @@ func (s *ReaderSuite) TestReaderCases(c *gc.C) {
 	b, err = ioutil.ReadAll(r)
 	c.Check(string(b), gc.Equals, "hello,")
 	c.Check(err, gc.IsNil)
+
+	// Case 12: OFFSET_TRUNCATED => ErrOffsetTruncated, and the offset is
+	// updated to the minimum readable offset.
+	r = NewReader(ctx, rjc, pb.ReadRequest{Journal: "a/journal", Offset: 105})
+	n, err = r.Read(nil)
+
+	c.Check(n, gc.Equals, 0)
+	c.Check(err, gc.Equals, ErrOffsetTruncated)
+	c.Check(r.Request.Offset, gc.Equals, int64(150))
+}
+
+func (s *ReaderSuite) TestLoadDictionaryURL(c *gc.C) {
+	var dir, err = ioutil.TempDir("", "ReaderSuite")
+	c.Assert(err, gc.IsNil)
+	defer func() { c.Check(os.RemoveAll(dir), gc.IsNil) }()
+	defer InstallFileTransport(dir)()
+
+	var dict = []byte("a dictionary fixture of the ReaderSuite")
+	var name = codecs.DictionaryName(dict)
+	c.Assert(ioutil.WriteFile(filepath.Join(dir, "dict"), dict, 0600), gc.IsNil)
+	c.Assert(ioutil.WriteFile(filepath.Join(dir, "other"), []byte("other"), 0600), gc.IsNil)
+
+	var ctx = context.Background()
+
+	// Case: the URL doesn't exist.
+	c.Check(loadDictionaryURL(ctx, name, "file:///missing"), gc.ErrorMatches,
+		`loading dictionary .*: !OK fetching dictionary \(404 Not Found, "file:///missing"\)`)
+	// Case: the URL has other content.
+	c.Check(loadDictionaryURL(ctx, name, "file:///other"), gc.ErrorMatches,
+		`loaded dictionary .* has unexpected content .*`)
+	// Case: success.
+	c.Check(loadDictionaryURL(ctx, name, "file:///dict"), gc.IsNil)
+
+	// The dictionary is now cached, and no longer requires its store.
+	b, err := codecs.LoadDictionary(codecs.Dictionary{Name: name})
+	c.Check(err, gc.IsNil)
+	c.Check(b, gc.DeepEquals, dict)
 }
 
 func (s *ReaderSuite) TestBufferedOffsetAdjustment(c *gc.C) {
@@ -56,6 +56,10 @@ func (rr *RetryReader) Offset() int64 {
 //  * Cancel is called, or the RetryReader context is cancelled.
 //  * The broker returns OFFSET_NOT_YET_AVAILABLE (ErrOffsetNotYetAvailable)
 //    for a non-blocking ReadRequest.
+//  * The broker returns OFFSET_TRUNCATED (ErrOffsetTruncated), because the
+//    requested offset is below the journal's minimum readable offset. The
+//    Offset is advanced to the minimum readable offset, and the client may
+//    continue reading from it if desired.
 //  * An offset jump occurred (ErrOffsetJump), in which case the client
 //    should inspect the new Offset and may continue reading if desired.
 //  * The broker returns io.EOF upon reaching the requested EndOffset.
@@ -99,6 +103,8 @@ func (rr *RetryReader) Read(p []byte) (n int, err error) {
 			} else {
 				return // Surface to caller.
 			}
+		case ErrOffsetTruncated:
+			return // Surface to caller.
 		case io.EOF, ErrInsufficientJournalBrokers, ErrNotJournalBroker, ErrJournalNotFound:
 			// Suppress logging for expected errors on first read attempt.
 			// We may be racing a concurrent Etcd watch and assignment of the broker cluster.
@@ -82,6 +82,26 @@ func (s *RetrySuite) TestReaderRetries(c *gc.C) {
 	c.Check(string(b), gc.Equals, "foobarbaz.")
 	c.Check(err, gc.IsNil)
 	c.Check(rr.Offset(), gc.Equals, int64(110))
+
+	// Start reader again, at an offset below the minimum readable offset.
+	rr.Restart(pb.ReadRequest{Journal: "a/journal", Offset: 100, EndOffset: 205})
+
+	go serveReadFixtures(c, broker,
+		readFixture{status: pb.Status_OFFSET_TRUNCATED, offset: 200},
+		readFixture{content: "after"},
+	)
+
+	// Expect OFFSET_TRUNCATED is surfaced, with the minimum readable offset.
+	b, err = ioutil.ReadAll(rr)
+	c.Check(b, gc.HasLen, 0)
+	c.Check(err, gc.Equals, ErrOffsetTruncated)
+	c.Check(rr.Offset(), gc.Equals, int64(200))
+
+	// We're able to continue reading from the minimum readable offset.
+	b, err = ioutil.ReadAll(rr)
+	c.Check(string(b), gc.Equals, "after")
+	c.Check(err, gc.IsNil)
+	c.Check(rr.Offset(), gc.Equals, int64(205))
 }
 
 func (s *RetrySuite) TestMisbehavingReaderCases(c *gc.C) {
@@ -5,11 +5,19 @@ import (
 	"io"
 	"io/ioutil"
 
+	"github.com/andybalholm/brotli"
 	"github.com/golang/snappy"
 	"github.com/klauspost/compress/gzip"
+	"github.com/pierrec/lz4/v4"
 	pb "go.gazette.dev/core/broker/protocol"
 )
 
+// BrotliLevel is the compression level of the BROTLI codec, in the range
+// [brotli.BestSpeed, brotli.BestCompression]. Levels beyond the default
+// achieve only marginally better ratios at a much higher CPU cost, which
+// can cause brokers to fall behind in compressing written fragments.
+var BrotliLevel = 9
+
 // Decompressor is a ReadCloser where Close closes and releases Decompressor
 // state, but does not Close or affect the underlying Reader.
 type Decompressor io.ReadCloser
@@ -21,6 +29,13 @@ type Compressor io.WriteCloser
 
 // NewCodecReader returns a Decompressor of the Reader encoded with CompressionCodec.
 func NewCodecReader(r io.Reader, codec pb.CompressionCodec) (Decompressor, error) {
+	return NewCodecReaderWithDictionary(r, codec, Dictionary{})
+}
+
+// NewCodecReaderWithDictionary returns a Decompressor of the Reader encoded
+// with CompressionCodec. The Dictionary is loaded and used by dictionary-backed
+// codecs, and is otherwise ignored.
+func NewCodecReaderWithDictionary(r io.Reader, codec pb.CompressionCodec, dict Dictionary) (Decompressor, error) {
 	switch codec {
 	case pb.CompressionCodec_NONE, pb.CompressionCodec_GZIP_OFFLOAD_DECOMPRESSION:
 		return ioutil.NopCloser(r), nil
@@ -30,6 +45,18 @@ func NewCodecReader(r io.Reader, codec pb.CompressionCodec) (Decompressor, error
 		return ioutil.NopCloser(snappy.NewReader(r)), nil
 	case pb.CompressionCodec_ZSTANDARD:
 		return zstdNewReader(r)
+	case pb.CompressionCodec_ZSTANDARD_SEEKABLE:
+		return newSeekableReader(r)
+	case pb.CompressionCodec_LZ4:
+		return ioutil.NopCloser(lz4.NewReader(r)), nil
+	case pb.CompressionCodec_BROTLI:
+		return ioutil.NopCloser(brotli.NewReader(r)), nil
+	case pb.CompressionCodec_ZSTANDARD_DICT:
+		if content, err := LoadDictionary(dict); err != nil {
+			return nil, err
+		} else {
+			return zstdNewDictReader(r, content)
+		}
 	default:
 		return nil, fmt.Errorf("unsupported codec %s", codec.String())
 	}
@@ -37,6 +64,13 @@ func NewCodecReader(r io.Reader, codec pb.CompressionCodec) (Decompressor, error
 
 // NewCodecWriter returns a Compressor wrapping the Writer encoding with CompressionCodec.
 func NewCodecWriter(w io.Writer, codec pb.CompressionCodec) (Compressor, error) {
+	return NewCodecWriterWithDictionary(w, codec, Dictionary{})
+}
+
+// NewCodecWriterWithDictionary returns a Compressor wrapping the Writer
+// encoding with CompressionCodec. The Dictionary is loaded and used by
+// dictionary-backed codecs, and is otherwise ignored.
+func NewCodecWriterWithDictionary(w io.Writer, codec pb.CompressionCodec, dict Dictionary) (Compressor, error) {
 	switch codec {
 	case pb.CompressionCodec_NONE:
 		return nopWriteCloser{w}, nil
@@ -46,6 +80,18 @@ func NewCodecWriter(w io.Writer, codec pb.CompressionCodec) (Compressor, error)
 		return snappy.NewBufferedWriter(w), nil
 	case pb.CompressionCodec_ZSTANDARD:
 		return zstdNewWriter(w)
+	case pb.CompressionCodec_ZSTANDARD_SEEKABLE:
+		return newSeekableWriter(w)
+	case pb.CompressionCodec_LZ4:
+		return lz4.NewWriter(w), nil
+	case pb.CompressionCodec_BROTLI:
+		return brotli.NewWriterLevel(w, BrotliLevel), nil
+	case pb.CompressionCodec_ZSTANDARD_DICT:
+		if content, err := LoadDictionary(dict); err != nil {
+			return nil, err
+		} else {
+			return zstdNewDictWriter(w, content)
+		}
 	default:
 		return nil, fmt.Errorf("unsupported codec %s", codec.String())
 	}
@@ -62,4 +108,10 @@ var (
 	zstdNewWriter = func(io.Writer) (io.WriteCloser, error) {
 		return nil, fmt.Errorf("ZSTANDARD was not enabled at compile time")
 	}
+	zstdNewDictReader = func(io.Reader, []byte) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("ZSTANDARD was not enabled at compile time")
+	}
+	zstdNewDictWriter = func(io.Writer, []byte) (io.WriteCloser, error) {
+		return nil, fmt.Errorf("ZSTANDARD was not enabled at compile time")
+	}
 )
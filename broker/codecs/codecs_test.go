@@ -0,0 +1,125 @@
+package codecs
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+)
+
+func TestCodecRoundTrip(t *testing.T) {
+	var content = strings.Repeat("The quick brown fox jumps over the lazy dog. ", 1000)
+
+	for _, codec := range []pb.CompressionCodec{
+		pb.CompressionCodec_NONE,
+		pb.CompressionCodec_GZIP,
+		pb.CompressionCodec_ZSTANDARD,
+		pb.CompressionCodec_SNAPPY,
+		pb.CompressionCodec_GZIP_OFFLOAD_DECOMPRESSION,
+		pb.CompressionCodec_ZSTANDARD_SEEKABLE,
+		pb.CompressionCodec_LZ4,
+		pb.CompressionCodec_BROTLI,
+	} {
+		t.Run(codec.String(), func(t *testing.T) {
+			var buf bytes.Buffer
+			var w, err = NewCodecWriter(&buf, codec)
+			if err != nil && codec == pb.CompressionCodec_ZSTANDARD {
+				t.Skip(err.Error()) // Built with the nozstd tag.
+			}
+			require.NoError(t, err)
+
+			// Write in uneven chunks.
+			for _, chunk := range []string{content[:7], content[7:4096], content[4096:]} {
+				var n, err = w.Write([]byte(chunk))
+				require.NoError(t, err)
+				require.Equal(t, len(chunk), n)
+			}
+			require.NoError(t, w.Close())
+
+			if codec != pb.CompressionCodec_NONE {
+				require.Less(t, buf.Len(), len(content)/4)
+			}
+
+			// GZIP_OFFLOAD_DECOMPRESSION readers pass through content which was
+			// already decompressed by the store, so read it as GZIP instead.
+			var readCodec = codec
+			if codec == pb.CompressionCodec_GZIP_OFFLOAD_DECOMPRESSION {
+				readCodec = pb.CompressionCodec_GZIP
+			}
+			r, err := NewCodecReader(bytes.NewReader(buf.Bytes()), readCodec)
+			require.NoError(t, err)
+			b, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			require.Equal(t, content, string(b))
+			require.NoError(t, r.Close())
+		})
+	}
+
+	var _, err = NewCodecWriter(ioutil.Discard, pb.CompressionCodec_INVALID)
+	require.EqualError(t, err, "unsupported codec INVALID")
+	_, err = NewCodecReader(bytes.NewReader(nil), pb.CompressionCodec(9999))
+	require.EqualError(t, err, "unsupported codec 9999")
+}
+
+func TestDictionaryTrainingAndRoundTrip(t *testing.T) {
+	var record = func(i int) []byte {
+		return []byte(fmt.Sprintf(`{"user_id":%d,"event":"page_view","url":"https://example.com/products/%d","agent":"Mozilla/5.0 (X11; Linux x86_64)"}`+"\n", i*7919, i%37))
+	}
+	var samples [][]byte
+	for i := 0; i != 1000; i++ {
+		samples = append(samples, record(i))
+	}
+
+	var dict = TrainDictionary(samples, 512)
+	require.LessOrEqual(t, len(dict), 512)
+	require.Contains(t, string(dict), `"event":"page_view","url":"https://example.com/products/`)
+	require.Equal(t, dict, TrainDictionary(samples, 512)) // Deterministic.
+
+	// Dictionaries are loaded once, and then cached.
+	var loads int
+	defer func(l func(Dictionary) ([]byte, error)) { DictionaryLoader = l }(DictionaryLoader)
+	DictionaryLoader = func(d Dictionary) ([]byte, error) {
+		loads++
+		require.Equal(t, pb.FragmentStore("file:///a/store/"), d.Store)
+
+		if d.Name == "a1a2a3a4a5a6a7a8a9b0b1b2b3b4b5b6b7b8b9c0" {
+			return []byte("not the named dictionary"), nil
+		}
+		return dict, nil
+	}
+	var d = Dictionary{Name: DictionaryName(dict), Store: "file:///a/store/"}
+
+	var _, err = NewCodecWriterWithDictionary(ioutil.Discard, pb.CompressionCodec_ZSTANDARD_DICT,
+		Dictionary{Name: "a1a2a3a4a5a6a7a8a9b0b1b2b3b4b5b6b7b8b9c0", Store: d.Store})
+	require.EqualError(t, err, "loaded dictionary a1a2a3a4a5a6a7a8a9b0b1b2b3b4b5b6b7b8b9c0 "+
+		"has unexpected content (name 7719866c0d6a124b5235ffa7db6fb4bbb3104ac0)")
+
+	var compress = func(codec pb.CompressionCodec, content []byte) []byte {
+		var buf bytes.Buffer
+		var w, err = NewCodecWriterWithDictionary(&buf, codec, d)
+		if err != nil {
+			t.Skip(err.Error()) // Built with the nozstd tag.
+		}
+		_, err = w.Write(content)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+	var content = append(record(1001), record(1002)...)
+	var plain, withDict = compress(pb.CompressionCodec_ZSTANDARD, content),
+		compress(pb.CompressionCodec_ZSTANDARD_DICT, content)
+	require.Less(t, len(withDict), len(plain)/2)
+
+	r, err := NewCodecReaderWithDictionary(bytes.NewReader(withDict), pb.CompressionCodec_ZSTANDARD_DICT, d)
+	require.NoError(t, err)
+	b, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, content, b)
+	require.NoError(t, r.Close())
+
+	require.Equal(t, 2, loads)
+}
@@ -0,0 +1,200 @@
+package codecs
+
+import (
+	"container/heap"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+
+	pb "go.gazette.dev/core/broker/protocol"
+)
+
+// Dictionary identifies the compression dictionary of a dictionary-backed
+// CompressionCodec (ZSTANDARD_DICT). It's ignored by other codecs.
+type Dictionary struct {
+	// Content-addressed Name of the dictionary.
+	Name string
+	// Store from which the dictionary is loaded, if it's not already cached.
+	Store pb.FragmentStore
+}
+
+// DictionaryLoader loads the content of a Dictionary from its Store. It's
+// provided by package fragment, which must be linked into programs which
+// read or write dictionary-compressed Fragments.
+var DictionaryLoader = func(d Dictionary) ([]byte, error) {
+	return nil, fmt.Errorf("no DictionaryLoader is configured")
+}
+
+// DictionaryName returns the content-addressed name of dictionary |content|.
+func DictionaryName(content []byte) string {
+	var sum = sha1.Sum(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// LoadDictionary returns the content of the Dictionary. Dictionaries are
+// immutable, and once loaded they're cached for the lifetime of the process.
+func LoadDictionary(d Dictionary) ([]byte, error) {
+	return LoadDictionaryWith(d.Name, func() ([]byte, error) { return DictionaryLoader(d) })
+}
+
+// LoadDictionaryWith returns the content of the named dictionary. If it's not
+// already cached, it's loaded using |load| rather than the DictionaryLoader,
+// and is verified and then cached for the lifetime of the process.
+func LoadDictionaryWith(name string, load func() ([]byte, error)) ([]byte, error) {
+	dictionaries.mu.Lock()
+	var content, ok = dictionaries.m[name]
+	dictionaries.mu.Unlock()
+
+	if ok {
+		return content, nil
+	}
+	var err error
+	if content, err = load(); err != nil {
+		return nil, fmt.Errorf("loading dictionary %s: %w", name, err)
+	} else if len(content) == 0 {
+		return nil, fmt.Errorf("loaded dictionary %s is empty", name)
+	} else if actual := DictionaryName(content); actual != name {
+		return nil, fmt.Errorf("loaded dictionary %s has unexpected content (name %s)", name, actual)
+	}
+
+	dictionaries.mu.Lock()
+	dictionaries.m[name] = content
+	dictionaries.mu.Unlock()
+
+	return content, nil
+}
+
+// TrainDictionary returns a dictionary of at most |size| bytes which is
+// trained from |samples|, each of which should be a representative message
+// or record of a journal. The dictionary is a "raw content" dictionary,
+// which ZStandard uses as a history of content preceding each compression:
+// it's composed of the segments of |samples| which best cover substrings
+// that occur within many samples. Segments are ordered from least to most
+// valuable, as ZStandard encodes nearer matches more cheaply.
+func TrainDictionary(samples [][]byte, size int) []byte {
+	// Count the number of distinct samples which contain each d-mer.
+	var freq = make(map[uint64]int)
+	var seen = make(map[uint64]struct{})
+
+	for _, sample := range samples {
+		for h := range seen {
+			delete(seen, h)
+		}
+		for i := 0; i+trainDmerSize <= len(sample); i++ {
+			var h = dmerHash(sample[i:])
+			if _, ok := seen[h]; !ok {
+				seen[h] = struct{}{}
+				freq[h]++
+			}
+		}
+	}
+
+	// Score candidate segments of each sample. A d-mer contributes to a
+	// score only if it occurs in more than one sample.
+	var score = func(seg []byte) (s int) {
+		for h := range seen {
+			delete(seen, h)
+		}
+		for i := 0; i+trainDmerSize <= len(seg); i++ {
+			var h = dmerHash(seg[i:])
+			if _, ok := seen[h]; !ok {
+				seen[h] = struct{}{}
+				if f := freq[h]; f > 1 {
+					s += f
+				}
+			}
+		}
+		return s
+	}
+
+	var candidates segmentHeap
+	for _, sample := range samples {
+		for i := 0; i < len(sample); i += trainSegmentSize {
+			var end = i + trainSegmentSize
+			if end > len(sample) {
+				end = len(sample)
+			}
+			if s := score(sample[i:end]); s != 0 {
+				candidates = append(candidates, segment{content: sample[i:end], score: s})
+			}
+		}
+	}
+	heap.Init(&candidates)
+
+	// Greedily select the highest scoring segment. As segments are selected,
+	// the d-mers they cover no longer contribute to the scores of other
+	// segments, which are lazily re-scored as they reach the top of the heap.
+	var selected []segment
+	var total int
+
+	for candidates.Len() != 0 && total < size {
+		var top = heap.Pop(&candidates).(segment)
+
+		if s := score(top.content); s == 0 {
+			continue
+		} else if s < top.score {
+			top.score = s
+			heap.Push(&candidates, top)
+			continue
+		}
+		if rem := size - total; len(top.content) > rem {
+			top.content = top.content[:rem]
+		}
+		for i := 0; i+trainDmerSize <= len(top.content); i++ {
+			delete(freq, dmerHash(top.content[i:]))
+		}
+		selected = append(selected, top)
+		total += len(top.content)
+	}
+
+	// Order segments by ascending score, so that the most valuable are last.
+	sort.SliceStable(selected, func(i, j int) bool { return selected[i].score < selected[j].score })
+
+	var out = make([]byte, 0, total)
+	for _, s := range selected {
+		out = append(out, s.content...)
+	}
+	return out
+}
+
+type segment struct {
+	content []byte
+	score   int
+}
+
+// segmentHeap is a max-heap of segments ordered on score.
+type segmentHeap []segment
+
+func (h segmentHeap) Len() int            { return len(h) }
+func (h segmentHeap) Less(i, j int) bool  { return h[i].score > h[j].score }
+func (h segmentHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *segmentHeap) Push(x interface{}) { *h = append(*h, x.(segment)) }
+func (h *segmentHeap) Pop() interface{} {
+	var old, n = *h, len(*h)
+	var x = old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// dmerHash returns a hash of the leading trainDmerSize bytes of |b|.
+func dmerHash(b []byte) uint64 {
+	var h uint64 = 14695981039346656037 // FNV-1a.
+	for _, c := range b[:trainDmerSize] {
+		h = (h ^ uint64(c)) * 1099511628211
+	}
+	return h
+}
+
+var dictionaries = struct {
+	m  map[string][]byte
+	mu sync.Mutex
+}{m: make(map[string][]byte)}
+
+const (
+	// Length of substrings which are counted across samples.
+	trainDmerSize = 8
+	// Maximum length of segments which are selected into a dictionary.
+	trainSegmentSize = 256
+)
@@ -11,4 +11,11 @@ import (
 func init() {
 	zstdNewReader = func(r io.Reader) (io.ReadCloser, error) { return zstd.NewReader(r), nil }
 	zstdNewWriter = func(w io.Writer) (io.WriteCloser, error) { return zstd.NewWriter(w), nil }
+
+	zstdNewDictReader = func(r io.Reader, dict []byte) (io.ReadCloser, error) {
+		return zstd.NewReaderDict(r, dict), nil
+	}
+	zstdNewDictWriter = func(w io.Writer, dict []byte) (io.WriteCloser, error) {
+		return zstd.NewWriterLevelDict(w, zstd.DefaultCompression, dict), nil
+	}
 }
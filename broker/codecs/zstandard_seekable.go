@@ -0,0 +1,281 @@
+package codecs
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// SeekableFrameSize is the maximum uncompressed size of each frame of a
+// ZSTANDARD_SEEKABLE encoding. Smaller frames allow for more precise seeks,
+// at the expense of compression ratio.
+var SeekableFrameSize = 1 << 20
+
+// SeekTableProbeSize is the length of the encoding suffix which is first
+// loaded by LoadSeekTable. It's sized to hold the complete SeekTable of
+// most encodings.
+var SeekTableProbeSize int64 = 1 << 16
+
+// SeekTableFooterSize is the size of the footer which concludes the seek
+// table of a ZSTANDARD_SEEKABLE encoding.
+const SeekTableFooterSize = 9
+
+// SeekFrame is an entry of a SeekTable.
+type SeekFrame struct {
+	// Size of the encoded frame.
+	CompressedSize uint32
+	// Size of the frame's decoded content.
+	DecompressedSize uint32
+}
+
+// SeekTable indexes the independently decodable frames of a ZSTANDARD_SEEKABLE
+// encoding. Its wire format is that of the ZStandard "seekable" format, which
+// is a skippable frame appended to the final content frame:
+//
+//	Skippable_Magic_Number   (4 bytes, little-endian 0x184D2A5E)
+//	Frame_Size               (4 bytes, little-endian)
+//	Seek_Table_Entries       (8 or 12 bytes each)
+//	Number_Of_Frames         (4 bytes, little-endian)
+//	Seek_Table_Descriptor    (1 byte)
+//	Seekable_Magic_Number    (4 bytes, little-endian 0x8F92EAB1)
+//
+// Entries optionally include a checksum of frame content. Checksums are
+// not written, and are ignored if present.
+type SeekTable []SeekFrame
+
+// Locate returns the compressed and decompressed offsets of the beginning of
+// the frame which covers decompressed |offset|. If |offset| is beyond the
+// encoded content, the offsets of the end of the encoding are returned.
+func (t SeekTable) Locate(offset int64) (compressed, decompressed int64) {
+	for _, f := range t {
+		if decompressed+int64(f.DecompressedSize) > offset {
+			break
+		}
+		compressed += int64(f.CompressedSize)
+		decompressed += int64(f.DecompressedSize)
+	}
+	return
+}
+
+// AppendTo appends the encoding of the SeekTable to |b|.
+func (t SeekTable) AppendTo(b []byte) []byte {
+	var putUint32 = func(v uint32) {
+		var tmp [4]byte
+		binary.LittleEndian.PutUint32(tmp[:], v)
+		b = append(b, tmp[:]...)
+	}
+
+	putUint32(seekableSkippableMagic)
+	putUint32(uint32(8*len(t) + SeekTableFooterSize))
+
+	for _, f := range t {
+		putUint32(f.CompressedSize)
+		putUint32(f.DecompressedSize)
+	}
+	putUint32(uint32(len(t)))
+	b = append(b, 0) // Seek_Table_Descriptor: no checksums.
+	putUint32(seekableMagic)
+
+	return b
+}
+
+// SeekTableSize returns the total encoded size of a SeekTable, given the
+// final SeekTableFooterSize bytes of a ZSTANDARD_SEEKABLE encoding.
+func SeekTableSize(footer []byte) (int64, error) {
+	if len(footer) != SeekTableFooterSize {
+		return 0, fmt.Errorf("invalid seek table footer length (%d)", len(footer))
+	} else if m := binary.LittleEndian.Uint32(footer[5:]); m != seekableMagic {
+		return 0, fmt.Errorf("invalid seek table magic number (%x)", m)
+	}
+	var frames = int64(binary.LittleEndian.Uint32(footer[0:4]))
+	return 8 + frames*seekEntrySize(footer[4]) + SeekTableFooterSize, nil
+}
+
+// ParseSeekTable parses a SeekTable from |b|, which must end with a complete
+// SeekTable encoding (for example, |b| may be the entire ZSTANDARD_SEEKABLE
+// encoding, or a sufficiently large suffix of it).
+func ParseSeekTable(b []byte) (SeekTable, error) {
+	if len(b) < SeekTableFooterSize {
+		return nil, fmt.Errorf("seek table is truncated")
+	}
+	var size, err = SeekTableSize(b[len(b)-SeekTableFooterSize:])
+	if err != nil {
+		return nil, err
+	} else if int64(len(b)) < size {
+		return nil, fmt.Errorf("seek table is truncated (%d bytes; expected %d)", len(b), size)
+	}
+	b = b[int64(len(b))-size:]
+
+	if m := binary.LittleEndian.Uint32(b[0:4]); m != seekableSkippableMagic {
+		return nil, fmt.Errorf("invalid seek table skippable magic number (%x)", m)
+	} else if fs := int64(binary.LittleEndian.Uint32(b[4:8])); fs != size-8 {
+		return nil, fmt.Errorf("invalid seek table frame size (%d; expected %d)", fs, size-8)
+	}
+
+	var entrySize = int(seekEntrySize(b[len(b)-5]))
+	var out SeekTable
+
+	for e := b[8 : len(b)-SeekTableFooterSize]; len(e) != 0; e = e[entrySize:] {
+		out = append(out, SeekFrame{
+			CompressedSize:   binary.LittleEndian.Uint32(e[0:4]),
+			DecompressedSize: binary.LittleEndian.Uint32(e[4:8]),
+		})
+	}
+	return out, nil
+}
+
+// ReadSeekTable reads the SeekTable of the ZSTANDARD_SEEKABLE encoding
+// of |size| bytes available through the ReaderAt.
+func ReadSeekTable(r io.ReaderAt, size int64) (SeekTable, error) {
+	var footer [SeekTableFooterSize]byte
+
+	if size < SeekTableFooterSize {
+		return nil, fmt.Errorf("seek table is truncated")
+	} else if _, err := r.ReadAt(footer[:], size-SeekTableFooterSize); err != nil {
+		return nil, fmt.Errorf("reading seek table footer: %w", err)
+	}
+	var tableSize, err = SeekTableSize(footer[:])
+	if err != nil {
+		return nil, err
+	} else if tableSize > size {
+		return nil, fmt.Errorf("seek table is truncated (%d bytes; expected %d)", size, tableSize)
+	}
+
+	var b = make([]byte, tableSize)
+	if _, err = r.ReadAt(b, size-tableSize); err != nil {
+		return nil, fmt.Errorf("reading seek table: %w", err)
+	}
+	return ParseSeekTable(b)
+}
+
+// LoadSeekTable loads the SeekTable of a ZSTANDARD_SEEKABLE encoding through
+// |suffix|, which returns the final |n| bytes of the encoding (or all of it,
+// if it's shorter). A suffix of SeekTableProbeSize bytes is loaded first,
+// which usually holds the complete SeekTable, and a larger SeekTable is then
+// loaded in full. If |suffix| returns nil content and no error, LoadSeekTable
+// returns a nil SeekTable.
+func LoadSeekTable(suffix func(n int64) ([]byte, error)) (SeekTable, error) {
+	var tail, err = suffix(SeekTableProbeSize)
+	if tail == nil || err != nil {
+		return nil, err
+	} else if len(tail) < SeekTableFooterSize {
+		return nil, fmt.Errorf("encoding is too short to hold a seek table (%d bytes)", len(tail))
+	}
+
+	size, err := SeekTableSize(tail[len(tail)-SeekTableFooterSize:])
+	if err != nil {
+		return nil, err
+	} else if size > int64(len(tail)) {
+		// The seek table is larger than our probe. Load it in full.
+		if tail, err = suffix(size); tail == nil || err != nil {
+			return nil, err
+		}
+	}
+	return ParseSeekTable(tail)
+}
+
+// seekEntrySize returns the size of a seek table entry, given the
+// Seek_Table_Descriptor which indicates whether checksums are present.
+func seekEntrySize(descriptor byte) int64 {
+	if descriptor&0x80 != 0 {
+		return 12
+	}
+	return 8
+}
+
+// seekableWriter is a Compressor which encodes ZSTANDARD_SEEKABLE. Content
+// is buffered through SeekableFrameSize, and is then encoded as an
+// independent ZStandard frame. The SeekTable is written on Close.
+type seekableWriter struct {
+	w     io.Writer
+	buf   []byte    // Content of the current frame.
+	out   []byte    // Encoding of the current frame.
+	table SeekTable // Frames written thus far.
+}
+
+func newSeekableWriter(w io.Writer) (Compressor, error) {
+	if _, err := getSeekableEncoder(); err != nil {
+		return nil, err
+	}
+	return &seekableWriter{w: w}, nil
+}
+
+func (s *seekableWriter) Write(p []byte) (n int, err error) {
+	for len(p) != 0 {
+		var c = SeekableFrameSize - len(s.buf)
+		if c > len(p) {
+			c = len(p)
+		}
+		s.buf = append(s.buf, p[:c]...)
+		p, n = p[c:], n+c
+
+		if len(s.buf) >= SeekableFrameSize {
+			if err = s.flush(); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// Close encodes any remaining buffered content, and writes the SeekTable.
+func (s *seekableWriter) Close() error {
+	if err := s.flush(); err != nil {
+		return err
+	}
+	var _, err = s.w.Write(s.table.AppendTo(s.out[:0]))
+	s.buf, s.out = nil, nil
+	return err
+}
+
+func (s *seekableWriter) flush() error {
+	if len(s.buf) == 0 {
+		return nil
+	}
+	var enc, _ = getSeekableEncoder()
+	s.out = enc.EncodeAll(s.buf, s.out[:0])
+
+	if _, err := s.w.Write(s.out); err != nil {
+		return err
+	}
+	s.table = append(s.table, SeekFrame{
+		CompressedSize:   uint32(len(s.out)),
+		DecompressedSize: uint32(len(s.buf)),
+	})
+	s.buf = s.buf[:0]
+	return nil
+}
+
+// newSeekableReader returns a Decompressor of a ZSTANDARD_SEEKABLE encoding,
+// or of any suffix of it which begins at a frame boundary. As the SeekTable
+// is itself a skippable frame, it's ignored by the decoder.
+func newSeekableReader(r io.Reader) (Decompressor, error) {
+	var dec, err = zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return dec.IOReadCloser(), nil
+}
+
+// getSeekableEncoder returns a shared *zstd.Encoder, which is used only
+// through its EncodeAll method (which may be called concurrently).
+func getSeekableEncoder() (*zstd.Encoder, error) {
+	seekableEncoderOnce.Do(func() {
+		seekableEncoder, seekableEncoderErr = zstd.NewWriter(nil)
+	})
+	return seekableEncoder, seekableEncoderErr
+}
+
+var (
+	seekableEncoder     *zstd.Encoder
+	seekableEncoderErr  error
+	seekableEncoderOnce sync.Once
+)
+
+const (
+	seekableSkippableMagic = 0x184D2A5E
+	seekableMagic          = 0x8F92EAB1
+)
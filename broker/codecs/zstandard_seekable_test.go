@@ -0,0 +1,136 @@
+package codecs
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+)
+
+func TestSeekableRoundTripAndSeek(t *testing.T) {
+	defer func(n int) { SeekableFrameSize = n }(SeekableFrameSize)
+	SeekableFrameSize = 10
+
+	var content = strings.Repeat("0123456789abcdefghij", 5) + "tail"
+	var buf bytes.Buffer
+
+	// Write in uneven chunks which don't align with frame boundaries.
+	var w, err = NewCodecWriter(&buf, pb.CompressionCodec_ZSTANDARD_SEEKABLE)
+	require.NoError(t, err)
+	for _, chunk := range []string{content[:3], content[3:27], content[27:]} {
+		var n, err = w.Write([]byte(chunk))
+		require.NoError(t, err)
+		require.Equal(t, len(chunk), n)
+	}
+	require.NoError(t, w.Close())
+
+	// Expect the complete encoding round-trips.
+	r, err := NewCodecReader(bytes.NewReader(buf.Bytes()), pb.CompressionCodec_ZSTANDARD_SEEKABLE)
+	require.NoError(t, err)
+	b, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, content, string(b))
+	require.NoError(t, r.Close())
+
+	// Expect the seek table indexes each frame.
+	table, err := ReadSeekTable(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Len(t, table, 11)
+
+	var total int64
+	for i, f := range table {
+		if i != len(table)-1 {
+			require.Equal(t, uint32(10), f.DecompressedSize)
+		} else {
+			require.Equal(t, uint32(4), f.DecompressedSize)
+		}
+		total += int64(f.CompressedSize)
+	}
+	size, err := SeekTableSize(buf.Bytes()[buf.Len()-SeekTableFooterSize:])
+	require.NoError(t, err)
+	require.Equal(t, int64(buf.Len()), total+size)
+
+	// Expect a read may begin from the frame covering any offset.
+	for _, offset := range []int64{0, 9, 10, 47, 100, 103} {
+		var compressed, decompressed = table.Locate(offset)
+		require.True(t, decompressed <= offset && offset < decompressed+10)
+
+		r, err = NewCodecReader(bytes.NewReader(buf.Bytes()[compressed:]), pb.CompressionCodec_ZSTANDARD_SEEKABLE)
+		require.NoError(t, err)
+		b, err = ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, content[decompressed:], string(b))
+	}
+
+	// An offset beyond the content maps to the end of the encoding.
+	var compressed, decompressed = table.Locate(1000)
+	require.Equal(t, total, compressed)
+	require.Equal(t, int64(len(content)), decompressed)
+
+	// The table may be parsed from any sufficient suffix.
+	parsed, err := ParseSeekTable(buf.Bytes()[buf.Len()-int(size):])
+	require.NoError(t, err)
+	require.Equal(t, table, parsed)
+	_, err = ParseSeekTable(buf.Bytes()[buf.Len()-int(size)+1:])
+	require.EqualError(t, err, "seek table is truncated (104 bytes; expected 105)")
+
+	// The table may be loaded through suffixes of the encoding. A probe
+	// smaller than the table requires that it be loaded in full.
+	defer func(n int64) { SeekTableProbeSize = n }(SeekTableProbeSize)
+	for _, probe := range []int64{1 << 16, 20} {
+		SeekTableProbeSize = probe
+
+		var calls []int64
+		parsed, err = LoadSeekTable(func(n int64) ([]byte, error) {
+			calls = append(calls, n)
+			if n > int64(buf.Len()) {
+				n = int64(buf.Len())
+			}
+			return buf.Bytes()[int64(buf.Len())-n:], nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, table, parsed)
+
+		if probe > size {
+			require.Equal(t, []int64{probe}, calls)
+		} else {
+			require.Equal(t, []int64{probe, size}, calls)
+		}
+	}
+}
+
+func TestSeekTableParsingErrors(t *testing.T) {
+	var table = SeekTable{{CompressedSize: 12, DecompressedSize: 34}}
+	var b = table.AppendTo(nil)
+
+	parsed, err := ParseSeekTable(b)
+	require.NoError(t, err)
+	require.Equal(t, table, parsed)
+
+	_, err = ParseSeekTable(b[:5])
+	require.EqualError(t, err, "seek table is truncated")
+
+	var bad = append([]byte(nil), b...)
+	bad[len(bad)-1] ^= 0xff
+	_, err = ParseSeekTable(bad)
+	require.EqualError(t, err, "invalid seek table magic number (7092eab1)")
+
+	bad = append([]byte(nil), b...)
+	bad[0] ^= 0xff
+	_, err = ParseSeekTable(bad)
+	require.EqualError(t, err, "invalid seek table skippable magic number (184d2aa1)")
+
+	// Entries having checksums are parsed (and checksums ignored).
+	var withSums = append([]byte(nil), b[:16]...)
+	withSums = append(withSums, 0xaa, 0xbb, 0xcc, 0xdd)
+	withSums = append(withSums, b[16:]...)
+	withSums[4] += 4                  // Frame_Size.
+	withSums[len(withSums)-5] |= 0x80 // Checksum_Flag.
+
+	parsed, err = ParseSeekTable(withSums)
+	require.NoError(t, err)
+	require.Equal(t, table, parsed)
+}
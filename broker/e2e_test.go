@@ -4,11 +4,15 @@ import (
 	"context"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.gazette.dev/core/auth"
 	pb "go.gazette.dev/core/broker/protocol"
 	"go.gazette.dev/core/etcdtest"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestE2EAppendAndReplicatedRead(t *testing.T) {
@@ -62,6 +66,128 @@ func TestE2EAppendAndReplicatedRead(t *testing.T) {
 	peer.cleanup()
 }
 
+func TestE2EAuthorizedAppendAndProxiedRead(t *testing.T) {
+	var ctx, etcd = pb.WithDispatchDefault(context.Background()), etcdtest.TestClient()
+	defer etcdtest.Cleanup()
+
+	var keyed, err = auth.NewKeyedAuth("c2VjcmV0")
+	require.NoError(t, err)
+
+	var broker = newTestBroker(t, etcd, pb.ProcessSpec_ID{Zone: "local", Suffix: "broker"})
+	var peer = newTestBroker(t, etcd, pb.ProcessSpec_ID{Zone: "peer", Suffix: "broker"})
+	broker.svc.Auth, peer.svc.Auth = keyed, keyed
+
+	// |broker| is primary of both journals. Only "journal/one" is replicated to |peer|.
+	setTestJournal(broker, pb.JournalSpec{Name: "journal/one", Replication: 2}, broker.id, peer.id)
+	setTestJournal(broker, pb.JournalSpec{Name: "journal/two", Replication: 1}, broker.id)
+	peer.catchUpKeySpace()
+
+	broker.initialFragmentLoad()
+	peer.initialFragmentLoad()
+
+	var authorize = func(cap pb.Capability, selector string) context.Context {
+		var sel, err = pb.ParseLabelSelector(selector)
+		require.NoError(t, err)
+		authCtx, err := keyed.Authorize(ctx, pb.Claims{Subject: "tester", Capability: cap, Selector: sel}, time.Minute)
+		require.NoError(t, err)
+		return authCtx
+	}
+	// Appends are made through |peer|, which proxies to the |broker| primary.
+	var appendTo = func(ctx context.Context, journal pb.Journal, content ...string) (*pb.AppendResponse, error) {
+		var stream, err = peer.client().Append(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&pb.AppendRequest{Journal: journal}))
+		for _, c := range content {
+			require.NoError(t, stream.Send(&pb.AppendRequest{Content: []byte(c)}))
+		}
+		if len(content) != 0 {
+			require.NoError(t, stream.Send(&pb.AppendRequest{})) // Intent to commit.
+		}
+		return stream.CloseAndRecv()
+	}
+
+	// Case: request without an authorization.
+	_, err = appendTo(ctx, "journal/one")
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Case: authorization doesn't include the journal.
+	resp, err := appendTo(authorize(pb.Capability_APPEND, "prefix=other/"), "journal/one")
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_NOT_ALLOWED, resp.Status)
+
+	// Case: authorization doesn't grant the APPEND capability.
+	resp, err = appendTo(authorize(pb.Capability_READ, "prefix=journal/"), "journal/one")
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_NOT_ALLOWED, resp.Status)
+
+	// Case: authorized appends are proxied to |broker| with the caller's Claims,
+	// and |broker| authorizes its replication to |peer|.
+	var appendCtx = authorize(pb.Capability_APPEND, "prefix=journal/")
+	resp, err = appendTo(appendCtx, "journal/one", "hello")
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OK, resp.Status)
+	require.Equal(t, broker.id, resp.Header.ProcessId)
+
+	resp, err = appendTo(appendCtx, "journal/two", "world!")
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OK, resp.Status)
+
+	// Case: a read of "journal/two" through |peer| is proxied to |broker|.
+	var rTwo, _ = peer.client().Read(authorize(pb.Capability_READ, "name=journal/two"),
+		&pb.ReadRequest{Journal: "journal/two", EndOffset: 6})
+	readResp, err := rTwo.Recv() // Fragment metadata.
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OK, readResp.Status)
+	expectReadResponse(t, rTwo, pb.ReadResponse{Offset: 0, Content: []byte("world!")})
+
+	// Case: read which isn't authorized for the journal.
+	rTwo, _ = peer.client().Read(authorize(pb.Capability_READ, "name=journal/one"),
+		&pb.ReadRequest{Journal: "journal/two"})
+	readResp, err = rTwo.Recv()
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_NOT_ALLOWED, readResp.Status)
+
+	// Case: List requires the LIST capability, and includes only authorized journals.
+	listResp, err := peer.client().List(authorize(pb.Capability_READ, ""), &pb.ListRequest{})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_NOT_ALLOWED, listResp.Status)
+
+	listResp, err = peer.client().List(authorize(pb.Capability_LIST, "name=journal/two"), &pb.ListRequest{})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OK, listResp.Status)
+	require.Len(t, listResp.Journals, 1)
+	require.Equal(t, pb.Journal("journal/two"), listResp.Journals[0].Spec.Name)
+
+	// Case: Apply requires that all changes are authorized.
+	var spec = listResp.Journals[0].Spec
+	spec.Name = "other/three"
+
+	applyResp, err := peer.client().Apply(authorize(pb.Capability_APPLY, "prefix=journal/"),
+		&pb.ApplyRequest{Changes: []pb.ApplyRequest_Change{{Upsert: &spec, ExpectModRevision: -1}}})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_NOT_ALLOWED, applyResp.Status)
+
+	// Case: journals may not be re-labeled out of the authorization's scope.
+	spec = listResp.Journals[0].Spec
+	spec.LabelSet = pb.MustLabelSet("foo", "bar")
+
+	applyResp, err = peer.client().Apply(authorize(pb.Capability_APPLY, "foo=bar"),
+		&pb.ApplyRequest{Changes: []pb.ApplyRequest_Change{{Upsert: &spec, ExpectModRevision: -1}}})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_NOT_ALLOWED, applyResp.Status)
+
+	applyResp, err = peer.client().Apply(authorize(pb.Capability_APPLY, "prefix=journal/"),
+		&pb.ApplyRequest{Changes: []pb.ApplyRequest_Change{{Upsert: &spec, ExpectModRevision: -1}}})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OK, applyResp.Status)
+
+	broker.tasks.Cancel()
+	peer.tasks.Cancel()
+
+	broker.cleanup()
+	peer.cleanup()
+}
+
 func TestE2EShutdownWithOngoingAppend(t *testing.T) {
 	var ctx, etcd = pb.WithDispatchDefault(context.Background()), etcdtest.TestClient()
 	defer etcdtest.Cleanup()
@@ -0,0 +1,250 @@
+// Package envelope implements the client-side envelope encryption of
+// persisted journal Fragments.
+//
+// Each encrypted Fragment is encrypted with its own randomly-generated data
+// key using AES-256-GCM. The data key is itself encrypted ("wrapped") by a
+// KMS under a named key-encryption key, and the wrapped data key is written
+// to a header which precedes the Fragment's ciphertext:
+//
+//	"GZENC\x01" | uvarint(len(name)) name | uvarint(len(version)) version |
+//	    uvarint(len(wrapped)) wrapped | segment | segment | ... | final segment
+//
+// Content is encrypted in independently-authenticated segments of up to
+// SegmentSize bytes, which allows Fragments of any size to be encrypted and
+// decrypted in a streaming fashion. The GCM nonce of each segment encodes
+// its index and whether it's the final segment of the Fragment, which
+// detects the re-ordering or truncation of segments.
+//
+// The header records the name and version of the key-encryption key which
+// wrapped the data key, and reads of a Fragment unwrap its data key with
+// exactly that key version. A key may therefore be rotated, and Fragments
+// encrypted under its prior versions remain readable so long as the KMS
+// retains those versions.
+package envelope
+
+import (
+	"bufio"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// KMS wraps and unwraps data keys under named key-encryption keys.
+// Keyring is a file-based KMS, and implementations may also delegate to an
+// external key management service.
+type KMS interface {
+	// WrapKey encrypts |dataKey| under the current version of the named key,
+	// returning the wrapped data key and the key version which was used.
+	WrapKey(ctx context.Context, name string, dataKey []byte) (wrapped []byte, version string, err error)
+	// UnwrapKey decrypts a |wrapped| data key which was returned by a
+	// prior call to WrapKey with the given key name and version.
+	UnwrapKey(ctx context.Context, name, version string, wrapped []byte) (dataKey []byte, err error)
+}
+
+// DefaultKMS is the KMS used to encrypt and decrypt Fragments, by brokers
+// and by clients which directly read Fragments from their stores. It may be
+// nil, in which case encrypted Fragments cannot be written or read.
+var DefaultKMS KMS
+
+// SegmentSize is the size of plaintext segments which are encrypted.
+const SegmentSize = 1 << 16
+
+// ErrNoKMS is returned when encrypting or decrypting without a KMS.
+var ErrNoKMS = errors.New("fragment is encrypted but no KMS is configured")
+
+// NewWriter returns a WriteCloser which encrypts content written to it with
+// a new data key, which is wrapped by the KMS under key |name|. The envelope
+// header is written to |w| before NewWriter returns. Close writes the final
+// segment, but does not Close or otherwise affect the underlying Writer.
+func NewWriter(ctx context.Context, w io.Writer, kms KMS, name string) (io.WriteCloser, error) {
+	if kms == nil {
+		return nil, ErrNoKMS
+	}
+	var dataKey = make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, fmt.Errorf("generating data key: %w", err)
+	}
+	var wrapped, version, err = kms.WrapKey(ctx, name, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("wrapping data key: %w", err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var hdr = append([]byte(nil), magic...)
+	for _, field := range [][]byte{[]byte(name), []byte(version), wrapped} {
+		var n [binary.MaxVarintLen64]byte
+		hdr = append(hdr, n[:binary.PutUvarint(n[:], uint64(len(field)))]...)
+		hdr = append(hdr, field...)
+	}
+	if _, err = w.Write(hdr); err != nil {
+		return nil, err
+	}
+
+	return &writer{
+		w:    w,
+		aead: aead,
+		buf:  make([]byte, 0, SegmentSize+aead.Overhead()),
+	}, nil
+}
+
+// NewReader returns a Reader which decrypts the envelope-encrypted content
+// of |r|, unwrapping its data key with the KMS. The envelope header is read
+// before NewReader returns.
+func NewReader(ctx context.Context, r io.Reader, kms KMS) (io.Reader, error) {
+	if kms == nil {
+		return nil, ErrNoKMS
+	}
+	var br = bufio.NewReaderSize(r, SegmentSize+gcmOverhead+1)
+
+	var hdr = make([]byte, len(magic))
+	if _, err := io.ReadFull(br, hdr); err != nil {
+		return nil, fmt.Errorf("reading envelope header: %w", err)
+	} else if string(hdr) != magic {
+		return nil, fmt.Errorf("invalid envelope header (%q)", hdr)
+	}
+
+	var fields [3][]byte
+	for i := range fields {
+		var n, err = binary.ReadUvarint(br)
+		if err != nil {
+			return nil, fmt.Errorf("reading envelope header: %w", err)
+		} else if n > maxHeaderField {
+			return nil, fmt.Errorf("invalid envelope header field length (%d)", n)
+		}
+		fields[i] = make([]byte, n)
+
+		if _, err = io.ReadFull(br, fields[i]); err != nil {
+			return nil, fmt.Errorf("reading envelope header: %w", err)
+		}
+	}
+	var name, version, wrapped = string(fields[0]), string(fields[1]), fields[2]
+
+	var dataKey, err = kms.UnwrapKey(ctx, name, version, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("unwrapping data key (key %s, version %s): %w", name, version, err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reader{
+		br:   br,
+		aead: aead,
+		buf:  make([]byte, SegmentSize+aead.Overhead()),
+	}, nil
+}
+
+type writer struct {
+	w     io.Writer
+	aead  cipher.AEAD
+	buf   []byte // Plaintext of the current segment.
+	index uint64 // Index of the current segment.
+}
+
+func (w *writer) Write(p []byte) (n int, err error) {
+	for len(p) != 0 {
+		// Seal a full segment only once further content is written,
+		// as the final segment must be marked as such.
+		if len(w.buf) == SegmentSize {
+			if err = w.seal(false); err != nil {
+				return
+			}
+		}
+		var c = SegmentSize - len(w.buf)
+		if c > len(p) {
+			c = len(p)
+		}
+		w.buf = append(w.buf, p[:c]...)
+		n, p = n+c, p[c:]
+	}
+	return
+}
+
+func (w *writer) Close() error { return w.seal(true) }
+
+func (w *writer) seal(final bool) error {
+	var nonce = segmentNonce(w.index, final)
+	w.buf = w.aead.Seal(w.buf[:0], nonce[:], w.buf, nil)
+
+	if _, err := w.w.Write(w.buf); err != nil {
+		return err
+	}
+	w.buf, w.index = w.buf[:0], w.index+1
+	return nil
+}
+
+type reader struct {
+	br    *bufio.Reader
+	aead  cipher.AEAD
+	buf   []byte // Buffer of the current segment.
+	plain []byte // Remaining plaintext of the current segment.
+	index uint64 // Index of the next segment.
+	final bool   // Whether the final segment has been read.
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	for len(r.plain) == 0 {
+		if r.final {
+			return 0, io.EOF
+		} else if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+	var n = copy(p, r.plain)
+	r.plain = r.plain[n:]
+	return n, nil
+}
+
+func (r *reader) open() error {
+	var n, err = io.ReadFull(r.br, r.buf)
+
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		r.final = true // A short segment is the final one.
+	} else if err != nil {
+		return err
+	} else if _, err = r.br.Peek(1); err == io.EOF {
+		r.final = true // A full segment may also be the final one.
+	} else if err != nil {
+		return err
+	}
+
+	var nonce = segmentNonce(r.index, r.final)
+	if r.plain, err = r.aead.Open(r.buf[:0], nonce[:], r.buf[:n], nil); err != nil {
+		return fmt.Errorf("decrypting segment %d: %w", r.index, err)
+	}
+	r.index++
+	return nil
+}
+
+// segmentNonce returns the nonce of the segment at |index|. As each data key
+// encrypts only a single Fragment, nonces need only be unique to the segment.
+func segmentNonce(index uint64, final bool) (nonce [12]byte) {
+	binary.BigEndian.PutUint64(nonce[:8], index)
+	if final {
+		nonce[11] = 1
+	}
+	return
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	var block, err = aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+const (
+	magic          = "GZENC\x01"
+	gcmOverhead    = 16
+	maxHeaderField = 1 << 12
+)
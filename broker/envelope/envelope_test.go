@@ -0,0 +1,181 @@
+package envelope
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTripWithSegmentSizes(t *testing.T) {
+	var kr = newTestKeyring(t, 2)
+	var ctx = context.Background()
+
+	for _, size := range []int{0, 1, 100, SegmentSize - 1, SegmentSize, SegmentSize + 1, 3*SegmentSize + 123} {
+		var content = make([]byte, size)
+		_, _ = rand.Read(content)
+
+		var buf bytes.Buffer
+		var w, err = NewWriter(ctx, &buf, kr, "a-key")
+		require.NoError(t, err)
+
+		// Write in irregular chunks.
+		for p := content; len(p) != 0; {
+			var n = 1 + len(p)/3
+			_, err = w.Write(p[:n])
+			require.NoError(t, err)
+			p = p[n:]
+		}
+		require.NoError(t, w.Close())
+		if size > 16 {
+			require.NotContains(t, buf.String(), string(content[:16]))
+		}
+
+		r, err := NewReader(ctx, &buf, kr)
+		require.NoError(t, err)
+		out, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, content, out)
+	}
+}
+
+func TestTamperedAndTruncatedContent(t *testing.T) {
+	var kr = newTestKeyring(t, 1)
+	var ctx = context.Background()
+
+	var content = make([]byte, 2*SegmentSize+10)
+	_, _ = rand.Read(content)
+
+	var buf bytes.Buffer
+	var w, err = NewWriter(ctx, &buf, kr, "a-key")
+	require.NoError(t, err)
+	_, _ = w.Write(content)
+	require.NoError(t, w.Close())
+	var encrypted = buf.Bytes()
+
+	var verify = func(b []byte, expect string) {
+		var r, err = NewReader(ctx, bytes.NewReader(b), kr)
+		if err == nil {
+			_, err = ioutil.ReadAll(r)
+		}
+		require.EqualError(t, err, expect)
+	}
+	var seg = SegmentSize + gcmOverhead
+	var hdr = len(encrypted) - 2*seg - (10 + gcmOverhead)
+
+	// Truncation at a segment boundary.
+	verify(encrypted[:hdr+seg], "decrypting segment 0: cipher: message authentication failed")
+	verify(encrypted[:hdr+2*seg], "decrypting segment 1: cipher: message authentication failed")
+	// Truncation within a segment.
+	verify(encrypted[:hdr+seg+100], "decrypting segment 1: cipher: message authentication failed")
+	// Modified content.
+	var modified = append([]byte(nil), encrypted...)
+	modified[hdr+seg+5] ^= 1
+	verify(modified, "decrypting segment 1: cipher: message authentication failed")
+	// Missing or modified header.
+	modified = append([]byte("XX"), encrypted[2:]...)
+	verify(modified, "invalid envelope header (\"XXENC\\x01\")")
+	verify(nil, "reading envelope header: EOF")
+}
+
+func TestKeyRotation(t *testing.T) {
+	var ctx = context.Background()
+	var dir = t.TempDir()
+	var path = filepath.Join(dir, "keyring.yaml")
+
+	var keys = []string{encodedKey(), encodedKey()}
+	require.NoError(t, os.WriteFile(path, []byte("a-key:\n  - "+keys[0]+"\n"), 0600))
+
+	var kr, err = NewKeyring(path)
+	require.NoError(t, err)
+	var before = encrypt(t, kr, "a-key", "before rotation")
+
+	// Rotate the key, and re-load the keyring.
+	require.NoError(t, os.WriteFile(path, []byte("a-key:\n  - "+keys[0]+"\n  - "+keys[1]+"\n"), 0600))
+	kr, err = NewKeyring(path)
+	require.NoError(t, err)
+	var after = encrypt(t, kr, "a-key", "after rotation")
+
+	// Content written before and after rotation remains readable.
+	require.Equal(t, "before rotation", decrypt(t, kr, before))
+	require.Equal(t, "after rotation", decrypt(t, kr, after))
+
+	// Content is bound to the key version which wrapped its data key.
+	require.NoError(t, os.WriteFile(path, []byte("a-key:\n  - "+keys[1]+"\n"), 0600))
+	kr, err = NewKeyring(path)
+	require.NoError(t, err)
+
+	_, err = NewReader(ctx, bytes.NewReader(before), kr)
+	require.EqualError(t, err, "unwrapping data key (key a-key, version 1): cipher: message authentication failed")
+	_, err = NewReader(ctx, bytes.NewReader(after), kr)
+	require.EqualError(t, err, "unwrapping data key (key a-key, version 2): key a-key version 2 not found in keyring")
+
+	// Unknown keys and missing KMS are errors.
+	_, err = NewWriter(ctx, ioutil.Discard, kr, "other-key")
+	require.EqualError(t, err, "wrapping data key: key other-key not found in keyring")
+	_, err = NewWriter(ctx, ioutil.Discard, nil, "a-key")
+	require.Equal(t, ErrNoKMS, err)
+	_, err = NewReader(ctx, bytes.NewReader(after), nil)
+	require.Equal(t, ErrNoKMS, err)
+}
+
+func TestKeyringValidation(t *testing.T) {
+	var path = filepath.Join(t.TempDir(), "keyring.yaml")
+
+	for _, tc := range []struct {
+		content, expect string
+	}{
+		{"a-key: []\n", "key a-key has no versions"},
+		{"a-key: [\"!!!\"]\n", "decoding key a-key version 1: illegal base64 data at input byte 0"},
+		{"a-key: [" + encodedKey() + ", Zm9v]\n", "invalid key a-key version 2 (3 bytes; expected 32)"},
+		{"a-key: 42\n", "decoding keyring: yaml: unmarshal errors:\n  line 1: cannot unmarshal !!int `42` into []string"},
+	} {
+		require.NoError(t, os.WriteFile(path, []byte(tc.content), 0600))
+		var _, err = NewKeyring(path)
+		require.EqualError(t, err, tc.expect)
+	}
+}
+
+func newTestKeyring(t *testing.T, versions int) *Keyring {
+	var path = filepath.Join(t.TempDir(), "keyring.yaml")
+	var content = "a-key:\n"
+	for i := 0; i != versions; i++ {
+		content += "  - " + encodedKey() + "\n"
+	}
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	var kr, err = NewKeyring(path)
+	require.NoError(t, err)
+	return kr
+}
+
+func encrypt(t *testing.T, kms KMS, name, content string) []byte {
+	var buf bytes.Buffer
+	var w, err = NewWriter(context.Background(), &buf, kms, name)
+	require.NoError(t, err)
+	_, err = io.WriteString(w, content)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func decrypt(t *testing.T, kms KMS, b []byte) string {
+	var r, err = NewReader(context.Background(), bytes.NewReader(b), kms)
+	require.NoError(t, err)
+	out, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	return string(out)
+}
+
+func encodedKey() string {
+	var key = make([]byte, 32)
+	_, _ = rand.Read(key)
+	return base64.StdEncoding.EncodeToString(key)
+}
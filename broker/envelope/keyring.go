@@ -0,0 +1,100 @@
+package envelope
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Keyring is a KMS of key-encryption keys which are loaded from a local file.
+// The file is YAML which maps key names to a list of base64-encoded 256-bit
+// key versions, of which the last is the current version:
+//
+//	# The "compliance" key, which has been rotated once.
+//	compliance:
+//	  - IFZcsDx7cQ+pHIhRTV/KEk9LlLPNYD6kVGj+s0hEsJA=
+//	  - +cP+WNwnxDHfOnkYFe2aaC6TedaDH49/Qgm/9iHqCe0=
+//
+// Data keys are wrapped under the current version of a key, and are unwrapped
+// using the version which wrapped them. A key is rotated by appending a new
+// version to its list, and prior versions must be retained for as long as
+// Fragments encrypted under them exist.
+type Keyring struct {
+	keys map[string][][]byte
+}
+
+// NewKeyring returns a Keyring of the key file at |path|.
+func NewKeyring(path string) (*Keyring, error) {
+	var b, err = ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading keyring: %w", err)
+	}
+	var encoded map[string][]string
+	if err = yaml.UnmarshalStrict(b, &encoded); err != nil {
+		return nil, fmt.Errorf("decoding keyring: %w", err)
+	}
+
+	var keys = make(map[string][][]byte, len(encoded))
+	for name, versions := range encoded {
+		if len(versions) == 0 {
+			return nil, fmt.Errorf("key %s has no versions", name)
+		}
+		for i, v := range versions {
+			var key, err = base64.StdEncoding.DecodeString(v)
+			if err != nil {
+				return nil, fmt.Errorf("decoding key %s version %d: %w", name, i+1, err)
+			} else if len(key) != 32 {
+				return nil, fmt.Errorf("invalid key %s version %d (%d bytes; expected 32)", name, i+1, len(key))
+			}
+			keys[name] = append(keys[name], key)
+		}
+	}
+	return &Keyring{keys: keys}, nil
+}
+
+// WrapKey wraps the |dataKey| under the current version of the named key.
+func (k *Keyring) WrapKey(_ context.Context, name string, dataKey []byte) ([]byte, string, error) {
+	var versions, ok = k.keys[name]
+	if !ok {
+		return nil, "", fmt.Errorf("key %s not found in keyring", name)
+	}
+	var version = strconv.Itoa(len(versions))
+
+	var aead, err = newAEAD(versions[len(versions)-1])
+	if err != nil {
+		return nil, "", err
+	}
+	var nonce = make([]byte, aead.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, "", err
+	}
+	return aead.Seal(nonce, nonce, dataKey, []byte(name+"/"+version)), version, nil
+}
+
+// UnwrapKey unwraps the |wrapped| data key using the given version of the named key.
+func (k *Keyring) UnwrapKey(_ context.Context, name, version string, wrapped []byte) ([]byte, error) {
+	var versions, ok = k.keys[name]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found in keyring", name)
+	}
+	var ind, err = strconv.Atoi(version)
+	if err != nil || ind < 1 || ind > len(versions) {
+		return nil, fmt.Errorf("key %s version %s not found in keyring", name, version)
+	}
+
+	aead, err := newAEAD(versions[ind-1])
+	if err != nil {
+		return nil, err
+	} else if len(wrapped) < aead.NonceSize() {
+		return nil, fmt.Errorf("invalid wrapped key length (%d)", len(wrapped))
+	}
+	var nonce, ciphertext = wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+
+	return aead.Open(nil, nonce, ciphertext, []byte(name+"/"+version))
+}
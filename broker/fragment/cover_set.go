@@ -112,6 +112,37 @@ func (s CoverSet) LongestOverlappingFragment(offset int64) (ind int, found bool)
 	return
 }
 
+// Oversize returns the leading (oldest) Fragments of the CoverSet which must be
+// removed for its remaining Fragments to cover at most |maxBytes| journal bytes
+// and number at most |maxFragments|, where a zero limit is unbounded. Bytes
+// covered by overlapping Fragments are counted once. The last Fragment of the
+// CoverSet, upon which the write head depends, is never returned, nor is a
+// Fragment which ends beyond |floor| or any Fragment which follows it.
+func (s CoverSet) Oversize(maxBytes, maxFragments, floor int64) CoverSet {
+	// covered[i] is the number of journal bytes covered by s[i:]. By the
+	// CoverSet invariant, any overlap of s[i] with a later Fragment is
+	// overlapped also by s[i+1].
+	var covered = make([]int64, len(s)+1)
+	for i := len(s) - 1; i >= 0; i-- {
+		var end = s[i].End
+		if i+1 != len(s) && s[i+1].Begin < end {
+			end = s[i+1].Begin
+		}
+		covered[i] = covered[i+1] + (end - s[i].Begin)
+	}
+
+	var n int
+	for ; n+1 < len(s); n++ {
+		if (maxBytes == 0 || covered[n] <= maxBytes) &&
+			(maxFragments == 0 || int64(len(s)-n) <= maxFragments) {
+			break // Within limits.
+		} else if s[n].End > floor {
+			break // Fragment is depended upon by a reader.
+		}
+	}
+	return s[:n]
+}
+
 // CoverSetDifference returns the subset of Fragments in |a| which cover
 // byte offsets not also covered by Fragments in |b|.
 func CoverSetDifference(a, b CoverSet) CoverSet {
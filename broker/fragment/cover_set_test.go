@@ -1,6 +1,7 @@
 package fragment
 
 import (
+	"math"
 	"testing"
 
 	"go.gazette.dev/core/broker/protocol"
@@ -218,6 +219,27 @@ func (s *CoverSetSuite) TestSetAddNoAction(c *gc.C) {
 	})
 }
 
+func (s *CoverSetSuite) TestSetAddCompactedFragment(c *gc.C) {
+	var expect = CoverSet{
+		{Fragment: protocol.Fragment{Begin: 100, End: 200}},
+		{Fragment: protocol.Fragment{Begin: 200, End: 500}},
+		{Fragment: protocol.Fragment{Begin: 500, End: 600}},
+	}
+	// A fragment which merges others replaces them, regardless of whether
+	// it's added before or after them.
+	var set CoverSet
+	for _, f := range [][2]int64{{100, 200}, {200, 300}, {300, 400}, {400, 500}, {500, 600}, {200, 500}} {
+		setAdd(&set, f[0], f[1])
+	}
+	c.Check(set, gc.DeepEquals, expect)
+
+	set = CoverSet{}
+	for _, f := range [][2]int64{{100, 200}, {200, 500}, {200, 300}, {300, 400}, {400, 500}, {500, 600}} {
+		setAdd(&set, f[0], f[1])
+	}
+	c.Check(set, gc.DeepEquals, expect)
+}
+
 func (s *CoverSetSuite) TestOffset(c *gc.C) {
 	var set CoverSet
 	c.Check(set.BeginOffset(), gc.Equals, int64(0))
@@ -343,6 +365,41 @@ func (s *CoverSetSuite) TestParseWithMultipleOverlaps(c *gc.C) {
 	})
 }
 
+func (s *CoverSetSuite) TestOversize(c *gc.C) {
+	var set CoverSet
+	setAdd(&set, 100, 200)
+	setAdd(&set, 150, 300) // Overlaps by 50 bytes.
+	setAdd(&set, 300, 400)
+	setAdd(&set, 500, 600) // Follows a gap.
+
+	var begins = func(s CoverSet) (out []int64) {
+		for _, f := range s {
+			out = append(out, f.Begin)
+		}
+		return
+	}
+	const unbounded = math.MaxInt64
+
+	for _, tc := range []struct {
+		maxBytes, maxFragments, floor int64
+		expect                        []int64
+	}{
+		{0, 0, unbounded, nil},            // No limits.
+		{400, 0, unbounded, nil},          // Overlap is counted once.
+		{399, 0, unbounded, []int64{100}}, // 350 bytes remain.
+		{350, 0, unbounded, []int64{100}},
+		{349, 0, unbounded, []int64{100, 150}},    // 200 bytes remain.
+		{1, 0, unbounded, []int64{100, 150, 300}}, // Last Fragment is kept.
+		{0, 3, unbounded, []int64{100}},
+		{0, 1, unbounded, []int64{100, 150, 300}},
+		{0, 1, 300, []int64{100, 150}}, // Stops at a Fragment ending beyond |floor|.
+		{0, 1, 299, []int64{100}},
+		{0, 1, 100, nil},
+	} {
+		c.Check(begins(set.Oversize(tc.maxBytes, tc.maxFragments, tc.floor)), gc.DeepEquals, tc.expect)
+	}
+}
+
 func setAdd(s *CoverSet, begin, end int64) bool {
 	var updated bool
 	*s, updated = s.Add(Fragment{
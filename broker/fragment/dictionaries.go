@@ -0,0 +1,118 @@
+package fragment
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sync"
+	"time"
+
+	"go.gazette.dev/core/broker/codecs"
+	pb "go.gazette.dev/core/broker/protocol"
+)
+
+func init() {
+	codecs.DictionaryLoader = func(d codecs.Dictionary) ([]byte, error) {
+		var ctx, cancel = context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		return OpenDictionary(ctx, d.Store, d.Name)
+	}
+}
+
+// PersistDictionary persists the compression dictionary |content| to the
+// FragmentStore, under its content-addressed name which is returned.
+// Dictionaries are stored beneath a "_dictionaries/" prefix of the store,
+// alongside (but not within) the Journals of the store.
+func PersistDictionary(ctx context.Context, store pb.FragmentStore, content []byte) (string, error) {
+	var name = codecs.DictionaryName(content)
+	var ep = store.URL()
+	var b = getBackend(ep.Scheme)
+
+	var err = b.PersistPath(ctx, ep, dictionaryPath(name),
+		io.NewSectionReader(bytes.NewReader(content), 0, int64(len(content))))
+	instrumentStoreOp(b.Provider(), "persist_dictionary", err)
+
+	if err != nil {
+		return "", err
+	}
+	knownDictionaries.Store(dictionaryKey{store, name}, struct{}{})
+	return name, nil
+}
+
+// OpenDictionary reads the named compression dictionary from the FragmentStore,
+// and verifies that its content matches its name.
+func OpenDictionary(ctx context.Context, store pb.FragmentStore, name string) ([]byte, error) {
+	if store == "" {
+		return nil, fmt.Errorf("dictionary %s has no store", name)
+	}
+	var ep = store.URL()
+	var b = getBackend(ep.Scheme)
+
+	var rc, err = b.OpenPath(ctx, ep, dictionaryPath(name))
+	instrumentStoreOp(b.Provider(), "open_dictionary", err)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	content, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("reading dictionary %s: %w", name, err)
+	} else if actual := codecs.DictionaryName(content); actual != name {
+		return nil, fmt.Errorf("dictionary %s has unexpected content (name %s)", name, actual)
+	}
+	return content, nil
+}
+
+// SignGetDictionaryURL returns a URL authenticating the bearer to perform a GET
+// operation of the named compression dictionary of the FragmentStore, for the
+// provided Duration from the current time.
+func SignGetDictionaryURL(store pb.FragmentStore, name string, d time.Duration) (string, error) {
+	var ep = store.URL()
+	var b = getBackend(ep.Scheme)
+
+	var signedURL, err = b.SignGetPath(ep, dictionaryPath(name), d)
+	instrumentStoreOp(b.Provider(), "get_signed_dictionary_url", err)
+	return signedURL, err
+}
+
+// ensureDictionary ensures the named compression dictionary is present in the
+// FragmentStore |to|. If it's not, it's copied from the first of the |from|
+// stores which has it.
+func ensureDictionary(ctx context.Context, name string, to pb.FragmentStore, from []pb.FragmentStore) error {
+	if _, ok := knownDictionaries.Load(dictionaryKey{to, name}); ok {
+		return nil
+	} else if _, err := OpenDictionary(ctx, to, name); err == nil {
+		knownDictionaries.Store(dictionaryKey{to, name}, struct{}{})
+		return nil
+	}
+
+	for _, store := range from {
+		if store == to {
+			continue
+		} else if content, err := codecs.LoadDictionary(codecs.Dictionary{Name: name, Store: store}); err != nil {
+			continue
+		} else {
+			_, err = PersistDictionary(ctx, to, content)
+			return err
+		}
+	}
+	return fmt.Errorf("dictionary %s was not found in store %s, or in any of %v", name, to, from)
+}
+
+// dictionaryPath returns the path of the named dictionary, relative to its store.
+func dictionaryPath(name string) string {
+	return path.Join("_dictionaries", name+".zdict")
+}
+
+type dictionaryKey struct {
+	store pb.FragmentStore
+	name  string
+}
+
+// knownDictionaries are dictionaries known to be present in their stores.
+var knownDictionaries sync.Map
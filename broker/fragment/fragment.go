@@ -2,6 +2,7 @@ package fragment
 
 import (
 	"io"
+	"time"
 
 	"go.gazette.dev/core/broker/protocol"
 )
@@ -11,6 +12,9 @@ type Fragment struct {
 	protocol.Fragment
 	// Local uncompressed file of the Fragment, or nil iff the Fragment is remote.
 	File File
+	// LastAppendTime is the UTC Time of the last commit of a local Fragment.
+	// It's zero if the Fragment is remote.
+	LastAppendTime time.Time
 }
 
 // File is the subset of os.File used in backing Fragments with local files.
@@ -22,7 +22,8 @@ type Index struct {
 	local          CoverSet        // Local Fragments only (having non-nil File).
 	condCh         chan struct{}   // Condition variable; notifies blocked queries on each |set| update.
 	firstRefreshCh chan struct{}   // Closed when the first remote index load has completed.
-	mu             sync.RWMutex    // Guards |set| and |condCh|.
+	minOffset      int64           // Minimum readable offset of the journal.
+	mu             sync.RWMutex    // Guards |set|, |condCh|, and |minOffset|.
 }
 
 // NewIndex returns a new, empty Index.
@@ -34,7 +35,10 @@ func NewIndex(ctx context.Context) *Index {
 	}
 }
 
-// Query the Index for a Fragment matching the ReadRequest.
+// Query the Index for a Fragment matching the ReadRequest. Content below the
+// minimum readable offset of the Index is never served: a request of a lesser
+// offset is refused with status OFFSET_TRUNCATED, and a response Offset of
+// the minimum readable offset.
 func (fi *Index) Query(ctx context.Context, req *pb.ReadRequest) (*pb.ReadResponse, File, error) {
 	defer fi.mu.RUnlock()
 	fi.mu.RLock()
@@ -45,10 +49,19 @@ func (fi *Index) Query(ctx context.Context, req *pb.ReadRequest) (*pb.ReadRespon
 
 	// Special handling for reads at the Journal Write head.
 	if resp.Offset == -1 {
-		resp.Offset = fi.set.EndOffset()
+		resp.Offset = fi.endOffset()
 	}
 
 	for {
+		// The minimum offset may have been advanced while we were blocked.
+		if resp.Offset < fi.minOffset {
+			resp.Status = pb.Status_OFFSET_TRUNCATED
+			resp.Offset = fi.minOffset
+			resp.WriteHead = fi.endOffset()
+
+			addTrace(ctx, "Index.Query(%s) => %s", req, resp)
+			return resp, nil, nil
+		}
 		var ind, found = fi.set.LongestOverlappingFragment(resp.Offset)
 
 		var condCh = fi.condCh
@@ -71,22 +84,36 @@ func (fi *Index) Query(ctx context.Context, req *pb.ReadRequest) (*pb.ReadRespon
 			found = true
 		}
 
+		// If the covering Fragment was last modified before the requested
+		// BeginModTime, skip over it and query again.
+		if found && modifiedBefore(fi.set[ind], req.BeginModTime) {
+			addTrace(ctx, " ... skipping Fragment [%d, %d) modified before %d",
+				fi.set[ind].Begin, fi.set[ind].End, req.BeginModTime)
+			resp.Offset = fi.set[ind].End
+			continue
+		}
+
 		if found {
 			resp.Status = pb.Status_OK
-			resp.WriteHead = fi.set.EndOffset()
+			resp.WriteHead = fi.endOffset()
 			resp.Fragment = new(pb.Fragment)
 			*resp.Fragment = fi.set[ind].Fragment
 
 			if resp.Fragment.BackingStore != "" && resp.Fragment.ModTime != 0 {
 				resp.FragmentUrl, err = SignGetURL(*resp.Fragment, time.Minute)
 			}
+			// Readers of the FragmentUrl also require its compression dictionary.
+			if err == nil && resp.FragmentUrl != "" && resp.Fragment.CompressionDictionary != "" {
+				resp.DictionaryUrl, err = SignGetDictionaryURL(resp.Fragment.BackingStore,
+					resp.Fragment.CompressionDictionary, time.Minute)
+			}
 			addTrace(ctx, "Index.Query(%s) => %s, localFile: %t", req, resp, fi.set[ind].File != nil)
 			return resp, fi.set[ind].File, err
 		}
 
 		if !req.Block {
 			resp.Status = pb.Status_OFFSET_NOT_YET_AVAILABLE
-			resp.WriteHead = fi.set.EndOffset()
+			resp.WriteHead = fi.endOffset()
 
 			addTrace(ctx, "Index.Query(%s) => %s", req, resp)
 			return resp, nil, nil
@@ -113,12 +140,52 @@ func (fi *Index) Query(ctx context.Context, req *pb.ReadRequest) (*pb.ReadRespon
 	}
 }
 
-// EndOffset returns the last (largest) End offset in the index.
+// EndOffset returns the last (largest) End offset in the index,
+// or the minimum readable offset of the index if it's larger.
 func (fi *Index) EndOffset() int64 {
 	defer fi.mu.RUnlock()
 	fi.mu.RLock()
 
-	return fi.set.EndOffset()
+	return fi.endOffset()
+}
+
+// MinOffset returns the minimum readable offset of the index.
+func (fi *Index) MinOffset() int64 {
+	defer fi.mu.RUnlock()
+	fi.mu.RLock()
+
+	return fi.minOffset
+}
+
+// SetMinOffset advances the minimum readable offset of the index to |offset|.
+// It's a no-op if |offset| is less than the current minimum offset.
+func (fi *Index) SetMinOffset(offset int64) {
+	defer fi.mu.Unlock()
+	fi.mu.Lock()
+
+	if offset > fi.minOffset {
+		fi.minOffset = offset
+		fi.wakeBlockedQueries()
+	}
+}
+
+// endOffset returns the larger of the CoverSet EndOffset and |minOffset|.
+// fi.mu must already be held.
+func (fi *Index) endOffset() int64 {
+	if eo := fi.set.EndOffset(); eo > fi.minOffset {
+		return eo
+	}
+	return fi.minOffset
+}
+
+// Persisted returns whether every local Fragment of the index has also been
+// observed in a listing of the journal's remote stores. It's trivially true
+// if the index has no local Fragments.
+func (fi *Index) Persisted() bool {
+	defer fi.mu.RUnlock()
+	fi.mu.RLock()
+
+	return len(fi.local) == 0
 }
 
 // SpoolCommit adds local Spool Fragment |frag| to the index.
@@ -220,6 +287,19 @@ func WalkAllStores(ctx context.Context, name pb.Journal, stores []pb.FragmentSto
 	return set, nil
 }
 
+// modifiedBefore returns whether Fragment |f| was last modified before unix
+// seconds |t|. Persisted Fragments are modified as of their ModTime, and
+// local Fragments which are not yet persisted (having a zero ModTime) as of
+// their LastAppendTime. A Fragment having neither is never modified before |t|.
+func modifiedBefore(f Fragment, t int64) bool {
+	if f.ModTime != 0 {
+		return f.ModTime < t
+	} else if !f.LastAppendTime.IsZero() {
+		return f.LastAppendTime.Unix() < t
+	}
+	return false
+}
+
 var timeNow = time.Now
 
 func addTrace(ctx context.Context, format string, args ...interface{}) {
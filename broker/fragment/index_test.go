@@ -47,7 +47,9 @@ func (s *IndexSuite) TestRemoteReplacesLocal(c *gc.C) {
 
 	var set = buildSet(c, 100, 200)
 	set[0].File = os.Stdin
+	c.Check(ind.Persisted(), gc.Equals, true) // Trivially true, with no local fragments.
 	ind.SpoolCommit(set[0])
+	c.Check(ind.Persisted(), gc.Equals, false)
 
 	// Precondition: local fragment is queryable.
 	var resp, file, err = ind.Query(context.Background(), &pb.ReadRequest{Offset: 110, Block: true})
@@ -64,6 +66,7 @@ func (s *IndexSuite) TestRemoteReplacesLocal(c *gc.C) {
 	// fragments covered by remote ones, we should see remote fragments only.
 	set = buildSet(c, 100, 150, 150, 200)
 	ind.ReplaceRemote(set)
+	c.Check(ind.Persisted(), gc.Equals, true)
 
 	resp, file, err = ind.Query(context.Background(), &pb.ReadRequest{Offset: 110, Block: true})
 	c.Check(resp, gc.DeepEquals, &pb.ReadResponse{
@@ -159,6 +162,118 @@ func (s *IndexSuite) TestQueryAtMissingMiddle(c *gc.C) {
 	c.Check(resp.Status, gc.Equals, pb.Status_OK)
 }
 
+func (s *IndexSuite) TestQueryWithBeginModTime(c *gc.C) {
+	var ind = NewIndex(context.Background())
+
+	var set = buildSet(c, 100, 200, 200, 300, 300, 400)
+	set[0].ModTime = 1000
+	set[1].ModTime = 2000
+	ind.ReplaceRemote(set[:2])
+	set[2].LastAppendTime = time.Unix(2500, 0)
+	ind.SpoolCommit(set[2]) // Local Fragment having zero ModTime.
+
+	// Expect Fragments modified before BeginModTime are skipped over.
+	var resp, _, err = ind.Query(context.Background(), &pb.ReadRequest{Offset: 0, BeginModTime: 1500})
+	c.Check(err, gc.IsNil)
+	c.Check(resp, gc.DeepEquals, &pb.ReadResponse{
+		Offset:    200,
+		WriteHead: 400,
+		Fragment:  &pb.Fragment{Begin: 200, End: 300, ModTime: 2000},
+	})
+
+	// A Fragment modified at exactly BeginModTime is not skipped.
+	resp, _, _ = ind.Query(context.Background(), &pb.ReadRequest{Offset: 150, BeginModTime: 1000})
+	c.Check(resp.Offset, gc.Equals, int64(150))
+	c.Check(resp.Fragment.ModTime, gc.Equals, int64(1000))
+
+	// Local Fragments are skipped if last appended to before BeginModTime.
+	resp, _, _ = ind.Query(context.Background(), &pb.ReadRequest{Offset: 0, BeginModTime: 2500})
+	c.Check(resp.Status, gc.Equals, pb.Status_OK)
+	c.Check(resp.Offset, gc.Equals, int64(300))
+	c.Check(resp.Fragment, gc.DeepEquals, &pb.Fragment{Begin: 300, End: 400})
+
+	resp, _, _ = ind.Query(context.Background(), &pb.ReadRequest{Offset: 0, BeginModTime: 3000})
+	c.Check(resp.Status, gc.Equals, pb.Status_OFFSET_NOT_YET_AVAILABLE)
+	c.Check(resp.Offset, gc.Equals, int64(400))
+
+	// Local Fragments without a LastAppendTime are never skipped.
+	ind.SpoolCommit(buildSet(c, 400, 500)[0])
+	resp, _, _ = ind.Query(context.Background(), &pb.ReadRequest{Offset: 0, BeginModTime: 3000})
+	c.Check(resp.Status, gc.Equals, pb.Status_OK)
+	c.Check(resp.Offset, gc.Equals, int64(400))
+	c.Check(resp.Fragment, gc.DeepEquals, &pb.Fragment{Begin: 400, End: 500})
+
+	// If all Fragments are skipped, a non-blocking read returns the advanced
+	// Offset with OFFSET_NOT_YET_AVAILABLE.
+	ind = NewIndex(context.Background())
+	ind.ReplaceRemote(set[:2])
+
+	resp, _, _ = ind.Query(context.Background(), &pb.ReadRequest{Offset: 0, BeginModTime: 3000})
+	c.Check(resp, gc.DeepEquals, &pb.ReadResponse{
+		Status:    pb.Status_OFFSET_NOT_YET_AVAILABLE,
+		Offset:    300,
+		WriteHead: 300,
+	})
+}
+
+func (s *IndexSuite) TestQueryWithMinOffset(c *gc.C) {
+	var ind = NewIndex(context.Background())
+	ind.ReplaceRemote(buildSet(c, 100, 200, 200, 300))
+
+	ind.SetMinOffset(250)
+	ind.SetMinOffset(150) // Ignored, as the minimum offset is never decreased.
+	c.Check(ind.MinOffset(), gc.Equals, int64(250))
+
+	// Reads below the minimum offset are refused, with the minimum offset.
+	var resp, _, err = ind.Query(context.Background(), &pb.ReadRequest{Offset: 110})
+	c.Check(err, gc.IsNil)
+	c.Check(resp, gc.DeepEquals, &pb.ReadResponse{
+		Status:    pb.Status_OFFSET_TRUNCATED,
+		Offset:    250,
+		WriteHead: 300,
+	})
+	// Reads at or above the minimum offset are unaffected.
+	resp, _, _ = ind.Query(context.Background(), &pb.ReadRequest{Offset: 250})
+	c.Check(resp, gc.DeepEquals, &pb.ReadResponse{
+		Offset:    250,
+		WriteHead: 300,
+		Fragment:  &pb.Fragment{Begin: 200, End: 300},
+	})
+	resp, _, _ = ind.Query(context.Background(), &pb.ReadRequest{Offset: 275})
+	c.Check(resp.Offset, gc.Equals, int64(275))
+
+	// A minimum offset beyond all Fragments is also the effective write head.
+	ind.SetMinOffset(400)
+	c.Check(ind.EndOffset(), gc.Equals, int64(400))
+
+	resp, _, _ = ind.Query(context.Background(), &pb.ReadRequest{Offset: 0})
+	c.Check(resp, gc.DeepEquals, &pb.ReadResponse{
+		Status:    pb.Status_OFFSET_TRUNCATED,
+		Offset:    400,
+		WriteHead: 400,
+	})
+	resp, _, _ = ind.Query(context.Background(), &pb.ReadRequest{Offset: -1})
+	c.Check(resp, gc.DeepEquals, &pb.ReadResponse{
+		Status:    pb.Status_OFFSET_NOT_YET_AVAILABLE,
+		Offset:    400,
+		WriteHead: 400,
+	})
+
+	// A blocked read is woken, and refused, by an advanced minimum offset.
+	ind = NewIndex(context.Background())
+	ind.ReplaceRemote(buildSet(c, 100, 200, 300, 400))
+
+	go ind.SetMinOffset(350)
+
+	resp, _, err = ind.Query(context.Background(), &pb.ReadRequest{Offset: 250, Block: true})
+	c.Check(err, gc.IsNil)
+	c.Check(resp, gc.DeepEquals, &pb.ReadResponse{
+		Status:    pb.Status_OFFSET_TRUNCATED,
+		Offset:    350,
+		WriteHead: 400,
+	})
+}
+
 func (s *IndexSuite) TestBlockedContextCancelled(c *gc.C) {
 	var indCtx, indCancel = context.WithCancel(context.Background())
 	var reqCtx, reqCancel = context.WithCancel(context.Background())
@@ -196,6 +311,8 @@ func (s *IndexSuite) TestWalkStoresAndURLSigning(c *gc.C) {
 		"root/one/a/journal/0000000000000222-0000000000000255-0000000000000000000000000000000000000333.sz", // Covered.
 		"root/two/a/journal/0000000000000222-0000000000000333-0000000000000000000000000000000000000444.gz",
 		"root/two/a/journal/0000000000000444-0000000000000555-0000000000000000000000000000000000000555.gz",
+		"root/two/a/journal/0000000000000555-0000000000000666-0000000000000000000000000000000000000666." +
+			"0102030405060708090a0b0c0d0e0f1011121314.zsd",
 	}
 
 	for _, path := range paths {
@@ -240,14 +357,21 @@ func (s *IndexSuite) TestWalkStoresAndURLSigning(c *gc.C) {
 	c.Check(err, gc.IsNil)
 	ind.ReplaceRemote(set)
 
-	c.Check(ind.set, gc.HasLen, 4) // Combined Fragments are reflected.
-	c.Check(ind.EndOffset(), gc.Equals, int64(0x555))
+	c.Check(ind.set, gc.HasLen, 5) // Combined Fragments are reflected.
+	c.Check(ind.EndOffset(), gc.Equals, int64(0x666))
 
 	// Expect root/two now provides Fragment 222-333.
 	resp, _, _ = ind.Query(context.Background(), &pb.ReadRequest{Offset: 0x223})
 	c.Check(resp.Status, gc.Equals, pb.Status_OK)
 	c.Check(resp.FragmentUrl, gc.Equals,
 		"file:///root/two/a/journal/0000000000000222-0000000000000333-0000000000000000000000000000000000000444.gz")
+	c.Check(resp.DictionaryUrl, gc.Equals, "")
+
+	// Expect a Fragment compressed with a dictionary also provides a dictionary URL.
+	resp, _, _ = ind.Query(context.Background(), &pb.ReadRequest{Offset: 0x556})
+	c.Check(resp.Status, gc.Equals, pb.Status_OK)
+	c.Check(resp.DictionaryUrl, gc.Equals,
+		"file:///root/two/_dictionaries/0102030405060708090a0b0c0d0e0f1011121314.zdict")
 }
 
 func (s *IndexSuite) TestInspectCases(c *gc.C) {
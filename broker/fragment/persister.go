@@ -23,6 +23,18 @@ type Persister struct {
 	ks         *keyspace.KeySpace
 	ticker     *time.Ticker
 	persistFn  func(context.Context, Spool, *pb.JournalSpec) error
+	status     map[pb.Journal]*PersistStatus // Guarded by |mu|.
+}
+
+// PersistStatus is the status of a journal's Spools within a Persister.
+type PersistStatus struct {
+	// Number of completed Spools which have yet to be persisted.
+	BacklogSpools int64
+	// Total content bytes of completed Spools which have yet to be persisted.
+	BacklogBytes int64
+	// Last error encountered in persisting a Spool, and its time.
+	LastErr     error
+	LastErrTime time.Time
 }
 
 // NewPersister returns an empty, initialized Persister.
@@ -41,6 +53,11 @@ func (p *Persister) SpoolComplete(spool Spool, primary bool) {
 		return // No-op.
 	}
 
+	p.updateStatus(spool.Journal, func(s *PersistStatus) {
+		s.BacklogSpools++
+		s.BacklogBytes += spool.ContentLength()
+	})
+
 	if primary {
 		// Attempt to immediately persist the Spool.
 		go p.attemptPersist(spool)
@@ -50,6 +67,33 @@ func (p *Persister) SpoolComplete(spool Spool, primary bool) {
 	return
 }
 
+// Status returns the PersistStatus of the journal.
+func (p *Persister) Status(journal pb.Journal) PersistStatus {
+	defer p.mu.Unlock()
+	p.mu.Lock()
+
+	if s, ok := p.status[journal]; ok {
+		return *s
+	}
+	return PersistStatus{}
+}
+
+// updateStatus applies |fn| to the PersistStatus of the journal.
+func (p *Persister) updateStatus(journal pb.Journal, fn func(*PersistStatus)) {
+	defer p.mu.Unlock()
+	p.mu.Lock()
+
+	if p.status == nil {
+		p.status = make(map[pb.Journal]*PersistStatus)
+	}
+	var s, ok = p.status[journal]
+	if !ok {
+		s = new(PersistStatus)
+		p.status[journal] = s
+	}
+	fn(s)
+}
+
 func (p *Persister) Finish() {
 	p.doneCh <- struct{}{}
 	<-p.doneCh
@@ -112,7 +156,12 @@ func (p *Persister) attemptPersist(spool Spool) {
 			"name":    spool.ContentName(),
 		}).Info("dropping Spool (JournalSpec was removed)")
 
+		releaseSpoolFile(spool.File)
 		spoolPersistedTotal.Inc()
+
+		p.mu.Lock()
+		delete(p.status, spool.Journal)
+		p.mu.Unlock()
 		return
 	}
 
@@ -123,8 +172,42 @@ func (p *Persister) attemptPersist(spool Spool) {
 			"name":    spool.ContentName(),
 			"err":     err,
 		}).Warn("failed to persist Spool (will retry)")
+
+		p.updateStatus(spool.Journal, func(s *PersistStatus) {
+			s.LastErr, s.LastErrTime = err, time.Now()
+		})
 		p.queue(spool)
 	} else {
+		releaseSpoolFile(spool.File)
 		spoolPersistedTotal.Inc()
+
+		p.updateStatus(spool.Journal, func(s *PersistStatus) {
+			s.BacklogSpools--
+			s.BacklogBytes -= spool.ContentLength()
+		})
+	}
+}
+
+// Recover the Spools of directory |dir| which weren't persisted prior to a
+// broker crash, and attempt to persist each. Spools which fail to persist
+// are queued for retry. Recover must be called after the KeySpace is loaded,
+// and before journals are served. See RecoverSpools.
+func (p *Persister) Recover(dir string) error {
+	var spools, err = RecoverSpools(dir)
+	if err != nil {
+		return err
+	}
+	for _, spool := range spools {
+		log.WithFields(log.Fields{
+			"journal": spool.Journal,
+			"name":    spool.ContentName(),
+		}).Info("recovered Spool")
+
+		p.updateStatus(spool.Journal, func(s *PersistStatus) {
+			s.BacklogSpools++
+			s.BacklogBytes += spool.ContentLength()
+		})
+		p.attemptPersist(spool)
 	}
+	return nil
 }
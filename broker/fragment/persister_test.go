@@ -191,6 +191,55 @@ func (p *PersisterSuite) TestServeUpdateBackingStore(c *gc.C) {
 	persister.mu.Unlock()
 }
 
+func (p *PersisterSuite) TestPersistStatus(c *gc.C) {
+	var ks = keyspace.NewKeySpace("/journals", func(kv *mvccpb.KeyValue) (interface{}, error) {
+		return allocator.Item{
+			ID:        "journal-1",
+			ItemValue: &pb.JournalSpec{},
+		}, nil
+	})
+	var client, ctx = etcdtest.TestClient(), context.Background()
+	defer etcdtest.Cleanup()
+	var _, err = client.Put(ctx, "/journals/items/journal-1", "")
+	c.Assert(err, gc.IsNil)
+	c.Check(ks.Load(ctx, client, 0), gc.IsNil)
+
+	var persistErr = errors.New("something has gone wrong")
+	var persister = NewPersister(ks)
+	persister.persistFn = func(context.Context, Spool, *pb.JournalSpec) error { return persistErr }
+
+	var obv testSpoolObserver
+	var spool = NewSpool("journal-1", &obv)
+	applyAndCommit(&spool)
+
+	// Completed Spools are reflected in the backlog.
+	c.Check(persister.Status("journal-1"), gc.DeepEquals, PersistStatus{})
+	persister.SpoolComplete(spool, false)
+	c.Check(persister.Status("journal-1"), gc.DeepEquals, PersistStatus{BacklogSpools: 1, BacklogBytes: 12})
+
+	// A failed attempt records its error, and the Spool remains in the backlog.
+	persister.attemptPersist(spool)
+	var status = persister.Status("journal-1")
+	c.Check(status.BacklogSpools, gc.Equals, int64(1))
+	c.Check(status.LastErr, gc.Equals, persistErr)
+	c.Check(status.LastErrTime.IsZero(), gc.Equals, false)
+
+	// A successful attempt removes the Spool from the backlog.
+	persister.persistFn = func(context.Context, Spool, *pb.JournalSpec) error { return nil }
+	persister.attemptPersist(spool)
+	c.Check(persister.Status("journal-1"), gc.DeepEquals, PersistStatus{
+		LastErr:     persistErr,
+		LastErrTime: status.LastErrTime,
+	})
+
+	// Status of a journal is removed with its JournalSpec.
+	spool.Journal = "journal-2"
+	persister.SpoolComplete(spool, false)
+	c.Check(persister.Status("journal-2").BacklogSpools, gc.Equals, int64(1))
+	persister.attemptPersist(spool)
+	c.Check(persister.Status("journal-2"), gc.DeepEquals, PersistStatus{})
+}
+
 func applyAndCommit(spool *Spool) {
 	spool.applyContent(&pb.ReplicateRequest{
 		Content:      []byte("some content"),
@@ -33,6 +33,11 @@ type Spool struct {
 	compressedLength int64
 	// Compressor of |compressedFile|.
 	compressor codecs.Compressor
+	// Encrypted form of the compressed (or raw, if uncompressed) Fragment.
+	// Set only while the Spool is being persisted.
+	encryptedFile File
+	// Length of encrypted content written to |encryptedFile|.
+	encryptedLength int64
 
 	delta    int64     // Delta offset of next byte to write, relative to Fragment.End.
 	summer   hash.Hash // Running SHA1 of the Fragment.File, through |Fragment.End + delta|.
@@ -125,6 +130,8 @@ func (s *Spool) applyCommit(r *pb.ReplicateRequest, primary bool) pb.ReplicateRe
 		if s.ContentLength() != 0 {
 			spoolCompletedTotal.Inc()
 			s.observer.SpoolComplete(*s, primary)
+		} else {
+			releaseSpoolFile(s.Fragment.File) // Holds only rolled-back content.
 		}
 		// If the proposal is strictly greater than our Fragment, take the proposal registers.
 		if r.Proposal.End > s.Fragment.End {
@@ -133,10 +140,11 @@ func (s *Spool) applyCommit(r *pb.ReplicateRequest, primary bool) pb.ReplicateRe
 		*s = Spool{
 			Fragment: Fragment{
 				Fragment: pb.Fragment{
-					Journal:          s.Fragment.Journal,
-					Begin:            r.Proposal.End,
-					End:              r.Proposal.End,
-					CompressionCodec: r.Proposal.CompressionCodec,
+					Journal:               s.Fragment.Journal,
+					Begin:                 r.Proposal.End,
+					End:                   r.Proposal.End,
+					CompressionCodec:      r.Proposal.CompressionCodec,
+					CompressionDictionary: r.Proposal.CompressionDictionary,
 				},
 			},
 			Registers: s.Registers,
@@ -166,16 +174,22 @@ func (s *Spool) applyCommit(r *pb.ReplicateRequest, primary bool) pb.ReplicateRe
 		spoolCommitsTotal.Inc()
 		spoolCommitBytesTotal.Add(float64(s.delta))
 
-		if primary && s.CompressionCodec != pb.CompressionCodec_NONE {
+		// Dictionary-backed codecs aren't compressed incrementally, as the
+		// Spool doesn't know the store from which to load its dictionary.
+		// Instead they're compressed when the Spool is persisted.
+		if primary && s.CompressionCodec != pb.CompressionCodec_NONE &&
+			s.CompressionCodec != pb.CompressionCodec_ZSTANDARD_DICT {
 			s.compressThrough(r.Proposal.End)
 		}
 		s.Fragment.Fragment = *r.Proposal
-		s.observer.SpoolCommit(s.Fragment)
+		s.Fragment.LastAppendTime = timeNow().UTC()
 
 		if s.FirstAppendTime.IsZero() {
-			s.FirstAppendTime = timeNow().UTC()
+			s.FirstAppendTime = s.Fragment.LastAppendTime
 		}
+		s.observer.SpoolCommit(s.Fragment)
 		s.Registers.Assign(r.Registers)
+		s.writeFileHeader()
 
 		// DEPRECATED metrics to remove
 		commitsTotal.WithLabelValues(pb.Status_OK.String()).Inc()
@@ -212,7 +226,7 @@ func (s *Spool) applyContent(r *pb.ReplicateRequest) error {
 		if s.Fragment.File == nil {
 			if s.ContentLength() != 0 {
 				panic("Spool.Fragment not empty.")
-			} else if s.Fragment.File, err = newSpoolFile(); err != nil {
+			} else if s.Fragment.File, err = newSpoolContentFile(s); err != nil {
 				err = fmt.Errorf("creating spool file: %s", err)
 				continue
 			}
@@ -275,7 +289,8 @@ func (s *Spool) compressThrough(end int64) {
 			err = fmt.Errorf("seeking compressedFile to start: %s", err)
 			continue
 		}
-		if s.compressor, err = codecs.NewCodecWriter(s.compressedFile, s.CompressionCodec); err != nil {
+		if s.compressor, err = codecs.NewCodecWriterWithDictionary(s.compressedFile, s.CompressionCodec,
+			codecs.Dictionary{Name: s.CompressionDictionary, Store: s.BackingStore}); err != nil {
 			err = fmt.Errorf("initializing compressor: %s", err)
 			continue
 		}
@@ -327,6 +342,18 @@ func (s *Spool) finishCompression() {
 	}
 }
 
+// persistedContent returns a reader of the Spool content which is written to
+// a fragment store: its encrypted content if the Spool has been encrypted,
+// or else its compressed content, or else its raw content if uncompressed.
+func (s *Spool) persistedContent() *io.SectionReader {
+	if s.encryptedFile != nil {
+		return io.NewSectionReader(s.encryptedFile, 0, s.encryptedLength)
+	} else if s.CompressionCodec != pb.CompressionCodec_NONE {
+		return io.NewSectionReader(s.compressedFile, 0, s.compressedLength)
+	}
+	return io.NewSectionReader(s.Fragment.File, 0, s.ContentLength())
+}
+
 // saveSumState marshals internal state of |summer| into |sumState|.
 func (s *Spool) saveSumState() {
 	if state, err := s.summer.(encoding.BinaryMarshaler).MarshalBinary(); err != nil {
@@ -0,0 +1,276 @@
+package fragment
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	pb "go.gazette.dev/core/broker/protocol"
+)
+
+// SpoolDirectory is a local directory in which the content of Spools is kept
+// in named files, which are recovered by RecoverSpools should the broker crash
+// before they're persisted. If empty, Spools are instead kept in anonymous
+// temporary files, and content which isn't persisted is lost on broker exit.
+//
+// Named Spool files are fsync'd as each commit is applied, and before the
+// commit is acknowledged, so that acknowledged content survives a crash of
+// the host and not only of the broker. This adds the latency of two fsyncs
+// to every append transaction of the broker.
+var SpoolDirectory string
+
+// RecoverSpools returns the Spools of directory |dir| which weren't persisted
+// and removed prior to a broker crash. Each file header records the Fragment
+// which was last committed to the Spool, and its content is verified against
+// the Fragment's SHA1 sum. If the most recent header was torn by the crash,
+// the Spool is recovered from its prior commit. Content beyond the committed Fragment, which was
+// never acknowledged to a client, is ignored. Files which cannot be recovered
+// are logged and renamed with an ".invalid" suffix, for later inspection.
+// Recovered Spools retain ownership of their files, which are removed once
+// the Spool is persisted by a Persister.
+func RecoverSpools(dir string) ([]Spool, error) {
+	var infos, err = ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var out []Spool
+
+	for _, info := range infos {
+		if !info.Mode().IsRegular() || !strings.HasSuffix(info.Name(), spoolFileSuffix) {
+			continue
+		}
+		var path = filepath.Join(dir, info.Name())
+
+		if spool, err := recoverSpool(path); err != nil {
+			log.WithFields(log.Fields{
+				"path": path,
+				"err":  err,
+			}).Error("failed to recover spool file (renaming as .invalid)")
+
+			if err = os.Rename(path, path+".invalid"); err != nil {
+				return nil, fmt.Errorf("renaming invalid spool file: %w", err)
+			}
+		} else if spool.ContentLength() == 0 {
+			_ = spool.File.Close()
+			releaseSpoolFile(spool.File)
+		} else {
+			out = append(out, spool)
+		}
+	}
+	return out, nil
+}
+
+func recoverSpool(path string) (Spool, error) {
+	var f, err = os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return Spool{}, err
+	}
+
+	var hdr spoolFileHeader
+	var slot int64
+	if slot, err = hdr.read(f); err != nil {
+		_ = f.Close()
+		return Spool{}, err
+	}
+	// Further headers are written to the other slot, preserving |hdr|.
+	var file = &namedSpoolFile{File: f, slot: 1 - slot}
+
+	var summer = sha1.New()
+	if n, err := io.Copy(summer, io.NewSectionReader(file, 0, hdr.Fragment.ContentLength())); err != nil {
+		_ = f.Close()
+		return Spool{}, fmt.Errorf("reading spool content: %w", err)
+	} else if n != hdr.Fragment.ContentLength() {
+		_ = f.Close()
+		return Spool{}, fmt.Errorf("spool content is truncated (%d bytes; expected %d)",
+			n, hdr.Fragment.ContentLength())
+	} else if hdr.Fragment.ContentLength() == 0 {
+		// Pass.
+	} else if sum := pb.SHA1SumFromDigest(summer.Sum(nil)); sum != hdr.Fragment.Sum {
+		_ = f.Close()
+		return Spool{}, fmt.Errorf("spool content SHA1 mismatch (%x; expected %x)",
+			sum.ToDigest(), hdr.Fragment.Sum.ToDigest())
+	}
+
+	return Spool{
+		Fragment:        Fragment{Fragment: hdr.Fragment, File: file, LastAppendTime: hdr.LastAppendTime},
+		FirstAppendTime: hdr.FirstAppendTime,
+		summer:          summer,
+		sumState:        zeroedSHA1State,
+	}, nil
+}
+
+// newSpoolContentFile returns a File for the content of a new Spool. If
+// SpoolDirectory is set, it's a named file of that directory.
+func newSpoolContentFile(s *Spool) (File, error) {
+	if SpoolDirectory == "" {
+		return newSpoolFile()
+	}
+	var f, err = ioutil.TempFile(SpoolDirectory, "spool-*"+spoolFileSuffix)
+	if err != nil {
+		return nil, err
+	}
+	var file = &namedSpoolFile{File: f}
+
+	if err = file.writeHeader(s); err == nil {
+		err = syncDir(SpoolDirectory) // Make the new file's entry durable.
+	}
+	if err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return nil, err
+	}
+	return file, nil
+}
+
+// writeFileHeader records the committed Fragment of the Spool to the header
+// of its named File, retrying indefinitely on filesystem errors. It's a no-op
+// if the Spool File is not named.
+func (s *Spool) writeFileHeader() {
+	var f, ok = s.Fragment.File.(*namedSpoolFile)
+	if !ok {
+		return
+	}
+	for {
+		if err := f.writeHeader(s); err != nil {
+			log.WithField("err", err).Error("failed to write spool header (will retry)")
+			time.Sleep(spoolRetryInterval)
+			continue
+		}
+		return // Success.
+	}
+}
+
+// releaseSpoolFile removes a named Spool File, which is no longer required
+// for crash recovery. The File remains readable until it's closed.
+// It's a no-op if |f| is not a named Spool File.
+func releaseSpoolFile(f File) {
+	if nf, ok := f.(*namedSpoolFile); !ok {
+		return
+	} else if err := os.Remove(nf.Name()); err != nil && !os.IsNotExist(err) {
+		log.WithFields(log.Fields{
+			"path": nf.Name(),
+			"err":  err,
+		}).Warn("failed to remove spool file")
+	}
+}
+
+// namedSpoolFile is a File having a leading spoolFileHeader. Offsets of the
+// File are relative to the end of the header.
+type namedSpoolFile struct {
+	*os.File
+	// Header slot to which the next header is written. Headers alternate
+	// between two slots, so that a header which is torn by a crash during
+	// its write doesn't also destroy its predecessor.
+	slot int64
+}
+
+func (f *namedSpoolFile) ReadAt(p []byte, off int64) (int, error) {
+	return f.File.ReadAt(p, off+spoolHeaderSize)
+}
+
+func (f *namedSpoolFile) WriteAt(p []byte, off int64) (int, error) {
+	return f.File.WriteAt(p, off+spoolHeaderSize)
+}
+
+func (f *namedSpoolFile) Seek(offset int64, whence int) (int64, error) {
+	if whence == io.SeekStart {
+		offset += spoolHeaderSize
+	}
+	var n, err = f.File.Seek(offset, whence)
+	return n - spoolHeaderSize, err
+}
+
+// writeHeader writes the committed Fragment of Spool |s| to the next header
+// slot. Spool content is synced before the header which references it, and
+// the header is then itself synced.
+func (f *namedSpoolFile) writeHeader(s *Spool) error {
+	var b, err = json.Marshal(spoolFileHeader{
+		Fragment:        s.Fragment.Fragment,
+		FirstAppendTime: s.FirstAppendTime,
+		LastAppendTime:  s.Fragment.LastAppendTime,
+	})
+	if err != nil {
+		return err
+	}
+	var buf = make([]byte, spoolHeaderSlotSize)
+	if n := copy(buf[copy(buf, spoolHeaderMagic):], b); n != len(b) {
+		return fmt.Errorf("spool header is too large (%d bytes)", len(b))
+	}
+
+	if err = f.File.Sync(); err != nil {
+		return fmt.Errorf("syncing spool content: %w", err)
+	} else if _, err = f.File.WriteAt(buf, f.slot*spoolHeaderSlotSize); err != nil {
+		return err
+	} else if err = f.File.Sync(); err != nil {
+		return fmt.Errorf("syncing spool header: %w", err)
+	}
+	f.slot = 1 - f.slot
+	return nil
+}
+
+// spoolFileHeader is written at the beginning of named Spool files, and
+// records a commit of the Spool. It's encoded as spoolHeaderMagic followed
+// by a JSON document, and padded with zeros to spoolHeaderSlotSize. Files
+// have two header slots, which are alternately written.
+type spoolFileHeader struct {
+	Fragment        pb.Fragment
+	FirstAppendTime time.Time
+	LastAppendTime  time.Time
+}
+
+// read the most recent valid header of the two header slots of |r|,
+// returning the slot from which it was read.
+func (hdr *spoolFileHeader) read(r io.ReaderAt) (int64, error) {
+	var hdrs [2]spoolFileHeader
+	var errs [2]error
+
+	for slot := range hdrs {
+		errs[slot] = hdrs[slot].readSlot(r, int64(slot))
+	}
+	switch {
+	case errs[0] != nil && errs[1] != nil:
+		return 0, errs[0]
+	case errs[0] != nil:
+		*hdr = hdrs[1]
+		return 1, nil
+	case errs[1] != nil || hdrs[0].Fragment.End >= hdrs[1].Fragment.End:
+		*hdr = hdrs[0]
+		return 0, nil
+	default:
+		*hdr = hdrs[1]
+		return 1, nil
+	}
+}
+
+func (hdr *spoolFileHeader) readSlot(r io.ReaderAt, slot int64) error {
+	var buf = make([]byte, spoolHeaderSlotSize)
+
+	if _, err := r.ReadAt(buf, slot*spoolHeaderSlotSize); err != nil {
+		return fmt.Errorf("reading spool header: %w", err)
+	} else if !bytes.HasPrefix(buf, []byte(spoolHeaderMagic)) {
+		return fmt.Errorf("spool header has unexpected magic")
+	}
+	buf = bytes.TrimRight(buf[len(spoolHeaderMagic):], "\x00")
+
+	if err := json.Unmarshal(buf, hdr); err != nil {
+		return fmt.Errorf("decoding spool header: %w", err)
+	} else if err = hdr.Fragment.Validate(); err != nil {
+		return fmt.Errorf("spool header Fragment: %w", err)
+	}
+	return nil
+}
+
+const (
+	spoolHeaderMagic    = "gazette-spool-v1\n"
+	spoolHeaderSlotSize = 4096
+	spoolHeaderSize     = 2 * spoolHeaderSlotSize
+	spoolFileSuffix     = ".spool"
+)
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"io"
 	"io/ioutil"
+	"os"
 	"time"
 
 	"go.gazette.dev/core/broker/codecs"
@@ -94,6 +95,45 @@ func (s *SpoolSuite) TestCompressionAndPrimary(c *gc.C) {
 		gc.Equals, "an initial write final write")
 }
 
+func (s *SpoolSuite) TestCompressionWithLZ4AndBrotli(c *gc.C) {
+	for _, codec := range []pb.CompressionCodec{pb.CompressionCodec_LZ4, pb.CompressionCodec_BROTLI} {
+		var obv testSpoolObserver
+		var spool = NewSpool("a/journal", &obv)
+		runReplicateSequence(c, &spool, codec, true)
+
+		c.Check(obv.completes, gc.HasLen, 1)
+		c.Check(obv.completes[0].compressor, gc.IsNil) // Closed.
+		c.Check(obv.completes[0].CompressionCodec, gc.Equals, codec)
+
+		c.Check(contentString(c, obv.completes[0], codec),
+			gc.Equals, "an initial write final write")
+	}
+}
+
+func (s *SpoolSuite) TestSeekableCompression(c *gc.C) {
+	defer func(n int) { codecs.SeekableFrameSize = n }(codecs.SeekableFrameSize)
+	codecs.SeekableFrameSize = 8
+
+	var obv testSpoolObserver
+	var spool = NewSpool("a/journal", &obv)
+	runReplicateSequence(c, &spool, pb.CompressionCodec_ZSTANDARD_SEEKABLE, true)
+
+	c.Check(obv.completes, gc.HasLen, 1)
+	var done = obv.completes[0]
+
+	c.Check(contentString(c, done, pb.CompressionCodec_ZSTANDARD_SEEKABLE),
+		gc.Equals, "an initial write final write")
+
+	// Expect the incrementally compressed Spool has a seek table
+	// indexing its frames.
+	var table, err = codecs.ReadSeekTable(done.compressedFile, done.compressedLength)
+	c.Assert(err, gc.IsNil)
+	c.Check(table, gc.HasLen, 4)
+
+	var _, begin = table.Locate(17)
+	c.Check(begin, gc.Equals, int64(16))
+}
+
 func (s *SpoolSuite) TestCompressionNotPrimary(c *gc.C) {
 	var obv testSpoolObserver
 	var spool = NewSpool("a/journal", &obv)
@@ -351,6 +391,155 @@ func (s *SpoolSuite) TestFileErrorRetries(c *gc.C) {
 	c.Check(contentString(c, spool, pb.CompressionCodec_GZIP), gc.Equals, "foobar")
 }
 
+func (s *SpoolSuite) TestNamedFileRecovery(c *gc.C) {
+	var dir = c.MkDir()
+	defer func(d string) { SpoolDirectory = d }(SpoolDirectory)
+	SpoolDirectory = dir
+
+	defer func(fn func() time.Time) { timeNow = fn }(timeNow)
+	timeNow = func() time.Time { return time.Unix(1234, 0) }
+
+	var listDir = func() (out []string) {
+		var infos, err = ioutil.ReadDir(dir)
+		c.Assert(err, gc.IsNil)
+		for _, info := range infos {
+			out = append(out, info.Name())
+		}
+		return
+	}
+
+	var obv testSpoolObserver
+	var spool = NewSpool("a/journal", &obv)
+	spool.MustApply(newProposal(pb.Fragment{
+		Journal:          "a/journal",
+		Begin:            100,
+		End:              100,
+		CompressionCodec: pb.CompressionCodec_GZIP,
+	}, regEmpty))
+
+	// Content which is rolled back doesn't retain a spool file.
+	var _, err = spool.Apply(&pb.ReplicateRequest{Content: []byte("rolled back")}, true)
+	c.Check(err, gc.IsNil)
+	c.Check(listDir(), gc.HasLen, 1)
+	spool.MustApply(newProposal(pb.Fragment{
+		Journal:          "a/journal",
+		Begin:            200,
+		End:              200,
+		CompressionCodec: pb.CompressionCodec_NONE,
+	}, regEmpty))
+	c.Check(listDir(), gc.HasLen, 0)
+
+	// Apply and commit content, followed by uncommitted content.
+	_, err = spool.Apply(&pb.ReplicateRequest{Content: []byte("committed")}, true)
+	c.Check(err, gc.IsNil)
+	var proposal = spool.Next()
+	_, err = spool.Apply(&pb.ReplicateRequest{Proposal: &proposal, Registers: &regFoo}, true)
+	c.Check(err, gc.IsNil)
+	_, err = spool.Apply(&pb.ReplicateRequest{Content: []byte(" and not"), ContentDelta: 0}, true)
+	c.Check(err, gc.IsNil)
+
+	// Simulate a crash, and recover the spool file.
+	var names = listDir()
+	c.Check(names, gc.HasLen, 1)
+
+	recovered, err := RecoverSpools(dir)
+	c.Check(err, gc.IsNil)
+	c.Assert(recovered, gc.HasLen, 1)
+	c.Check(recovered[0].Fragment.Fragment, gc.DeepEquals, spool.Fragment.Fragment)
+	c.Check(recovered[0].FirstAppendTime.Equal(time.Unix(1234, 0)), gc.Equals, true)
+	c.Check(recovered[0].LastAppendTime.Equal(time.Unix(1234, 0)), gc.Equals, true)
+	c.Check(contentString(c, recovered[0], pb.CompressionCodec_NONE), gc.Equals, "committed")
+
+	// The recovered spool may be compressed for persistence.
+	recovered[0].CompressionCodec = pb.CompressionCodec_GZIP
+	recovered[0].finishCompression()
+	c.Check(contentString(c, recovered[0], pb.CompressionCodec_GZIP), gc.Equals, "committed")
+
+	// Once released, its file is removed.
+	releaseSpoolFile(recovered[0].File)
+	c.Check(listDir(), gc.HasLen, 0)
+
+	// Spool files having corrupted content or headers are renamed, and not
+	// recovered. Other files are ignored.
+	spool = NewSpool("a/journal", &obv)
+	_, err = spool.Apply(&pb.ReplicateRequest{Content: []byte("more")}, true)
+	c.Check(err, gc.IsNil)
+	proposal = spool.Next()
+	_, err = spool.Apply(&pb.ReplicateRequest{Proposal: &proposal, Registers: &regFoo}, true)
+	c.Check(err, gc.IsNil)
+	_, err = spool.File.WriteAt([]byte("M"), 0)
+	c.Check(err, gc.IsNil)
+
+	names = listDir()
+	c.Check(names, gc.HasLen, 1)
+
+	c.Check(ioutil.WriteFile(dir+"/other.spool", []byte("garbage"), 0600), gc.IsNil)
+	c.Check(ioutil.WriteFile(dir+"/ignored", []byte("garbage"), 0600), gc.IsNil)
+
+	recovered, err = RecoverSpools(dir)
+	c.Check(err, gc.IsNil)
+	c.Check(recovered, gc.HasLen, 0)
+	c.Check(listDir(), gc.DeepEquals, []string{"ignored", "other.spool.invalid", names[0] + ".invalid"})
+}
+
+func (s *SpoolSuite) TestNamedFileTornHeaderRecovery(c *gc.C) {
+	var dir = c.MkDir()
+	defer func(d string) { SpoolDirectory = d }(SpoolDirectory)
+	SpoolDirectory = dir
+
+	var obv testSpoolObserver
+	var spool = NewSpool("a/journal", &obv)
+
+	// Commit twice. The header of the Spool's creation is written to the
+	// first slot, and of its commits to the second and then first slots.
+	for _, content := range []string{"first", " second"} {
+		var _, err = spool.Apply(&pb.ReplicateRequest{Content: []byte(content)}, true)
+		c.Check(err, gc.IsNil)
+		var proposal = spool.Next()
+		_, err = spool.Apply(&pb.ReplicateRequest{Proposal: &proposal, Registers: &regFoo}, true)
+		c.Check(err, gc.IsNil)
+	}
+	var f = spool.File.(*namedSpoolFile).File
+	var first = pb.Fragment{
+		Journal:          "a/journal",
+		Begin:            0,
+		End:              5,
+		Sum:              pb.SHA1SumOf("first"),
+		CompressionCodec: pb.CompressionCodec_NONE,
+	}
+
+	var recoverSpools = func() []Spool {
+		var recovered, err = RecoverSpools(dir)
+		c.Check(err, gc.IsNil)
+		return recovered
+	}
+
+	// Case: both headers are intact, and the most recent commit is recovered.
+	var recovered = recoverSpools()
+	c.Assert(recovered, gc.HasLen, 1)
+	c.Check(recovered[0].Fragment.Fragment, gc.DeepEquals, spool.Fragment.Fragment)
+	c.Check(contentString(c, recovered[0], pb.CompressionCodec_NONE), gc.Equals, "first second")
+	c.Check(recovered[0].File.Close(), gc.IsNil)
+
+	// Case: the most recent header is torn, and the prior commit is recovered.
+	var _, err = f.WriteAt([]byte("torn"), 100)
+	c.Check(err, gc.IsNil)
+
+	recovered = recoverSpools()
+	c.Assert(recovered, gc.HasLen, 1)
+	c.Check(recovered[0].Fragment.Fragment, gc.DeepEquals, first)
+	c.Check(contentString(c, recovered[0], pb.CompressionCodec_NONE), gc.Equals, "first")
+	c.Check(recovered[0].File.Close(), gc.IsNil)
+
+	// Case: both headers are torn, and the file is renamed as invalid.
+	_, err = f.WriteAt([]byte("torn"), spoolHeaderSlotSize+100)
+	c.Check(err, gc.IsNil)
+
+	c.Check(recoverSpools(), gc.HasLen, 0)
+	_, err = os.Stat(f.Name() + ".invalid")
+	c.Check(err, gc.IsNil)
+}
+
 func useShortRetryInterval() func() {
 	var d = spoolRetryInterval
 	spoolRetryInterval = time.Millisecond
@@ -404,8 +593,9 @@ func contentString(c *gc.C, s Spool, codec pb.CompressionCodec) string {
 	if s.compressedFile == nil {
 		rc = ioutil.NopCloser(io.NewSectionReader(s.File, 0, s.ContentLength()))
 	} else {
-		rc, err = codecs.NewCodecReader(
-			io.NewSectionReader(s.compressedFile, 0, s.compressedLength), codec)
+		rc, err = codecs.NewCodecReaderWithDictionary(
+			io.NewSectionReader(s.compressedFile, 0, s.compressedLength), codec,
+			codecs.Dictionary{Name: s.CompressionDictionary})
 	}
 	c.Assert(err, gc.IsNil)
 
@@ -521,6 +711,7 @@ func runReplicateSequence(c *gc.C, s *Spool, codec pb.CompressionCodec, primary
 			c.Check(s.FirstAppendTime.IsZero(), gc.Equals, true)
 		} else {
 			c.Check(s.FirstAppendTime.Equal(fixedTime), gc.Equals, true)
+			c.Check(s.LastAppendTime.Equal(fixedTime), gc.Equals, true)
 		}
 	}
 }
@@ -20,3 +20,17 @@ var newSpoolFile = func() (File, error) {
 		return f, os.Remove(f.Name())
 	}
 }
+
+// syncDir flushes the entries of directory |dir| to stable storage, so that
+// files created within it survive a crash of the host.
+func syncDir(dir string) error {
+	var f, err = os.Open(dir)
+	if err != nil {
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
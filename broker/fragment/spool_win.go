@@ -23,6 +23,10 @@ var newSpoolFile = func() (File, error) {
 	}
 }
 
+// syncDir is a no-op on Windows, which doesn't support flushing directories.
+// Directory entries are instead made durable by the NTFS journal.
+func syncDir(string) error { return nil }
+
 func removeFileFinalizer(f *os.File) {
 	if err := f.Close(); err != nil {
 		log.WithFields(log.Fields{"name": f.Name(), "err": err}).Error("failed to Close file in finalizer")
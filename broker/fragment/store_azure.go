@@ -38,11 +38,15 @@ func (a *azureBackend) Provider() string {
 }
 
 func (a *azureBackend) SignGet(ep *url.URL, fragment pb.Fragment, d time.Duration) (string, error) {
+	return a.SignGetPath(ep, fragment.ContentPath(), d)
+}
+
+func (a *azureBackend) SignGetPath(ep *url.URL, path string, d time.Duration) (string, error) {
 	cfg, _, err := a.azureClient(ep)
 	if err != nil {
 		return "", err
 	}
-	blobName := cfg.rewritePath(cfg.prefix, fragment.ContentPath())
+	blobName := cfg.rewritePath(cfg.prefix, path)
 	sasQueryParams, err := azblob.BlobSASSignatureValues{
 		Protocol:      azblob.SASProtocolHTTPS, // Users MUST use HTTPS (not HTTP)
 		ExpiryTime:    time.Now().UTC().Add(d), // Timestamps are expected in UTC https://docs.microsoft.com/en-us/rest/api/storageservices/create-service-sas#service-sas-example
@@ -82,11 +86,15 @@ func (a *azureBackend) Exists(ctx context.Context, ep *url.URL, fragment pb.Frag
 }
 
 func (a *azureBackend) Open(ctx context.Context, ep *url.URL, fragment pb.Fragment) (io.ReadCloser, error) {
+	return a.OpenPath(ctx, ep, fragment.ContentPath())
+}
+
+func (a *azureBackend) OpenPath(ctx context.Context, ep *url.URL, path string) (io.ReadCloser, error) {
 	cfg, client, err := a.azureClient(ep)
 	if err != nil {
 		return nil, err
 	}
-	blobURL, err := a.buildBlobURL(cfg, client, fragment.ContentPath())
+	blobURL, err := a.buildBlobURL(cfg, client, path)
 	if err != nil {
 		return nil, err
 	}
@@ -97,26 +105,57 @@ func (a *azureBackend) Open(ctx context.Context, ep *url.URL, fragment pb.Fragme
 	return download.Body(azblob.RetryReaderOptions{}), nil
 }
 
-func (a *azureBackend) Persist(ctx context.Context, ep *url.URL, spool Spool) error {
+func (a *azureBackend) OpenRange(ctx context.Context, ep *url.URL, fragment pb.Fragment, offset, length int64) (io.ReadCloser, error) {
 	cfg, client, err := a.azureClient(ep)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	blobURL, err := a.buildBlobURL(cfg, client, spool.ContentPath())
+	blobURL, err := a.buildBlobURL(cfg, client, fragment.ContentPath())
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if offset < 0 {
+		// Azure doesn't support suffix ranges. Resolve |offset| from the blob size.
+		props, err := blobURL.GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{})
+		if err != nil {
+			return nil, err
+		} else if offset += props.ContentLength(); offset < 0 {
+			offset = 0
+		}
+	}
+	var count int64 = azblob.CountToEnd
+	if length >= 0 {
+		count = length
 	}
+	download, err := blobURL.Download(ctx, offset, count, azblob.BlobAccessConditions{}, false, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return download.Body(azblob.RetryReaderOptions{}), nil
+}
+
+func (a *azureBackend) Persist(ctx context.Context, ep *url.URL, spool Spool) error {
 	headers := azblob.BlobHTTPHeaders{}
-	var body io.ReadSeeker
 	if spool.CompressionCodec == pb.CompressionCodec_GZIP_OFFLOAD_DECOMPRESSION {
 		headers.ContentEncoding = "gzip"
 	}
-	if spool.CompressionCodec != pb.CompressionCodec_NONE {
-		body = io.NewSectionReader(spool.compressedFile, 0, spool.compressedLength)
-	} else {
-		body = io.NewSectionReader(spool.File, 0, spool.ContentLength())
+	return a.upload(ctx, ep, spool.ContentPath(), spool.persistedContent(), headers, modTimeMetadata(spool))
+}
+
+func (a *azureBackend) PersistPath(ctx context.Context, ep *url.URL, path string, content *io.SectionReader) error {
+	return a.upload(ctx, ep, path, content, azblob.BlobHTTPHeaders{}, nil)
+}
+
+func (a *azureBackend) upload(ctx context.Context, ep *url.URL, path string, body io.ReadSeeker, headers azblob.BlobHTTPHeaders, metadata map[string]string) error {
+	cfg, client, err := a.azureClient(ep)
+	if err != nil {
+		return err
+	}
+	blobURL, err := a.buildBlobURL(cfg, client, path)
+	if err != nil {
+		return err
 	}
-	_, err = blobURL.Upload(ctx, body, headers, azblob.Metadata{}, azblob.BlobAccessConditions{}, azblob.DefaultAccessTier, azblob.BlobTagsMap{}, azblob.ClientProvidedKeyOptions{})
+	_, err = blobURL.Upload(ctx, body, headers, azblob.Metadata(metadata), azblob.BlobAccessConditions{}, azblob.DefaultAccessTier, azblob.BlobTagsMap{}, azblob.ClientProvidedKeyOptions{})
 	return err
 }
 
@@ -130,7 +169,10 @@ func (a *azureBackend) List(ctx context.Context, store pb.FragmentStore, ep *url
 		return err
 	}
 	containerURL := azblob.NewContainerURL(*u, client)
-	options := azblob.ListBlobsSegmentOptions{Prefix: cfg.rewritePath(cfg.prefix, journal.String()) + "/"}
+	options := azblob.ListBlobsSegmentOptions{
+		Prefix:  cfg.rewritePath(cfg.prefix, journal.String()) + "/",
+		Details: azblob.BlobListingDetails{Metadata: true},
+	}
 	for marker := (azblob.Marker{}); marker.NotDone(); {
 		segmentList, err := containerURL.ListBlobsFlatSegment(ctx, marker, options)
 		if err != nil {
@@ -144,7 +186,7 @@ func (a *azureBackend) List(ctx context.Context, store pb.FragmentStore, ep *url
 			} else if *(blob.Properties.ContentLength) == 0 && frag.ContentLength() > 0 {
 				log.WithFields(log.Fields{"bucket": cfg.bucket, "name": blob.Name}).Warning("zero-length fragment")
 			} else {
-				frag.ModTime = blob.Properties.LastModified.Unix()
+				frag.ModTime = modTimeFromMetadata(blob.Metadata, blob.Properties.LastModified.Unix())
 				frag.BackingStore = store
 				callback(frag)
 			}
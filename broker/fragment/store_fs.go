@@ -29,13 +29,17 @@ func (s fsBackend) Provider() string {
 	return "fs"
 }
 
-func (s fsBackend) SignGet(ep *url.URL, fragment pb.Fragment, _ time.Duration) (string, error) {
+func (s fsBackend) SignGet(ep *url.URL, fragment pb.Fragment, d time.Duration) (string, error) {
+	return s.SignGetPath(ep, fragment.ContentPath(), d)
+}
+
+func (s fsBackend) SignGetPath(ep *url.URL, path string, _ time.Duration) (string, error) {
 	var cfg, err = s.fsCfg(ep)
 	if err != nil {
 		return "", err
 	}
 
-	return "file://" + cfg.rewritePath(ep.Path, fragment.ContentPath()), nil
+	return "file://" + cfg.rewritePath(ep.Path, path), nil
 }
 
 func (s fsBackend) Exists(_ context.Context, ep *url.URL, fragment pb.Fragment) (bool, error) {
@@ -55,23 +59,71 @@ func (s fsBackend) Exists(_ context.Context, ep *url.URL, fragment pb.Fragment)
 	}
 }
 
-func (s fsBackend) Open(_ context.Context, ep *url.URL, fragment pb.Fragment) (io.ReadCloser, error) {
+func (s fsBackend) Open(ctx context.Context, ep *url.URL, fragment pb.Fragment) (io.ReadCloser, error) {
+	return s.OpenPath(ctx, ep, fragment.ContentPath())
+}
+
+func (s fsBackend) OpenRange(ctx context.Context, ep *url.URL, fragment pb.Fragment, offset, length int64) (io.ReadCloser, error) {
+	var rc, err = s.Open(ctx, ep, fragment)
+	if err != nil {
+		return nil, err
+	}
+	var f = rc.(*os.File)
+
+	if offset < 0 {
+		var info os.FileInfo
+		if info, err = f.Stat(); err != nil {
+			_ = f.Close()
+			return nil, err
+		} else if offset += info.Size(); offset < 0 {
+			offset = 0
+		}
+	}
+	if _, err = f.Seek(offset, io.SeekStart); err != nil {
+		_ = f.Close()
+		return nil, err
+	} else if length < 0 {
+		return f, nil
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(f, length), f}, nil
+}
+
+func (s fsBackend) Persist(ctx context.Context, ep *url.URL, spool Spool) error {
+	if err := s.PersistPath(ctx, ep, spool.ContentPath(), spool.persistedContent()); err != nil {
+		return err
+	} else if spool.ModTime == 0 {
+		return nil
+	}
+	var cfg, err = s.fsCfg(ep)
+	if err != nil {
+		return err
+	}
+	// The ModTime of the Spool is recorded as the file's modification time.
+	var path = filepath.Join(FileSystemStoreRoot, filepath.FromSlash(cfg.rewritePath(ep.Path, spool.ContentPath())))
+	var modTime = time.Unix(spool.ModTime, 0)
+	return os.Chtimes(path, modTime, modTime)
+}
+
+func (s fsBackend) OpenPath(_ context.Context, ep *url.URL, name string) (io.ReadCloser, error) {
 	var cfg, err = s.fsCfg(ep)
 	if err != nil {
 		return nil, err
 	}
 
-	var path = filepath.Join(FileSystemStoreRoot, filepath.FromSlash(cfg.rewritePath(ep.Path, fragment.ContentPath())))
+	var path = filepath.Join(FileSystemStoreRoot, filepath.FromSlash(cfg.rewritePath(ep.Path, name)))
 	return os.Open(path)
 }
 
-func (s fsBackend) Persist(_ context.Context, ep *url.URL, spool Spool) error {
+func (s fsBackend) PersistPath(_ context.Context, ep *url.URL, name string, content *io.SectionReader) error {
 	var cfg, err = s.fsCfg(ep)
 	if err != nil {
 		return err
 	}
 
-	var path = filepath.Join(FileSystemStoreRoot, filepath.FromSlash(cfg.rewritePath(ep.Path, spool.ContentPath())))
+	var path = filepath.Join(FileSystemStoreRoot, filepath.FromSlash(cfg.rewritePath(ep.Path, name)))
 
 	// Create the fragment's directory, if not already present.
 	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
@@ -92,12 +144,7 @@ func (s fsBackend) Persist(_ context.Context, ep *url.URL, spool Spool) error {
 		}
 	}(f.Name())
 
-	switch spool.CompressionCodec {
-	case pb.CompressionCodec_NONE:
-		_, err = io.Copy(f, io.NewSectionReader(spool.File, 0, spool.ContentLength()))
-	default:
-		_, err = io.Copy(f, io.NewSectionReader(spool.compressedFile, 0, spool.compressedLength))
-	}
+	_, err = io.Copy(f, content)
 
 	if err == nil {
 		err = f.Close()
@@ -37,6 +37,10 @@ func (s *gcsBackend) Provider() string {
 }
 
 func (s *gcsBackend) SignGet(ep *url.URL, fragment pb.Fragment, d time.Duration) (string, error) {
+	return s.SignGetPath(ep, fragment.ContentPath(), d)
+}
+
+func (s *gcsBackend) SignGetPath(ep *url.URL, path string, d time.Duration) (string, error) {
 	cfg, _, opts, err := s.gcsClient(ep)
 	if err != nil {
 		return "", err
@@ -44,7 +48,7 @@ func (s *gcsBackend) SignGet(ep *url.URL, fragment pb.Fragment, d time.Duration)
 	opts.Method = "GET"
 	opts.Expires = time.Now().Add(d)
 
-	return storage.SignedURL(cfg.bucket, cfg.rewritePath(cfg.prefix, fragment.ContentPath()), &opts)
+	return storage.SignedURL(cfg.bucket, cfg.rewritePath(cfg.prefix, path), &opts)
 }
 
 func (s *gcsBackend) Exists(ctx context.Context, ep *url.URL, fragment pb.Fragment) (exists bool, err error) {
@@ -62,30 +66,51 @@ func (s *gcsBackend) Exists(ctx context.Context, ep *url.URL, fragment pb.Fragme
 }
 
 func (s *gcsBackend) Open(ctx context.Context, ep *url.URL, fragment pb.Fragment) (io.ReadCloser, error) {
+	return s.OpenPath(ctx, ep, fragment.ContentPath())
+}
+
+func (s *gcsBackend) OpenRange(ctx context.Context, ep *url.URL, fragment pb.Fragment, offset, length int64) (io.ReadCloser, error) {
 	cfg, client, _, err := s.gcsClient(ep)
 	if err != nil {
 		return nil, err
 	}
-	return client.Bucket(cfg.bucket).Object(cfg.rewritePath(cfg.prefix, fragment.ContentPath())).NewReader(ctx)
+	// Ask for the object as stored. Otherwise, GCS ignores ranges of objects
+	// having a "Content-Encoding: gzip" and instead decompresses them.
+	var obj = client.Bucket(cfg.bucket).Object(cfg.rewritePath(cfg.prefix, fragment.ContentPath()))
+	return obj.ReadCompressed(true).NewRangeReader(ctx, offset, length)
 }
 
 func (s *gcsBackend) Persist(ctx context.Context, ep *url.URL, spool Spool) error {
+	var encoding string
+	if spool.CompressionCodec == pb.CompressionCodec_GZIP_OFFLOAD_DECOMPRESSION {
+		encoding = "gzip"
+	}
+	return s.put(ctx, ep, spool.ContentPath(), spool.persistedContent(), encoding, modTimeMetadata(spool))
+}
+
+func (s *gcsBackend) OpenPath(ctx context.Context, ep *url.URL, path string) (io.ReadCloser, error) {
+	cfg, client, _, err := s.gcsClient(ep)
+	if err != nil {
+		return nil, err
+	}
+	return client.Bucket(cfg.bucket).Object(cfg.rewritePath(cfg.prefix, path)).NewReader(ctx)
+}
+
+func (s *gcsBackend) PersistPath(ctx context.Context, ep *url.URL, path string, content *io.SectionReader) error {
+	return s.put(ctx, ep, path, content, "", nil)
+}
+
+func (s *gcsBackend) put(ctx context.Context, ep *url.URL, path string, content io.Reader, encoding string, metadata map[string]string) error {
 	cfg, client, _, err := s.gcsClient(ep)
 	if err != nil {
 		return err
 	}
 	ctx, cancel := context.WithCancel(ctx)
-	var wc = client.Bucket(cfg.bucket).Object(cfg.rewritePath(cfg.prefix, spool.ContentPath())).NewWriter(ctx)
+	var wc = client.Bucket(cfg.bucket).Object(cfg.rewritePath(cfg.prefix, path)).NewWriter(ctx)
+	wc.ContentEncoding = encoding
+	wc.Metadata = metadata
 
-	if spool.CompressionCodec == pb.CompressionCodec_GZIP_OFFLOAD_DECOMPRESSION {
-		wc.ContentEncoding = "gzip"
-	}
-	if spool.CompressionCodec != pb.CompressionCodec_NONE {
-		_, err = io.Copy(wc, io.NewSectionReader(spool.compressedFile, 0, spool.compressedLength))
-	} else {
-		_, err = io.Copy(wc, io.NewSectionReader(spool.File, 0, spool.ContentLength()))
-	}
-	if err != nil {
+	if _, err = io.Copy(wc, content); err != nil {
 		cancel() // Abort |wc|.
 	} else {
 		err = wc.Close()
@@ -113,7 +138,7 @@ func (s *gcsBackend) List(ctx context.Context, store pb.FragmentStore, ep *url.U
 		} else if obj.Size == 0 && frag.ContentLength() > 0 {
 			log.WithFields(log.Fields{"bucket": cfg.bucket, "name": obj.Name}).Warning("zero-length fragment")
 		} else {
-			frag.ModTime = obj.Updated.Unix()
+			frag.ModTime = modTimeFromMetadata(obj.Metadata, obj.Updated.Unix())
 			frag.BackingStore = store
 			callback(frag)
 		}
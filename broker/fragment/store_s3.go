@@ -59,6 +59,10 @@ func (s *s3Backend) Provider() string {
 }
 
 func (s *s3Backend) SignGet(ep *url.URL, fragment pb.Fragment, d time.Duration) (string, error) {
+	return s.SignGetPath(ep, fragment.ContentPath(), d)
+}
+
+func (s *s3Backend) SignGetPath(ep *url.URL, path string, d time.Duration) (string, error) {
 	cfg, client, err := s.s3Client(ep)
 	if err != nil {
 		return "", err
@@ -66,7 +70,7 @@ func (s *s3Backend) SignGet(ep *url.URL, fragment pb.Fragment, d time.Duration)
 
 	var getObj = s3.GetObjectInput{
 		Bucket: aws.String(cfg.bucket),
-		Key:    aws.String(cfg.rewritePath(cfg.prefix, fragment.ContentPath())),
+		Key:    aws.String(cfg.rewritePath(cfg.prefix, path)),
 	}
 	var req, _ = client.GetObjectRequest(&getObj)
 	return req.Presign(d)
@@ -92,15 +96,34 @@ func (s *s3Backend) Exists(ctx context.Context, ep *url.URL, fragment pb.Fragmen
 }
 
 func (s *s3Backend) Open(ctx context.Context, ep *url.URL, fragment pb.Fragment) (io.ReadCloser, error) {
+	return s.OpenPath(ctx, ep, fragment.ContentPath())
+}
+
+func (s *s3Backend) OpenRange(ctx context.Context, ep *url.URL, fragment pb.Fragment, offset, length int64) (io.ReadCloser, error) {
+	var getObj s3.GetObjectInput
+
+	if offset < 0 {
+		getObj.Range = aws.String(fmt.Sprintf("bytes=%d", offset))
+	} else if length < 0 {
+		getObj.Range = aws.String(fmt.Sprintf("bytes=%d-", offset))
+	} else {
+		getObj.Range = aws.String(fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	}
+	return s.get(ctx, ep, fragment.ContentPath(), getObj)
+}
+
+func (s *s3Backend) OpenPath(ctx context.Context, ep *url.URL, path string) (io.ReadCloser, error) {
+	return s.get(ctx, ep, path, s3.GetObjectInput{})
+}
+
+func (s *s3Backend) get(ctx context.Context, ep *url.URL, path string, getObj s3.GetObjectInput) (io.ReadCloser, error) {
 	cfg, client, err := s.s3Client(ep)
 	if err != nil {
 		return nil, err
 	}
+	getObj.Bucket = aws.String(cfg.bucket)
+	getObj.Key = aws.String(cfg.rewritePath(cfg.prefix, path))
 
-	var getObj = s3.GetObjectInput{
-		Bucket: aws.String(cfg.bucket),
-		Key:    aws.String(cfg.rewritePath(cfg.prefix, fragment.ContentPath())),
-	}
 	var resp *s3.GetObjectOutput
 	if resp, err = client.GetObjectWithContext(ctx, &getObj); err != nil {
 		return nil, err
@@ -109,15 +132,25 @@ func (s *s3Backend) Open(ctx context.Context, ep *url.URL, fragment pb.Fragment)
 }
 
 func (s *s3Backend) Persist(ctx context.Context, ep *url.URL, spool Spool) error {
+	var putObj s3.PutObjectInput
+
+	if spool.CompressionCodec == pb.CompressionCodec_GZIP_OFFLOAD_DECOMPRESSION {
+		putObj.ContentEncoding = aws.String("gzip")
+	}
+	return s.put(ctx, ep, spool.ContentPath(), spool.persistedContent(), putObj)
+}
+
+func (s *s3Backend) PersistPath(ctx context.Context, ep *url.URL, path string, content *io.SectionReader) error {
+	return s.put(ctx, ep, path, content, s3.PutObjectInput{})
+}
+
+func (s *s3Backend) put(ctx context.Context, ep *url.URL, path string, body io.ReadSeeker, putObj s3.PutObjectInput) error {
 	cfg, client, err := s.s3Client(ep)
 	if err != nil {
 		return err
 	}
-
-	var putObj = s3.PutObjectInput{
-		Bucket: aws.String(cfg.bucket),
-		Key:    aws.String(cfg.rewritePath(cfg.prefix, spool.ContentPath())),
-	}
+	putObj.Bucket = aws.String(cfg.bucket)
+	putObj.Key = aws.String(cfg.rewritePath(cfg.prefix, path))
 
 	if cfg.ACL != "" {
 		putObj.ACL = aws.String(cfg.ACL)
@@ -128,14 +161,7 @@ func (s *s3Backend) Persist(ctx context.Context, ep *url.URL, spool Spool) error
 	if cfg.SSE != "" {
 		putObj.ServerSideEncryption = aws.String(cfg.SSE)
 	}
-	if spool.CompressionCodec == pb.CompressionCodec_GZIP_OFFLOAD_DECOMPRESSION {
-		putObj.ContentEncoding = aws.String("gzip")
-	}
-	if spool.CompressionCodec != pb.CompressionCodec_NONE {
-		putObj.Body = io.NewSectionReader(spool.compressedFile, 0, spool.compressedLength)
-	} else {
-		putObj.Body = io.NewSectionReader(spool.File, 0, spool.ContentLength())
-	}
+	putObj.Body = body
 	_, err = client.PutObjectWithContext(ctx, &putObj)
 	return err
 }
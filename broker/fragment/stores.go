@@ -3,15 +3,20 @@ package fragment
 import (
 	"bytes"
 	"context"
+	"crypto/sha1"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/url"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
 
 	"github.com/gorilla/schema"
 	"github.com/pkg/errors"
+	"go.gazette.dev/core/broker/codecs"
+	"go.gazette.dev/core/broker/envelope"
 	pb "go.gazette.dev/core/broker/protocol"
 )
 
@@ -24,7 +29,18 @@ type backend interface {
 	SignGet(ep *url.URL, fragment pb.Fragment, d time.Duration) (string, error)
 	Exists(ctx context.Context, ep *url.URL, fragment pb.Fragment) (bool, error)
 	Open(ctx context.Context, ep *url.URL, fragment pb.Fragment) (io.ReadCloser, error)
+	// OpenRange reads at most |length| bytes of the stored Fragment file,
+	// beginning at |offset|, or through its end if |length| is negative.
+	// If |offset| is negative, the final -|offset| bytes of the file are read
+	// and |length| must also be negative. Stored content is read as-is,
+	// and is never decompressed by the store.
+	OpenRange(ctx context.Context, ep *url.URL, fragment pb.Fragment, offset, length int64) (io.ReadCloser, error)
 	Persist(ctx context.Context, ep *url.URL, spool Spool) error
+	// SignGetPath, OpenPath, and PersistPath sign, read, and write objects of
+	// the store which aren't Fragments, at a |path| relative to the store.
+	SignGetPath(ep *url.URL, path string, d time.Duration) (string, error)
+	OpenPath(ctx context.Context, ep *url.URL, path string) (io.ReadCloser, error)
+	PersistPath(ctx context.Context, ep *url.URL, path string, content *io.SectionReader) error
 	List(ctx context.Context, store pb.FragmentStore, ep *url.URL, name pb.Journal, callback func(pb.Fragment)) error
 	Remove(ctx context.Context, fragment pb.Fragment) error
 }
@@ -69,19 +85,74 @@ func SignGetURL(fragment pb.Fragment, d time.Duration) (string, error) {
 
 // Open a Reader of the Fragment on the store. The returned ReadCloser does not
 // perform any applicable client-side decompression, but does request server
-// decompression in the case of GZIP_OFFLOAD_DECOMPRESSION.
+// decompression in the case of GZIP_OFFLOAD_DECOMPRESSION. If the Fragment is
+// encrypted, it's decrypted using the envelope.DefaultKMS.
 func Open(ctx context.Context, fragment pb.Fragment) (io.ReadCloser, error) {
 	var ep = fragment.BackingStore.URL()
 	var b = getBackend(ep.Scheme)
 
 	var rc, err = b.Open(ctx, ep, fragment)
 	instrumentStoreOp(b.Provider(), "open", err)
-	return rc, err
+
+	if err != nil || !fragment.Encrypted {
+		return rc, err
+	}
+	dec, err := envelope.NewReader(ctx, rc, envelope.DefaultKMS)
+	if err != nil {
+		_ = rc.Close()
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{dec, rc}, nil
+}
+
+// OpenAt opens a Reader of the Fragment on the store, as does Open, and returns
+// the journal offset at which the returned raw content begins. It's the
+// Fragment Begin unless the Fragment is ZSTANDARD_SEEKABLE, in which case the
+// Fragment's seek table is first read from the store, and only content
+// beginning with the compressed frame which covers |offset| is read.
+func OpenAt(ctx context.Context, fragment pb.Fragment, offset int64) (io.ReadCloser, int64, error) {
+	if fragment.CompressionCodec != pb.CompressionCodec_ZSTANDARD_SEEKABLE ||
+		fragment.Encrypted || offset <= fragment.Begin {
+
+		var rc, err = Open(ctx, fragment)
+		return rc, fragment.Begin, err
+	}
+	var ep = fragment.BackingStore.URL()
+	var b = getBackend(ep.Scheme)
+
+	var table, err = codecs.LoadSeekTable(func(n int64) ([]byte, error) {
+		var rc, err = b.OpenRange(ctx, ep, fragment, -n, -1)
+		instrumentStoreOp(b.Provider(), "open_range", err)
+
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return ioutil.ReadAll(rc)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	var compressed, decompressed = table.Locate(offset - fragment.Begin)
+
+	rc, err := b.OpenRange(ctx, ep, fragment, compressed, -1)
+	instrumentStoreOp(b.Provider(), "open_range", err)
+
+	if err != nil {
+		return nil, 0, err
+	}
+	return rc, fragment.Begin + decompressed, nil
 }
 
 // Persist a Spool to the JournalSpec's store. If the Spool Fragment is already
 // present, this is a no-op. If the Spool has not been compressed incrementally,
-// it will be compressed before being persisted.
+// it will be compressed before being persisted. If the Spool is compressed with
+// a dictionary which isn't present in the store, it's first copied from
+// another of the JournalSpec's stores. If the JournalSpec has an EncryptionKey,
+// the Spool is encrypted under it using the envelope.DefaultKMS.
 func Persist(ctx context.Context, spool Spool, spec *pb.JournalSpec) error {
 	if DisableStores {
 		return nil // No-op.
@@ -89,6 +160,7 @@ func Persist(ctx context.Context, spool Spool, spec *pb.JournalSpec) error {
 		return nil // No-op.
 	}
 	spool.BackingStore = spec.Fragment.Stores[0]
+	spool.Encrypted = spec.Fragment.EncryptionKey != ""
 
 	if postfix, err := evalPathPostfix(spool, spec); err != nil {
 		return err
@@ -107,10 +179,21 @@ func Persist(ctx context.Context, spool Spool, spec *pb.JournalSpec) error {
 		return nil // All done.
 	}
 
+	if spool.CompressionCodec == pb.CompressionCodec_ZSTANDARD_DICT {
+		if err = ensureDictionary(ctx, spool.CompressionDictionary, spool.BackingStore, spec.Fragment.Stores); err != nil {
+			return err
+		}
+	}
 	// Ensure |compressedFile| is ready. This is a no-op if compressed incrementally.
 	if spool.CompressionCodec != pb.CompressionCodec_NONE {
 		spool.finishCompression()
 	}
+	if spool.Encrypted {
+		if err = encryptSpool(ctx, &spool, spec.Fragment.EncryptionKey); err != nil {
+			return err
+		}
+		defer spool.encryptedFile.Close()
+	}
 
 	// We expect persisting individual spools to be fast, but have seen bugs
 	// in the past where eg a storage backend behavior change caused occasional
@@ -146,6 +229,274 @@ func Remove(ctx context.Context, fragment pb.Fragment) error {
 	return err
 }
 
+// Copy |fragment| from its BackingStore to the FragmentStore |to|, returning
+// the copied Fragment. Fragment content is verified against its SHA1 Sum as
+// it's read from the BackingStore, and the persisted copy is then read back
+// and verified as well. If the copy already exists in |to|, it's verified
+// but is not re-written. If |encryptionKey| is non-empty, the copy is
+// encrypted under it. The copy retains the ModTime of |fragment|, which file,
+// GCS, and Azure stores record and report in listings of the copy (S3
+// listings report the time of the copy).
+func Copy(ctx context.Context, fragment pb.Fragment, to pb.FragmentStore, encryptionKey string) (pb.Fragment, error) {
+	return merge(ctx, []pb.Fragment{fragment}, to, fragment.CompressionCodec,
+		fragment.CompressionDictionary, encryptionKey, fragment.ModTime)
+}
+
+// Merge the content of adjacent |fragments| into a single Fragment, which is
+// encoded with |codec| and persisted to the FragmentStore |to|. The content
+// of each of |fragments| is verified against its SHA1 Sum as it's read, and
+// the persisted Fragment is then read back and verified as well. If the
+// merged Fragment already exists in |to|, it's verified but not re-written.
+// If |codec| is dictionary-backed, the merged Fragment is compressed with
+// the named |dictionary|, which is copied to |to| from the store of one
+// of |fragments| if it's not already present. If |encryptionKey| is
+// non-empty, the merged Fragment is encrypted under it. The merged Fragment
+// has the latest ModTime of |fragments|, so that it ages (and is tiered or
+// pruned) no earlier than its most recent content would have.
+// Merge does not remove |fragments|.
+func Merge(ctx context.Context, fragments []pb.Fragment, to pb.FragmentStore, codec pb.CompressionCodec, dictionary, encryptionKey string) (pb.Fragment, error) {
+	var modTime int64
+	for _, f := range fragments {
+		if f.ModTime > modTime {
+			modTime = f.ModTime
+		}
+	}
+	return merge(ctx, fragments, to, codec, dictionary, encryptionKey, modTime)
+}
+
+// merge is Merge, where the merged Fragment is persisted with |modTime|
+// if it's non-zero.
+func merge(ctx context.Context, fragments []pb.Fragment, to pb.FragmentStore, codec pb.CompressionCodec, dictionary, encryptionKey string, modTime int64) (pb.Fragment, error) {
+	if len(fragments) == 0 {
+		return pb.Fragment{}, fmt.Errorf("expected at least one fragment")
+	}
+	var spool = Spool{Fragment: Fragment{Fragment: pb.Fragment{
+		Journal:          fragments[0].Journal,
+		Begin:            fragments[0].Begin,
+		End:              fragments[0].Begin,
+		CompressionCodec: codec,
+		BackingStore:     to,
+		PathPostfix:      fragments[0].PathPostfix,
+		Encrypted:        encryptionKey != "",
+		ModTime:          modTime,
+	}}}
+	if codec == pb.CompressionCodec_ZSTANDARD_DICT {
+		spool.CompressionDictionary = dictionary
+	}
+
+	var err error
+	if spool.File, err = newSpoolFile(); err != nil {
+		return pb.Fragment{}, fmt.Errorf("creating spool file: %w", err)
+	}
+	defer spool.File.Close()
+
+	// Read and verify each fragment, building up the content and SHA1 sum
+	// of the merged Fragment. The BackingStore encoding of a fragment isn't
+	// copied directly, as it may differ from |codec| or may have been
+	// decoded by the store on read (eg, GZIP_OFFLOAD_DECOMPRESSION).
+	var summer = sha1.New()
+	for _, f := range fragments {
+		if f.Journal != spool.Journal || f.Begin != spool.End {
+			return pb.Fragment{}, fmt.Errorf("fragment %s is not adjacent to %s",
+				f.ContentPath(), spool.ContentPath())
+		} else if err = readVerified(ctx, f, io.MultiWriter(spool.File, summer)); err != nil {
+			return pb.Fragment{}, errors.WithMessagef(err, "reading fragment %s", f.ContentPath())
+		}
+		spool.End = f.End
+	}
+	spool.Sum = pb.SHA1SumFromDigest(summer.Sum(nil))
+
+	var ep = to.URL()
+	var b = getBackend(ep.Scheme)
+
+	exists, err := b.Exists(ctx, ep, spool.Fragment.Fragment)
+	instrumentStoreOp(b.Provider(), "exist", err)
+	if err != nil {
+		return pb.Fragment{}, err
+	}
+
+	if !exists {
+		if spool.CompressionCodec == pb.CompressionCodec_ZSTANDARD_DICT {
+			var from []pb.FragmentStore
+			for _, f := range fragments {
+				from = append(from, f.BackingStore)
+			}
+			if err = ensureDictionary(ctx, spool.CompressionDictionary, to, from); err != nil {
+				return pb.Fragment{}, err
+			}
+		}
+		if spool.CompressionCodec != pb.CompressionCodec_NONE {
+			spool.finishCompression()
+			defer spool.compressedFile.Close()
+		}
+		if spool.Encrypted {
+			if err = encryptSpool(ctx, &spool, encryptionKey); err != nil {
+				return pb.Fragment{}, err
+			}
+			defer spool.encryptedFile.Close()
+		}
+
+		if err = b.Persist(ctx, ep, spool); err == nil {
+			storePersistedBytesTotal.WithLabelValues(b.Provider()).Add(float64(spool.ContentLength()))
+		}
+		instrumentStoreOp(b.Provider(), "persist", err)
+
+		if err != nil {
+			return pb.Fragment{}, err
+		}
+	}
+
+	if err = readVerified(ctx, spool.Fragment.Fragment, ioutil.Discard); err != nil {
+		return pb.Fragment{}, errors.WithMessage(err, "verifying persisted fragment")
+	}
+	return spool.Fragment.Fragment, nil
+}
+
+// Verify reads and decompresses the content of |fragment| from its
+// BackingStore, and returns an error if its length or SHA1 sum don't match
+// those of the Fragment.
+func Verify(ctx context.Context, fragment pb.Fragment) error {
+	return readVerified(ctx, fragment, ioutil.Discard)
+}
+
+// QuarantinePrefix is the path, relative to the BackingStore of a Fragment,
+// under which Quarantine moves it. It includes a rune which journal names may
+// not, so that quarantined Fragments are never listed as those of a journal.
+const QuarantinePrefix = "quarantine~/"
+
+// Quarantine moves |fragment| to QuarantinePrefix within its BackingStore,
+// returning the moved Fragment. Unlike Copy, the stored bytes of the fragment
+// are moved as-is and are not verified, decompressed, or decrypted, as the
+// fragment may be corrupt. An uncompressed fragment is moved through its End
+// offset, and any further content is discarded. As with Copy, the moved
+// Fragment retains the ModTime of |fragment|.
+func Quarantine(ctx context.Context, fragment pb.Fragment) (pb.Fragment, error) {
+	var ep = fragment.BackingStore.URL()
+	var b = getBackend(ep.Scheme)
+
+	var to = *ep
+	to.Path, to.RawPath = to.Path+QuarantinePrefix, ""
+
+	var spool = Spool{Fragment: Fragment{Fragment: fragment}}
+	spool.BackingStore = pb.FragmentStore(to.String())
+
+	// Read through OpenRange, which never decodes stored content
+	// (as Open may, for GZIP_OFFLOAD_DECOMPRESSION).
+	var rc, err = b.OpenRange(ctx, ep, fragment, 0, -1)
+	instrumentStoreOp(b.Provider(), "open_range", err)
+	if err != nil {
+		return pb.Fragment{}, err
+	}
+	defer rc.Close()
+
+	var file File
+	if file, err = newSpoolFile(); err != nil {
+		return pb.Fragment{}, fmt.Errorf("creating spool file: %w", err)
+	}
+	defer file.Close()
+
+	var n int64
+	if n, err = io.Copy(file, rc); err != nil {
+		return pb.Fragment{}, errors.WithMessage(err, "reading fragment")
+	}
+	if spool.Encrypted {
+		spool.encryptedFile, spool.encryptedLength = file, n
+	} else if spool.CompressionCodec == pb.CompressionCodec_NONE {
+		spool.File = file
+	} else {
+		spool.compressedFile, spool.compressedLength = file, n
+	}
+
+	err = b.Persist(ctx, &to, spool)
+	instrumentStoreOp(b.Provider(), "persist", err)
+
+	if err != nil {
+		return pb.Fragment{}, err
+	} else if err = Remove(ctx, fragment); err != nil {
+		return pb.Fragment{}, err
+	}
+	return spool.Fragment.Fragment, nil
+}
+
+// modTimeMetadataKey is the key of object metadata under which stores record
+// the ModTime of a persisted Spool, if it has one (as when it's a copy).
+const modTimeMetadataKey = "gazettemodtime"
+
+// modTimeMetadata returns object metadata which records the ModTime of
+// |spool|, or nil if it has none.
+func modTimeMetadata(spool Spool) map[string]string {
+	if spool.ModTime == 0 {
+		return nil
+	}
+	return map[string]string{modTimeMetadataKey: strconv.FormatInt(spool.ModTime, 10)}
+}
+
+// modTimeFromMetadata returns the ModTime recorded in object |metadata|,
+// or |modTime| of the object itself if none is recorded.
+func modTimeFromMetadata(metadata map[string]string, modTime int64) int64 {
+	if v, ok := metadata[modTimeMetadataKey]; !ok {
+		return modTime
+	} else if recorded, err := strconv.ParseInt(v, 10, 64); err != nil {
+		return modTime
+	} else {
+		return recorded
+	}
+}
+
+// encryptSpool encrypts the persisted content of |spool| under the named key
+// of the envelope.DefaultKMS, into the Spool's |encryptedFile|.
+func encryptSpool(ctx context.Context, spool *Spool, key string) error {
+	var file, err = newSpoolFile()
+	if err != nil {
+		return fmt.Errorf("creating spool file: %w", err)
+	}
+
+	var w io.WriteCloser
+	if w, err = envelope.NewWriter(ctx, file, envelope.DefaultKMS, key); err == nil {
+		if _, err = io.Copy(w, spool.persistedContent()); err == nil {
+			err = w.Close()
+		}
+	}
+	if err == nil {
+		spool.encryptedLength, err = file.Seek(0, io.SeekCurrent)
+	}
+	if err != nil {
+		_ = file.Close()
+		return errors.WithMessage(err, "encrypting fragment")
+	}
+	spool.encryptedFile = file
+	return nil
+}
+
+// readVerified reads the decompressed content of |fragment| into |w|,
+// and verifies its length and SHA1 Sum.
+func readVerified(ctx context.Context, fragment pb.Fragment, w io.Writer) error {
+	var rc, err = Open(ctx, fragment)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	dec, err := codecs.NewCodecReaderWithDictionary(rc, fragment.CompressionCodec,
+		codecs.Dictionary{Name: fragment.CompressionDictionary, Store: fragment.BackingStore})
+	if err != nil {
+		return err
+	}
+	defer dec.Close()
+
+	var summer = sha1.New()
+	var n int64
+
+	if n, err = io.Copy(io.MultiWriter(w, summer), dec); err != nil {
+		return err
+	} else if n != fragment.ContentLength() {
+		return fmt.Errorf("invalid content length (%d; expected %d)", n, fragment.ContentLength())
+	} else if sum := pb.SHA1SumFromDigest(summer.Sum(nil)); sum != fragment.Sum {
+		return fmt.Errorf("invalid content SHA1 (%x; expected %x)", sum.ToDigest(), fragment.Sum.ToDigest())
+	}
+	return nil
+}
+
 func parseStoreArgs(ep *url.URL, args interface{}) error {
 	var decoder = schema.NewDecoder()
 	decoder.IgnoreUnknownKeys(false)
@@ -6,12 +6,14 @@ import (
 	"io/ioutil"
 	"os"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.gazette.dev/core/broker/client"
 	"go.gazette.dev/core/broker/codecs"
+	"go.gazette.dev/core/broker/envelope"
 	pb "go.gazette.dev/core/broker/protocol"
 )
 
@@ -124,10 +126,441 @@ func TestStoreInteractions(t *testing.T) {
 		func(f pb.Fragment) { panic("not called") }))
 }
 
+func TestOpenAtSeekableFrame(t *testing.T) {
+	defer func(n int) { codecs.SeekableFrameSize = n }(codecs.SeekableFrameSize)
+	codecs.SeekableFrameSize = 10
+	// Require that the seek table be read with a second, larger suffix.
+	defer func(n int64) { codecs.SeekTableProbeSize = n }(codecs.SeekTableProbeSize)
+	codecs.SeekTableProbeSize = 12
+
+	var dir, err = ioutil.TempDir("", "stores_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	defer func(s string) { FileSystemStoreRoot = s }(FileSystemStoreRoot)
+	FileSystemStoreRoot = dir
+
+	var data = strings.Repeat("0123456789", 4) + "tail"
+	var frag = pb.Fragment{
+		Journal:          "a/journal",
+		Begin:            100,
+		End:              100 + int64(len(data)),
+		CompressionCodec: pb.CompressionCodec_ZSTANDARD_SEEKABLE,
+		BackingStore:     "file:///",
+	}
+	require.NoError(t, os.MkdirAll(dir+"/a/journal", 0700))
+	file, err := os.Create(dir + "/" + frag.ContentPath())
+	require.NoError(t, err)
+	comp, err := codecs.NewCodecWriter(file, frag.CompressionCodec)
+	require.NoError(t, err)
+	_, err = comp.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, comp.Close())
+
+	// Corrupt the magic number of the first frame. Reads which must decode it
+	// fail, while reads which seek past it succeed.
+	_, err = file.WriteAt([]byte{0, 0, 0, 0}, 0)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	var ctx = context.Background()
+	for _, tc := range []struct {
+		offset, begin int64
+	}{
+		{offset: 100, begin: 100},
+		{offset: 121, begin: 120},
+		{offset: 130, begin: 130},
+		{offset: 143, begin: 140},
+	} {
+		var rc, begin, err = OpenAt(ctx, frag, tc.offset)
+		require.NoError(t, err)
+		require.Equal(t, tc.begin, begin)
+
+		fr, err := client.NewFragmentReaderAt(rc, frag, begin, tc.offset)
+		require.NoError(t, err)
+		b, err := ioutil.ReadAll(fr)
+
+		if tc.begin == frag.Begin {
+			require.Regexp(t, "magic number mismatch", err)
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, data[tc.offset-frag.Begin:], string(b))
+		}
+		require.NoError(t, fr.Close())
+	}
+
+	// Ranges of a fragment file may be read directly.
+	var ep = frag.BackingStore.URL()
+	rc, err := getBackend(ep.Scheme).OpenRange(ctx, ep, frag, 1, 3)
+	require.NoError(t, err)
+	b, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+
+	raw, err := ioutil.ReadFile(dir + "/" + frag.ContentPath())
+	require.NoError(t, err)
+	require.Equal(t, raw[1:4], b)
+
+	rc, err = getBackend(ep.Scheme).OpenRange(ctx, ep, frag, -5, -1)
+	require.NoError(t, err)
+	b, err = ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+	require.Equal(t, raw[len(raw)-5:], b)
+}
+
+func TestStoreCopy(t *testing.T) {
+	var dir, err = ioutil.TempDir("", "stores_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	defer func(s string) { FileSystemStoreRoot = s }(FileSystemStoreRoot)
+	FileSystemStoreRoot = dir
+
+	var hot, cold pb.FragmentStore = "file:///hot/", "file:///cold/"
+	var spec = &pb.JournalSpec{Fragment: pb.JournalSpec_Fragment{Stores: []pb.FragmentStore{hot}}}
+
+	var ctx = context.Background()
+	var aged = time.Unix(1500000000, 0)
+
+	for _, spool := range buildSpoolFixtures(t) {
+		require.NoError(t, Persist(ctx, spool, spec))
+		// Age the hot fragment, so that its ModTime differs from the time of its copy.
+		require.NoError(t, os.Chtimes(dir+"/hot/"+spool.ContentPath(), aged, aged))
+	}
+	var hotFrags []pb.Fragment
+	require.NoError(t, List(ctx, hot, tstBar,
+		func(f pb.Fragment) { hotFrags = append(hotFrags, f) }))
+	require.Len(t, hotFrags, 2)
+	sort.Slice(hotFrags, func(i, j int) bool { return hotFrags[i].Begin < hotFrags[j].Begin })
+	require.Equal(t, aged.Unix(), hotFrags[0].ModTime)
+
+	// Copy each fragment twice, to exercise handling where the copy exists.
+	for _, f := range hotFrags {
+		var copied, err = Copy(ctx, f, cold, "")
+		require.NoError(t, err)
+		require.Equal(t, cold, copied.BackingStore)
+		require.Equal(t, f.Sum, copied.Sum)
+		require.Equal(t, f.ModTime, copied.ModTime)
+
+		copied, err = Copy(ctx, f, cold, "")
+		require.NoError(t, err)
+		require.Equal(t, cold, copied.BackingStore)
+	}
+
+	var coldFrags []pb.Fragment
+	require.NoError(t, List(ctx, cold, tstBar,
+		func(f pb.Fragment) { coldFrags = append(coldFrags, f) }))
+	require.Len(t, coldFrags, 2)
+
+	sort.Slice(coldFrags, func(i, j int) bool { return coldFrags[i].Begin < coldFrags[j].Begin })
+	require.Equal(t, tstBarData[0], readFrag(t, coldFrags[0]))
+	require.Equal(t, tstBarData[1], readFrag(t, coldFrags[1]))
+
+	// Copies retain the ModTime of their source fragments.
+	require.Equal(t, aged.Unix(), coldFrags[0].ModTime)
+	require.Equal(t, aged.Unix(), coldFrags[1].ModTime)
+
+	// Case: content of the fragment doesn't match its SHA1 Sum.
+	var bad = hotFrags[0]
+	require.NoError(t, os.Rename(
+		dir+"/hot/"+bad.ContentPath(),
+		dir+"/hot/"+bad.ContentPath()+".tmp"))
+	bad.Sum = pb.SHA1SumOf("wrong content")
+	require.NoError(t, os.Rename(
+		dir+"/hot/"+hotFrags[0].ContentPath()+".tmp",
+		dir+"/hot/"+bad.ContentPath()))
+
+	_, err = Copy(ctx, bad, cold, "")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid content SHA1")
+	require.Error(t, Verify(ctx, bad))
+	require.NoError(t, Verify(ctx, hotFrags[1]))
+
+	// The corrupt fragment may be quarantined within its store. Its stored
+	// bytes are moved as-is.
+	stored, err := ioutil.ReadFile(dir + "/hot/" + bad.ContentPath())
+	require.NoError(t, err)
+
+	moved, err := Quarantine(ctx, bad)
+	require.NoError(t, err)
+
+	var quarantine = pb.FragmentStore("file:///hot/" + QuarantinePrefix)
+	require.Equal(t, quarantine, moved.BackingStore)
+
+	quarantined, err := ioutil.ReadFile(dir + "/hot/" + QuarantinePrefix + bad.ContentPath())
+	require.NoError(t, err)
+	require.Equal(t, stored, quarantined)
+
+	var listed []pb.Fragment
+	require.NoError(t, List(ctx, quarantine, tstBar,
+		func(f pb.Fragment) { listed = append(listed, f) }))
+	require.Len(t, listed, 1)
+	require.Equal(t, bad.Sum, listed[0].Sum)
+	require.Equal(t, aged.Unix(), listed[0].ModTime)
+	require.Equal(t, tstBarData[0], readFrag(t, listed[0]))
+
+	listed = nil
+	require.NoError(t, List(ctx, hot, tstBar,
+		func(f pb.Fragment) { listed = append(listed, f) }))
+	require.Equal(t, []pb.Fragment{hotFrags[1]}, listed)
+}
+
+func TestStoreMerge(t *testing.T) {
+	var dir, err = ioutil.TempDir("", "stores_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	defer func(s string) { FileSystemStoreRoot = s }(FileSystemStoreRoot)
+	FileSystemStoreRoot = dir
+
+	var store pb.FragmentStore = "file:///root/"
+	var spec = &pb.JournalSpec{Fragment: pb.JournalSpec_Fragment{Stores: []pb.FragmentStore{store}}}
+
+	var ctx = context.Background()
+	for i, spool := range buildSpoolFixtures(t) {
+		require.NoError(t, Persist(ctx, spool, spec))
+		// Give each fragment a distinct ModTime, which doesn't follow its offsets.
+		var modTime = time.Unix(1500000000+int64(i%2)*1000, 0)
+		require.NoError(t, os.Chtimes(dir+"/root/"+spool.ContentPath(), modTime, modTime))
+	}
+	var frags []pb.Fragment
+	require.NoError(t, List(ctx, store, tstRWFoo,
+		func(f pb.Fragment) { frags = append(frags, f) }))
+	sort.Slice(frags, func(i, j int) bool { return frags[i].Begin < frags[j].Begin })
+	require.Len(t, frags, 3)
+
+	// Case: fragments which aren't adjacent cannot be merged.
+	_, err = Merge(ctx, []pb.Fragment{frags[0], frags[2]}, store, pb.CompressionCodec_GZIP, "", "")
+	require.EqualError(t, err, "fragment "+frags[2].ContentPath()+" is not adjacent to "+
+		(&pb.Fragment{
+			Journal:          tstRWFoo,
+			Begin:            frags[0].Begin,
+			End:              frags[0].End,
+			CompressionCodec: pb.CompressionCodec_GZIP,
+		}).ContentPath())
+
+	// Case: fragments are merged and re-encoded with a different codec.
+	merged, err := Merge(ctx, frags, store, pb.CompressionCodec_GZIP, "", "")
+	require.NoError(t, err)
+
+	var content = tstRWFooData[0] + tstRWFooData[1] + tstRWFooData[2]
+	require.Equal(t, pb.Fragment{
+		Journal:          tstRWFoo,
+		Begin:            0,
+		End:              int64(len(content)),
+		Sum:              pb.SHA1SumOf(content),
+		CompressionCodec: pb.CompressionCodec_GZIP,
+		BackingStore:     store,
+		ModTime:          1500001000, // Latest ModTime of |frags|.
+	}, merged)
+	require.Equal(t, content, readFrag(t, merged))
+
+	// The merged fragment is listed alongside the originals, with its ModTime.
+	var listed []pb.Fragment
+	require.NoError(t, List(ctx, store, tstRWFoo,
+		func(f pb.Fragment) { listed = append(listed, f) }))
+	require.Len(t, listed, 4)
+
+	for _, f := range listed {
+		if f.CompressionCodec == pb.CompressionCodec_GZIP {
+			require.Equal(t, merged, f)
+		}
+	}
+}
+
+func TestStoreEncryption(t *testing.T) {
+	var dir, err = ioutil.TempDir("", "stores_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	defer client.InstallFileTransport(dir)()
+	defer func(s string) { FileSystemStoreRoot = s }(FileSystemStoreRoot)
+	FileSystemStoreRoot = dir
+
+	defer func(kms envelope.KMS) { envelope.DefaultKMS = kms }(envelope.DefaultKMS)
+	var keys = []string{
+		"IFZcsDx7cQ+pHIhRTV/KEk9LlLPNYD6kVGj+s0hEsJA=",
+		"+cP+WNwnxDHfOnkYFe2aaC6TedaDH49/Qgm/9iHqCe0=",
+	}
+	var loadKeyring = func(versions ...string) {
+		var path = dir + "/keyring.yaml"
+		require.NoError(t, ioutil.WriteFile(path,
+			[]byte("a-key: ["+strings.Join(versions, ", ")+"]\n"), 0600))
+		var kr, err = envelope.NewKeyring(path)
+		require.NoError(t, err)
+		envelope.DefaultKMS = kr
+	}
+	loadKeyring(keys[0])
+
+	var store pb.FragmentStore = "file:///root/"
+	var spec = &pb.JournalSpec{Fragment: pb.JournalSpec_Fragment{
+		Stores:        []pb.FragmentStore{store},
+		EncryptionKey: "a-key",
+	}}
+
+	var ctx = context.Background()
+	for _, spool := range buildSpoolFixtures(t) {
+		require.NoError(t, Persist(ctx, spool, spec))
+		require.NoError(t, Persist(ctx, spool, spec))
+	}
+	var frags []pb.Fragment
+	require.NoError(t, List(ctx, store, tstRWFoo,
+		func(f pb.Fragment) { frags = append(frags, f) }))
+	sort.Slice(frags, func(i, j int) bool { return frags[i].Begin < frags[j].Begin })
+	require.Len(t, frags, 3)
+
+	for i, f := range frags {
+		require.True(t, f.Encrypted)
+		require.True(t, strings.HasSuffix(f.ContentName(), ".sz.enc"))
+
+		// Stored content is encrypted, and is transparently decrypted on read.
+		raw, err := ioutil.ReadFile(dir + "/root/" + f.ContentPath())
+		require.NoError(t, err)
+		require.NotContains(t, string(raw), tstRWFooData[i])
+		require.Equal(t, tstRWFooData[i], readFrag(t, f))
+		require.NoError(t, Verify(ctx, f))
+	}
+
+	// Clients which directly read a fragment also decrypt it.
+	getURL, err := SignGetURL(frags[0], time.Minute)
+	require.NoError(t, err)
+	fr, err := client.OpenFragmentURL(ctx, frags[0], 0, getURL)
+	require.NoError(t, err)
+	b, err := ioutil.ReadAll(fr)
+	require.NoError(t, err)
+	require.NoError(t, fr.Close())
+	require.Equal(t, tstRWFooData[0], string(b))
+
+	// Rotate the key. Fragments encrypted under the prior version remain
+	// readable, and merged fragments are encrypted under the current version.
+	loadKeyring(keys[0], keys[1])
+
+	merged, err := Merge(ctx, frags, store, pb.CompressionCodec_ZSTANDARD_SEEKABLE, "", "a-key")
+	require.NoError(t, err)
+	require.True(t, merged.Encrypted)
+
+	var content = tstRWFooData[0] + tstRWFooData[1] + tstRWFooData[2]
+	require.Equal(t, content, readFrag(t, merged))
+	require.Equal(t, tstRWFooData[1], readFrag(t, frags[1]))
+
+	// Clients read encrypted ZSTANDARD_SEEKABLE fragments from their beginning.
+	getURL, err = SignGetURL(merged, time.Minute)
+	require.NoError(t, err)
+	fr, err = client.OpenFragmentURL(ctx, merged, frags[2].Begin, getURL)
+	require.NoError(t, err)
+	b, err = ioutil.ReadAll(fr)
+	require.NoError(t, err)
+	require.NoError(t, fr.Close())
+	require.Equal(t, tstRWFooData[2], string(b))
+
+	// A fragment may be copied without encryption.
+	copied, err := Copy(ctx, frags[0], "file:///plain/", "")
+	require.NoError(t, err)
+	require.False(t, copied.Encrypted)
+	require.Equal(t, tstRWFooData[0], readFrag(t, copied))
+
+	// Encrypted fragments cannot be read or written without a KMS.
+	envelope.DefaultKMS = nil
+
+	_, err = Open(ctx, frags[0])
+	require.Equal(t, envelope.ErrNoKMS, err)
+	_, err = Copy(ctx, copied, "file:///other/", "a-key")
+	require.EqualError(t, err, "encrypting fragment: "+envelope.ErrNoKMS.Error())
+}
+
+func TestStoreDictionaries(t *testing.T) {
+	if _, err := codecs.NewCodecWriter(ioutil.Discard, pb.CompressionCodec_ZSTANDARD); err != nil {
+		t.Skip(err.Error()) // Built with the nozstd tag.
+	}
+	var dir = t.TempDir()
+	defer client.InstallFileTransport(dir)()
+	defer func(s string) { FileSystemStoreRoot = s }(FileSystemStoreRoot)
+	FileSystemStoreRoot = dir
+
+	var hot, cold pb.FragmentStore = "file:///hot/", "file:///cold/"
+	var ctx = context.Background()
+
+	var samples [][]byte
+	for i := 0; i != 10; i++ {
+		samples = append(samples, []byte(tstRWFooData[0]))
+	}
+	var dict = codecs.TrainDictionary(samples, 1024)
+	require.NotEmpty(t, dict)
+
+	name, err := PersistDictionary(ctx, hot, dict)
+	require.NoError(t, err)
+	require.Equal(t, codecs.DictionaryName(dict), name)
+
+	b, err := OpenDictionary(ctx, hot, name)
+	require.NoError(t, err)
+	require.Equal(t, dict, b)
+	_, err = OpenDictionary(ctx, cold, name)
+	require.Error(t, err)
+
+	// Persist a spool to |cold|, which doesn't yet have the dictionary.
+	var spool = NewSpool(tstRWFoo, &testSpoolObserver{})
+	spool.CompressionCodec, spool.CompressionDictionary = pb.CompressionCodec_ZSTANDARD_DICT, name
+
+	require.NoError(t, spool.applyContent(&pb.ReplicateRequest{Content: []byte(tstRWFooData[0])}))
+	var proposal = spool.Next()
+	require.Equal(t, pb.Status_OK, spool.applyCommit(&pb.ReplicateRequest{
+		Proposal:  &proposal,
+		Registers: new(pb.LabelSet),
+	}, true).Status)
+
+	var spec = &pb.JournalSpec{Fragment: pb.JournalSpec_Fragment{
+		Stores: []pb.FragmentStore{cold, hot},
+	}}
+	require.NoError(t, Persist(ctx, spool, spec))
+
+	// The dictionary was copied to |cold| alongside the fragment.
+	b, err = OpenDictionary(ctx, cold, name)
+	require.NoError(t, err)
+	require.Equal(t, dict, b)
+
+	var frags []pb.Fragment
+	require.NoError(t, List(ctx, cold, tstRWFoo,
+		func(f pb.Fragment) { frags = append(frags, f) }))
+	require.Len(t, frags, 1)
+	require.Equal(t, name, frags[0].CompressionDictionary)
+	require.True(t, strings.HasSuffix(frags[0].ContentName(), "."+name+".zsd"))
+	require.Equal(t, tstRWFooData[0], readFrag(t, frags[0]))
+
+	// Clients which directly read a fragment also decompress it.
+	getURL, err := SignGetURL(frags[0], time.Minute)
+	require.NoError(t, err)
+	fr, err := client.OpenFragmentURL(ctx, frags[0], 0, getURL)
+	require.NoError(t, err)
+	b, err = ioutil.ReadAll(fr)
+	require.NoError(t, err)
+	require.NoError(t, fr.Close())
+	require.Equal(t, tstRWFooData[0], string(b))
+
+	// Copies of the fragment bring its dictionary.
+	copied, err := Copy(ctx, frags[0], "file:///other/", "")
+	require.NoError(t, err)
+	require.Equal(t, name, copied.CompressionDictionary)
+	_, err = OpenDictionary(ctx, "file:///other/", name)
+	require.NoError(t, err)
+
+	// A fragment may be merged into a dictionary-compressed fragment.
+	merged, err := Merge(ctx, frags, hot, pb.CompressionCodec_ZSTANDARD_DICT, name, "")
+	require.NoError(t, err)
+	require.Equal(t, name, merged.CompressionDictionary)
+	require.Equal(t, tstRWFooData[0], readFrag(t, merged))
+
+	// A fragment cannot be persisted with a dictionary that's not found.
+	_, err = Merge(ctx, frags, hot, pb.CompressionCodec_ZSTANDARD_DICT,
+		"a1a2a3a4a5a6a7a8a9b0b1b2b3b4b5b6b7b8b9c0", "")
+	require.Regexp(t, "dictionary a1a2.* was not found in store file:///hot/, or in any of .*", err)
+}
+
 func readFrag(t *testing.T, f pb.Fragment) string {
 	var rc, err = Open(context.Background(), f)
 	require.NoError(t, err)
-	dec, err := codecs.NewCodecReader(rc, f.CompressionCodec)
+	dec, err := codecs.NewCodecReaderWithDictionary(rc, f.CompressionCodec,
+		codecs.Dictionary{Name: f.CompressionDictionary, Store: f.BackingStore})
 	require.NoError(t, err)
 	b, err := ioutil.ReadAll(dec)
 	require.NoError(t, err)
@@ -29,20 +29,27 @@ func (svc *Service) ListFragments(ctx context.Context, req *pb.FragmentsRequest)
 		}
 	}()
 
+	var claims pb.Claims
 	if err = req.Validate(); err != nil {
 		return nil, err
+	} else if claims, err = svc.verify(ctx); err != nil {
+		return nil, err
 	}
 	if req.PageLimit == 0 {
 		req.PageLimit = defaultPageLimit
 	}
 
-	res, err = svc.resolver.resolve(resolveArgs{
+	// Fragments are listed from the index of an assigned replica,
+	// so a suspended journal is resumed to serve the request.
+	res, err = svc.resolveOrResume(resolveArgs{
 		ctx:            ctx,
 		journal:        req.Journal,
 		mayProxy:       !req.DoNotProxy,
 		requirePrimary: false,
 		proxyHeader:    req.Header,
-	})
+		claims:         claims,
+		require:        pb.Capability_READ,
+	}, mayAlwaysResume)
 
 	if err != nil {
 		return nil, err
@@ -52,6 +59,9 @@ func (svc *Service) ListFragments(ctx context.Context, req *pb.FragmentsRequest)
 		return &pb.FragmentsResponse{Status: pb.Status_NOT_ALLOWED, Header: res.Header}, nil
 	} else if res.replica == nil {
 		req.Header = &res.Header // Attach resolved Header to |req|, which we'll forward.
+		if ctx, err = svc.authorize(ctx, claims); err != nil {
+			return nil, err
+		}
 		ctx = pb.WithDispatchRoute(ctx, req.Header.Route, req.Header.ProcessId)
 		return svc.jc.ListFragments(ctx, req)
 	}
@@ -60,8 +70,12 @@ func (svc *Service) ListFragments(ctx context.Context, req *pb.FragmentsRequest)
 		Status: pb.Status_OK,
 		Header: res.Header,
 	}
+	var minOffset = res.replica.index.MinOffset()
+	if req.IncludeTruncated {
+		minOffset = 0
+	}
 	err = res.replica.index.Inspect(ctx, func(fragmentSet fragment.CoverSet) error {
-		resp.Fragments, resp.NextPageToken, err = listFragments(req, fragmentSet)
+		resp.Fragments, resp.NextPageToken, err = listFragments(req, fragmentSet, minOffset)
 		return err
 	})
 	return resp, err
@@ -69,8 +83,9 @@ func (svc *Service) ListFragments(ctx context.Context, req *pb.FragmentsRequest)
 
 // List FragmentsResponse__Fragment matching the query, and return the
 // NextPageToken to be used for subsequent requests. If NextPageToken is nil
-// there are no further Fragments to enumerate.
-func listFragments(req *pb.FragmentsRequest, set fragment.CoverSet) ([]pb.FragmentsResponse__Fragment, int64, error) {
+// there are no further Fragments to enumerate. Fragments which are wholly
+// below |minOffset| are omitted.
+func listFragments(req *pb.FragmentsRequest, set fragment.CoverSet, minOffset int64) ([]pb.FragmentsResponse__Fragment, int64, error) {
 	// Determine |next| offset within |set| at which we begin or continue enumeration.
 	var next = sort.Search(len(set), func(i int) bool {
 		return set[i].Begin >= req.NextPageToken
@@ -81,6 +96,9 @@ func listFragments(req *pb.FragmentsRequest, set fragment.CoverSet) ([]pb.Fragme
 	for ; next != len(set) && len(out) != cap(out); next++ {
 		var f = set[next]
 
+		if f.End <= minOffset {
+			continue // Fragment content is no longer readable.
+		}
 		// ModTime may be zero on the Fragment if it's local-only, and not yet
 		// persisted to any store. We included these in the response iff
 		// EndModTime is zero.
@@ -211,6 +211,35 @@ func TestFragmentsListCases(t *testing.T) {
 		NextPageToken: 0,
 	}, resp)
 
+	// Case: Fragments wholly below the minimum readable offset are omitted.
+	broker.replica("a/journal").index.SetMinOffset(110)
+
+	resp, err = broker.client().ListFragments(ctx, &pb.FragmentsRequest{
+		Journal:      "a/journal",
+		SignatureTTL: &oneSec,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &pb.FragmentsResponse{
+		Status:        pb.Status_OK,
+		Header:        expectHeader,
+		Fragments:     fragments[2:],
+		NextPageToken: 0,
+	}, resp)
+
+	// Case: They're included if requested.
+	resp, err = broker.client().ListFragments(ctx, &pb.FragmentsRequest{
+		Journal:          "a/journal",
+		SignatureTTL:     &oneSec,
+		IncludeTruncated: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &pb.FragmentsResponse{
+		Status:        pb.Status_OK,
+		Header:        expectHeader,
+		Fragments:     fragments,
+		NextPageToken: 0,
+	}, resp)
+
 	broker.cleanup()
 }
 
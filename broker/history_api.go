@@ -0,0 +1,127 @@
+package broker
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/client/v3"
+	"go.gazette.dev/core/allocator"
+	pb "go.gazette.dev/core/broker/protocol"
+	pbx "go.gazette.dev/core/broker/protocol/ext"
+	"go.gazette.dev/core/keyspace"
+	"google.golang.org/grpc/peer"
+)
+
+// HistoryRetention is the duration for which recorded revisions of
+// JournalSpecs are retained. Older revisions of a journal are removed as
+// the journal is changed. If zero, revisions are retained indefinitely.
+var HistoryRetention = 90 * 24 * time.Hour
+
+// History dispatches the JournalServer.History API.
+func (svc *Service) History(ctx context.Context, req *pb.HistoryRequest) (resp *pb.HistoryResponse, err error) {
+	defer instrumentJournalServerRPC("History", &err, nil)()
+
+	defer func() {
+		if err != nil {
+			var addr net.Addr
+			if p, ok := peer.FromContext(ctx); ok {
+				addr = p.Addr
+			}
+			log.WithFields(log.Fields{"err": err, "req": req, "client": addr}).
+				Warn("served History RPC failed")
+		}
+	}()
+
+	var s = svc.resolver.state
+
+	resp = &pb.HistoryResponse{
+		Status: pb.Status_OK,
+		Header: pbx.NewUnroutedHeader(s),
+	}
+	var claims pb.Claims
+	if err = req.Validate(); err != nil {
+		return resp, err
+	} else if claims, err = svc.verify(ctx); err != nil {
+		return resp, err
+	} else if claims.Capability&pb.Capability_LIST == 0 {
+		resp.Status = pb.Status_NOT_ALLOWED
+		return resp, nil
+	}
+
+	if req.PageLimit == 0 {
+		req.PageLimit = defaultPageLimit
+	}
+	var kvs []*mvccpb.KeyValue
+	if kvs, resp.NextPageToken, err = allocator.ListItemRevisions(ctx, svc.etcd, s.KS,
+		req.Journal.String(), req.PageToken, int(req.PageLimit)); err != nil {
+		return resp, err
+	}
+
+	for _, kv := range kvs {
+		var rev pb.JournalSpecRevision
+
+		if err = rev.Unmarshal(kv.Value); err != nil {
+			return resp, errors.WithMessagef(err, "decoding revision %s", kv.Key)
+		}
+		rev.Revision = kv.ModRevision
+
+		if !mayListJournalRevision(claims, &rev) {
+			continue // Revision is not visible to the caller.
+		}
+		resp.Revisions = append(resp.Revisions, rev)
+	}
+	return resp, nil
+}
+
+// recordJournalRevision returns an Op which records the JournalSpecRevision
+// |rev| in the history of its journal.
+func recordJournalRevision(ks *keyspace.KeySpace, rev pb.JournalSpecRevision, now time.Time) clientv3.Op {
+	return clientv3.OpPut(allocator.ItemRevisionKey(ks, rev.Journal.String(), now), rev.MarshalString())
+}
+
+// currentJournalSpec returns the JournalSpec of |journal| and its ModRevision,
+// as observed by the KeySpace, or nil and zero if the journal doesn't exist.
+func currentJournalSpec(s *allocator.State, journal pb.Journal) (*pb.JournalSpec, int64) {
+	defer s.KS.Mu.RUnlock()
+	s.KS.Mu.RLock()
+
+	if ind, ok := s.KS.Search(allocator.ItemKey(s.KS, journal.String())); ok {
+		var kv = s.KS.KeyValues[ind]
+		return kv.Decoded.(allocator.Item).ItemValue.(*pb.JournalSpec), kv.Raw.ModRevision
+	}
+	return nil, 0
+}
+
+// pruneJournalHistory removes revisions of |journals| which were recorded
+// more than HistoryRetention before |now|. Failures are logged.
+func pruneJournalHistory(ctx context.Context, svc *Service, journals []pb.Journal, now time.Time) {
+	if HistoryRetention == 0 {
+		return
+	}
+	for _, journal := range journals {
+		if err := allocator.PruneItemRevisions(ctx, svc.etcd, svc.resolver.state.KS,
+			journal.String(), now.Add(-HistoryRetention)); err != nil {
+			log.WithFields(log.Fields{"err": err, "journal": journal}).
+				Warn("failed to prune journal history")
+		}
+	}
+}
+
+// mayListJournalRevision returns whether |claims| allow listing of the
+// JournalSpecRevision, which requires that they allow listing of both its
+// Before and After JournalSpecs.
+func mayListJournalRevision(claims pb.Claims, rev *pb.JournalSpecRevision) bool {
+	for _, spec := range []*pb.JournalSpec{rev.Before, rev.After} {
+		if spec == nil {
+			spec = &pb.JournalSpec{Name: rev.Journal}
+		}
+		if !claims.Allows(pb.Capability_LIST, journalAuthLabels(spec)) {
+			return false
+		}
+	}
+	return true
+}
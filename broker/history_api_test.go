@@ -0,0 +1,155 @@
+package broker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.gazette.dev/core/auth"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/etcdtest"
+)
+
+func TestHistoryCases(t *testing.T) {
+	var ctx, etcd = pb.WithDispatchDefault(context.Background()), etcdtest.TestClient()
+	defer etcdtest.Cleanup()
+
+	// Each Apply observes a distinct, advancing time.
+	defer func(f func() time.Time) { timeNow = f }(timeNow)
+	var clock int64
+	timeNow = func() time.Time { clock++; return time.Unix(1000+clock, 0) }
+
+	var keyed, err = auth.NewKeyedAuth("c2VjcmV0")
+	require.NoError(t, err)
+
+	var broker = newTestBroker(t, etcd, pb.ProcessSpec_ID{Zone: "local", Suffix: "broker"})
+	broker.svc.Auth = keyed
+
+	var authorize = func(cap pb.Capability, selector string) context.Context {
+		var sel, err = pb.ParseLabelSelector(selector)
+		require.NoError(t, err)
+		authCtx, err := keyed.Authorize(ctx, pb.Claims{Subject: "tester", Capability: cap, Selector: sel}, time.Minute)
+		require.NoError(t, err)
+		return authCtx
+	}
+	var applyCtx = authorize(pb.Capability_APPLY|pb.Capability_LIST, "prefix=journal/")
+
+	var apply = func(dryRun bool, changes ...pb.ApplyRequest_Change) int64 {
+		var resp, err = broker.client().Apply(applyCtx, &pb.ApplyRequest{Changes: changes, DryRun: dryRun})
+		require.NoError(t, err)
+		require.Equal(t, pb.Status_OK, resp.Status)
+		return resp.Header.Etcd.Revision
+	}
+
+	var specA = pb.JournalSpec{
+		Name:        "journal/A",
+		Replication: 1,
+		Fragment: pb.JournalSpec_Fragment{
+			Length:           1024,
+			RefreshInterval:  time.Second,
+			CompressionCodec: pb.CompressionCodec_SNAPPY,
+		},
+	}
+	var specB = specA
+	specB.Name = "journal/B"
+	var updatedA = specA
+	updatedA.Replication = 2
+
+	// Create A & B, update and then delete A, and dry-run an update of B.
+	var rev1 = apply(false, pb.ApplyRequest_Change{Upsert: &specA}, pb.ApplyRequest_Change{Upsert: &specB})
+	var rev2 = apply(false, pb.ApplyRequest_Change{Upsert: &updatedA, ExpectModRevision: rev1})
+	var rev3 = apply(false, pb.ApplyRequest_Change{Delete: "journal/A", ExpectModRevision: rev2})
+	_ = apply(true, pb.ApplyRequest_Change{Upsert: &updatedA, ExpectModRevision: rev1})
+
+	// Case: History of a single journal.
+	resp, err := broker.client().History(applyCtx, &pb.HistoryRequest{Journal: "journal/A"})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OK, resp.Status)
+
+	for _, r := range resp.Revisions {
+		require.NotEmpty(t, r.Client)
+	}
+	require.Equal(t, []pb.JournalSpecRevision{
+		{Journal: "journal/A", Revision: rev1, ApplyTime: 1001, AppliedBy: "tester",
+			Client: resp.Revisions[0].Client, After: &specA},
+		{Journal: "journal/A", Revision: rev2, ApplyTime: 1002, AppliedBy: "tester",
+			Client: resp.Revisions[1].Client, Before: &specA, BeforeRevision: rev1, After: &updatedA},
+		{Journal: "journal/A", Revision: rev3, ApplyTime: 1003, AppliedBy: "tester",
+			Client: resp.Revisions[2].Client, Before: &updatedA, BeforeRevision: rev2},
+	}, resp.Revisions)
+
+	// Case: History of all journals.
+	resp, err = broker.client().History(applyCtx, &pb.HistoryRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Revisions, 4)
+	require.Equal(t, pb.Journal("journal/A"), resp.Revisions[0].Journal)
+	require.Equal(t, pb.Journal("journal/B"), resp.Revisions[3].Journal)
+	require.Equal(t, rev1, resp.Revisions[3].Revision)
+	require.Empty(t, resp.NextPageToken)
+
+	// Case: History is paged.
+	resp, err = broker.client().History(applyCtx, &pb.HistoryRequest{PageLimit: 3})
+	require.NoError(t, err)
+	require.Len(t, resp.Revisions, 3)
+	require.Equal(t, rev3, resp.Revisions[2].Revision)
+	require.NotEmpty(t, resp.NextPageToken)
+
+	resp, err = broker.client().History(applyCtx, &pb.HistoryRequest{PageLimit: 3, PageToken: resp.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, resp.Revisions, 1)
+	require.Equal(t, pb.Journal("journal/B"), resp.Revisions[0].Journal)
+	require.Empty(t, resp.NextPageToken)
+
+	// Case: a page token must be within the requested history.
+	_, err = broker.client().History(applyCtx, &pb.HistoryRequest{Journal: "journal/B", PageToken: "/other"})
+	require.EqualError(t, err, `rpc error: code = Unknown desc = invalid page token "/other"`)
+
+	// Case: revisions of journals which aren't visible to the caller are excluded.
+	resp, err = broker.client().History(authorize(pb.Capability_LIST, "name=journal/B"), &pb.HistoryRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Revisions, 1)
+	require.Equal(t, pb.Journal("journal/B"), resp.Revisions[0].Journal)
+
+	// Case: the LIST capability is required.
+	resp, err = broker.client().History(authorize(pb.Capability_READ, ""), &pb.HistoryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_NOT_ALLOWED, resp.Status)
+
+	// Case: invalid request.
+	_, err = broker.client().History(applyCtx, &pb.HistoryRequest{Journal: "/invalid"})
+	require.EqualError(t, err, `rpc error: code = Unknown desc = Journal: cannot begin with '/' (/invalid)`)
+
+	// Case: a change which doesn't expect a revision records the journal's
+	// current JournalSpec as its Before, while a stale expectation fails.
+	var updatedB = specB
+	updatedB.Replication = 2
+
+	var rev4 = apply(false, pb.ApplyRequest_Change{Upsert: &updatedB, ExpectModRevision: -1})
+
+	applyResp, err := broker.client().Apply(applyCtx, &pb.ApplyRequest{
+		Changes: []pb.ApplyRequest_Change{{Delete: "journal/B", ExpectModRevision: rev1}}})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_ETCD_TRANSACTION_FAILED, applyResp.Status)
+
+	resp, err = broker.client().History(applyCtx, &pb.HistoryRequest{Journal: "journal/B"})
+	require.NoError(t, err)
+	require.Len(t, resp.Revisions, 2)
+	require.Equal(t, rev4, resp.Revisions[1].Revision)
+	require.Equal(t, &specB, resp.Revisions[1].Before)
+	require.Equal(t, rev1, resp.Revisions[1].BeforeRevision)
+
+	// Case: revisions older than HistoryRetention are pruned as a journal changes.
+	defer func(d time.Duration) { HistoryRetention = d }(HistoryRetention)
+	HistoryRetention = 2500 * time.Millisecond
+
+	var rev5 = apply(false, pb.ApplyRequest_Change{Upsert: &specB, ExpectModRevision: rev4})
+
+	resp, err = broker.client().History(applyCtx, &pb.HistoryRequest{Journal: "journal/B"})
+	require.NoError(t, err)
+	require.Len(t, resp.Revisions, 2)
+	require.Equal(t, rev4, resp.Revisions[0].Revision)
+	require.Equal(t, rev5, resp.Revisions[1].Revision)
+
+	broker.cleanup()
+}
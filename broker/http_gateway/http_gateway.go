@@ -11,20 +11,44 @@ import (
 	"net/url"
 	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/gorilla/schema"
+	"github.com/gorilla/websocket"
 	log "github.com/sirupsen/logrus"
 	"go.gazette.dev/core/broker/client"
 	pb "go.gazette.dev/core/broker/protocol"
+	"google.golang.org/grpc/metadata"
 )
 
 // Gateway presents an HTTP gateway to Gazette brokers, by mapping GET, HEAD,
 // and PUT requests into equivalent Read RPCs and Append RPCs.
+//
+// A GET request which accepts "text/event-stream" reads the journal's
+// messages as Server-Sent Events, and a GET request which upgrades to a
+// WebSocket reads the journal's messages as WebSocket messages (or, with
+// query parameter "append=true", appends WebSocket messages to the journal).
+// The framing of a streamed journal is determined through a Stat RPC, and
+// message streams (including appends) therefore require READ capability.
+//
+// An Authorization header of a request is passed through to brokers. Browser
+// EventSource and WebSocket clients, which cannot set request headers, may
+// instead pass a bearer token of a GET request through the AuthTokenParam
+// query parameter or the AuthTokenCookie cookie. As browsers attach cookies
+// to requests which other sites initiate, the cookie is honored only for
+// requests without an Origin, of the same origin, or of an origin which is
+// explicitly listed by AllowedOrigins (and not merely allowed by "*").
 type Gateway struct {
-	decoder *schema.Decoder
-	client  pb.RoutedJournalClient
+	// AllowedOrigins are origins which may make cross-origin requests of the
+	// Gateway. An origin of "*" allows requests from any origin. If empty,
+	// cross-origin requests are not allowed.
+	AllowedOrigins []string
+
+	decoder  *schema.Decoder
+	client   pb.RoutedJournalClient
+	upgrader websocket.Upgrader
 }
 
 // NewGateway returns a Gateway using the BrokerClient.
@@ -32,23 +56,140 @@ func NewGateway(client pb.RoutedJournalClient) *Gateway {
 	var decoder = schema.NewDecoder()
 	decoder.IgnoreUnknownKeys(false)
 
-	return &Gateway{
+	var h = &Gateway{
 		decoder: decoder,
 		client:  client,
 	}
+	h.upgrader.CheckOrigin = h.checkWebSocketOrigin
+	return h
 }
 
 func (h *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	switch r.Method {
-	case "GET", "HEAD":
+	var cors = h.writeCORSHeaders(w, r)
+
+	if r.Method == "GET" {
+		h.liftAuthToken(r)
+	}
+
+	switch {
+	case r.Method == "OPTIONS" && cors:
+		w.WriteHeader(http.StatusNoContent) // 204.
+	case r.Method == "GET" && websocket.IsWebSocketUpgrade(r):
+		h.serveWebSocket(w, r)
+	case r.Method == "GET" && strings.Contains(r.Header.Get("Accept"), "text/event-stream"):
+		h.serveEvents(w, r)
+	case r.Method == "GET", r.Method == "HEAD":
 		h.serveRead(w, r)
-	case "PUT":
+	case r.Method == "PUT":
 		h.serveWrite(w, r)
 	default:
 		http.Error(w, fmt.Sprintf("unknown method: %s", r.Method), http.StatusBadRequest)
 	}
 }
 
+// CORS returns an http.Handler which applies the CORS policy of the Gateway
+// to requests of |next|, and itself responds to preflight requests. It's
+// intended for handlers which are served alongside the Gateway.
+func (h *Gateway) CORS(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h.writeCORSHeaders(w, r) && r.Method == "OPTIONS" {
+			w.WriteHeader(http.StatusNoContent) // 204.
+		} else {
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// writeCORSHeaders writes CORS response headers if the request has an Origin
+// which is allowed, and returns whether it did.
+func (h *Gateway) writeCORSHeaders(w http.ResponseWriter, r *http.Request) bool {
+	var origin = r.Header.Get("Origin")
+	if origin == "" || !h.allowsOrigin(origin) {
+		return false
+	}
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+	w.Header().Add("Vary", "Origin")
+	w.Header().Set("Access-Control-Expose-Headers", strings.Join(exposedHeaders, ", "))
+
+	if r.Method == "OPTIONS" {
+		w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, POST, PUT")
+		w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, "+LastEventIDHeader)
+	}
+	return true
+}
+
+// allowsOrigin returns whether the Gateway allows requests from |origin|.
+func (h *Gateway) allowsOrigin(origin string) bool {
+	for _, o := range h.AllowedOrigins {
+		if o == "*" || o == origin {
+			return true
+		}
+	}
+	return false
+}
+
+// checkWebSocketOrigin returns whether a WebSocket upgrade may proceed.
+// Upgrades of requests without an Origin, having an allowed Origin, or
+// having an Origin which matches the request Host are permitted.
+func (h *Gateway) checkWebSocketOrigin(r *http.Request) bool {
+	var origin = r.Header.Get("Origin")
+	return origin == "" || h.allowsOrigin(origin) || isSameOrigin(origin, r)
+}
+
+// allowsCookieOrigin returns whether the AuthTokenCookie of the request may
+// be used. Requests without an Origin, of the same origin, or of an origin
+// which is explicitly listed by AllowedOrigins are permitted.
+func (h *Gateway) allowsCookieOrigin(r *http.Request) bool {
+	var origin = r.Header.Get("Origin")
+	if origin == "" || isSameOrigin(origin, r) {
+		return true
+	}
+	for _, o := range h.AllowedOrigins {
+		if o == origin {
+			return true
+		}
+	}
+	return false
+}
+
+// isSameOrigin returns whether |origin| matches the Host of the request.
+func isSameOrigin(origin string, r *http.Request) bool {
+	var u, err = url.Parse(origin)
+	return err == nil && strings.EqualFold(u.Host, r.Host)
+}
+
+// liftAuthToken removes a bearer token passed by the AuthTokenParam query
+// parameter from the URL of the http.Request, and sets it (or a token of the
+// AuthTokenCookie cookie, if its origin is allowed) as the request's
+// Authorization header, if the request doesn't already have one.
+func (h *Gateway) liftAuthToken(r *http.Request) {
+	var q = r.URL.Query()
+	var token = q.Get(AuthTokenParam)
+
+	if _, ok := q[AuthTokenParam]; ok {
+		q.Del(AuthTokenParam)
+		r.URL.RawQuery = q.Encode()
+	}
+	if token == "" && h.allowsCookieOrigin(r) {
+		if c, err := r.Cookie(AuthTokenCookie); err == nil {
+			token = c.Value
+		}
+	}
+	if token != "" && r.Header.Get("Authorization") == "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+}
+
+// RequestContext returns the Context of the http.Request. If the request
+// has an Authorization header, it's passed through to brokers and consumers,
+// which verify it as they would for any other client.
+func RequestContext(r *http.Request) context.Context {
+	if authz := r.Header.Get("Authorization"); authz != "" {
+		return metadata.AppendToOutgoingContext(r.Context(), "authorization", authz)
+	}
+	return r.Context()
+}
+
 func (h *Gateway) serveRead(w http.ResponseWriter, r *http.Request) {
 	var req, err = h.parseReadRequest(r)
 	if err != nil {
@@ -56,7 +197,7 @@ func (h *Gateway) serveRead(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var reader = client.NewReader(r.Context(), h.client, req)
+	var reader = client.NewReader(RequestContext(r), h.client, req)
 	if _, err = reader.Read(nil); err == client.ErrOffsetJump {
 		// Swallow this error, as the client is notified via the Content-Range
 		// header and we can continue the read. Any future jump after this one
@@ -100,7 +241,7 @@ func (h *Gateway) serveWrite(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var appender = client.NewAppender(r.Context(), h.client, req)
+	var appender = client.NewAppender(RequestContext(r), h.client, req)
 	if _, err = io.Copy(appender, r.Body); err == nil {
 		err = appender.Close()
 	}
@@ -117,6 +258,7 @@ func (h *Gateway) parseReadRequest(r *http.Request) (pb.ReadRequest, error) {
 	var schema struct {
 		Offset int64
 		Block  bool
+		Since  string
 	}
 	var q url.Values
 	var err error
@@ -130,6 +272,11 @@ func (h *Gateway) parseReadRequest(r *http.Request) (pb.ReadRequest, error) {
 		Block:        schema.Block,
 		MetadataOnly: r.Method == "HEAD",
 	}
+	if err == nil && schema.Since != "" {
+		if req.BeginModTime, err = pb.ParseModTime(schema.Since); err != nil {
+			err = fmt.Errorf("invalid since: %w", err)
+		}
+	}
 	if err == nil {
 		err = req.Validate()
 	}
@@ -184,7 +331,7 @@ func writeReadResponse(w http.ResponseWriter, r *http.Request, resp pb.ReadRespo
 		http.Error(w, resp.Status.String(), http.StatusNotFound) // 404.
 	case pb.Status_INSUFFICIENT_JOURNAL_BROKERS:
 		http.Error(w, resp.Status.String(), http.StatusServiceUnavailable) // 503.
-	case pb.Status_OFFSET_NOT_YET_AVAILABLE:
+	case pb.Status_OFFSET_NOT_YET_AVAILABLE, pb.Status_OFFSET_TRUNCATED:
 		http.Error(w, resp.Status.String(), http.StatusRequestedRangeNotSatisfiable) // 416.
 	default:
 		http.Error(w, resp.Status.String(), http.StatusInternalServerError) // 500.
@@ -208,6 +355,8 @@ func writeAppendResponse(w http.ResponseWriter, r *http.Request, resp pb.AppendR
 		w.WriteHeader(http.StatusNoContent) // 204.
 	case pb.Status_JOURNAL_NOT_FOUND:
 		w.WriteHeader(http.StatusNotFound) // 404.
+	case pb.Status_INVALID_FRAMING, pb.Status_SCHEMA_VIOLATION:
+		http.Error(w, resp.Status.String(), http.StatusBadRequest) // 400.
 	default:
 		http.Error(w, resp.Status.String(), http.StatusInternalServerError) // 500.
 	}
@@ -249,6 +398,26 @@ const (
 	CommitBeginHeader = "X-Commit-Begin"
 	CommitEndHeader   = "X-Commit-End"
 	CommitSumHeader   = "X-Commit-SHA1-Sum"
+
+	LastEventIDHeader = "Last-Event-ID"
+
+	AuthTokenParam  = "access_token"
+	AuthTokenCookie = "gazette_access_token"
 )
 
+// exposedHeaders are response headers which cross-origin clients may read.
+var exposedHeaders = []string{
+	"Content-Range",
+	"Location",
+	FragmentLastModifiedHeader,
+	FragmentLocationHeader,
+	FragmentNameHeader,
+	RouteTokenHeader,
+	CloseErrorHeader,
+	WriteHeadHeader,
+	CommitBeginHeader,
+	CommitEndHeader,
+	CommitSumHeader,
+}
+
 var errBrokerTerminated = errors.New("broker terminated RPC")
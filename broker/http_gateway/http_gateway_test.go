@@ -1,6 +1,8 @@
 package http_gateway
 
 import (
+	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -8,8 +10,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gorilla/websocket"
 	pb "go.gazette.dev/core/broker/protocol"
 	"go.gazette.dev/core/broker/teststub"
+	"go.gazette.dev/core/labels"
+	"go.gazette.dev/core/message"
+	"google.golang.org/grpc/metadata"
 	gc "gopkg.in/check.v1"
 )
 
@@ -31,12 +37,20 @@ func (s *HTTPSuite) TestReadRequestParsing(c *gc.C) {
 			Journal: "journal/name", Offset: 123, Block: true}},
 		{method: "HEAD", url: "/journal/name?offset=123&block=true", rr: pb.ReadRequest{
 			Journal: "journal/name", Offset: 123, Block: true, MetadataOnly: true}},
+		{method: "GET", url: "/journal/name?since=1500000000", rr: pb.ReadRequest{
+			Journal: "journal/name", BeginModTime: 1500000000}},
+		{method: "GET", url: "/journal/name?since=2017-07-14T02:40:00Z", rr: pb.ReadRequest{
+			Journal: "journal/name", BeginModTime: 1500000000}},
 
 		// Validation errors.
 		{method: "GET", url: "/journal/name?offset=-2",
 			err: `invalid Offset \(-2; .*`},
 		{method: "GET", url: "/journal//name",
 			err: `Journal: must be a clean path \(journal//name\)`},
+		{method: "GET", url: "/journal/name?since=yesterday",
+			err: `invalid since: expected RFC 3339 timestamp or unix seconds \(yesterday\)`},
+		{method: "GET", url: "/journal/name?since=-1",
+			err: `invalid BeginModTime \(-1; expected >= 0\)`},
 
 		// Schema decoding errors.
 		{method: "GET", url: "/journal/name?block=foobar",
@@ -174,6 +188,285 @@ func (s *HTTPSuite) TestServingWrite(c *gc.C) {
 	c.Check(w.Header()["X-Write-Head"], gc.DeepEquals, []string{"200"})
 }
 
+func (s *HTTPSuite) TestStreamRequestParsing(c *gc.C) {
+	var g = NewGateway(nil)
+
+	var cases = []struct {
+		url, lastEventID string
+		err              string
+		rr               pb.ReadRequest
+	}{
+		{url: "/journal/name?offset=123&block=true", rr: pb.ReadRequest{
+			Journal: "journal/name", Offset: 123, Block: true}},
+		// Last-Event-ID takes precedence over the offset parameter.
+		{url: "/journal/name?offset=123", lastEventID: "456", rr: pb.ReadRequest{
+			Journal: "journal/name", Offset: 456}},
+
+		// Validation errors.
+		{url: "/journal/name", lastEventID: "foobar",
+			err: `invalid Last-Event-ID \(foobar\)`},
+		{url: "/journal/name", lastEventID: "-2",
+			err: `invalid Offset \(-2; .*`},
+	}
+
+	for _, tc := range cases {
+		var req, _ = http.NewRequest("GET", tc.url, nil)
+		if tc.lastEventID != "" {
+			req.Header.Set(LastEventIDHeader, tc.lastEventID)
+		}
+		var rr, err = g.parseStreamRequest(req)
+
+		if tc.err != "" {
+			c.Check(err, gc.ErrorMatches, tc.err)
+		} else {
+			c.Check(rr, gc.DeepEquals, tc.rr)
+		}
+	}
+}
+
+func (s *HTTPSuite) TestServingEvents(c *gc.C) {
+	defer setMessageUnpacker()()
+
+	var broker = teststub.NewBroker(c)
+	defer broker.Cleanup()
+	// Capture the authorization passed through to brokers.
+	var authz []string
+	var withAuthz = func(stat func(context.Context, *pb.StatRequest) (*pb.StatResponse, error)) func(context.Context, *pb.StatRequest) (*pb.StatResponse, error) {
+		return func(ctx context.Context, req *pb.StatRequest) (*pb.StatResponse, error) {
+			var md, _ = metadata.FromIncomingContext(ctx)
+			authz = md.Get("authorization")
+			return stat(ctx, req)
+		}
+	}
+	broker.StatFunc = withAuthz(statJournalFixture(labels.ContentType_JSONLines))
+
+	var rjc = pb.NewRoutedJournalClient(broker.Client(), pb.NoopDispatchRouter{})
+	var g = NewGateway(rjc)
+
+	go func() {
+		c.Check(<-broker.ReadReqCh, gc.DeepEquals, pb.ReadRequest{Journal: "a/journal", Offset: 1031})
+
+		broker.ReadRespCh <- pb.ReadResponse{
+			Status:    pb.Status_OK,
+			Header:    readResponseFixture.Header,
+			Offset:    1031,
+			WriteHead: 2048,
+			Fragment:  readResponseFixture.Fragment,
+		}
+		broker.ReadRespCh <- pb.ReadResponse{Content: []byte(`{"a": 1}` + "\n" + `{"b"`), Offset: 1031}
+		broker.ReadRespCh <- pb.ReadResponse{Content: []byte(`:2}` + "\n" + "not json\n"), Offset: 1044}
+		broker.ReadRespCh <- pb.ReadResponse{Status: pb.Status_OFFSET_NOT_YET_AVAILABLE}
+		broker.WriteLoopErrCh <- nil
+	}()
+
+	// An EventSource passes its token as a query parameter.
+	var req, _ = http.NewRequest("GET", "/a/journal?offset=123&access_token=a-token", nil)
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set(LastEventIDHeader, "1031")
+	var w = httptest.NewRecorder()
+
+	g.ServeHTTP(w, req)
+
+	c.Check(w.Code, gc.Equals, http.StatusOK)
+	c.Check(w.Header().Get("Content-Type"), gc.Equals, "text/event-stream")
+	c.Check(w.Body.String(), gc.Equals, ""+
+		"id: 1040\ndata: {\"offset\":1031,\"next\":1040,\"message\":{\"a\":1}}\n\n"+
+		"id: 1048\ndata: {\"offset\":1040,\"next\":1048,\"message\":{\"b\":2}}\n\n"+
+		"id: 1057\ndata: {\"offset\":1048,\"next\":1057,\"message\":\"not json\","+
+		"\"error\":\"message is not a valid JSON document\"}\n\n"+
+		"event: close\ndata: OFFSET_NOT_YET_AVAILABLE\n\n")
+	c.Check(w.Flushed, gc.Equals, true)
+	c.Check(authz, gc.DeepEquals, []string{"Bearer a-token"})
+
+	// Journals having an unsupported framing are refused.
+	// This client passes its token as a cookie.
+	broker.StatFunc = withAuthz(statJournalFixture(labels.ContentType_CSV))
+	req, _ = http.NewRequest("GET", "/a/journal?offset=123", nil)
+	req.Header.Set("Accept", "text/event-stream")
+	req.AddCookie(&http.Cookie{Name: AuthTokenCookie, Value: "cookie-token"})
+	w = httptest.NewRecorder()
+	g.ServeHTTP(w, req)
+
+	c.Check(w.Code, gc.Equals, http.StatusBadRequest)
+	c.Check(w.Body.String(), gc.Equals, "unpacking of content-type (text/csv) is not supported\n")
+	c.Check(authz, gc.DeepEquals, []string{"Bearer cookie-token"})
+
+	// Journals which the client may not read are refused.
+	broker.StatFunc = withAuthz(func(context.Context, *pb.StatRequest) (*pb.StatResponse, error) {
+		return &pb.StatResponse{Status: pb.Status_NOT_ALLOWED, Header: *readResponseFixture.Header}, nil
+	})
+	w = httptest.NewRecorder()
+	g.ServeHTTP(w, req)
+
+	c.Check(w.Code, gc.Equals, http.StatusForbidden)
+	c.Check(w.Body.String(), gc.Equals, "NOT_ALLOWED\n")
+}
+
+func (s *HTTPSuite) TestServingWebSocketRead(c *gc.C) {
+	defer setMessageUnpacker()()
+
+	var broker = teststub.NewBroker(c)
+	defer broker.Cleanup()
+	broker.StatFunc = statJournalFixture(labels.ContentType_ProtoFixed)
+
+	var rjc = pb.NewRoutedJournalClient(broker.Client(), pb.NoopDispatchRouter{})
+	var srv = httptest.NewServer(NewGateway(rjc))
+	defer srv.Close()
+
+	var frames, _ = message.EncodeFixedProtoFrame(&pb.Fragment{Journal: "a/journal", End: 12}, nil)
+	var encoded, _ = (&pb.Fragment{Journal: "a/journal", End: 12}).Marshal()
+
+	go func() {
+		c.Check(<-broker.ReadReqCh, gc.DeepEquals, pb.ReadRequest{Journal: "a/journal", Offset: 1024})
+
+		broker.ReadRespCh <- readResponseFixture
+		broker.ReadRespCh <- pb.ReadResponse{Content: frames, Offset: 1024}
+		broker.ReadRespCh <- pb.ReadResponse{Status: pb.Status_OFFSET_NOT_YET_AVAILABLE}
+		broker.WriteLoopErrCh <- nil
+	}()
+
+	var conn, _, err = websocket.DefaultDialer.Dial(
+		"ws"+strings.TrimPrefix(srv.URL, "http")+"/a/journal?offset=1024", nil)
+	c.Assert(err, gc.IsNil)
+	defer conn.Close()
+
+	var msg StreamedMessage
+	c.Check(conn.ReadJSON(&msg), gc.IsNil)
+	c.Check(msg.Offset, gc.Equals, int64(1024))
+	c.Check(msg.Next, gc.Equals, int64(1024+len(frames)))
+
+	var decoded []byte
+	c.Check(json.Unmarshal(msg.Message, &decoded), gc.IsNil)
+	c.Check(decoded, gc.DeepEquals, encoded)
+
+	// The read ends, and the server closes the WebSocket.
+	_, _, err = conn.ReadMessage()
+	c.Check(websocket.IsCloseError(err, websocket.CloseNormalClosure), gc.Equals, true)
+	c.Check(err, gc.ErrorMatches, ".*OFFSET_NOT_YET_AVAILABLE")
+}
+
+func (s *HTTPSuite) TestServingWebSocketAppend(c *gc.C) {
+	var broker = teststub.NewBroker(c)
+	defer broker.Cleanup()
+	broker.StatFunc = statJournalFixture(labels.ContentType_JSONLines)
+
+	var rjc = pb.NewRoutedJournalClient(broker.Client(), pb.NoopDispatchRouter{})
+	var srv = httptest.NewServer(NewGateway(rjc))
+	defer srv.Close()
+
+	go func() {
+		c.Check(<-broker.AppendReqCh, gc.DeepEquals, pb.AppendRequest{Journal: "a/journal"})
+		c.Check(<-broker.AppendReqCh, gc.DeepEquals, pb.AppendRequest{Content: []byte(`{"a":1}` + "\n")})
+		c.Check(<-broker.AppendReqCh, gc.DeepEquals, pb.AppendRequest{})
+		c.Check(<-broker.ReadLoopErrCh, gc.Equals, io.EOF)
+
+		broker.AppendRespCh <- appendResponseFixture
+	}()
+
+	var conn, _, err = websocket.DefaultDialer.Dial(
+		"ws"+strings.TrimPrefix(srv.URL, "http")+"/a/journal?append=true", nil)
+	c.Assert(err, gc.IsNil)
+	defer conn.Close()
+
+	c.Check(conn.WriteMessage(websocket.TextMessage, []byte(`{"a":1}`)), gc.IsNil)
+
+	var ack StreamedAppend
+	c.Check(conn.ReadJSON(&ack), gc.IsNil)
+	c.Check(ack, gc.DeepEquals, StreamedAppend{Begin: 100, End: 200})
+}
+
+func (s *HTTPSuite) TestCORS(c *gc.C) {
+	var g = NewGateway(nil)
+	g.AllowedOrigins = []string{"http://allowed"}
+
+	// Case: preflight request of an allowed origin.
+	var req, _ = http.NewRequest("OPTIONS", "/a/journal", nil)
+	req.Header.Set("Origin", "http://allowed")
+	var w = httptest.NewRecorder()
+	g.ServeHTTP(w, req)
+
+	c.Check(w.Code, gc.Equals, http.StatusNoContent)
+	c.Check(w.Header().Get("Access-Control-Allow-Origin"), gc.Equals, "http://allowed")
+	c.Check(w.Header().Get("Access-Control-Allow-Methods"), gc.Equals, "GET, HEAD, POST, PUT")
+	c.Check(w.Header().Get("Access-Control-Expose-Headers"), gc.Matches, ".*X-Write-Head.*")
+
+	// Case: preflight request of a disallowed origin.
+	req.Header.Set("Origin", "http://other")
+	w = httptest.NewRecorder()
+	g.ServeHTTP(w, req)
+
+	c.Check(w.Code, gc.Equals, http.StatusBadRequest)
+	c.Check(w.Header().Get("Access-Control-Allow-Origin"), gc.Equals, "")
+
+	// Case: any origin is allowed.
+	g.AllowedOrigins = []string{"*"}
+	w = httptest.NewRecorder()
+	g.ServeHTTP(w, req)
+
+	c.Check(w.Code, gc.Equals, http.StatusNoContent)
+	c.Check(w.Header().Get("Access-Control-Allow-Origin"), gc.Equals, "http://other")
+}
+
+func (s *HTTPSuite) TestAuthTokenCookieOrigins(c *gc.C) {
+	var g = NewGateway(nil)
+	g.AllowedOrigins = []string{"http://allowed", "*"}
+
+	var lift = func(origin string) string {
+		var req, _ = http.NewRequest("GET", "http://gateway/a/journal?append=true", nil)
+		req.AddCookie(&http.Cookie{Name: AuthTokenCookie, Value: "cookie-token"})
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		g.liftAuthToken(req)
+		return req.Header.Get("Authorization")
+	}
+
+	// Cookies of requests without an Origin, of the same origin, or of an
+	// explicitly allowed origin are used.
+	c.Check(lift(""), gc.Equals, "Bearer cookie-token")
+	c.Check(lift("http://gateway"), gc.Equals, "Bearer cookie-token")
+	c.Check(lift("http://allowed"), gc.Equals, "Bearer cookie-token")
+	// Cookies of other origins are not, though "*" allows their requests.
+	c.Check(lift("http://other"), gc.Equals, "")
+
+	// A token of the query parameter is used regardless of Origin.
+	var req, _ = http.NewRequest("GET", "http://gateway/a/journal?access_token=a-token", nil)
+	req.Header.Set("Origin", "http://other")
+	g.liftAuthToken(req)
+
+	c.Check(req.Header.Get("Authorization"), gc.Equals, "Bearer a-token")
+	c.Check(req.URL.RawQuery, gc.Equals, "")
+}
+
+// setMessageUnpacker sets NewMessageUnpacker for the duration of a test,
+// returning a function which restores it.
+func setMessageUnpacker() func() {
+	var prior = NewMessageUnpacker
+	NewMessageUnpacker = message.NewMessageUnpacker
+	return func() { NewMessageUnpacker = prior }
+}
+
+// statJournalFixture returns a Stat implementation of journal "a/journal",
+// having |contentType|.
+func statJournalFixture(contentType string) func(context.Context, *pb.StatRequest) (*pb.StatResponse, error) {
+	return func(context.Context, *pb.StatRequest) (*pb.StatResponse, error) {
+		return &pb.StatResponse{
+			Status: pb.Status_OK,
+			Header: *readResponseFixture.Header,
+			Spec: &pb.JournalSpec{
+				Name:        "a/journal",
+				Replication: 1,
+				LabelSet:    pb.MustLabelSet(labels.ContentType, contentType),
+				Fragment: pb.JournalSpec_Fragment{
+					Length:           1024,
+					CompressionCodec: pb.CompressionCodec_NONE,
+					RefreshInterval:  time.Minute,
+				},
+			},
+		}, nil
+	}
+}
+
 var (
 	_ = gc.Suite(&HTTPSuite{})
 
@@ -0,0 +1,283 @@
+// Package rest implements a JSON REST API over the broker Journal and consumer
+// Shard gRPC services, for web tooling and scripts which lack a gRPC stack.
+// Request and response bodies are the JSON mappings of the corresponding
+// protocol messages, and the API is described by an OpenAPI document served
+// at Prefix + "openapi.json".
+//
+// Endpoints, relative to Prefix, are:
+//
+//   GET  journals?selector=...    Journal.List
+//   POST journals                 Journal.Apply
+//   GET  fragments?journal=...    Journal.ListFragments
+//   GET  shards?selector=...      Shard.List
+//   POST shards                   Shard.Apply
+//   POST shards/stat              Shard.Stat
+//   POST shards/unassign          Shard.Unassign
+//
+// Selectors are given in their LabelSelector string form, as parsed by
+// ParseLabelSelector. A response having a non-OK Status is returned with HTTP
+// status 200: as with the gRPC APIs, clients must check the response Status.
+// An RPC error is returned with an HTTP status mapped from its gRPC code.
+package rest
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gorilla/schema"
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/http_gateway"
+	pb "go.gazette.dev/core/broker/protocol"
+	pc "go.gazette.dev/core/consumer/protocol"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Prefix is the URL path prefix of the API.
+const Prefix = "/api/v1/"
+
+// API serves the JSON REST API.
+type API struct {
+	journals pb.JournalClient
+	shards   pc.ShardClient
+	decoder  *schema.Decoder
+}
+
+// NewAPI returns an API which dispatches to the JournalClient and ShardClient.
+// If |shards| is nil, Shard endpoints are not served.
+func NewAPI(journals pb.JournalClient, shards pc.ShardClient) *API {
+	var decoder = schema.NewDecoder()
+	decoder.IgnoreUnknownKeys(false)
+
+	return &API{
+		journals: journals,
+		shards:   shards,
+		decoder:  decoder,
+	}
+}
+
+func (a *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var route = r.Method + " " + strings.TrimPrefix(r.URL.Path, Prefix)
+
+	if strings.Contains(route, " shards") && a.shards == nil {
+		writeError(w, http.StatusNotFound, fmt.Errorf("shard APIs are not served by this process"))
+		return
+	}
+
+	switch route {
+	case "GET journals":
+		a.listJournals(w, r)
+	case "POST journals":
+		a.applyJournals(w, r)
+	case "GET fragments":
+		a.listFragments(w, r)
+	case "GET shards":
+		a.listShards(w, r)
+	case "POST shards":
+		a.applyShards(w, r)
+	case "POST shards/stat":
+		a.statShard(w, r)
+	case "POST shards/unassign":
+		a.unassignShards(w, r)
+	case "GET openapi.json":
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(openAPIDocument)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Errorf("unknown endpoint: %s %s", r.Method, r.URL.Path))
+	}
+}
+
+func (a *API) listJournals(w http.ResponseWriter, r *http.Request) {
+	var req pb.ListRequest
+	if err := a.parseSelector(r, &req.Selector); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+	} else if err = req.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+	} else {
+		var resp, err = a.journals.List(pb.WithDispatchDefault(http_gateway.RequestContext(r)), &req)
+		writeResponse(w, resp, err)
+	}
+}
+
+func (a *API) applyJournals(w http.ResponseWriter, r *http.Request) {
+	var req pb.ApplyRequest
+	if err := decodeRequest(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+	} else {
+		var resp, err = a.journals.Apply(pb.WithDispatchDefault(http_gateway.RequestContext(r)), &req)
+		writeResponse(w, resp, err)
+	}
+}
+
+func (a *API) listFragments(w http.ResponseWriter, r *http.Request) {
+	var req, err = a.parseFragmentsRequest(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	resp, err := a.journals.ListFragments(pb.WithDispatchDefault(http_gateway.RequestContext(r)), &req)
+	writeResponse(w, resp, err)
+}
+
+func (a *API) listShards(w http.ResponseWriter, r *http.Request) {
+	var req pc.ListRequest
+	if err := a.parseSelector(r, &req.Selector); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+	} else if err = req.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+	} else {
+		var resp, err = a.shards.List(pb.WithDispatchDefault(http_gateway.RequestContext(r)), &req)
+		writeResponse(w, resp, err)
+	}
+}
+
+func (a *API) applyShards(w http.ResponseWriter, r *http.Request) {
+	var req pc.ApplyRequest
+	if err := decodeRequest(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+	} else {
+		var resp, err = a.shards.Apply(pb.WithDispatchDefault(http_gateway.RequestContext(r)), &req)
+		writeResponse(w, resp, err)
+	}
+}
+
+func (a *API) statShard(w http.ResponseWriter, r *http.Request) {
+	var req pc.StatRequest
+	if err := decodeRequest(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+	} else {
+		var resp, err = a.shards.Stat(pb.WithDispatchDefault(http_gateway.RequestContext(r)), &req)
+		writeResponse(w, resp, err)
+	}
+}
+
+func (a *API) unassignShards(w http.ResponseWriter, r *http.Request) {
+	var req pc.UnassignRequest
+	if err := decodeRequest(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+	} else {
+		var resp, err = a.shards.Unassign(pb.WithDispatchDefault(http_gateway.RequestContext(r)), &req)
+		writeResponse(w, resp, err)
+	}
+}
+
+// parseSelector parses the "selector" query parameter of the request.
+func (a *API) parseSelector(r *http.Request, out *pb.LabelSelector) error {
+	var schema struct {
+		Selector string
+	}
+	var q, err = url.ParseQuery(r.URL.RawQuery)
+	if err == nil {
+		err = a.decoder.Decode(&schema, q)
+	}
+	if err == nil {
+		*out, err = pb.ParseLabelSelector(schema.Selector)
+	}
+	return err
+}
+
+func (a *API) parseFragmentsRequest(r *http.Request) (pb.FragmentsRequest, error) {
+	var schema struct {
+		Journal       string
+		BeginModTime  string `schema:"begin_mod_time"`
+		EndModTime    string `schema:"end_mod_time"`
+		NextPageToken int64  `schema:"next_page_token"`
+		PageLimit     int32  `schema:"page_limit"`
+		SignatureTTL  string `schema:"signature_ttl"`
+	}
+	var q url.Values
+	var err error
+
+	if q, err = url.ParseQuery(r.URL.RawQuery); err == nil {
+		err = a.decoder.Decode(&schema, q)
+	}
+	var req = pb.FragmentsRequest{
+		Journal:       pb.Journal(schema.Journal),
+		NextPageToken: schema.NextPageToken,
+		PageLimit:     schema.PageLimit,
+	}
+	if err == nil && schema.BeginModTime != "" {
+		if req.BeginModTime, err = pb.ParseModTime(schema.BeginModTime); err != nil {
+			err = fmt.Errorf("invalid begin_mod_time: %w", err)
+		}
+	}
+	if err == nil && schema.EndModTime != "" {
+		if req.EndModTime, err = pb.ParseModTime(schema.EndModTime); err != nil {
+			err = fmt.Errorf("invalid end_mod_time: %w", err)
+		}
+	}
+	if err == nil && schema.SignatureTTL != "" {
+		var ttl time.Duration
+		if ttl, err = time.ParseDuration(schema.SignatureTTL); err == nil {
+			req.SignatureTTL = &ttl
+		}
+	}
+	if err == nil {
+		err = req.Validate()
+	}
+	return req, err
+}
+
+// validatingMessage is a protocol message having a Validate method.
+type validatingMessage interface {
+	proto.Message
+	Validate() error
+}
+
+// decodeRequest decodes the JSON request body into |msg|, and validates it.
+func decodeRequest(r *http.Request, msg validatingMessage) error {
+	if err := jsonpb.Unmarshal(r.Body, msg); err != nil {
+		return fmt.Errorf("decoding request body: %w", err)
+	}
+	return msg.Validate()
+}
+
+// writeResponse writes the JSON encoding of |msg|, or |err| if non-nil.
+func writeResponse(w http.ResponseWriter, msg proto.Message, err error) {
+	if err != nil {
+		log.WithField("err", err).Warn("rest: failed to proxy request")
+		writeError(w, httpStatusOf(err), err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	var m = jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+	_ = m.Marshal(w, msg)
+}
+
+// httpStatusOf maps the gRPC status code of the RPC error |err| to an HTTP
+// status code.
+func httpStatusOf(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.FailedPrecondition:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// writeError writes a JSON object having the error message of |err|.
+func writeError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(struct {
+		Error string `json:"error"`
+	}{err.Error()})
+}
+
+//go:embed openapi.json
+var openAPIDocument []byte
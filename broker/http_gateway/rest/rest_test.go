@@ -0,0 +1,254 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/broker/teststub"
+	pc "go.gazette.dev/core/consumer/protocol"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestJournalEndpoints(t *testing.T) {
+	var ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	var broker = teststub.NewBroker(t)
+	defer broker.Cleanup()
+
+	var api = NewAPI(broker.Client(), nil)
+
+	// Selectors are parsed from their string form. Authorization headers
+	// are passed through to the broker.
+	broker.ListFunc = func(ctx context.Context, req *pb.ListRequest) (*pb.ListResponse, error) {
+		var md, _ = metadata.FromIncomingContext(ctx)
+		require.Equal(t, []string{"Bearer a-token"}, md.Get("authorization"))
+		require.Equal(t, pb.LabelSelector{
+			Include: pb.MustLabelSet("app", "bar", "prefix", "foo/"),
+			Exclude: pb.MustLabelSet("baz", ""),
+		}, req.Selector)
+
+		return &pb.ListResponse{
+			Journals: []pb.ListResponse_Journal{{
+				Spec:        pb.JournalSpec{Name: "foo/bar", Replication: 1},
+				ModRevision: 123,
+			}},
+		}, nil
+	}
+	var resp = serve(ctx, api, "GET", "journals?selector=app%3Dbar%2Cprefix%3Dfoo%2F%2C%21baz", "")
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+
+	var list pb.ListResponse
+	require.NoError(t, jsonpb.Unmarshal(resp.Body, &list))
+	require.Len(t, list.Journals, 1)
+	require.Equal(t, pb.Journal("foo/bar"), list.Journals[0].Spec.Name)
+	require.Equal(t, int64(123), list.Journals[0].ModRevision)
+
+	// Invalid selectors and unknown parameters are rejected.
+	resp = serve(ctx, api, "GET", "journals?selector=%21%21", "")
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+	require.Contains(t, resp.Body.String(), `"error":`)
+	resp = serve(ctx, api, "GET", "journals?other=1", "")
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+	require.JSONEq(t, `{"error": "schema: invalid path \"other\""}`, resp.Body.String())
+
+	// Apply decodes JSON ApplyRequests, including expected revisions.
+	broker.ApplyFunc = func(ctx context.Context, req *pb.ApplyRequest) (*pb.ApplyResponse, error) {
+		require.Len(t, req.Changes, 1)
+		require.Equal(t, int64(123), req.Changes[0].ExpectModRevision)
+		require.Equal(t, pb.Journal("foo/bar"), req.Changes[0].Upsert.Name)
+		require.Equal(t, time.Minute, req.Changes[0].Upsert.Fragment.RefreshInterval)
+
+		return &pb.ApplyResponse{Status: pb.Status_ETCD_TRANSACTION_FAILED}, nil
+	}
+	resp = serve(ctx, api, "POST", "journals", `{"changes": [{"expect_mod_revision": 123, "upsert": {
+		"name": "foo/bar", "replication": 1, "fragment": {
+			"length": 1024, "compression_codec": "NONE", "refresh_interval": "60s"}}}]}`)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Contains(t, resp.Body.String(), `"status":"ETCD_TRANSACTION_FAILED"`)
+
+	// Malformed and invalid requests are rejected before reaching the broker.
+	resp = serve(ctx, api, "POST", "journals", `{"changes": [`)
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+	require.Contains(t, resp.Body.String(), "decoding request body")
+	resp = serve(ctx, api, "POST", "journals", `{"changes": [{"delete": "foo//bar"}]}`)
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+	require.Contains(t, resp.Body.String(), "must be a clean path")
+
+	// Fragments are listed with query parameters of the FragmentsRequest.
+	broker.ListFragmentsFunc = func(ctx context.Context, req *pb.FragmentsRequest) (*pb.FragmentsResponse, error) {
+		var ttl = time.Hour
+		require.Equal(t, &pb.FragmentsRequest{
+			Journal:       "foo/bar",
+			BeginModTime:  1500000000,
+			EndModTime:    1600000000,
+			NextPageToken: 456,
+			PageLimit:     10,
+			SignatureTTL:  &ttl,
+		}, req)
+
+		return &pb.FragmentsResponse{
+			Fragments: []pb.FragmentsResponse__Fragment{{
+				Spec:      pb.Fragment{Journal: "foo/bar", Begin: 0, End: 100, CompressionCodec: pb.CompressionCodec_NONE},
+				SignedUrl: "http://signed",
+			}},
+		}, nil
+	}
+	resp = serve(ctx, api, "GET", "fragments?journal=foo/bar&begin_mod_time=2017-07-14T02:40:00Z"+
+		"&end_mod_time=1600000000&next_page_token=456&page_limit=10&signature_ttl=1h", "")
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Contains(t, resp.Body.String(), `"signed_url":"http://signed"`)
+
+	resp = serve(ctx, api, "GET", "fragments?journal=foo/bar&begin_mod_time=yesterday", "")
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+	require.Contains(t, resp.Body.String(), "invalid begin_mod_time: expected RFC 3339 timestamp or unix seconds (yesterday)")
+
+	// RPC errors are mapped to an HTTP status of their gRPC code, or to 500.
+	for _, tc := range []struct {
+		err    error
+		expect int
+	}{
+		{status.Error(codes.InvalidArgument, "invalid request"), http.StatusBadRequest},
+		{status.Error(codes.Unauthenticated, "missing token"), http.StatusUnauthorized},
+		{status.Error(codes.PermissionDenied, "not allowed"), http.StatusForbidden},
+		{status.Error(codes.NotFound, "not found"), http.StatusNotFound},
+		{status.Error(codes.FailedPrecondition, "precondition"), http.StatusConflict},
+		{status.Error(codes.Unavailable, "unavailable"), http.StatusInternalServerError},
+		{context.DeadlineExceeded, http.StatusInternalServerError},
+	} {
+		broker.ListFunc = func(context.Context, *pb.ListRequest) (*pb.ListResponse, error) {
+			return nil, tc.err
+		}
+		resp = serve(ctx, api, "GET", "journals", "")
+		require.Equal(t, tc.expect, resp.Code)
+		require.Contains(t, resp.Body.String(), status.Convert(tc.err).Message())
+	}
+
+	// Shard endpoints aren't served without a ShardClient.
+	resp = serve(ctx, api, "GET", "shards", "")
+	require.Equal(t, http.StatusNotFound, resp.Code)
+	// Nor are unknown endpoints.
+	resp = serve(ctx, api, "DELETE", "journals", "")
+	require.Equal(t, http.StatusNotFound, resp.Code)
+}
+
+func TestShardEndpoints(t *testing.T) {
+	var ctx = context.Background()
+	var shards = &shardClient{}
+	var api = NewAPI(nil, shards)
+
+	shards.list = func(req *pc.ListRequest) (*pc.ListResponse, error) {
+		require.Equal(t, pb.LabelSelector{Include: pb.MustLabelSet("id", "a-shard")}, req.Selector)
+		return &pc.ListResponse{Shards: []pc.ListResponse_Shard{{
+			Spec:        pc.ShardSpec{Id: "a-shard"},
+			ModRevision: 12,
+		}}}, nil
+	}
+	var resp = serve(ctx, api, "GET", "shards?selector=id%3Da-shard", "")
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Contains(t, resp.Body.String(), `"id":"a-shard"`)
+
+	shards.apply = func(req *pc.ApplyRequest) (*pc.ApplyResponse, error) {
+		require.Equal(t, []pc.ApplyRequest_Change{{ExpectModRevision: -1, Delete: "a-shard"}}, req.Changes)
+		return &pc.ApplyResponse{}, nil
+	}
+	resp = serve(ctx, api, "POST", "shards", `{"changes": [{"expect_mod_revision": -1, "delete": "a-shard"}]}`)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Contains(t, resp.Body.String(), `"status":"OK"`)
+
+	shards.stat = func(req *pc.StatRequest) (*pc.StatResponse, error) {
+		require.Equal(t, &pc.StatRequest{
+			Shard:       "a-shard",
+			ReadThrough: pb.Offsets{"a/journal": 1234},
+		}, req)
+		return &pc.StatResponse{ReadThrough: pb.Offsets{"a/journal": 5678}}, nil
+	}
+	resp = serve(ctx, api, "POST", "shards/stat", `{"shard": "a-shard", "read_through": {"a/journal": 1234}}`)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Contains(t, resp.Body.String(), `"read_through":{"a/journal":"5678"}`)
+
+	resp = serve(ctx, api, "POST", "shards/stat", `{}`)
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+
+	shards.unassign = func(req *pc.UnassignRequest) (*pc.UnassignResponse, error) {
+		require.Equal(t, &pc.UnassignRequest{Shards: []pc.ShardID{"a-shard"}, OnlyFailed: true}, req)
+		return &pc.UnassignResponse{Shards: []pc.ShardID{"a-shard"}}, nil
+	}
+	resp = serve(ctx, api, "POST", "shards/unassign", `{"shards": ["a-shard"], "only_failed": true}`)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Contains(t, resp.Body.String(), `"shards":["a-shard"]`)
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	var resp = serve(context.Background(), NewAPI(nil, nil), "GET", "openapi.json", "")
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	var doc struct {
+		OpenAPI string `json:"openapi"`
+		Paths   map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+		} `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &doc))
+	require.Equal(t, "3.0.3", doc.OpenAPI)
+
+	var ops []string
+	for _, methods := range doc.Paths {
+		for _, op := range methods {
+			ops = append(ops, op.OperationID)
+		}
+	}
+	require.ElementsMatch(t, []string{"listJournals", "applyJournals", "listFragments",
+		"listShards", "applyShards", "statShard", "unassignShards"}, ops)
+}
+
+func serve(ctx context.Context, api *API, method, path, body string) *httptest.ResponseRecorder {
+	var req = httptest.NewRequest(method, Prefix+path, strings.NewReader(body)).WithContext(ctx)
+	req.Header.Set("Authorization", "Bearer a-token")
+
+	var w = httptest.NewRecorder()
+	api.ServeHTTP(w, req)
+	return w
+}
+
+// shardClient is a pc.ShardClient which dispatches to test closures.
+type shardClient struct {
+	stat     func(*pc.StatRequest) (*pc.StatResponse, error)
+	list     func(*pc.ListRequest) (*pc.ListResponse, error)
+	apply    func(*pc.ApplyRequest) (*pc.ApplyResponse, error)
+	unassign func(*pc.UnassignRequest) (*pc.UnassignResponse, error)
+}
+
+func (c *shardClient) Stat(_ context.Context, req *pc.StatRequest, _ ...grpc.CallOption) (*pc.StatResponse, error) {
+	return c.stat(req)
+}
+func (c *shardClient) List(_ context.Context, req *pc.ListRequest, _ ...grpc.CallOption) (*pc.ListResponse, error) {
+	return c.list(req)
+}
+func (c *shardClient) Watch(context.Context, *pc.ListRequest, ...grpc.CallOption) (pc.Shard_WatchClient, error) {
+	panic("not implemented")
+}
+func (c *shardClient) Apply(_ context.Context, req *pc.ApplyRequest, _ ...grpc.CallOption) (*pc.ApplyResponse, error) {
+	return c.apply(req)
+}
+func (c *shardClient) History(context.Context, *pc.HistoryRequest, ...grpc.CallOption) (*pc.HistoryResponse, error) {
+	panic("not implemented")
+}
+func (c *shardClient) GetHints(context.Context, *pc.GetHintsRequest, ...grpc.CallOption) (*pc.GetHintsResponse, error) {
+	panic("not implemented")
+}
+func (c *shardClient) Unassign(_ context.Context, req *pc.UnassignRequest, _ ...grpc.CallOption) (*pc.UnassignResponse, error) {
+	return c.unassign(req)
+}
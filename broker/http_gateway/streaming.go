@@ -0,0 +1,361 @@
+package http_gateway
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/client"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/labels"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewMessageUnpacker returns a function which unpacks the message of each
+// frame of content having the given content-type, or an error if the
+// content-type isn't supported. The gazette broker sets it to
+// message.NewMessageUnpacker. If nil, message streams are refused.
+var NewMessageUnpacker func(contentType string) (func(*bufio.Reader) ([]byte, error), error)
+
+// StreamedMessage is a message of a journal, as it's streamed to clients of
+// Server-Sent Events and WebSocket reads. A message of a JSON-lines journal is
+// streamed as its JSON document. Messages of other framings are streamed as
+// base64-encoded strings of their encoded message (without its frame header).
+//
+// A line of a JSON-lines journal which isn't a valid JSON document doesn't end
+// the stream. It's instead streamed as a JSON string of the line, with an Error.
+type StreamedMessage struct {
+	// Offset of the message within the journal.
+	Offset int64 `json:"offset"`
+	// Next offset of the journal, just beyond the message.
+	Next int64 `json:"next"`
+	// Message content.
+	Message json.RawMessage `json:"message"`
+	// Error of the message, if it couldn't be decoded.
+	Error string `json:"error,omitempty"`
+}
+
+// StreamedAppend acknowledges the append of a message sent to a WebSocket
+// append stream.
+type StreamedAppend struct {
+	// Begin offset of the committed append.
+	Begin int64 `json:"begin"`
+	// End offset of the committed append.
+	End int64 `json:"end"`
+}
+
+// messageStream reads the messages of a journal, as framed by its content-type.
+type messageStream struct {
+	rr     *client.RetryReader
+	br     *bufio.Reader
+	unpack func(*bufio.Reader) ([]byte, error)
+	json   bool // Are messages JSON documents?
+}
+
+// newMessageStream returns a messageStream of the ReadRequest, or an error
+// and the HTTP status code which should be returned to the client.
+func (h *Gateway) newMessageStream(r *http.Request, req pb.ReadRequest) (*messageStream, int, error) {
+	var spec, code, err = h.getJournal(r, req.Journal)
+	if err != nil {
+		return nil, code, err
+	}
+	var contentType = spec.LabelSet.ValueOf(labels.ContentType)
+	var s = &messageStream{json: contentType == labels.ContentType_JSONLines}
+
+	if NewMessageUnpacker == nil {
+		return nil, http.StatusBadRequest, errors.New("gateway doesn't support message streams")
+	} else if s.unpack, err = NewMessageUnpacker(contentType); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	s.rr = client.NewRetryReader(RequestContext(r), h.client, req)
+	s.br = bufio.NewReader(s.rr)
+
+	return s, http.StatusOK, nil
+}
+
+// next returns the next StreamedMessage of the messageStream.
+func (s *messageStream) next() (StreamedMessage, error) {
+	for {
+		var begin = s.rr.AdjustedOffset(s.br)
+		var msg, err = s.unpack(s.br)
+
+		switch errors.Cause(err) {
+		case nil:
+		case io.ErrNoProgress, client.ErrOffsetJump:
+			// Swallow empty reads, and continue reading at a jumped offset,
+			// which is reflected in the Offset of the next StreamedMessage.
+			continue
+		default:
+			return StreamedMessage{}, err
+		}
+		var out = StreamedMessage{Offset: begin, Next: s.rr.AdjustedOffset(s.br)}
+
+		if s.json && json.Valid(msg) {
+			out.Message = msg
+		} else if s.json {
+			out.Message, _ = json.Marshal(string(msg))
+			out.Error = "message is not a valid JSON document"
+		} else {
+			out.Message, _ = json.Marshal(msg) // Encodes as a base64 string.
+		}
+		return out, nil
+	}
+}
+
+// serveEvents serves a read of the journal's messages as Server-Sent Events.
+// Each event has an "id" of the Next offset of its StreamedMessage, and "data"
+// of the JSON-encoded StreamedMessage. A reconnecting client's Last-Event-ID
+// header resumes the read from that offset. When the read ends, a final "close"
+// event is sent having "data" of its error.
+func (h *Gateway) serveEvents(w http.ResponseWriter, r *http.Request) {
+	var req, err = h.parseStreamRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var stream, code, streamErr = h.newMessageStream(r, req)
+	if streamErr != nil {
+		http.Error(w, streamErr.Error(), code)
+		return
+	}
+	defer stream.rr.Cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	var flush = func() {
+		if flusher, ok := w.(http.Flusher); ok {
+			flusher.Flush()
+		}
+	}
+	flush()
+
+	for {
+		var msg StreamedMessage
+		if msg, err = stream.next(); err != nil {
+			break
+		}
+		var b []byte
+		if b, err = json.Marshal(msg); err != nil {
+			break
+		} else if _, err = fmt.Fprintf(w, "id: %d\ndata: %s\n\n", msg.Next, b); err != nil {
+			break
+		} else if stream.br.Buffered() == 0 {
+			flush() // Flush only when our next read may block.
+		}
+	}
+
+	if r.Context().Err() == nil {
+		_, _ = fmt.Fprintf(w, "event: close\ndata: %s\n\n", err)
+		flush()
+	}
+	logStreamError(r, req.Journal, err)
+}
+
+// serveWebSocket serves a WebSocket read or, if query parameter "append" is
+// set, a WebSocket append.
+func (h *Gateway) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	var q = r.URL.Query()
+	var doAppend bool
+
+	if s := q.Get("append"); s != "" {
+		var err error
+		if doAppend, err = strconv.ParseBool(s); err != nil {
+			http.Error(w, fmt.Sprintf("invalid append (%s)", s), http.StatusBadRequest)
+			return
+		}
+		q.Del("append")
+		r.URL.RawQuery = q.Encode()
+	}
+
+	if doAppend {
+		h.serveWebSocketAppend(w, r)
+	} else {
+		h.serveWebSocketRead(w, r)
+	}
+}
+
+// serveWebSocketRead serves a read of the journal's messages, where each
+// WebSocket text message is a JSON-encoded StreamedMessage. When the read
+// ends, the WebSocket is closed with a reason of its error.
+func (h *Gateway) serveWebSocketRead(w http.ResponseWriter, r *http.Request) {
+	var req, err = h.parseStreamRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var stream, code, streamErr = h.newMessageStream(r, req)
+	if streamErr != nil {
+		http.Error(w, streamErr.Error(), code)
+		return
+	}
+	defer stream.rr.Cancel()
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return // Upgrade has already responded with an HTTP error.
+	}
+	defer conn.Close()
+
+	// Read and discard client messages, so that control messages are processed.
+	// If the client closes or breaks the connection, cancel the journal read.
+	go func() {
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				stream.rr.Cancel()
+				return
+			}
+		}
+	}()
+
+	for {
+		var msg StreamedMessage
+		if msg, err = stream.next(); err != nil {
+			break
+		}
+		var b []byte
+		if b, err = json.Marshal(msg); err != nil {
+			break
+		} else if err = conn.WriteMessage(websocket.TextMessage, b); err != nil {
+			break
+		}
+	}
+
+	closeWebSocket(conn, err)
+	logStreamError(r, req.Journal, err)
+}
+
+// serveWebSocketAppend serves appends of WebSocket messages to the journal.
+// Each message is appended as its own Append RPC, and acknowledged with a
+// JSON-encoded StreamedAppend. A message to a JSON-lines journal which doesn't
+// end in a newline has one added. If an append fails, the WebSocket is closed
+// with a reason of its error.
+func (h *Gateway) serveWebSocketAppend(w http.ResponseWriter, r *http.Request) {
+	var req, err = h.parseAppendRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var spec, code, specErr = h.getJournal(r, req.Journal)
+	if specErr != nil {
+		http.Error(w, specErr.Error(), code)
+		return
+	}
+	var jsonLines = spec.LabelSet.ValueOf(labels.ContentType) == labels.ContentType_JSONLines
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return // Upgrade has already responded with an HTTP error.
+	}
+	defer conn.Close()
+
+	for {
+		var content []byte
+		if _, content, err = conn.ReadMessage(); err != nil {
+			return // Client closed or broke the connection.
+		}
+		if jsonLines && !bytes.HasSuffix(content, []byte{'\n'}) {
+			content = append(content, '\n')
+		}
+
+		var resp pb.AppendResponse
+		if resp, err = client.Append(RequestContext(r), h.client, req, bytes.NewReader(content)); err != nil {
+			break
+		}
+		var b, _ = json.Marshal(StreamedAppend{Begin: resp.Commit.Begin, End: resp.Commit.End})
+
+		if err = conn.WriteMessage(websocket.TextMessage, b); err != nil {
+			break
+		}
+	}
+
+	closeWebSocket(conn, err)
+	logStreamError(r, req.Journal, err)
+}
+
+// parseStreamRequest parses a ReadRequest of a message stream. The request
+// offset may be given by a Last-Event-ID header, which takes precedence.
+func (h *Gateway) parseStreamRequest(r *http.Request) (pb.ReadRequest, error) {
+	var req, err = h.parseReadRequest(r)
+
+	if id := r.Header.Get(LastEventIDHeader); err == nil && id != "" {
+		if req.Offset, err = strconv.ParseInt(id, 10, 64); err != nil {
+			err = fmt.Errorf("invalid %s (%s)", LastEventIDHeader, id)
+		} else {
+			err = req.Validate()
+		}
+	}
+	return req, err
+}
+
+// getJournal returns the JournalSpec of |journal|, or an error and the HTTP
+// status code which should be returned to the client. The JournalSpec is
+// fetched through the Stat RPC, which requires only READ capability.
+func (h *Gateway) getJournal(r *http.Request, journal pb.Journal) (*pb.JournalSpec, int, error) {
+	var resp, err = client.StatJournal(RequestContext(r), h.client, journal.StripMeta())
+
+	switch {
+	case err == nil && resp.Spec != nil:
+		return resp.Spec, http.StatusOK, nil
+	case err == nil:
+		return nil, http.StatusInternalServerError, errors.New("broker didn't return a JournalSpec")
+	case resp != nil && resp.Status == pb.Status_JOURNAL_NOT_FOUND:
+		return nil, http.StatusNotFound, err
+	case resp != nil && resp.Status == pb.Status_NOT_ALLOWED,
+		status.Code(err) == codes.PermissionDenied:
+		return nil, http.StatusForbidden, err
+	case status.Code(err) == codes.Unauthenticated:
+		return nil, http.StatusUnauthorized, err
+	default:
+		return nil, http.StatusInternalServerError, err
+	}
+}
+
+// closeWebSocket sends a close message to the WebSocket peer, having a reason
+// of the error which ended the stream.
+func closeWebSocket(conn *websocket.Conn, err error) {
+	var code = websocket.CloseInternalServerErr
+	if isExpectedStreamError(err) {
+		code = websocket.CloseNormalClosure
+	}
+	var reason = err.Error()
+	if len(reason) > maxCloseReasonLen {
+		reason = reason[:maxCloseReasonLen]
+	}
+	_ = conn.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
+}
+
+func logStreamError(r *http.Request, journal pb.Journal, err error) {
+	if r.Context().Err() != nil || isExpectedStreamError(err) {
+		// Common & expected errors. Don't log.
+	} else {
+		log.WithFields(log.Fields{"journal": journal, "err": err}).
+			Warn("http_gateway: failed to proxy message stream")
+	}
+}
+
+// isExpectedStreamError returns whether |err| is a common and expected
+// reason for a message stream to end.
+func isExpectedStreamError(err error) bool {
+	var cause = errors.Cause(err)
+
+	return cause == context.Canceled ||
+		cause == client.ErrOffsetNotYetAvailable ||
+		websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway)
+}
+
+// maxCloseReasonLen is the maximum length of a WebSocket close reason,
+// which shares a 125-byte control frame with its 2-byte status code.
+const maxCloseReasonLen = 123
@@ -224,6 +224,71 @@ func (n *Node) MarkUnpatchedForDeletion() {
 	}
 }
 
+// Diff is the difference of a journal between a tree of current JournalSpecs,
+// and a tree of JournalSpecs to be applied.
+type Diff struct {
+	// Name of the journal.
+	Name pb.Journal
+	// Current JournalSpec of the journal, or nil if the journal doesn't exist.
+	Current *pb.JournalSpec
+	// Revision of the current JournalSpec within Etcd.
+	Revision int64
+	// Applied JournalSpec of the journal, or nil if it's to be deleted.
+	Applied *pb.JournalSpec
+	// Revision expected by the applied Node.
+	ExpectRevision int64
+}
+
+// DiffTrees returns Diffs of each terminal Node of the |applied| tree which
+// differs from its current JournalSpec in the |current| tree, ordered on
+// journal name. Specifications are pushed down to terminal Nodes before being
+// compared, but neither tree is modified. Journals of |current| which aren't
+// in |applied| are unaffected by the apply, and don't produce a Diff.
+func DiffTrees(current, applied *Node) []Diff {
+	var index = make(map[pb.Journal]*Node)
+	var out []Diff
+
+	walkPushedDown(current, pb.JournalSpec{}, nil, func(n Node) {
+		index[n.Spec.Name] = &n
+	})
+	walkPushedDown(applied, pb.JournalSpec{}, nil, func(n Node) {
+		var diff = Diff{Name: n.Spec.Name, ExpectRevision: n.Revision}
+
+		if cur, ok := index[n.Spec.Name]; ok {
+			diff.Current, diff.Revision = &cur.Spec, cur.Revision
+		}
+		if n.Delete == nil || !*n.Delete {
+			diff.Applied = &n.Spec
+		}
+
+		if diff.Current != nil && diff.Applied != nil && diff.Current.Equal(diff.Applied) &&
+			(diff.ExpectRevision == -1 || diff.ExpectRevision == diff.Revision) {
+			return // Unchanged.
+		} else if diff.Current == nil && diff.Applied == nil && diff.ExpectRevision == -1 {
+			return // Deletion of a journal which doesn't exist.
+		}
+		out = append(out, diff)
+	})
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// walkPushedDown invokes |cb| with each terminal Node of the tree rooted at
+// |n|, having the specification and deletion pushed down from its parents.
+func walkPushedDown(n *Node, spec pb.JournalSpec, del *bool, cb func(Node)) {
+	spec = pb.UnionJournalSpecs(n.Spec, spec)
+	if n.Delete != nil {
+		del = n.Delete
+	}
+	if !n.IsDir() {
+		cb(Node{Delete: del, Spec: spec, Revision: n.Revision})
+	}
+	for i := range n.Children {
+		walkPushedDown(&n.Children[i], spec, del, cb)
+	}
+}
+
 // extractTree derives the tree from ordered []Nodes implied by their shared
 // path "directories", or component prefixes. For example, journals:
 //  - root/foo/bar
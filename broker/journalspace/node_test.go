@@ -106,6 +106,43 @@ func (s *NodeSuite) TestPushDown(c *gc.C) {
 	}
 }
 
+func (s *NodeSuite) TestDiffTrees(c *gc.C) {
+	var current = FromListResponse(&pb.ListResponse{Journals: []pb.ListResponse_Journal{
+		{Spec: pb.JournalSpec{Name: "root/aaa", Replication: 1}, ModRevision: 10},
+		{Spec: pb.JournalSpec{Name: "root/bbb", Replication: 1}, ModRevision: 11},
+		{Spec: pb.JournalSpec{Name: "root/ccc", Replication: 1}, ModRevision: 12},
+		{Spec: pb.JournalSpec{Name: "root/ddd", Replication: 1}, ModRevision: 13},
+		{Spec: pb.JournalSpec{Name: "root/eee", Replication: 1}, ModRevision: 14},
+	}})
+	var applied = Node{
+		Spec: pb.JournalSpec{Name: "root/", Replication: 1},
+		Children: []Node{
+			{Spec: pb.JournalSpec{Name: "root/aaa"}, Revision: 10},                 // Unchanged.
+			{Spec: pb.JournalSpec{Name: "root/bbb", Replication: 2}, Revision: 11}, // Updated.
+			{Spec: pb.JournalSpec{Name: "root/ccc"}, Revision: 1},                  // Unchanged, at the wrong revision.
+			{Spec: pb.JournalSpec{Name: "root/ddd"}, Revision: 13, Delete: &boxedTrue},
+			{Spec: pb.JournalSpec{Name: "root/fff"}},                                   // Created.
+			{Spec: pb.JournalSpec{Name: "root/ggg"}, Revision: -1, Delete: &boxedTrue}, // Doesn't exist.
+		},
+	}
+	c.Check(applied.Validate(), gc.IsNil)
+
+	var expect = func(name pb.Journal, replication int32) *pb.JournalSpec {
+		return &pb.JournalSpec{Name: name, Replication: replication}
+	}
+	c.Check(DiffTrees(&current, &applied), gc.DeepEquals, []Diff{
+		{Name: "root/bbb", Current: expect("root/bbb", 1), Revision: 11, Applied: expect("root/bbb", 2), ExpectRevision: 11},
+		{Name: "root/ccc", Current: expect("root/ccc", 1), Revision: 12, Applied: expect("root/ccc", 1), ExpectRevision: 1},
+		{Name: "root/ddd", Current: expect("root/ddd", 1), Revision: 13, ExpectRevision: 13},
+		{Name: "root/fff", Applied: expect("root/fff", 1)},
+	})
+
+	// Neither tree was modified.
+	c.Check(applied.Spec.Replication, gc.Equals, int32(1))
+	c.Check(applied.Children[0].Spec.Replication, gc.Equals, int32(0))
+	c.Check(current.Spec.Replication, gc.Equals, int32(1))
+}
+
 func (s *NodeSuite) TestPatchAndDeletionMarking(c *gc.C) {
 	var tree = extractTree([]Node{
 		{Spec: pb.JournalSpec{Name: "root/aaa/000"}},
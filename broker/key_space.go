@@ -1,18 +1,65 @@
 package broker
 
 import (
+	"bytes"
+
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.gazette.dev/core/allocator"
 	pb "go.gazette.dev/core/broker/protocol"
 	pbx "go.gazette.dev/core/broker/protocol/ext"
+	"go.gazette.dev/core/broker/schema"
 	"go.gazette.dev/core/keyspace"
 )
 
+// SchemasPrefix is the KeySpace prefix under which SchemaSpecs are stored.
+const SchemasPrefix = "/schemas/"
+
 // NewKeySpace returns a KeySpace suitable for use with an Allocator.
 // It decodes allocator Items as JournalSpec messages, Members as BrokerSpecs,
-// and Assignments as Routes.
+// and Assignments as Routes. Keys under SchemasPrefix are decoded as
+// SchemaSpecs and compiled into *schema.Schema values. Recorded revisions
+// under allocator.HistoryPrefix are ignored.
 func NewKeySpace(prefix string) *keyspace.KeySpace {
-	return allocator.NewAllocatorKeySpace(prefix, decoder{})
+	var schemasPrefix = []byte(prefix + SchemasPrefix)
+	var decode = allocator.NewAllocatorKeyValueDecoder(prefix, decoder{})
+
+	var ks = keyspace.NewKeySpace(prefix, func(raw *mvccpb.KeyValue) (interface{}, error) {
+		if bytes.HasPrefix(raw.Key, schemasPrefix) {
+			return decodeSchema(string(raw.Key[len(schemasPrefix):]), raw)
+		}
+		return decode(raw)
+	})
+	ks.IgnorePrefix = prefix + allocator.HistoryPrefix
+	return ks
+}
+
+// SchemaKey returns the unique key for the SchemaSpec |name| under the KeySpace.
+func SchemaKey(ks *keyspace.KeySpace, name string) string {
+	return ks.Root + SchemasPrefix + name
+}
+
+// LookupSchema returns the compiled Schema |name| of the KeySpace, if it
+// exists. The KeySpace must be read-locked by the caller.
+func LookupSchema(ks *keyspace.KeySpace, name string) (*schema.Schema, bool) {
+	if ind, ok := ks.Search(SchemaKey(ks, name)); ok {
+		return ks.KeyValues[ind].Decoded.(*schema.Schema), true
+	}
+	return nil, false
+}
+
+// decodeSchema decodes and compiles the SchemaSpec of |raw|, which must have
+// a Name matching |name|, derived from its Etcd key.
+func decodeSchema(name string, raw *mvccpb.KeyValue) (*schema.Schema, error) {
+	var s = new(pb.SchemaSpec)
+
+	if err := s.Unmarshal(raw.Value); err != nil {
+		return nil, err
+	} else if err = s.Validate(); err != nil {
+		return nil, err
+	} else if s.Name != name {
+		return nil, pb.NewValidationError("SchemaSpec Name doesn't match key (%+v vs %+v)", s.Name, name)
+	}
+	return schema.Compile(s)
 }
 
 // decoder is an instance of allocator.Decoder. It strictly enforces that
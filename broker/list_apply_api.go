@@ -3,8 +3,10 @@ package broker
 import (
 	"context"
 	"net"
+	"sort"
 	"strings"
 
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"go.etcd.io/etcd/client/v3"
 	"go.gazette.dev/core/allocator"
@@ -34,14 +36,98 @@ func (svc *Service) List(ctx context.Context, req *pb.ListRequest) (resp *pb.Lis
 		Status: pb.Status_OK,
 		Header: pbx.NewUnroutedHeader(s),
 	}
+	var claims pb.Claims
 	if err = req.Validate(); err != nil {
 		return resp, err
+	} else if claims, err = svc.verify(ctx); err != nil {
+		return resp, err
+	} else if claims.Capability&pb.Capability_LIST == 0 {
+		resp.Status = pb.Status_NOT_ALLOWED
+		return resp, nil
 	}
-	var metaLabels, allLabels pb.LabelSet
 
 	defer s.KS.Mu.RUnlock()
 	s.KS.Mu.RLock()
 
+	resp.Journals = listJournals(s, req.Selector, claims)
+	return resp, nil
+}
+
+// Watch dispatches the JournalServer.Watch API.
+func (svc *Service) Watch(req *pb.ListRequest, stream pb.Journal_WatchServer) (err error) {
+	defer instrumentJournalServerRPC("Watch", &err, nil)()
+
+	defer func() {
+		if err != nil {
+			var addr net.Addr
+			if p, ok := peer.FromContext(stream.Context()); ok {
+				addr = p.Addr
+			}
+			log.WithFields(log.Fields{"err": err, "req": req, "client": addr}).
+				Warn("served Watch RPC failed")
+		}
+	}()
+
+	var s = svc.resolver.state
+
+	var claims pb.Claims
+	if err = req.Validate(); err != nil {
+		return err
+	} else if claims, err = svc.verify(stream.Context()); err != nil {
+		return err
+	} else if claims.Capability&pb.Capability_LIST == 0 {
+		return stream.Send(&pb.WatchResponse{
+			Status: pb.Status_NOT_ALLOWED,
+			Header: pbx.NewUnroutedHeader(s),
+		})
+	}
+
+	// Like proxy reads, watches are long-lived and must be stopped
+	// when the Service begins shutdown.
+	var ctx, cancel = context.WithCancel(stream.Context())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-svc.stopProxyReadsCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	var sent = make(map[pb.Journal]pb.ListResponse_Journal)
+	var revision int64
+
+	for snapshot := true; true; snapshot = false {
+		var resp = &pb.WatchResponse{Status: pb.Status_OK}
+
+		s.KS.Mu.RLock()
+		if err = s.KS.WaitForRevision(ctx, revision+1); err == nil {
+			revision = s.KS.Header.Revision
+			resp.Upserts, resp.Deletes = diffJournals(sent, listJournals(s, req.Selector, claims))
+		}
+		s.KS.Mu.RUnlock()
+
+		if err != nil {
+			return nil // Watch was cancelled, or the Service is stopping.
+		} else if !snapshot && len(resp.Upserts) == 0 && len(resp.Deletes) == 0 {
+			continue // No changes to send.
+		}
+		resp.Header = pbx.NewUnroutedHeader(s)
+
+		if err = stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	panic("not reached")
+}
+
+// listJournals returns journals of the State which match the LabelSelector
+// and are visible to the Claims. The State KeySpace must be read-locked.
+func listJournals(s *allocator.State, selector pb.LabelSelector, claims pb.Claims) []pb.ListResponse_Journal {
+	var out []pb.ListResponse_Journal
+	var metaLabels, allLabels pb.LabelSet
+
 	var it = allocator.LeftJoin{
 		LenL: len(s.Items),
 		LenR: len(s.Assignments),
@@ -57,16 +143,46 @@ func (svc *Service) List(ctx context.Context, req *pb.ListRequest) (resp *pb.Lis
 		metaLabels = pb.ExtractJournalSpecMetaLabels(&journal.Spec, metaLabels)
 		allLabels = pb.UnionLabelSets(metaLabels, journal.Spec.LabelSet, allLabels)
 
-		if !req.Selector.Matches(allLabels) {
+		if !selector.Matches(allLabels) {
 			continue
+		} else if !claims.Allows(pb.Capability_LIST, allLabels) {
+			continue // Journal is not visible to the caller.
 		}
 		journal.ModRevision = s.Items[cur.Left].Raw.ModRevision
 		pbx.Init(&journal.Route, s.Assignments[cur.RightBegin:cur.RightEnd])
 		pbx.AttachEndpoints(&journal.Route, s.KS)
 
-		resp.Journals = append(resp.Journals, journal)
+		out = append(out, journal)
 	}
-	return resp, nil
+	return out
+}
+
+// diffJournals returns |journals| which were not previously |sent|, or which
+// have a different ModRevision or Route than when they were sent. It also
+// returns journals of |sent| which are no longer present in |journals|.
+// |sent| is updated to reflect |journals|.
+func diffJournals(sent map[pb.Journal]pb.ListResponse_Journal, journals []pb.ListResponse_Journal) (
+	upserts []pb.ListResponse_Journal, deletes []pb.Journal) {
+
+	var present = make(map[pb.Journal]struct{}, len(journals))
+	for _, journal := range journals {
+		present[journal.Spec.Name] = struct{}{}
+
+		if prev, ok := sent[journal.Spec.Name]; ok &&
+			prev.ModRevision == journal.ModRevision && prev.Route.Equal(journal.Route) {
+			continue // Unchanged.
+		}
+		sent[journal.Spec.Name] = journal
+		upserts = append(upserts, journal)
+	}
+	for name := range sent {
+		if _, ok := present[name]; !ok {
+			deletes = append(deletes, name)
+			delete(sent, name)
+		}
+	}
+	sort.Slice(deletes, func(i, j int) bool { return deletes[i] < deletes[j] })
+	return upserts, deletes
 }
 
 // Apply dispatches the JournalServer.Apply API.
@@ -84,48 +200,174 @@ func (svc *Service) Apply(ctx context.Context, req *pb.ApplyRequest) (resp *pb.A
 		}
 	}()
 
+	var claims pb.Claims
 	if err = req.Validate(); err != nil {
 		return new(pb.ApplyResponse), err
+	} else if claims, err = svc.verify(ctx); err != nil {
+		return new(pb.ApplyResponse), err
 	}
 
-	var cmp []clientv3.Cmp
-	var ops []clientv3.Op
 	var s = svc.resolver.state
 
-	for _, change := range req.Changes {
-		var key string
+	resp = &pb.ApplyResponse{
+		Status: pb.Status_OK,
+		Header: pbx.NewUnroutedHeader(s),
+	}
+	if !mayApplyJournalChanges(s, claims, req.Changes) {
+		resp.Status = pb.Status_NOT_ALLOWED
+		return resp, nil
+	}
+
+	// Each change is recorded as a JournalSpecRevision of the journal's history.
+	var now = timeNow()
+	var addr string
+	if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+	}
 
-		if change.Upsert != nil {
-			key = allocator.ItemKey(s.KS, change.Upsert.Name.String())
-			ops = append(ops, clientv3.OpPut(key, change.Upsert.MarshalString()))
-		} else {
-			key = allocator.ItemKey(s.KS, change.Delete.String())
-			ops = append(ops, clientv3.OpDelete(key))
+	// The recorded Before of each change is initially its JournalSpec as
+	// observed by our KeySpace. The transaction verifies it remains current
+	// and, if not, returns the actual JournalSpec with which we try again.
+	var before = make([]*pb.JournalSpec, len(req.Changes))
+	var beforeRevision = make([]int64, len(req.Changes))
+
+	for i, change := range req.Changes {
+		before[i], beforeRevision[i] = currentJournalSpec(s, changedJournal(change))
+	}
+
+	for {
+		var cmp []clientv3.Cmp
+		var ops, elseOps []clientv3.Op
+		var journals []pb.Journal
+
+		for i, change := range req.Changes {
+			var rev = pb.JournalSpecRevision{
+				Journal:        changedJournal(change),
+				ApplyTime:      now.Unix(),
+				AppliedBy:      claims.Subject,
+				Client:         addr,
+				Before:         before[i],
+				BeforeRevision: beforeRevision[i],
+			}
+			var key = allocator.ItemKey(s.KS, rev.Journal.String())
+
+			if change.Upsert != nil {
+				rev.After = withBrokerManagedFields(rev.Before, change.Upsert)
+				ops = append(ops, clientv3.OpPut(key, rev.After.MarshalString()))
+			} else {
+				ops = append(ops, clientv3.OpDelete(key))
+			}
+			ops = append(ops, recordJournalRevision(s.KS, rev, now))
+			elseOps = append(elseOps, clientv3.OpGet(key))
+			journals = append(journals, rev.Journal)
+
+			cmp = append(cmp, clientv3.Compare(clientv3.ModRevision(key), "=", rev.BeforeRevision))
+			// Allow caller to explicitly ignore revision comparison
+			// by passing a value of -1 for revision.
+			if change.ExpectModRevision != -1 {
+				cmp = append(cmp, clientv3.Compare(clientv3.ModRevision(key), "=", change.ExpectModRevision))
+			}
+		}
+
+		// A dry-run evaluates the comparisons of the transaction, but not its ops.
+		if req.DryRun {
+			ops = nil
 		}
 
-		// Allow caller to explicitly ignore revision comparison
-		// by passing a value of -1 for revision.
-		if change.ExpectModRevision != -1 {
-			cmp = append(cmp, clientv3.Compare(clientv3.ModRevision(key), "=", change.ExpectModRevision))
+		var txnResp clientv3.OpResponse
+		if txnResp, err = svc.etcd.Do(ctx, clientv3.OpTxn(cmp, ops, elseOps)); err != nil {
+			return resp, err
+		}
+		resp.Header.Etcd.Revision = txnResp.Txn().Header.Revision
+
+		if txnResp.Txn().Succeeded {
+			if len(ops) != 0 {
+				// If we made changes, delay responding until we have read our own Etcd write.
+				s.KS.Mu.RLock()
+				err = s.KS.WaitForRevision(ctx, txnResp.Txn().Header.Revision)
+				s.KS.Mu.RUnlock()
+
+				pruneJournalHistory(ctx, svc, journals, now)
+			}
+			return resp, err
+		}
+
+		// Determine whether the transaction failed due to the caller's
+		// expectations, or a raced change of a journal's current JournalSpec.
+		for i, change := range req.Changes {
+			var kvs = txnResp.Txn().Responses[i].GetResponseRange().Kvs
+
+			if len(kvs) == 0 {
+				before[i], beforeRevision[i] = nil, 0
+			} else {
+				before[i], beforeRevision[i] = new(pb.JournalSpec), kvs[0].ModRevision
+
+				if err = before[i].Unmarshal(kvs[0].Value); err != nil {
+					return resp, errors.WithMessagef(err, "decoding JournalSpec %s", kvs[0].Key)
+				}
+			}
+			if change.ExpectModRevision != -1 && change.ExpectModRevision != beforeRevision[i] {
+				resp.Status = pb.Status_ETCD_TRANSACTION_FAILED
+			}
+		}
+		if resp.Status != pb.Status_OK {
+			return resp, nil
 		}
 	}
+}
 
-	resp = &pb.ApplyResponse{
-		Status: pb.Status_OK,
-		Header: pbx.NewUnroutedHeader(s),
+// changedJournal returns the Journal of the ApplyRequest_Change.
+func changedJournal(change pb.ApplyRequest_Change) pb.Journal {
+	if change.Upsert != nil {
+		return change.Upsert.Name
 	}
+	return change.Delete
+}
 
-	var txnResp clientv3.OpResponse
-	if txnResp, err = svc.etcd.Do(ctx, clientv3.OpTxn(cmp, ops, nil)); err != nil {
-		return resp, err
-	} else if !txnResp.Txn().Succeeded {
-		resp.Status = pb.Status_ETCD_TRANSACTION_FAILED
-	} else if len(ops) != 0 {
-		// If we made changes, delay responding until we have read our own Etcd write.
-		s.KS.Mu.RLock()
-		err = s.KS.WaitForRevision(ctx, txnResp.Txn().Header.Revision)
-		s.KS.Mu.RUnlock()
+// mayApplyJournalChanges returns whether |claims| allow all of the |changes|.
+// The Claims must allow the current JournalSpec of each changed journal,
+// if there is one, as well as the upserted JournalSpec. Otherwise a caller
+// could re-label a journal into, or out of, the scope of its Claims.
+func mayApplyJournalChanges(s *allocator.State, claims pb.Claims, changes []pb.ApplyRequest_Change) bool {
+	defer s.KS.Mu.RUnlock()
+	s.KS.Mu.RLock()
+
+	for _, change := range changes {
+		var name = change.Delete
+		if change.Upsert != nil {
+			name = change.Upsert.Name
+
+			if !claims.Allows(pb.Capability_APPLY, journalAuthLabels(change.Upsert)) {
+				return false
+			}
+		}
+
+		var current = &pb.JournalSpec{Name: name}
+		if item, ok := allocator.LookupItem(s.KS, name.String()); ok {
+			current = item.ItemValue.(*pb.JournalSpec)
+		}
+		if !claims.Allows(pb.Capability_APPLY, journalAuthLabels(current)) {
+			return false
+		}
+	}
+	return true
+}
+
+// withBrokerManagedFields returns a copy of |spec| having the broker-managed
+// fields of the journal's |current| JournalSpec, which is nil if the journal
+// doesn't exist. MinOffset is advanced only by Truncate, and an Apply never
+// rolls it back. Suspend is updated only by brokers as they suspend and resume
+// the journal, and an Apply retains the journal's current Suspend (or none,
+// if the journal is new).
+func withBrokerManagedFields(current, spec *pb.JournalSpec) *pb.JournalSpec {
+	var out = *spec
+	out.Suspend = pb.JournalSpec_Suspend{}
+
+	if current != nil {
+		if current.MinOffset > out.MinOffset {
+			out.MinOffset = current.MinOffset
+		}
+		out.Suspend = current.Suspend
 	}
-	resp.Header.Etcd.Revision = txnResp.Txn().Header.Revision
-	return resp, err
+	return &out
 }
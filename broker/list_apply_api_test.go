@@ -121,6 +121,126 @@ func TestListCases(t *testing.T) {
 	broker.cleanup()
 }
 
+func TestWatchCases(t *testing.T) {
+	var ctx, etcd = pb.WithDispatchDefault(context.Background()), etcdtest.TestClient()
+	defer etcdtest.Cleanup()
+
+	var fragSpec = pb.JournalSpec_Fragment{
+		Length:           1024,
+		RefreshInterval:  time.Second,
+		CompressionCodec: pb.CompressionCodec_SNAPPY,
+	}
+	var specA = &pb.JournalSpec{Name: "journal/1/A", Replication: 1, Fragment: fragSpec}
+	var specB = &pb.JournalSpec{Name: "journal/2/B", Replication: 1, Fragment: fragSpec}
+	var specC = &pb.JournalSpec{Name: "journal/1/C", Replication: 1, Fragment: fragSpec}
+
+	var broker = newTestBroker(t, etcd, pb.ProcessSpec_ID{Zone: "local", Suffix: "broker"})
+
+	var apply = func(changes ...pb.ApplyRequest_Change) {
+		var resp, err = broker.client().Apply(ctx, &pb.ApplyRequest{Changes: changes})
+		require.NoError(t, err)
+		require.Equal(t, pb.Status_OK, resp.Status)
+	}
+	apply(pb.ApplyRequest_Change{Upsert: specA}, pb.ApplyRequest_Change{Upsert: specB})
+
+	var watchCtx, watchCancel = context.WithCancel(ctx)
+	var stream, err = broker.client().Watch(watchCtx, &pb.ListRequest{
+		Selector: pb.LabelSelector{Include: pb.MustLabelSet("prefix", "journal/1/")},
+	})
+	require.NoError(t, err)
+
+	var recv = func() *pb.WatchResponse {
+		var resp, err = stream.Recv()
+		require.NoError(t, err)
+		require.NoError(t, resp.Validate())
+		require.Equal(t, pb.Status_OK, resp.Status)
+		return resp
+	}
+
+	// Expect an initial snapshot of matched journals.
+	var resp = recv()
+	require.Len(t, resp.Upserts, 1)
+	require.Equal(t, *specA, resp.Upserts[0].Spec)
+	require.Empty(t, resp.Deletes)
+
+	// Case: a created journal is upserted.
+	apply(pb.ApplyRequest_Change{Upsert: specC})
+
+	resp = recv()
+	require.Len(t, resp.Upserts, 1)
+	require.Equal(t, *specC, resp.Upserts[0].Spec)
+	require.Nil(t, resp.Upserts[0].Route.Members)
+
+	// Case: changes of journals which aren't matched are not sent,
+	// but an updated Route of a matched journal is.
+	apply(pb.ApplyRequest_Change{Upsert: specB, ExpectModRevision: -1})
+	mustKeyValues(t, etcd, map[string]string{
+		allocator.AssignmentKey(broker.ks, allocator.Assignment{
+			ItemID:       "journal/1/C",
+			MemberZone:   "local",
+			MemberSuffix: "broker",
+			Slot:         1,
+		}): ""})
+
+	resp = recv()
+	require.Len(t, resp.Upserts, 1)
+	require.Equal(t, *specC, resp.Upserts[0].Spec)
+	require.Equal(t, []pb.ProcessSpec_ID{{Zone: "local", Suffix: "broker"}},
+		resp.Upserts[0].Route.Members)
+	require.Empty(t, resp.Deletes)
+
+	// Case: a deleted journal is sent as a deletion.
+	apply(pb.ApplyRequest_Change{Delete: specA.Name, ExpectModRevision: -1})
+
+	resp = recv()
+	require.Empty(t, resp.Upserts)
+	require.Equal(t, []pb.Journal{specA.Name}, resp.Deletes)
+
+	// Case: Errors on request validation error.
+	stream, err = broker.client().Watch(ctx, &pb.ListRequest{
+		Selector: pb.LabelSelector{Include: pb.MustLabelSet("prefix", "invalid/because/missing/trailing/slash")},
+	})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Regexp(t, `.* Selector.Include.Labels\["prefix"\]: expected trailing '/' (.*)`, err)
+
+	watchCancel()
+	broker.cleanup()
+}
+
+func TestWatchDiffCases(t *testing.T) {
+	var mk = func(name pb.Journal, rev int64, members ...pb.ProcessSpec_ID) pb.ListResponse_Journal {
+		return pb.ListResponse_Journal{
+			Spec:        pb.JournalSpec{Name: name},
+			ModRevision: rev,
+			Route:       pb.Route{Members: members, Primary: -1},
+		}
+	}
+	var sent = make(map[pb.Journal]pb.ListResponse_Journal)
+
+	// Initially, all journals are upserted.
+	var upserts, deletes = diffJournals(sent, []pb.ListResponse_Journal{mk("a", 1), mk("b", 2), mk("c", 3)})
+	require.Equal(t, []pb.ListResponse_Journal{mk("a", 1), mk("b", 2), mk("c", 3)}, upserts)
+	require.Empty(t, deletes)
+
+	// Unchanged journals are not upserted again.
+	upserts, deletes = diffJournals(sent, []pb.ListResponse_Journal{mk("a", 1), mk("b", 2), mk("c", 3)})
+	require.Empty(t, upserts)
+	require.Empty(t, deletes)
+
+	// Journals having updated revisions or routes are upserted,
+	// and journals which are no longer listed are deleted.
+	var member = pb.ProcessSpec_ID{Zone: "zone", Suffix: "member"}
+	upserts, deletes = diffJournals(sent, []pb.ListResponse_Journal{mk("b", 4), mk("d", 5, member)})
+	require.Equal(t, []pb.ListResponse_Journal{mk("b", 4), mk("d", 5, member)}, upserts)
+	require.Equal(t, []pb.Journal{"a", "c"}, deletes)
+
+	upserts, deletes = diffJournals(sent, []pb.ListResponse_Journal{mk("b", 4), mk("d", 5)})
+	require.Equal(t, []pb.ListResponse_Journal{mk("d", 5)}, upserts)
+	require.Empty(t, deletes)
+	require.Len(t, sent, 2)
+}
+
 func TestApplyCases(t *testing.T) {
 	var ctx, etcd = pb.WithDispatchDefault(context.Background()), etcdtest.TestClient()
 	defer etcdtest.Cleanup()
@@ -186,6 +306,37 @@ func TestApplyCases(t *testing.T) {
 			},
 		})).Status)
 
+	// Case: A dry-run of an update at the correct revision succeeds,
+	// but doesn't commit the update.
+	var dryRunSpecB = specB
+	dryRunSpecB.Replication = 2
+
+	var revB = verifyAndFetchRev("journal/B", specB)
+	var resp = must(broker.client().Apply(ctx, &pb.ApplyRequest{
+		Changes: []pb.ApplyRequest_Change{
+			{Upsert: &dryRunSpecB, ExpectModRevision: revB},
+			{Upsert: &pb.JournalSpec{Name: "journal/C", Replication: 1, Fragment: fragSpec}},
+		},
+		DryRun: true,
+	}))
+	require.Equal(t, pb.Status_OK, resp.Status)
+	require.Equal(t, revB, verifyAndFetchRev("journal/B", specB))
+
+	var listResp, err = broker.client().List(ctx, &pb.ListRequest{
+		Selector: pb.LabelSelector{Include: pb.MustLabelSet("name", "journal/C")},
+	})
+	require.NoError(t, err)
+	require.Empty(t, listResp.Journals)
+
+	// Case: A dry-run at the wrong revision fails.
+	require.Equal(t, pb.Status_ETCD_TRANSACTION_FAILED,
+		must(broker.client().Apply(ctx, &pb.ApplyRequest{
+			Changes: []pb.ApplyRequest_Change{
+				{Upsert: &dryRunSpecB, ExpectModRevision: revB - 1},
+			},
+			DryRun: true,
+		})).Status)
+
 	// Case: Deletion at wrong revision fails.
 	require.Equal(t, pb.Status_ETCD_TRANSACTION_FAILED,
 		must(broker.client().Apply(ctx, &pb.ApplyRequest{
@@ -218,9 +369,15 @@ func TestApplyCases(t *testing.T) {
 			},
 		})).Status)
 
-	// Case: Invalid requests fail with an error.
-	var _, err = broker.client().Apply(ctx, &pb.ApplyRequest{
+	// Case: Invalid requests fail with an error, including dry-runs.
+	_, err = broker.client().Apply(ctx, &pb.ApplyRequest{
+		Changes: []pb.ApplyRequest_Change{{Delete: "invalid journal name"}},
+	})
+	require.Regexp(t, `.* Changes\[0\].Delete: not a valid token \(invalid journal name\)`, err)
+
+	_, err = broker.client().Apply(ctx, &pb.ApplyRequest{
 		Changes: []pb.ApplyRequest_Change{{Delete: "invalid journal name"}},
+		DryRun:  true,
 	})
 	require.Regexp(t, `.* Changes\[0\].Delete: not a valid token \(invalid journal name\)`, err)
 
@@ -0,0 +1,410 @@
+// Package mirror replicates journals of a source Gazette cluster into journals
+// of a destination cluster. Each destination journal is written with content
+// identical to its source journal, at identical offsets, so that checkpoints
+// of consumers of a source journal remain valid against its mirror (for
+// example, after a failover to the destination cluster).
+package mirror
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/client"
+	"go.gazette.dev/core/broker/fragment"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/labels"
+	"go.gazette.dev/core/message"
+)
+
+// Mirror replicates journals of a Source cluster which match its Selector
+// into a Destination cluster.
+//
+// JournalSpecs of source journals are applied to the destination, creating
+// destination journals as required and updating them as their source specs
+// change. Destination journals are never deleted by a Mirror, even if their
+// source journals are.
+//
+// The content of each journal is read from the Source beginning at the
+// current write head of its destination journal, and is appended to the
+// destination with an explicit AppendRequest offset. An append therefore
+// fails, rather than writing content at a different offset, should the
+// destination journal have been written by another client. The Mirror then
+// re-reads the destination write head and resumes from it.
+//
+// If content of the source journal at the destination write head no longer
+// exists (for example, because its fragments were pruned), the Mirror seeds
+// the destination by copying the next persisted fragment of the source into
+// the first fragment store of the destination journal, waiting for the
+// destination brokers to index it, and then jumping the destination write
+// head to its end. Seeding requires that the Mirror be able to read the
+// source fragment stores, and write the destination ones.
+type Mirror struct {
+	// Source and Destination clusters.
+	Source, Destination pb.RoutedJournalClient
+	// Selector of source journals to mirror.
+	Selector pb.LabelSelector
+	// Stores, if non-empty, replace the fragment stores of source JournalSpecs
+	// as they're applied to the destination.
+	Stores []pb.FragmentStore
+	// ListInterval is the interval with which source journals are listed,
+	// and with which JournalSpecs are mirrored.
+	ListInterval time.Duration
+	// SeedTimeout bounds the time spent waiting for destination brokers to
+	// index a seeded fragment.
+	SeedTimeout time.Duration
+}
+
+// Serve the Mirror until the Context is cancelled. An error is returned only
+// if source journals cannot be initially listed. Other errors are logged and
+// retried.
+func (m *Mirror) Serve(ctx context.Context) error {
+	var pl, err = client.NewPolledList(ctx, m.Source, m.ListInterval, pb.ListRequest{Selector: m.Selector})
+	if err != nil {
+		return errors.WithMessage(err, "listing source journals")
+	}
+
+	var running = make(map[pb.Journal]context.CancelFunc)
+	var doneCh = make(chan pb.Journal)
+
+	defer func() {
+		for _, cancel := range running {
+			cancel()
+		}
+		for range running {
+			<-doneCh
+		}
+	}()
+
+	for {
+		select {
+		case <-pl.UpdateCh():
+		case journal := <-doneCh:
+			delete(running, journal)
+			continue
+		case <-ctx.Done():
+			return nil
+		}
+		var src = pl.List()
+
+		if err := m.applySpecs(ctx, src); err != nil {
+			log.WithField("err", err).Warn("failed to mirror journal specs (will retry)")
+		}
+
+		var listed = make(map[pb.Journal]struct{}, len(src.Journals))
+		for _, j := range src.Journals {
+			listed[j.Spec.Name] = struct{}{}
+
+			if _, ok := running[j.Spec.Name]; ok {
+				continue
+			}
+			var journalCtx, cancel = context.WithCancel(ctx)
+			running[j.Spec.Name] = cancel
+
+			go func(journal pb.Journal) {
+				m.serveJournal(journalCtx, journal)
+				doneCh <- journal
+			}(j.Spec.Name)
+		}
+		// Stop mirrors of journals which are no longer listed. Their
+		// goroutines are removed from |running| as they exit.
+		for journal, cancel := range running {
+			if _, ok := listed[journal]; !ok {
+				cancel()
+			}
+		}
+	}
+}
+
+// applySpecs applies mirrored JournalSpecs of listed source journals
+// which differ from their current destination JournalSpecs.
+func (m *Mirror) applySpecs(ctx context.Context, src *pb.ListResponse) error {
+	var dst, err = client.ListAllJournals(ctx, m.Destination, pb.ListRequest{Selector: m.Selector})
+	if err != nil {
+		return errors.WithMessage(err, "listing destination journals")
+	}
+	var current = make(map[pb.Journal]pb.ListResponse_Journal, len(dst.Journals))
+	for _, j := range dst.Journals {
+		current[j.Spec.Name] = j
+	}
+
+	var req = new(pb.ApplyRequest)
+	for _, j := range src.Journals {
+		var cur, ok = current[j.Spec.Name]
+
+		var spec *pb.JournalSpec
+		if ok {
+			spec = mirroredSpec(&j.Spec, &cur.Spec, m.Stores)
+		} else {
+			spec = mirroredSpec(&j.Spec, nil, m.Stores)
+		}
+		if ok && spec.Equal(&cur.Spec) {
+			continue
+		}
+		log.WithFields(log.Fields{
+			"journal":  spec.Name,
+			"revision": cur.ModRevision,
+		}).Info("applying mirrored journal spec")
+
+		req.Changes = append(req.Changes, pb.ApplyRequest_Change{
+			ExpectModRevision: cur.ModRevision,
+			Upsert:            spec,
+		})
+	}
+	if len(req.Changes) == 0 {
+		return nil
+	} else if err = req.Validate(); err != nil {
+		return err
+	}
+	_, err = client.ApplyJournals(ctx, m.Destination, req)
+	return err
+}
+
+// mirroredSpec returns the JournalSpec of a destination journal which mirrors
+// the |src| JournalSpec. If |stores| is non-empty, it replaces the fragment
+// stores of |src|. If the destination journal exists with JournalSpec |cur|,
+// broker-managed fields of |cur| are retained.
+func mirroredSpec(src, cur *pb.JournalSpec, stores []pb.FragmentStore) *pb.JournalSpec {
+	var out = *src
+	out.Suspend = pb.JournalSpec_Suspend{}
+
+	if len(stores) != 0 {
+		out.Fragment.Stores = append([]pb.FragmentStore(nil), stores...)
+	}
+	if cur != nil {
+		out.Suspend = cur.Suspend
+
+		if cur.MinOffset > out.MinOffset {
+			out.MinOffset = cur.MinOffset
+		}
+	}
+	return &out
+}
+
+// serveJournal mirrors the journal until the Context is cancelled,
+// retrying encountered errors with a back-off.
+func (m *Mirror) serveJournal(ctx context.Context, journal pb.Journal) {
+	log.WithField("journal", journal).Info("started mirroring journal")
+
+	for attempt := 0; true; attempt++ {
+		var err = m.mirrorJournal(ctx, journal)
+		if ctx.Err() != nil {
+			break
+		}
+		mirrorErrorsTotal.WithLabelValues(journal.String()).Inc()
+
+		log.WithFields(log.Fields{
+			"journal": journal,
+			"err":     err,
+			"attempt": attempt,
+		}).Warn("failed to mirror journal (will retry)")
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(backoff(attempt)):
+		}
+	}
+	mirrorLagBytes.DeleteLabelValues(journal.String())
+	log.WithField("journal", journal).Info("stopped mirroring journal")
+}
+
+// mirrorJournal appends content of the source journal to the destination
+// journal, beginning at the destination's write head, until an error is
+// encountered or the Context is cancelled. If the journal validates its
+// message framing, appends are cut on message frame boundaries.
+func (m *Mirror) mirrorJournal(ctx context.Context, journal pb.Journal) error {
+	var offset, err = writeHead(ctx, m.Destination, journal)
+	if err != nil {
+		return errors.WithMessage(err, "reading destination write head")
+	}
+	spec, err := client.GetJournal(ctx, m.Source, journal)
+	if err != nil {
+		return errors.WithMessage(err, "fetching source spec")
+	}
+	var rr = client.NewRetryReader(ctx, m.Source, pb.ReadRequest{
+		Journal: journal,
+		Offset:  offset,
+		Block:   true,
+	})
+	defer rr.Cancel()
+
+	// If non-nil, |frames| tracks the trailing partial message frame of read
+	// source content, which is held in |pending| until it's completed.
+	var frames *message.FrameValidator
+	var pending []byte
+
+	var resetFrames = func() (err error) {
+		if spec.ValidateFraming || spec.ValidateSchema {
+			frames, err = message.NewFrameValidator(spec.LabelSet.ValueOf(labels.ContentType), nil)
+		}
+		pending = pending[:0]
+		return err
+	}
+	if err = resetFrames(); err != nil {
+		return errors.WithMessage(err, "building frame validator")
+	}
+
+	var buf = make([]byte, mirrorChunkSize)
+	var label = journal.String()
+
+	for {
+		var n, err = rr.Read(buf)
+
+		if err == client.ErrOffsetJump {
+			// Source content from |offset| through rr.Offset() doesn't exist.
+			if offset, err = m.seed(ctx, journal, offset, rr.Offset()); err != nil {
+				return errors.WithMessage(err, "seeding destination")
+			} else if err = resetFrames(); err != nil {
+				return errors.WithMessage(err, "building frame validator")
+			}
+			rr.Restart(pb.ReadRequest{Journal: journal, Offset: offset, Block: true})
+			continue
+		} else if err != nil {
+			return errors.WithMessage(err, "reading source")
+		}
+
+		var content = buf[:n]
+		if frames != nil && n != 0 {
+			if _, err = frames.Validate(content); err != nil {
+				return errors.WithMessagef(err, "validating source framing at offset %d", offset)
+			}
+			pending = append(pending, content...)
+			content = pending[:len(pending)-frames.Partial()]
+		}
+
+		if len(content) != 0 {
+			var resp, err = client.Append(ctx, m.Destination,
+				pb.AppendRequest{Journal: journal, Offset: offset},
+				bytes.NewReader(content))
+
+			if err != nil {
+				return errors.WithMessagef(err, "appending at offset %d", offset)
+			}
+			offset = resp.Commit.End
+			mirroredBytesTotal.WithLabelValues(label).Add(float64(len(content)))
+
+			if frames != nil {
+				pending = append(pending[:0], pending[len(content):]...)
+			}
+		}
+		if head := rr.Reader.Response.WriteHead; head >= offset {
+			mirrorLagBytes.WithLabelValues(label).Set(float64(head - offset))
+		}
+	}
+}
+
+// seed the destination journal, which has write head |from|, such that it
+// may be mirrored from the persisted source fragment which begins at |to|.
+// It returns the new destination write head.
+func (m *Mirror) seed(ctx context.Context, journal pb.Journal, from, to int64) (int64, error) {
+	var frags, err = client.ListAllFragments(ctx, m.Source, pb.FragmentsRequest{Journal: journal})
+	if err != nil {
+		return 0, errors.WithMessage(err, "listing source fragments")
+	}
+	var seed *pb.Fragment
+	for _, f := range frags.Fragments {
+		if f.Spec.Begin == to && f.Spec.BackingStore != "" {
+			seed = &f.Spec
+			break
+		}
+	}
+	if seed == nil {
+		return 0, fmt.Errorf("source skips offsets [%d, %d), and has no persisted fragment at %d", from, to, to)
+	}
+
+	spec, err := client.GetJournal(ctx, m.Destination, journal)
+	if err != nil {
+		return 0, errors.WithMessage(err, "fetching destination spec")
+	} else if len(spec.Fragment.Stores) == 0 {
+		return 0, errors.New("destination journal has no fragment stores")
+	}
+
+	log.WithFields(log.Fields{
+		"journal":  journal,
+		"from":     from,
+		"fragment": seed.ContentName(),
+		"store":    spec.Fragment.Stores[0],
+	}).Info("seeding destination journal with source fragment")
+
+	copied, err := fragment.Copy(ctx, *seed, spec.Fragment.Stores[0], spec.Fragment.EncryptionKey)
+	if err != nil {
+		return 0, errors.WithMessage(err, "copying fragment")
+	}
+
+	// Wait for destination brokers to index the copied fragment.
+	var waitCtx, cancel = context.WithTimeout(ctx, m.SeedTimeout)
+	defer cancel()
+
+	for {
+		if head, err := writeHead(waitCtx, m.Destination, journal); err != nil {
+			return 0, errors.WithMessage(err, "waiting for destination to index seeded fragment")
+		} else if head >= copied.End {
+			break
+		}
+		select {
+		case <-waitCtx.Done():
+			return 0, errors.WithMessage(waitCtx.Err(), "waiting for destination to index seeded fragment")
+		case <-time.After(time.Second):
+		}
+	}
+
+	// Issue an empty append which jumps the destination write head.
+	if _, err = client.Append(ctx, m.Destination, pb.AppendRequest{
+		Journal: journal,
+		Offset:  copied.End,
+	}); err != nil {
+		return 0, errors.WithMessage(err, "jumping destination write head")
+	}
+	return copied.End, nil
+}
+
+// writeHead returns the current write head of the journal.
+func writeHead(ctx context.Context, rjc pb.RoutedJournalClient, journal pb.Journal) (int64, error) {
+	var r = client.NewReader(ctx, rjc, pb.ReadRequest{
+		Journal:      journal,
+		Offset:       -1,
+		Block:        false,
+		MetadataOnly: true,
+	})
+	if _, err := r.Read(nil); err == client.ErrOffsetNotYetAvailable {
+		return r.Response.Offset, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return 0, fmt.Errorf("expected %s", client.ErrOffsetNotYetAvailable)
+}
+
+func backoff(attempt int) time.Duration {
+	switch attempt {
+	case 0, 1:
+		return time.Second
+	case 2, 3, 4:
+		return 5 * time.Second
+	default:
+		return 30 * time.Second
+	}
+}
+
+// mirrorChunkSize is the maximum size of a single Read of source content,
+// and thus of a single mirrored Append.
+const mirrorChunkSize = 1 << 20
+
+var (
+	mirroredBytesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gazette_mirror_bytes_total",
+		Help: "Total number of bytes mirrored from a source journal to its destination.",
+	}, []string{"journal"})
+	mirrorLagBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gazette_mirror_lag_bytes",
+		Help: "Number of bytes by which a destination journal lags the last-known write head of its source.",
+	}, []string{"journal"})
+	mirrorErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gazette_mirror_errors_total",
+		Help: "Total number of errors encountered while mirroring a journal (which are retried).",
+	}, []string{"journal"})
+)
@@ -0,0 +1,51 @@
+package mirror
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+)
+
+func TestMirroredSpec(t *testing.T) {
+	var src = pb.JournalSpec{
+		Name:        "a/journal",
+		Replication: 3,
+		LabelSet:    pb.MustLabelSet("foo", "bar"),
+		Fragment: pb.JournalSpec_Fragment{
+			Length:           1024,
+			CompressionCodec: pb.CompressionCodec_SNAPPY,
+			Stores:           []pb.FragmentStore{"s3://source-bucket/"},
+		},
+		MinOffset: 100,
+		Suspend: pb.JournalSpec_Suspend{
+			Level:  pb.JournalSpec_Suspend_FULL,
+			Offset: 200,
+		},
+	}
+
+	// A new destination journal has the source spec, without its Suspend.
+	var out = mirroredSpec(&src, nil, nil)
+	var expect = src
+	expect.Suspend = pb.JournalSpec_Suspend{}
+	require.Equal(t, &expect, out)
+
+	// Stores replace those of the source.
+	out = mirroredSpec(&src, nil, []pb.FragmentStore{"gs://dest-bucket/", "s3://dest-bucket/"})
+	require.Equal(t, []pb.FragmentStore{"gs://dest-bucket/", "s3://dest-bucket/"}, out.Fragment.Stores)
+	require.Equal(t, []pb.FragmentStore{"s3://source-bucket/"}, src.Fragment.Stores)
+
+	// Broker-managed fields of an existing destination journal are retained.
+	var cur = *mirroredSpec(&src, nil, nil)
+	cur.MinOffset = 150
+	cur.Suspend = pb.JournalSpec_Suspend{Offset: 300}
+
+	out = mirroredSpec(&src, &cur, nil)
+	require.Equal(t, &cur, out)
+
+	// A larger source MinOffset is mirrored.
+	src.MinOffset = 175
+	out = mirroredSpec(&src, &cur, nil)
+	require.Equal(t, int64(175), int64(out.MinOffset))
+	require.Equal(t, cur.Suspend, out.Suspend)
+}
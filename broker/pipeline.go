@@ -21,10 +21,17 @@ type pipeline struct {
 	readBarrierCh chan struct{}                // Coordinates hand-off of receive-side of the pipeline.
 	recvResp      []pb.ReplicateResponse       // Most recent response gathered from each peer.
 	recvErrs      []error                      // First error on receive from each peer.
+
+	quorum   int           // Number of replicas (including the primary) which commit a write.
+	owed     []int         // Responses owed by each peer from past quorum commits.
+	notifyCh chan struct{} // Signaled as peer responses are ready. Non-nil iff |quorum| < R.
+	dropped  int           // Number of peers dropped after failing to acknowledge a commit.
 }
 
-// newPipeline returns a new pipeline.
-func newPipeline(ctx context.Context, hdr pb.Header, spool fragment.Spool, returnCh chan<- fragment.Spool, jc pb.JournalClient) *pipeline {
+// newPipeline returns a new pipeline. If |quorumCommit| and the Route has more
+// than two members, the pipeline commits writes upon acknowledgement by a
+// majority of replicas, and lagging peers catch up asynchronously.
+func newPipeline(ctx context.Context, hdr pb.Header, spool fragment.Spool, returnCh chan<- fragment.Spool, jc pb.JournalClient, quorumCommit bool) *pipeline {
 	if hdr.Route.Primary == -1 {
 		panic("dial requires Route with Primary != -1")
 	}
@@ -39,15 +46,28 @@ func newPipeline(ctx context.Context, hdr pb.Header, spool fragment.Spool, retur
 		readBarrierCh: make(chan struct{}),
 		recvResp:      make([]pb.ReplicateResponse, R),
 		recvErrs:      make([]error, R),
+		quorum:        commitQuorum(R, quorumCommit),
+		owed:          make([]int, R),
 	}
 	close(pln.readBarrierCh)
 
+	if pln.quorum != R {
+		pln.notifyCh = make(chan struct{}, 1)
+	}
+
 	for i := range pln.Route.Members {
 		if i == int(pln.Route.Primary) {
 			continue
 		}
-		pln.streams[i], pln.sendErrs[i] = jc.Replicate(
-			pb.WithDispatchRoute(ctx, pln.Route, pln.Route.Members[i]))
+		var ctx = pb.WithDispatchRoute(ctx, pln.Route, pln.Route.Members[i])
+
+		if !pln.commitsOnQuorum() {
+			pln.streams[i], pln.sendErrs[i] = jc.Replicate(ctx)
+		} else if s, err := newAsyncStream(ctx, jc, pln.notifyCh); err != nil {
+			pln.sendErrs[i] = err
+		} else {
+			pln.streams[i] = s
+		}
 	}
 	return pln
 }
@@ -120,8 +140,12 @@ func (pln *pipeline) barrier() (waitFor <-chan struct{}, closeAfter chan<- struc
 }
 
 // gather synchronously receives a ReplicateResponse from all replicas.
+// Responses owed by peers from past quorum commits are received first.
 func (pln *pipeline) gather() {
 	for i, s := range pln.streams {
+		for s != nil && pln.owed[i] != 0 && pln.recvErrs[i] == nil {
+			pln.recvOwed(i)
+		}
 		if s != nil && pln.recvErrs[i] == nil {
 			pln.recvErrs[i] = s.RecvMsg(&pln.recvResp[i])
 
@@ -135,7 +159,12 @@ func (pln *pipeline) gather() {
 }
 
 // gatherOK calls gather, and treats any non-OK response status as an error.
+// If the pipeline commits on a quorum, it instead calls gatherQuorum.
 func (pln *pipeline) gatherOK() {
+	if pln.commitsOnQuorum() {
+		pln.gatherQuorum()
+		return
+	}
 	pln.gather()
 
 	for i, s := range pln.streams {
@@ -153,8 +182,13 @@ func (pln *pipeline) gatherOK() {
 // * The |rollToOffset| equal to our own which must be rolled to in order for a peer to participate, or
 // * An Etcd revision to read through.
 // It treats any other non-OK response status as an error.
+// If the pipeline commits on a quorum, peers which don't respond in time are dropped.
 func (pln *pipeline) gatherSync() (rollToOffset int64, rollToRegisters *pb.LabelSet, readThroughRev int64) {
-	pln.gather()
+	if pln.commitsOnQuorum() {
+		pln.gatherQuorumSync()
+	} else {
+		pln.gather()
+	}
 
 	for i, s := range pln.streams {
 		if s == nil || pln.recvErrs[i] != nil {
@@ -199,6 +233,13 @@ func (pln *pipeline) gatherSync() (rollToOffset int64, rollToRegisters *pb.Label
 			pln.recvErrs[i] = fmt.Errorf("unexpected Status: %s", &respHeap)
 		}
 	}
+
+	// Peers of a quorum pipeline which failed to sync are dropped.
+	for i, s := range pln.streams {
+		if s != nil && pln.recvErrs[i] != nil && pln.commitsOnQuorum() {
+			s.(*asyncStream).cancel()
+		}
+	}
 	return
 }
 
@@ -206,6 +247,9 @@ func (pln *pipeline) gatherSync() (rollToOffset int64, rollToRegisters *pb.Label
 // An unexpected received message is treated as an error.
 func (pln *pipeline) gatherEOF() {
 	for i, s := range pln.streams {
+		for s != nil && pln.owed[i] != 0 && pln.recvErrs[i] == nil {
+			pln.recvOwed(i)
+		}
 		if s == nil || pln.recvErrs[i] != nil {
 			// Local spool placeholder, or the stream has already failed.
 		} else if msg, err := s.Recv(); err == io.EOF {
@@ -251,6 +295,15 @@ func (pln *pipeline) String() string {
 	return fmt.Sprintf("pipeline<header: %s, spool: %s>", &pln.Header, pln.spool.String())
 }
 
+// commitQuorum returns the number of |R| replicas (including the primary)
+// which must acknowledge a write before it commits.
+func commitQuorum(R int, quorumCommit bool) int {
+	if quorumCommit && R > 2 {
+		return R/2 + 1
+	}
+	return R
+}
+
 func boxHeaderProcessID(hdr pb.Header, id pb.ProcessSpec_ID) *pb.Header {
 	var out = new(pb.Header)
 	*out = hdr
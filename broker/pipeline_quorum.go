@@ -0,0 +1,348 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	pb "go.gazette.dev/core/broker/protocol"
+)
+
+// gatherQuorum receives ReplicateResponses until a quorum of replicas
+// (including the primary) have acknowledged the pipeline's most recent
+// proposal, or until a quorum is no longer possible. Peers which don't
+// acknowledge in time for the quorum owe their acknowledgements, which are
+// read and verified by future gathers. Any non-OK response is an error.
+func (pln *pipeline) gatherQuorum() {
+	for i, s := range pln.streams {
+		if s != nil && pln.recvErrs[i] == nil {
+			pln.owed[i]++
+		}
+	}
+	for {
+		var acks, live = 1, 1 // Count the primary.
+
+		for i, s := range pln.streams {
+			if s == nil || pln.recvErrs[i] != nil {
+				continue
+			}
+			// Read all owed responses which are ready, without blocking.
+			for pln.owed[i] != 0 && pln.recvErrs[i] == nil && s.(*asyncStream).ready() {
+				pln.recvOwed(i)
+			}
+			if pln.recvErrs[i] == nil {
+				live++
+			}
+			if pln.recvErrs[i] == nil && pln.owed[i] == 0 {
+				acks++
+			}
+		}
+		if acks >= pln.quorum || live < pln.quorum {
+			return
+		}
+		<-pln.notifyCh // Block until another response is ready.
+	}
+}
+
+// recvOwed receives the next owed ReplicateResponse of peer |i|, which must
+// be OK. A peer which fails is dropped from the pipeline.
+func (pln *pipeline) recvOwed(i int) {
+	var err = pln.streams[i].RecvMsg(&pln.recvResp[i])
+
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	} else if err == nil && pln.recvResp[i].Status != pb.Status_OK {
+		err = fmt.Errorf("unexpected !OK response: %s", &pln.recvResp[i])
+	}
+	if pln.owed[i]--; err == nil {
+		return
+	}
+	pln.dropPeer(i, err)
+}
+
+// gatherQuorumSync receives a ReplicateResponse from each live peer of a
+// quorum pipeline. Once |quorumSyncTimeout| has elapsed, peers which have
+// yet to respond are dropped, so long as a quorum of peers has responded.
+func (pln *pipeline) gatherQuorumSync() {
+	var pending = make([]bool, len(pln.streams))
+	for i, s := range pln.streams {
+		pending[i] = s != nil && pln.recvErrs[i] == nil
+	}
+	var timeoutCh = time.After(quorumSyncTimeout)
+
+	for {
+		var acks, waiting = 1, 0 // Count the primary.
+
+		for i, s := range pln.streams {
+			if pending[i] && s.(*asyncStream).ready() {
+				pending[i] = false
+
+				// Map EOF to ErrUnexpectedEOF, as in gather().
+				if pln.recvErrs[i] = s.RecvMsg(&pln.recvResp[i]); pln.recvErrs[i] == io.EOF {
+					pln.recvErrs[i] = io.ErrUnexpectedEOF
+				}
+			}
+			if pending[i] {
+				waiting++
+			} else if s != nil && pln.recvErrs[i] == nil {
+				acks++
+			}
+		}
+		if waiting == 0 {
+			return
+		}
+
+		select {
+		case <-pln.notifyCh:
+		case <-timeoutCh:
+			timeoutCh = nil
+
+			if acks < pln.quorum {
+				continue // Keep waiting, as a quorum hasn't responded.
+			}
+			for i := range pln.streams {
+				if pending[i] {
+					pln.dropPeer(i, errReplicaSyncTimeout)
+				}
+			}
+			return
+		}
+	}
+}
+
+// dropPeer drops peer |i| from a quorum pipeline due to |err|. The peer
+// cancels its Replicate RPC, and regards itself as out of sync until it's
+// again synchronized by a re-built pipeline.
+func (pln *pipeline) dropPeer(i int, err error) {
+	pln.recvErrs[i], pln.owed[i] = err, 0
+	pln.streams[i].(*asyncStream).cancel()
+	pln.dropped++
+
+	log.WithFields(log.Fields{
+		"journal": pln.spool.Journal,
+		"peer":    pln.Route.Members[i],
+		"err":     err,
+	}).Warn("dropping failed replication peer from quorum pipeline")
+}
+
+// hasDroppedPeers returns true if the pipeline commits on a quorum, and any
+// of its peers have failed or been dropped.
+func (pln *pipeline) hasDroppedPeers() bool {
+	if !pln.commitsOnQuorum() {
+		return false
+	}
+	for i := range pln.streams {
+		if pln.sendErrs[i] != nil || pln.recvErrs[i] != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// commitsOnQuorum returns true if the pipeline commits on a quorum of
+// replicas, rather than requiring all of them.
+func (pln *pipeline) commitsOnQuorum() bool { return pln.notifyCh != nil }
+
+// quorumSendErr returns the first encountered send-side error, unless the
+// pipeline commits on a quorum which the encountered errors don't preclude.
+func (pln *pipeline) quorumSendErr() error {
+	if pln.toleratesErrors(pln.sendErrs) {
+		return nil
+	}
+	return pln.sendErr()
+}
+
+// quorumRecvErr returns the first encountered receive-side error, unless the
+// pipeline commits on a quorum which the encountered errors don't preclude.
+func (pln *pipeline) quorumRecvErr() error {
+	if pln.toleratesErrors(pln.recvErrs) {
+		return nil
+	}
+	return pln.recvErr()
+}
+
+// toleratesErrors returns true if the peers not having |errs| form a quorum.
+// Errors of the primary are never tolerated.
+func (pln *pipeline) toleratesErrors(errs []error) bool {
+	var failed int
+	for i, err := range errs {
+		if err == nil {
+			continue
+		} else if i == int(pln.Route.Primary) {
+			return false
+		}
+		failed++
+	}
+	return len(errs)-failed >= pln.quorum
+}
+
+// asyncStream is a Journal_ReplicateClient of a quorum pipeline, which sends
+// and receives through queues serviced by its own goroutines. A slow peer
+// therefore doesn't block the pipeline, which proceeds with a quorum of peers
+// as the slow peer catches up. A peer which falls more than asyncStreamQueue
+// messages behind is cancelled.
+type asyncStream struct {
+	pb.Journal_ReplicateClient
+	ctx       context.Context
+	cancel    context.CancelFunc
+	sendCh    chan *pb.ReplicateRequest
+	recvCh    chan asyncRecv
+	notifyCh  chan<- struct{}
+	closeOnce sync.Once
+
+	mu      sync.Mutex
+	sendErr error // First error of Send.
+}
+
+type asyncRecv struct {
+	resp *pb.ReplicateResponse
+	err  error
+}
+
+// newAsyncStream starts a Replicate RPC of the JournalClient which is serviced
+// by an asyncStream. |notifyCh| is signaled as each response is ready.
+func newAsyncStream(ctx context.Context, jc pb.JournalClient, notifyCh chan<- struct{}) (*asyncStream, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	var stream, err = jc.Replicate(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	var s = &asyncStream{
+		Journal_ReplicateClient: stream,
+		ctx:                     ctx,
+		cancel:                  cancel,
+		sendCh:                  make(chan *pb.ReplicateRequest, asyncStreamQueue),
+		recvCh:                  make(chan asyncRecv, asyncStreamQueue),
+		notifyCh:                notifyCh,
+	}
+	go s.sendLoop()
+	go s.recvLoop()
+
+	return s, nil
+}
+
+// Send queues the ReplicateRequest to be sent. It fails if the peer has
+// fallen too far behind, or if a previous send failed.
+func (s *asyncStream) Send(r *pb.ReplicateRequest) error {
+	s.mu.Lock()
+	var err = s.sendErr
+	s.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+	// Copy, as the caller may update |r| after we return.
+	var rCopy = *r
+
+	select {
+	case s.sendCh <- &rCopy:
+		return nil
+	default:
+		s.setSendErr(errReplicaLagging)
+		s.cancel()
+		return errReplicaLagging
+	}
+}
+
+// CloseSend closes the send-side of the stream, after queued requests are sent.
+func (s *asyncStream) CloseSend() error {
+	s.closeOnce.Do(func() { close(s.sendCh) })
+	return nil
+}
+
+// RecvMsg receives the next ReplicateResponse of the stream into |m|.
+func (s *asyncStream) RecvMsg(m interface{}) error {
+	var r asyncRecv
+
+	// Prefer a ready response, even if the stream has since been cancelled.
+	select {
+	case r = <-s.recvCh:
+	default:
+		select {
+		case r = <-s.recvCh:
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		}
+	}
+	if r.err != nil {
+		return r.err
+	}
+	*m.(*pb.ReplicateResponse) = *r.resp
+	return nil
+}
+
+// Recv receives the next ReplicateResponse of the stream.
+func (s *asyncStream) Recv() (*pb.ReplicateResponse, error) {
+	var resp = new(pb.ReplicateResponse)
+	if err := s.RecvMsg(resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ready returns true if RecvMsg will not block.
+func (s *asyncStream) ready() bool {
+	return len(s.recvCh) != 0 || s.ctx.Err() != nil
+}
+
+func (s *asyncStream) setSendErr(err error) {
+	s.mu.Lock()
+	if s.sendErr == nil {
+		s.sendErr = err
+	}
+	s.mu.Unlock()
+}
+
+func (s *asyncStream) sendLoop() {
+	for r := range s.sendCh {
+		if err := s.Journal_ReplicateClient.Send(r); err != nil {
+			// The causal error of the stream is read by recvLoop.
+			s.setSendErr(err)
+			return
+		}
+	}
+	if err := s.Journal_ReplicateClient.CloseSend(); err != nil {
+		s.setSendErr(err)
+	}
+}
+
+func (s *asyncStream) recvLoop() {
+	for {
+		var resp = new(pb.ReplicateResponse)
+		var err = s.Journal_ReplicateClient.RecvMsg(resp)
+
+		select {
+		case s.recvCh <- asyncRecv{resp: resp, err: err}:
+		case <-s.ctx.Done():
+			// RecvMsg returns the Context error.
+		}
+		select {
+		case s.notifyCh <- struct{}{}:
+		default: // Already signaled.
+		}
+
+		if err != nil {
+			s.cancel() // Release resources of the completed stream.
+			return
+		}
+	}
+}
+
+var (
+	errReplicaLagging     = fmt.Errorf("replication peer is lagging by more than %d messages", asyncStreamQueue)
+	errReplicaSyncTimeout = errors.New("replication peer didn't synchronize before the quorum timeout")
+)
+
+// asyncStreamQueue is the number of queued messages by which an asyncStream
+// peer may lag before it's cancelled.
+const asyncStreamQueue = 256
+
+// quorumSyncTimeout is the duration a quorum pipeline waits for all peers to
+// respond to a synchronization, before it drops those which haven't.
+var quorumSyncTimeout = 5 * time.Second
@@ -137,6 +137,77 @@ func TestPipelinePeerErrorCases(t *testing.T) {
 	rm.cleanup()
 }
 
+func TestPipelineQuorumCommit(t *testing.T) {
+	var ctx, rm = context.Background(), newReplicationMock(t)
+	var hdr = rm.header(0, 100)
+	var pln = newPipeline(ctx, *hdr, <-rm.spoolCh, rm.spoolCh, rm.brokerA.Client(), true)
+	require.Equal(t, 2, pln.quorum)
+
+	var scatterProposal = func(expect ...*teststub.Broker) {
+		var content = pb.ReplicateRequest{Content: []byte("foo")}
+		pln.scatter(&content)
+		var proposal = pln.spool.Next()
+		var req = pb.ReplicateRequest{Proposal: &proposal, Acknowledge: true}
+		pln.scatter(&req)
+
+		for _, b := range expect {
+			require.Equal(t, content, <-b.ReplReqCh)
+			require.Equal(t, req, <-b.ReplReqCh)
+		}
+	}
+
+	// Peer A acknowledges, while peer C stalls. Expect the proposal commits
+	// without C, which now owes an acknowledgement.
+	scatterProposal(rm.brokerA, rm.brokerC)
+	rm.brokerA.ReplRespCh <- pb.ReplicateResponse{Status: pb.Status_OK}
+
+	pln.gatherOK()
+	require.NoError(t, pln.quorumRecvErr())
+	require.NoError(t, pln.quorumSendErr())
+	require.Equal(t, []int{0, 0, 1}, pln.owed)
+
+	// Peer C catches up and acknowledges the next proposal, while A stalls.
+	scatterProposal(rm.brokerA, rm.brokerC)
+	rm.brokerC.ReplRespCh <- pb.ReplicateResponse{Status: pb.Status_OK}
+	rm.brokerC.ReplRespCh <- pb.ReplicateResponse{Status: pb.Status_OK}
+
+	pln.gatherOK()
+	require.NoError(t, pln.recvErr())
+	require.Equal(t, []int{1, 0, 0}, pln.owed)
+
+	// Peer C fails. Expect it's dropped, and A maintains a quorum.
+	rm.brokerC.WriteLoopErrCh <- errors.New("error!")
+	require.Equal(t, context.Canceled, <-rm.brokerC.ReadLoopErrCh)
+
+	scatterProposal(rm.brokerA)
+	rm.brokerA.ReplRespCh <- pb.ReplicateResponse{Status: pb.Status_OK}
+	rm.brokerA.ReplRespCh <- pb.ReplicateResponse{Status: pb.Status_OK}
+
+	pln.gatherOK()
+	require.NoError(t, pln.quorumRecvErr())
+	require.EqualError(t, pln.recvErrs[2], `rpc error: code = Unknown desc = error!`)
+	require.Regexp(t, `recv from zone:"C" suffix:"3" : rpc error: .*`, pln.recvErr())
+	require.Equal(t, []int{0, 0, 0}, pln.owed)
+
+	// Peer A also fails. A quorum is no longer possible.
+	scatterProposal(rm.brokerA)
+	rm.brokerA.WriteLoopErrCh <- errors.New("error!")
+	require.Equal(t, context.Canceled, <-rm.brokerA.ReadLoopErrCh)
+
+	pln.gatherOK()
+	require.Regexp(t, `recv from zone:"A" suffix:"1" : rpc error: .*`, pln.quorumRecvErr())
+
+	pln.closeSend()
+	pln.gatherEOF()
+	rm.cleanup()
+
+	// Pipelines of fewer than three replicas require all replicas.
+	require.Equal(t, 1, commitQuorum(1, true))
+	require.Equal(t, 2, commitQuorum(2, true))
+	require.Equal(t, 3, commitQuorum(3, false))
+	require.Equal(t, 3, commitQuorum(5, true))
+}
+
 func TestPipelineGatherSyncCases(t *testing.T) {
 	var ctx, rm = context.Background(), newReplicationMock(t)
 	var pln = rm.newPipeline(ctx, rm.header(0, 100))
@@ -311,7 +382,7 @@ func (m *replicationMock) header(id int, rev int64) *pb.Header {
 }
 
 func (m *replicationMock) newPipeline(ctx context.Context, hdr *pb.Header) *pipeline {
-	return newPipeline(ctx, *hdr, <-m.spoolCh, m.spoolCh, m.brokerA.Client())
+	return newPipeline(ctx, *hdr, <-m.spoolCh, m.spoolCh, m.brokerA.Client(), false)
 }
 
 func (m *replicationMock) cleanup() {
@@ -0,0 +1,138 @@
+package protocol
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+)
+
+// Capability is a bit-mask of operations which an authorization may grant.
+type Capability uint32
+
+const (
+	// Capability_LIST grants listing of resource specifications.
+	Capability_LIST Capability = 1 << iota
+	// Capability_READ grants reading of resource content and metadata
+	// (eg, journal reads and fragment listings).
+	Capability_READ
+	// Capability_APPEND grants appending to journals.
+	Capability_APPEND
+	// Capability_APPLY grants the creation, update, and deletion of resource
+	// specifications.
+	Capability_APPLY
+	// Capability_REPLICATE grants participation in journal replication,
+	// and is used by brokers in their communication with one another.
+	Capability_REPLICATE
+
+	// Capability_ALL grants all capabilities.
+	Capability_ALL Capability = math.MaxUint32
+)
+
+var capabilityNames = []struct {
+	cap  Capability
+	name string
+}{
+	{Capability_LIST, "LIST"},
+	{Capability_READ, "READ"},
+	{Capability_APPEND, "APPEND"},
+	{Capability_APPLY, "APPLY"},
+	{Capability_REPLICATE, "REPLICATE"},
+}
+
+// ParseCapability parses a Capability from a comma-separated list of
+// capability names (eg, "READ,APPEND"). Names are case-insensitive,
+// and "ALL" names all capabilities.
+func ParseCapability(s string) (Capability, error) {
+	var out Capability
+
+	for _, part := range strings.Split(s, ",") {
+		var name = strings.ToUpper(strings.TrimSpace(part))
+		if name == "" {
+			continue
+		} else if name == "ALL" {
+			out |= Capability_ALL
+			continue
+		}
+
+		var found bool
+		for _, cn := range capabilityNames {
+			if cn.name == name {
+				out, found = out|cn.cap, true
+			}
+		}
+		if !found {
+			return 0, NewValidationError("unknown capability (%s)", part)
+		}
+	}
+	return out, nil
+}
+
+// String returns a comma-separated list of the Capability's names.
+func (c Capability) String() string {
+	if c == Capability_ALL {
+		return "ALL"
+	}
+	var names []string
+	for _, cn := range capabilityNames {
+		if c&cn.cap != 0 {
+			names = append(names, cn.name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// Claims are the verified assertions of an authorization. They grant their
+// bearer a Capability over those resources which are matched by Selector.
+//
+// Journals are matched by their labels, as well as by their "name" and
+// "prefix" meta-labels (see ExtractJournalSpecMetaLabels), so that a grant
+// may be scoped to journal names having a prefix, for example
+// "prefix=examples/foobar/". Shards are likewise matched by their labels
+// and their "id" and "prefix" meta-labels.
+type Claims struct {
+	// Subject identifies the bearer of the authorization (eg, a user or service).
+	Subject string
+	// Capability granted by the authorization.
+	Capability Capability
+	// Selector of resources to which the authorization applies.
+	// A zero-valued LabelSelector matches all resources.
+	Selector LabelSelector
+	// Time after which the authorization is no longer valid,
+	// or zero if it doesn't expire.
+	ExpiresAt time.Time
+}
+
+// Allows returns whether the Claims grant all Capabilities of |require|
+// to the resource having LabelSet |ls|.
+func (c *Claims) Allows(require Capability, ls LabelSet) bool {
+	return c.Capability&require == require && c.Selector.Matches(ls)
+}
+
+// Validate returns an error if the Claims are not well-formed.
+func (c *Claims) Validate() error {
+	if err := c.Selector.Validate(); err != nil {
+		return ExtendContext(err, "Selector")
+	}
+	return nil
+}
+
+// Authorizer attaches an authorization for Claims to a Context
+// of an outgoing RPC. The authorization lasts for at most |ttl|.
+type Authorizer interface {
+	Authorize(ctx context.Context, claims Claims, ttl time.Duration) (context.Context, error)
+}
+
+// Verifier verifies the authorization of an incoming RPC Context,
+// and returns its Claims. It returns a gRPC status error of code
+// Unauthenticated if the Context has no valid authorization.
+type Verifier interface {
+	Verify(ctx context.Context) (Claims, error)
+}
+
+// Auth both verifies authorizations of incoming RPCs, and authorizes
+// outgoing RPCs made on behalf of a verified caller.
+type Auth interface {
+	Authorizer
+	Verifier
+}
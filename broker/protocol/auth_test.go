@@ -0,0 +1,69 @@
+package protocol
+
+import (
+	gc "gopkg.in/check.v1"
+)
+
+type AuthSuite struct{}
+
+func (s *AuthSuite) TestCapabilityParsingAndFormatting(c *gc.C) {
+	var cases = []struct {
+		input  string
+		expect Capability
+		str    string
+	}{
+		{"", 0, ""},
+		{"read", Capability_READ, "READ"},
+		{"APPEND, read", Capability_READ | Capability_APPEND, "READ,APPEND"},
+		{"list,apply,replicate", Capability_LIST | Capability_APPLY | Capability_REPLICATE, "LIST,APPLY,REPLICATE"},
+		{"read,all", Capability_ALL, "ALL"},
+	}
+	for _, tc := range cases {
+		var cap, err = ParseCapability(tc.input)
+		c.Check(err, gc.IsNil)
+		c.Check(cap, gc.Equals, tc.expect)
+		c.Check(cap.String(), gc.Equals, tc.str)
+	}
+
+	var _, err = ParseCapability("read,frobulate")
+	c.Check(err, gc.ErrorMatches, `unknown capability \(frobulate\)`)
+}
+
+func (s *AuthSuite) TestClaimsAllows(c *gc.C) {
+	var spec = &JournalSpec{Name: "a/journal/name", LabelSet: MustLabelSet("foo", "bar")}
+	var set = UnionLabelSets(ExtractJournalSpecMetaLabels(spec, LabelSet{}), spec.LabelSet, LabelSet{})
+
+	var mustSelector = func(s string) LabelSelector {
+		var sel, err = ParseLabelSelector(s)
+		c.Assert(err, gc.IsNil)
+		return sel
+	}
+	var cases = []struct {
+		claims  Claims
+		require Capability
+		expect  bool
+	}{
+		// Zero-valued Claims grant nothing.
+		{Claims{}, Capability_READ, false},
+		// An empty Selector matches everything.
+		{Claims{Capability: Capability_READ}, Capability_READ, true},
+		{Claims{Capability: Capability_ALL}, Capability_APPEND | Capability_APPLY, true},
+		// All required capabilities must be granted.
+		{Claims{Capability: Capability_READ}, Capability_READ | Capability_APPEND, false},
+		// Scoping by name prefix.
+		{Claims{Capability: Capability_READ, Selector: mustSelector("prefix=a/journal/")}, Capability_READ, true},
+		{Claims{Capability: Capability_READ, Selector: mustSelector("prefix=a/other/")}, Capability_READ, false},
+		// Scoping by label.
+		{Claims{Capability: Capability_READ, Selector: mustSelector("foo=bar")}, Capability_READ, true},
+		{Claims{Capability: Capability_READ, Selector: mustSelector("foo in (baz, bing)")}, Capability_READ, false},
+		{Claims{Capability: Capability_READ, Selector: mustSelector("prefix=a/, foo!=bar")}, Capability_READ, false},
+	}
+	for _, tc := range cases {
+		c.Check(tc.claims.Allows(tc.require, set), gc.Equals, tc.expect)
+	}
+
+	var claims = Claims{Selector: LabelSelector{Include: LabelSet{Labels: []Label{{Name: "a|b"}}}}}
+	c.Check(claims.Validate(), gc.ErrorMatches, `Selector.Include.Labels\[0\].Name: not a valid token \(a\|b\)`)
+}
+
+var _ = gc.Suite(&AuthSuite{})
@@ -173,10 +173,7 @@ func (d *dispatcher) Pick(info balancer.PickInfo) (balancer.PickResult, error) {
 		// Initiate a new SubConn to the ProcessSpec_ID.
 		var err error
 		if msc.subConn, err = d.cc.NewSubConn(
-			[]resolver.Address{{
-				Addr: d.idToAddr(dr.route, dispatchID),
-				Type: resolver.Backend,
-			}},
+			[]resolver.Address{d.idToAddr(dr.route, dispatchID)},
 			balancer.NewSubConnOptions{},
 		); err != nil {
 			return balancer.PickResult{}, err
@@ -267,13 +264,21 @@ func (d *dispatcher) less(lhs, rhs ProcessSpec_ID) bool {
 }
 
 // idToAddr returns a suitable address for the ID.
-func (d *dispatcher) idToAddr(rt Route, id ProcessSpec_ID) string {
+func (d *dispatcher) idToAddr(rt Route, id ProcessSpec_ID) resolver.Address {
 	if id == (ProcessSpec_ID{}) {
-		return d.cc.Target() // Use the default service address.
+		// Use the default service address.
+		return resolver.Address{Addr: d.cc.Target(), Type: resolver.Backend}
 	}
 	for i := range rt.Members {
 		if rt.Members[i] == id {
-			return rt.Endpoints[i].GRPCAddr()
+			var addr = resolver.Address{Addr: rt.Endpoints[i].GRPCAddr(), Type: resolver.Backend}
+
+			// A member dialed over TLS must be verified by the host of its
+			// advertised Endpoint, and not that of the default service address.
+			if u := rt.Endpoints[i].URL(); u.Scheme == "https" {
+				addr.ServerName = u.Hostname()
+			}
+			return addr
 		}
 	}
 	panic("ProcessSpec_ID must be in Route.Members")
@@ -231,6 +231,25 @@ func (s *DispatcherSuite) TestDispatchMarkAndSweep(c *gc.C) {
 	c.Check(err, gc.IsNil)
 }
 
+func (s *DispatcherSuite) TestAddressOfID(c *gc.C) {
+	var cc mockClientConn
+	var disp = dispatcherBuilder{zone: "local"}.Build(&cc, balancer.BuildOptions{}).(*dispatcher)
+	close(disp.sweepDoneCh) // Disable async sweeping.
+
+	var rt = buildRouteFixture()
+	rt.Endpoints[1] = "https://secure.host:8443"
+
+	// The default service address is used for a zero-valued ID.
+	c.Check(disp.idToAddr(rt, ProcessSpec_ID{}), gc.DeepEquals,
+		resolver.Address{Addr: "default.addr", Type: resolver.Backend})
+	// Members dialed in the clear have no ServerName.
+	c.Check(disp.idToAddr(rt, rt.Members[0]), gc.DeepEquals,
+		resolver.Address{Addr: "remote.addr", Type: resolver.Backend})
+	// Members dialed over TLS are verified by the host of their Endpoint.
+	c.Check(disp.idToAddr(rt, rt.Members[1]), gc.DeepEquals,
+		resolver.Address{Addr: "secure.host:8443", ServerName: "secure.host", Type: resolver.Backend})
+}
+
 type mockClientConn struct {
 	err     error
 	created []mockSubConn
@@ -9,6 +9,7 @@ import (
 // and query components. At present, supported schemes are:
 //
 //  * http://host(:port)/path?query
+//  * https://host(:port)/path?query
 //
 type Endpoint string
 
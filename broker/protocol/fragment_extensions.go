@@ -8,12 +8,22 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // ContentName returns the content-addressed base file name of this Fragment.
 func (m *Fragment) ContentName() string {
-	return fmt.Sprintf("%016x-%016x-%x%s", m.Begin, m.End,
-		m.Sum.ToDigest(), m.CompressionCodec.ToExtension())
+	var name = fmt.Sprintf("%016x-%016x-%x", m.Begin, m.End, m.Sum.ToDigest())
+
+	if m.CompressionDictionary != "" {
+		name += "." + m.CompressionDictionary
+	}
+	name += m.CompressionCodec.ToExtension()
+
+	if m.Encrypted {
+		name += encryptedExtension
+	}
+	return name
 }
 
 // ContentPath returns the content-addressed path of this Fragment.
@@ -33,16 +43,32 @@ func (m *Fragment) Validate() error {
 		return NewValidationError("expected Begin <= End (have %d, %d)", m.Begin, m.End)
 	} else if err = m.CompressionCodec.Validate(); err != nil {
 		return ExtendContext(err, "CompressionCodec")
+	} else if err = validateCompressionDictionary(m.CompressionCodec, m.CompressionDictionary); err != nil {
+		return err
 	} else if err = ValidatePathComponent(m.PathPostfix, 0, maxJournalNameLen); err != nil {
 		return ExtendContext(err, "PathPostfix")
 	}
 	return nil
 }
 
+// ParseModTime parses a Fragment ModTime, in unix seconds, from |s|, which is
+// either an RFC 3339 timestamp or integer unix seconds. It's used to parse a
+// ReadRequest BeginModTime from user input.
+func ParseModTime(s string) (int64, error) {
+	if sec, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return sec, nil
+	} else if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.Unix(), nil
+	}
+	return 0, fmt.Errorf("expected RFC 3339 timestamp or unix seconds (%s)", s)
+}
+
 // ParseFragmentFromRelativePath parses a Fragment from its relative path name,
 // under the Journal's storage location within a fragment store. Path components
 // contributed by the Journal must have already been stripped from the path
-// string, leaving only a path postfix, content name, and compression extension.
+// string, leaving only a path postfix, content name, compression extension,
+// and an encryption extension if the Fragment is encrypted. The content name
+// of a Fragment compressed with a dictionary is followed by the dictionary name.
 //
 //      ParseFragmentFromRelativePath("a/journal",
 //          "a=1/b=2/00000000499602d2-7fffffffffffffff-0102030405060708090a0b0c0d0e0f1011121314.gz")
@@ -60,6 +86,23 @@ func ParseFragmentFromRelativePath(journal Journal, name string) (Fragment, erro
 	var ext = path.Ext(name)
 	name = name[:len(name)-len(ext)]
 
+	var encrypted bool
+	if ext == encryptedExtension {
+		encrypted = true
+		ext = path.Ext(name)
+		name = name[:len(name)-len(ext)]
+	}
+
+	var cc, err = CompressionCodecFromExtension(ext)
+	if err != nil {
+		return Fragment{}, err
+	}
+	var dictionary string
+	if cc == CompressionCodec_ZSTANDARD_DICT {
+		ext = path.Ext(name)
+		name, dictionary = name[:len(name)-len(ext)], strings.TrimPrefix(ext, ".")
+	}
+
 	if fields := strings.Split(name, "-"); len(fields) != 3 {
 		return Fragment{}, NewValidationError("wrong Fragment format: %v", name)
 	} else if begin, err := strconv.ParseInt(fields[0], 16, 64); err != nil {
@@ -70,16 +113,16 @@ func ParseFragmentFromRelativePath(journal Journal, name string) (Fragment, erro
 		return Fragment{}, ExtendContext(&ValidationError{Err: err}, "Sum")
 	} else if len(sum) != sha1.Size {
 		return Fragment{}, NewValidationError("invalid SHA1Sum length: %x", sum)
-	} else if cc, err := CompressionCodecFromExtension(ext); err != nil {
-		return Fragment{}, err
 	} else {
 		f = Fragment{
-			Journal:          journal,
-			Begin:            begin,
-			End:              end,
-			Sum:              SHA1SumFromDigest(sum),
-			CompressionCodec: cc,
-			PathPostfix:      postfix,
+			Journal:               journal,
+			Begin:                 begin,
+			End:                   end,
+			Sum:                   SHA1SumFromDigest(sum),
+			CompressionCodec:      cc,
+			PathPostfix:           postfix,
+			Encrypted:             encrypted,
+			CompressionDictionary: dictionary,
 		}
 	}
 	return f, f.Validate()
@@ -130,6 +173,14 @@ func CompressionCodecFromExtension(ext string) (CompressionCodec, error) {
 		return CompressionCodec_SNAPPY, nil
 	case "", ".gzod":
 		return CompressionCodec_GZIP_OFFLOAD_DECOMPRESSION, nil
+	case ".zsts":
+		return CompressionCodec_ZSTANDARD_SEEKABLE, nil
+	case ".lz4":
+		return CompressionCodec_LZ4, nil
+	case ".br", ".brotli":
+		return CompressionCodec_BROTLI, nil
+	case ".zsd":
+		return CompressionCodec_ZSTANDARD_DICT, nil
 	default:
 		return CompressionCodec_NONE, NewValidationError("unrecognized compression extension: %s", ext)
 	}
@@ -156,15 +207,44 @@ func (m CompressionCodec) ToExtension() string {
 		return ".sz"
 	case CompressionCodec_GZIP_OFFLOAD_DECOMPRESSION:
 		return "" // TODO(johnny): Switch to ".gzod" when v2 broker fully released.
+	case CompressionCodec_ZSTANDARD_SEEKABLE:
+		return ".zsts"
+	case CompressionCodec_LZ4:
+		return ".lz4"
+	case CompressionCodec_BROTLI:
+		return ".br"
+	case CompressionCodec_ZSTANDARD_DICT:
+		return ".zsd"
 	default:
 		panic("invalid CompressionCodec")
 	}
 }
 
+// validateCompressionDictionary returns an error if the dictionary |name|
+// isn't a content-addressed dictionary name, or if a dictionary is either
+// missing from or not permitted with the CompressionCodec.
+func validateCompressionDictionary(codec CompressionCodec, name string) error {
+	if codec != CompressionCodec_ZSTANDARD_DICT {
+		if name != "" {
+			return NewValidationError("unexpected CompressionDictionary with codec %s (%s)", codec, name)
+		}
+		return nil
+	} else if name == "" {
+		return NewValidationError("expected CompressionDictionary with codec %s", codec)
+	} else if b, err := hex.DecodeString(name); err != nil || len(b) != sha1.Size || hex.EncodeToString(b) != name {
+		return NewValidationError("invalid CompressionDictionary (%s; expected a hex SHA1 sum)", name)
+	}
+	return nil
+}
+
 func (m CompressionCodec) MarshalYAML() (interface{}, error) {
 	return m.String(), nil
 }
 
+// encryptedExtension is the file extension of encrypted Fragments,
+// which follows the extension of their CompressionCodec.
+const encryptedExtension = ".enc"
+
 func (m *CompressionCodec) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var str string
 
@@ -27,6 +27,23 @@ func (s *FragmentSuite) TestContentName(c *gc.C) {
 	f.CompressionCodec = CompressionCodec_GZIP_OFFLOAD_DECOMPRESSION
 	c.Check(f.ContentName(), gc.Equals,
 		"00000000499602d2-7fffffffffffffff-0102030405060708090a0b0c0d0e0f1011121314")
+
+	f.CompressionCodec = CompressionCodec_LZ4
+	c.Check(f.ContentName(), gc.Equals,
+		"00000000499602d2-7fffffffffffffff-0102030405060708090a0b0c0d0e0f1011121314.lz4")
+
+	f.CompressionCodec = CompressionCodec_BROTLI
+	c.Check(f.ContentName(), gc.Equals,
+		"00000000499602d2-7fffffffffffffff-0102030405060708090a0b0c0d0e0f1011121314.br")
+
+	f.CompressionCodec, f.Encrypted = CompressionCodec_ZSTANDARD, true
+	c.Check(f.ContentName(), gc.Equals,
+		"00000000499602d2-7fffffffffffffff-0102030405060708090a0b0c0d0e0f1011121314.zst.enc")
+
+	f.CompressionCodec, f.CompressionDictionary = CompressionCodec_ZSTANDARD_DICT, "a1a2a3a4a5a6a7a8a9b0b1b2b3b4b5b6b7b8b9c0"
+	c.Check(f.ContentName(), gc.Equals,
+		"00000000499602d2-7fffffffffffffff-0102030405060708090a0b0c0d0e0f1011121314."+
+			"a1a2a3a4a5a6a7a8a9b0b1b2b3b4b5b6b7b8b9c0.zsd.enc")
 }
 
 func (s *FragmentSuite) TestContentPath(c *gc.C) {
@@ -68,6 +85,16 @@ func (s *FragmentSuite) TestValidationCases(c *gc.C) {
 	c.Check(f.Validate(), gc.ErrorMatches, "CompressionCodec: invalid value .*")
 	f.CompressionCodec = CompressionCodec_GZIP
 
+	f.CompressionDictionary = "a1a2a3a4a5a6a7a8a9b0b1b2b3b4b5b6b7b8b9c0"
+	c.Check(f.Validate(), gc.ErrorMatches, `unexpected CompressionDictionary with codec GZIP \(a1a2.*\)`)
+	f.CompressionCodec = CompressionCodec_ZSTANDARD_DICT
+	c.Check(f.Validate(), gc.IsNil)
+	f.CompressionDictionary = "A1A2A3A4A5A6A7A8A9B0B1B2B3B4B5B6B7B8B9C0"
+	c.Check(f.Validate(), gc.ErrorMatches, `invalid CompressionDictionary \(A1A2.*; expected a hex SHA1 sum\)`)
+	f.CompressionDictionary = ""
+	c.Check(f.Validate(), gc.ErrorMatches, "expected CompressionDictionary with codec ZSTANDARD_DICT")
+	f.CompressionCodec = CompressionCodec_GZIP
+
 	f.PathPostfix = "a=b/c=2"
 	c.Check(f.Validate(), gc.IsNil)
 
@@ -115,6 +142,47 @@ func (s *FragmentSuite) TestParsingSuccessCases(c *gc.C) {
 		CompressionCodec: CompressionCodec_GZIP_OFFLOAD_DECOMPRESSION,
 	})
 
+	// Case: LZ4 and Brotli extensions.
+	f, err = ParseFragmentFromRelativePath("a/journal",
+		"00000000499602d2-7fffffffffffffff-0102030405060708090a0b0c0d0e0f1011121314.lz4")
+	c.Check(err, gc.IsNil)
+	c.Check(f.CompressionCodec, gc.Equals, CompressionCodec_LZ4)
+
+	f, err = ParseFragmentFromRelativePath("a/journal",
+		"00000000499602d2-7fffffffffffffff-0102030405060708090a0b0c0d0e0f1011121314.brotli")
+	c.Check(err, gc.IsNil)
+	c.Check(f.CompressionCodec, gc.Equals, CompressionCodec_BROTLI)
+
+	// Case: encrypted fragment.
+	f, err = ParseFragmentFromRelativePath("a/journal",
+		"00000000499602d2-7fffffffffffffff-0102030405060708090a0b0c0d0e0f1011121314.sz.enc")
+
+	c.Check(err, gc.IsNil)
+	c.Check(f, gc.DeepEquals, Fragment{
+		Journal:          "a/journal",
+		Begin:            1234567890,
+		End:              math.MaxInt64,
+		Sum:              SHA1Sum{Part1: 0x0102030405060708, Part2: 0x090a0b0c0d0e0f10, Part3: 0x11121314},
+		CompressionCodec: CompressionCodec_SNAPPY,
+		Encrypted:        true,
+	})
+
+	// Case: encrypted fragment compressed with a dictionary.
+	f, err = ParseFragmentFromRelativePath("a/journal",
+		"00000000499602d2-7fffffffffffffff-0102030405060708090a0b0c0d0e0f1011121314."+
+			"a1a2a3a4a5a6a7a8a9b0b1b2b3b4b5b6b7b8b9c0.zsd.enc")
+
+	c.Check(err, gc.IsNil)
+	c.Check(f, gc.DeepEquals, Fragment{
+		Journal:               "a/journal",
+		Begin:                 1234567890,
+		End:                   math.MaxInt64,
+		Sum:                   SHA1Sum{Part1: 0x0102030405060708, Part2: 0x090a0b0c0d0e0f10, Part3: 0x11121314},
+		CompressionCodec:      CompressionCodec_ZSTANDARD_DICT,
+		Encrypted:             true,
+		CompressionDictionary: "a1a2a3a4a5a6a7a8a9b0b1b2b3b4b5b6b7b8b9c0",
+	})
+
 	// Case: empty Spool (begin == end, and zero checksum).
 	f, err = ParseFragmentFromRelativePath("a/journal",
 		"00000000499602d2-00000000499602d2-0000000000000000000000000000000000000000.raw")
@@ -153,12 +221,33 @@ func (s *FragmentSuite) TestParsingErrorCases(c *gc.C) {
 		"00000000499602d2-7fffffffffffffff-0102030405060708090a0b0c0d0e0f1011121314.XXX")
 	c.Check(err, gc.ErrorMatches, "unrecognized compression extension: .XXX")
 
+	_, err = ParseFragmentFromRelativePath("a/journal",
+		"00000000499602d2-7fffffffffffffff-0102030405060708090a0b0c0d0e0f1011121314.zsd")
+	c.Check(err, gc.ErrorMatches, "expected CompressionDictionary with codec ZSTANDARD_DICT")
+
+	_, err = ParseFragmentFromRelativePath("a/journal",
+		"00000000499602d2-7fffffffffffffff-0102030405060708090a0b0c0d0e0f1011121314.XX.zsd")
+	c.Check(err, gc.ErrorMatches, `invalid CompressionDictionary \(XX; expected a hex SHA1 sum\)`)
+
 	// Expect we also Validate the parsed Fragment before returning.
 	_, err = ParseFragmentFromRelativePath("a/journal",
 		"7fffffffffffffff-00000000499602d2-0102030405060708090a0b0c0d0e0f1011121314.gz")
 	c.Check(err, gc.ErrorMatches, "expected Begin <= End .*")
 }
 
+func (s *FragmentSuite) TestParseModTime(c *gc.C) {
+	var t, err = ParseModTime("1572512400")
+	c.Check(err, gc.IsNil)
+	c.Check(t, gc.Equals, int64(1572512400))
+
+	t, err = ParseModTime("2019-10-31T09:00:00Z")
+	c.Check(err, gc.IsNil)
+	c.Check(t, gc.Equals, int64(1572512400))
+
+	_, err = ParseModTime("yesterday")
+	c.Check(err, gc.ErrorMatches, `expected RFC 3339 timestamp or unix seconds \(yesterday\)`)
+}
+
 var _ = gc.Suite(&FragmentSuite{})
 
 func Test(t *testing.T) { gc.TestingT(t) }
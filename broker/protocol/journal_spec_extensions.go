@@ -113,6 +113,12 @@ func (m *JournalSpec) Validate() error {
 		return ExtendContext(err, "Flags")
 	} else if m.MaxAppendRate < 0 {
 		return NewValidationError("invalid MaxAppendRate (%d; expected >= 0)", m.MaxAppendRate)
+	} else if m.MinOffset < 0 {
+		return NewValidationError("invalid MinOffset (%d; expected >= 0)", m.MinOffset)
+	} else if err = m.Suspend.Validate(); err != nil {
+		return ExtendContext(err, "Suspend")
+	} else if m.ValidateSchema && m.LabelSet.ValueOf(labels.MessageType) == "" {
+		return NewValidationError("ValidateSchema requires a %s label", labels.MessageType)
 	}
 	return nil
 }
@@ -124,6 +130,10 @@ func (m *JournalSpec_Fragment) Validate() error {
 			m.Length, minFragmentLen, maxFragmentLen)
 	} else if err := m.CompressionCodec.Validate(); err != nil {
 		return ExtendContext(err, "CompressionCodec")
+	} else if err = validateCompressionDictionary(m.CompressionCodec, m.CompressionDictionary); err != nil {
+		return err
+	} else if m.CompressionCodec == CompressionCodec_ZSTANDARD_DICT && len(m.Stores) == 0 {
+		return NewValidationError("ZSTANDARD_DICT requires at least one store")
 	}
 	for i, store := range m.Stores {
 		if err := store.Validate(); err != nil {
@@ -144,6 +154,35 @@ func (m *JournalSpec_Fragment) Validate() error {
 			m.FlushInterval, minFlushInterval)
 	}
 
+	if m.MaxBytes < 0 {
+		return NewValidationError("invalid MaxBytes (%d; expected >= 0)", m.MaxBytes)
+	} else if m.MaxBytes != 0 && m.MaxBytes < m.Length {
+		return NewValidationError("invalid MaxBytes (%d; expected 0 or >= Length %d)",
+			m.MaxBytes, m.Length)
+	} else if m.MaxFragments < 0 {
+		return NewValidationError("invalid MaxFragments (%d; expected >= 0)", m.MaxFragments)
+	} else if m.ReaderFloor < 0 {
+		return NewValidationError("invalid ReaderFloor (%d; expected >= 0)", m.ReaderFloor)
+	}
+
+	if len(m.TierAfter) != 0 && len(m.TierAfter) >= len(m.Stores) {
+		return NewValidationError("invalid TierAfter (%d thresholds; expected fewer than Stores (%d))",
+			len(m.TierAfter), len(m.Stores))
+	}
+	for i, d := range m.TierAfter {
+		if d < 0 {
+			return NewValidationError("invalid TierAfter[%d] (%s; expected >= 0)", i, d)
+		}
+	}
+
+	if m.EncryptionKey != "" {
+		if err := ValidateToken(m.EncryptionKey, TokenSymbols, minEncryptionKeyLen, maxEncryptionKeyLen); err != nil {
+			return ExtendContext(err, "EncryptionKey")
+		} else if m.CompressionCodec == CompressionCodec_GZIP_OFFLOAD_DECOMPRESSION {
+			return NewValidationError("GZIP_OFFLOAD_DECOMPRESSION is incompatible with EncryptionKey (%s)", m.EncryptionKey)
+		}
+	}
+
 	// Ensure the PathPostfixTemplate parses and evaluates without
 	// error over a zero-valued struct having the proper shape.
 	if tpl, err := template.New("postfix").Parse(m.PathPostfixTemplate); err != nil {
@@ -225,6 +264,36 @@ func (x *JournalSpec_Flag) UnmarshalYAML(unmarshal func(interface{}) error) erro
 	}
 }
 
+// Validate returns an error if the JournalSpec_Suspend is not well-formed.
+func (m *JournalSpec_Suspend) Validate() error {
+	if _, ok := JournalSpec_Suspend_Level_name[int32(m.Level)]; !ok {
+		return NewValidationError("invalid Level (%s)", m.Level)
+	} else if m.Offset < 0 {
+		return NewValidationError("invalid Offset (%d; expected >= 0)", m.Offset)
+	}
+	return nil
+}
+
+// MarshalYAML maps the JournalSpec_Suspend_Level to its enum name.
+func (x JournalSpec_Suspend_Level) MarshalYAML() (interface{}, error) {
+	return x.String(), nil
+}
+
+// UnmarshalYAML maps a YAML string to the Level of corresponding enum name.
+func (x *JournalSpec_Suspend_Level) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if tag, ok := JournalSpec_Suspend_Level_value[str]; !ok {
+		return fmt.Errorf("%q is not a valid JournalSpec_Suspend_Level (options are %v)", str, JournalSpec_Suspend_Level_value)
+	} else {
+		*x = JournalSpec_Suspend_Level(tag)
+		return nil
+	}
+}
+
 // MarshalString returns the marshaled encoding of the JournalSpec as a string.
 func (m *JournalSpec) MarshalString() string {
 	var d, err = m.Marshal()
@@ -234,9 +303,21 @@ func (m *JournalSpec) MarshalString() string {
 	return string(d)
 }
 
-// DesiredReplication returns the configured Replication of the spec. It
-// implements allocator.ItemValue.
+// MarshalString returns the marshaled encoding of the JournalSpecRevision as a string.
+func (m *JournalSpecRevision) MarshalString() string {
+	var d, err = m.Marshal()
+	if err != nil {
+		panic(err.Error()) // Cannot happen, as we use no custom marshalling.
+	}
+	return string(d)
+}
+
+// DesiredReplication returns the configured Replication of the spec, or zero
+// if the journal is fully suspended. It implements allocator.ItemValue.
 func (m *JournalSpec) DesiredReplication() int {
+	if m.Suspend.Level == JournalSpec_Suspend_FULL {
+		return 0 // Suspended journals have no assignments.
+	}
 	if MaxReplication < m.Replication {
 		return int(MaxReplication)
 	}
@@ -273,12 +354,48 @@ func UnionJournalSpecs(a, b JournalSpec) JournalSpec {
 	if a.Fragment.PathPostfixTemplate == "" {
 		a.Fragment.PathPostfixTemplate = b.Fragment.PathPostfixTemplate
 	}
+	if a.Fragment.MaxBytes == 0 {
+		a.Fragment.MaxBytes = b.Fragment.MaxBytes
+	}
+	if a.Fragment.MaxFragments == 0 {
+		a.Fragment.MaxFragments = b.Fragment.MaxFragments
+	}
+	if a.Fragment.ReaderFloor == 0 {
+		a.Fragment.ReaderFloor = b.Fragment.ReaderFloor
+	}
+	if !a.Fragment.PruneOversize {
+		a.Fragment.PruneOversize = b.Fragment.PruneOversize
+	}
+	if a.Fragment.TierAfter == nil {
+		a.Fragment.TierAfter = b.Fragment.TierAfter
+	}
+	if a.Fragment.EncryptionKey == "" {
+		a.Fragment.EncryptionKey = b.Fragment.EncryptionKey
+	}
+	if a.Fragment.CompressionDictionary == "" {
+		a.Fragment.CompressionDictionary = b.Fragment.CompressionDictionary
+	}
 	if a.Flags == JournalSpec_NOT_SPECIFIED {
 		a.Flags = b.Flags
 	}
 	if a.MaxAppendRate == 0 {
 		a.MaxAppendRate = b.MaxAppendRate
 	}
+	if a.MinOffset == 0 {
+		a.MinOffset = b.MinOffset
+	}
+	if a.Suspend == (JournalSpec_Suspend{}) {
+		a.Suspend = b.Suspend
+	}
+	if !a.QuorumCommit {
+		a.QuorumCommit = b.QuorumCommit
+	}
+	if !a.ValidateFraming {
+		a.ValidateFraming = b.ValidateFraming
+	}
+	if !a.ValidateSchema {
+		a.ValidateSchema = b.ValidateSchema
+	}
 	return a
 }
 
@@ -311,12 +428,48 @@ func IntersectJournalSpecs(a, b JournalSpec) JournalSpec {
 	if a.Fragment.PathPostfixTemplate != b.Fragment.PathPostfixTemplate {
 		a.Fragment.PathPostfixTemplate = ""
 	}
+	if a.Fragment.MaxBytes != b.Fragment.MaxBytes {
+		a.Fragment.MaxBytes = 0
+	}
+	if a.Fragment.MaxFragments != b.Fragment.MaxFragments {
+		a.Fragment.MaxFragments = 0
+	}
+	if a.Fragment.ReaderFloor != b.Fragment.ReaderFloor {
+		a.Fragment.ReaderFloor = 0
+	}
+	if a.Fragment.PruneOversize != b.Fragment.PruneOversize {
+		a.Fragment.PruneOversize = false
+	}
+	if !durationsEq(a.Fragment.TierAfter, b.Fragment.TierAfter) {
+		a.Fragment.TierAfter = nil
+	}
+	if a.Fragment.EncryptionKey != b.Fragment.EncryptionKey {
+		a.Fragment.EncryptionKey = ""
+	}
+	if a.Fragment.CompressionDictionary != b.Fragment.CompressionDictionary {
+		a.Fragment.CompressionDictionary = ""
+	}
 	if a.Flags != b.Flags {
 		a.Flags = JournalSpec_NOT_SPECIFIED
 	}
 	if a.MaxAppendRate != b.MaxAppendRate {
 		a.MaxAppendRate = 0
 	}
+	if a.MinOffset != b.MinOffset {
+		a.MinOffset = 0
+	}
+	if a.Suspend != b.Suspend {
+		a.Suspend = JournalSpec_Suspend{}
+	}
+	if a.QuorumCommit != b.QuorumCommit {
+		a.QuorumCommit = false
+	}
+	if a.ValidateFraming != b.ValidateFraming {
+		a.ValidateFraming = false
+	}
+	if a.ValidateSchema != b.ValidateSchema {
+		a.ValidateSchema = false
+	}
 	return a
 }
 
@@ -349,12 +502,48 @@ func SubtractJournalSpecs(a, b JournalSpec) JournalSpec {
 	if a.Fragment.PathPostfixTemplate == b.Fragment.PathPostfixTemplate {
 		a.Fragment.PathPostfixTemplate = ""
 	}
+	if a.Fragment.MaxBytes == b.Fragment.MaxBytes {
+		a.Fragment.MaxBytes = 0
+	}
+	if a.Fragment.MaxFragments == b.Fragment.MaxFragments {
+		a.Fragment.MaxFragments = 0
+	}
+	if a.Fragment.ReaderFloor == b.Fragment.ReaderFloor {
+		a.Fragment.ReaderFloor = 0
+	}
+	if a.Fragment.PruneOversize == b.Fragment.PruneOversize {
+		a.Fragment.PruneOversize = false
+	}
+	if durationsEq(a.Fragment.TierAfter, b.Fragment.TierAfter) {
+		a.Fragment.TierAfter = nil
+	}
+	if a.Fragment.EncryptionKey == b.Fragment.EncryptionKey {
+		a.Fragment.EncryptionKey = ""
+	}
+	if a.Fragment.CompressionDictionary == b.Fragment.CompressionDictionary {
+		a.Fragment.CompressionDictionary = ""
+	}
 	if a.Flags == b.Flags {
 		a.Flags = JournalSpec_NOT_SPECIFIED
 	}
 	if a.MaxAppendRate == b.MaxAppendRate {
 		a.MaxAppendRate = 0
 	}
+	if a.MinOffset == b.MinOffset {
+		a.MinOffset = 0
+	}
+	if a.Suspend == b.Suspend {
+		a.Suspend = JournalSpec_Suspend{}
+	}
+	if a.QuorumCommit == b.QuorumCommit {
+		a.QuorumCommit = false
+	}
+	if a.ValidateFraming == b.ValidateFraming {
+		a.ValidateFraming = false
+	}
+	if a.ValidateSchema == b.ValidateSchema {
+		a.ValidateSchema = false
+	}
 	return a
 }
 
@@ -397,6 +586,18 @@ func validateJournalLabelConstraints(ls LabelSet) error {
 	return nil
 }
 
+func durationsEq(a, b []time.Duration) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 const (
 	minJournalNameLen, maxJournalNameLen   = 4, 512
 	maxJournalReplication                  = 5
@@ -404,3 +605,5 @@ const (
 	minFlushInterval                       = time.Minute
 	minFragmentLen, maxFragmentLen         = 1 << 10, 1 << 34 // 1024 => 17,179,869,184
 )
+
+const minEncryptionKeyLen, maxEncryptionKeyLen = 1, 256
@@ -68,6 +68,7 @@ func (s *JournalSuite) TestSpecValidationCases(c *gc.C) {
 
 		Flags:         JournalSpec_O_RDWR,
 		MaxAppendRate: 12345,
+		MinOffset:     67890,
 	}
 	c.Check(spec.Validate(), gc.IsNil) // Base case: validates successfully.
 
@@ -89,6 +90,21 @@ func (s *JournalSuite) TestSpecValidationCases(c *gc.C) {
 	MaxReplication = 1
 	c.Check(spec.DesiredReplication(), gc.Equals, 1)
 
+	// A fully suspended journal desires no replicas.
+	spec.Suspend = JournalSpec_Suspend{Level: JournalSpec_Suspend_FULL, Offset: 1234}
+	c.Check(spec.Validate(), gc.IsNil)
+	c.Check(spec.DesiredReplication(), gc.Equals, 0)
+	spec.Suspend.Offset = -1
+	c.Check(spec.Validate(), gc.ErrorMatches, `Suspend: invalid Offset \(-1; expected >= 0\)`)
+	spec.Suspend.Level = 9999
+	c.Check(spec.Validate(), gc.ErrorMatches, `Suspend: invalid Level \(9999\)`)
+	spec.Suspend = JournalSpec_Suspend{}
+
+	// Schema validation requires a message-type label.
+	spec.ValidateSchema = true
+	c.Check(spec.Validate(), gc.ErrorMatches, `ValidateSchema requires a app.gazette.dev/message-type label`)
+	spec.ValidateSchema = false
+
 	spec.Labels[0].Name = "xxx xxx"
 	c.Check(spec.Validate(), gc.ErrorMatches, `Labels.Labels\[0\].Name: not a valid token \(xxx xxx\)`)
 
@@ -142,6 +158,10 @@ func (s *JournalSuite) TestSpecValidationCases(c *gc.C) {
 	c.Check(spec.Validate(), gc.ErrorMatches, `invalid MaxAppendRate \(-1; expected >= 0\)`)
 	spec.MaxAppendRate = 0
 
+	spec.MinOffset = -1
+	c.Check(spec.Validate(), gc.ErrorMatches, `invalid MinOffset \(-1; expected >= 0\)`)
+	spec.MinOffset = 0
+
 	// Additional tests of JournalSpec_Fragment cases.
 	var f = &spec.Fragment
 
@@ -168,6 +188,45 @@ func (s *JournalSuite) TestSpecValidationCases(c *gc.C) {
 	c.Check(f.Validate(), gc.ErrorMatches, `invalid FlushInterval \(1s; expected >= 1m0s\)`)
 	f.FlushInterval = time.Hour * 2
 
+	f.MaxBytes = -1
+	c.Check(f.Validate(), gc.ErrorMatches, `invalid MaxBytes \(-1; expected >= 0\)`)
+	f.MaxBytes = 100
+	c.Check(f.Validate(), gc.ErrorMatches, `invalid MaxBytes \(100; expected 0 or >= Length 1024\)`)
+	f.MaxBytes = 1 << 30
+
+	f.MaxFragments = -1
+	c.Check(f.Validate(), gc.ErrorMatches, `invalid MaxFragments \(-1; expected >= 0\)`)
+	f.MaxFragments = 100
+
+	f.ReaderFloor = -1
+	c.Check(f.Validate(), gc.ErrorMatches, `invalid ReaderFloor \(-1; expected >= 0\)`)
+	f.ReaderFloor = 1 << 20
+
+	f.TierAfter = []time.Duration{time.Hour, time.Hour}
+	c.Check(f.Validate(), gc.ErrorMatches, `invalid TierAfter \(2 thresholds; expected fewer than Stores \(2\)\)`)
+	f.TierAfter = []time.Duration{-time.Hour}
+	c.Check(f.Validate(), gc.ErrorMatches, `invalid TierAfter\[0\] \(-1h0m0s; expected >= 0\)`)
+	f.TierAfter = []time.Duration{time.Hour * 24}
+
+	f.EncryptionKey = "bad key"
+	c.Check(f.Validate(), gc.ErrorMatches, `EncryptionKey: not a valid token \(bad key\)`)
+	f.EncryptionKey, f.CompressionCodec = "a-key", CompressionCodec_GZIP_OFFLOAD_DECOMPRESSION
+	var stores = f.Stores
+	f.Stores = []FragmentStore{"s3://a-bucket/", "s3://b-bucket/"}
+	c.Check(f.Validate(), gc.ErrorMatches, `GZIP_OFFLOAD_DECOMPRESSION is incompatible with EncryptionKey \(a-key\)`)
+	f.CompressionCodec, f.Stores = CompressionCodec_SNAPPY, stores
+
+	f.CompressionCodec = CompressionCodec_ZSTANDARD_DICT
+	c.Check(f.Validate(), gc.ErrorMatches, `expected CompressionDictionary with codec ZSTANDARD_DICT`)
+	f.CompressionDictionary = "a1a2a3a4a5a6a7a8a9b0b1b2b3b4b5b6b7b8b9c0"
+	c.Check(f.Validate(), gc.IsNil)
+	f.Stores, f.TierAfter = nil, nil
+	c.Check(f.Validate(), gc.ErrorMatches, `ZSTANDARD_DICT requires at least one store`)
+	f.Stores, f.TierAfter = stores, []time.Duration{time.Hour * 24}
+	f.CompressionCodec = CompressionCodec_SNAPPY
+	c.Check(f.Validate(), gc.ErrorMatches, `unexpected CompressionDictionary with codec SNAPPY \(a1a2.*\)`)
+	f.CompressionDictionary = ""
+
 	f.PathPostfixTemplate = "{{ bad template"
 	c.Check(f.Validate(), gc.ErrorMatches, `PathPostfixTemplate: template: postfix:1: .*`)
 	f.PathPostfixTemplate = ""
@@ -217,6 +276,32 @@ func (s *JournalSuite) TestFlagYAMLRoundTrip(c *gc.C) {
 		`"notAnEnum" is not a valid JournalSpec_Flag \(options are .*\)`)
 }
 
+func (s *JournalSuite) TestSuspendYAMLRoundTrip(c *gc.C) {
+	var cases = []struct {
+		Suspend JournalSpec_Suspend `yaml:",omitempty"`
+		enc     string
+	}{
+		{JournalSpec_Suspend{}, "{}\n"},
+		{JournalSpec_Suspend{Level: JournalSpec_Suspend_FULL, Offset: 1234}, "suspend:\n  level: FULL\n  offset: 1234\n"},
+		{JournalSpec_Suspend{Offset: 1234}, "suspend:\n  offset: 1234\n"},
+	}
+	for _, tc := range cases {
+		var b, err = yaml.Marshal(tc)
+		c.Check(err, gc.IsNil)
+		c.Check(string(b), gc.Equals, tc.enc)
+
+		var s2 = tc
+		s2.Suspend = JournalSpec_Suspend{}
+
+		c.Check(yaml.Unmarshal(b, &s2), gc.IsNil)
+		c.Check(s2.Suspend, gc.Equals, tc.Suspend)
+	}
+
+	var l JournalSpec_Suspend_Level
+	c.Check(yaml.Unmarshal([]byte(`"notAnEnum"`), &l), gc.ErrorMatches,
+		`"notAnEnum" is not a valid JournalSpec_Suspend_Level \(options are .*\)`)
+}
+
 func (s *JournalSuite) TestSetOperations(c *gc.C) {
 	var model = JournalSpec{
 		Replication: 3,
@@ -228,16 +313,24 @@ func (s *JournalSuite) TestSetOperations(c *gc.C) {
 			},
 		},
 		Fragment: JournalSpec_Fragment{
-			Length:              1024,
-			CompressionCodec:    CompressionCodec_SNAPPY,
-			Stores:              []FragmentStore{"s3://bucket/"},
-			RefreshInterval:     time.Minute,
-			Retention:           time.Hour,
-			FlushInterval:       time.Hour,
-			PathPostfixTemplate: "{{ .Foo }}",
+			Length:                1024,
+			CompressionCodec:      CompressionCodec_SNAPPY,
+			Stores:                []FragmentStore{"s3://bucket/"},
+			RefreshInterval:       time.Minute,
+			Retention:             time.Hour,
+			FlushInterval:         time.Hour,
+			PathPostfixTemplate:   "{{ .Foo }}",
+			MaxBytes:              1 << 30,
+			MaxFragments:          100,
+			ReaderFloor:           1e5,
+			TierAfter:             []time.Duration{time.Hour},
+			EncryptionKey:         "a-key",
+			CompressionDictionary: "a1a2a3a4a5a6a7a8a9b0b1b2b3b4b5b6b7b8b9c0",
 		},
 		Flags:         JournalSpec_O_RDWR,
 		MaxAppendRate: 1e3,
+		MinOffset:     1e5,
+		Suspend:       JournalSpec_Suspend{Level: JournalSpec_Suspend_FULL, Offset: 1e5},
 	}
 	var other = JournalSpec{
 		Replication: 1,
@@ -249,16 +342,24 @@ func (s *JournalSuite) TestSetOperations(c *gc.C) {
 			},
 		},
 		Fragment: JournalSpec_Fragment{
-			Length:              5678,
-			CompressionCodec:    CompressionCodec_NONE,
-			Stores:              []FragmentStore{"gs://other-bucket/"},
-			RefreshInterval:     10 * time.Hour,
-			Retention:           10 * time.Hour,
-			FlushInterval:       10 * time.Hour,
-			PathPostfixTemplate: "{{ .Bar }}",
+			Length:                5678,
+			CompressionCodec:      CompressionCodec_NONE,
+			Stores:                []FragmentStore{"gs://other-bucket/"},
+			RefreshInterval:       10 * time.Hour,
+			Retention:             10 * time.Hour,
+			FlushInterval:         10 * time.Hour,
+			PathPostfixTemplate:   "{{ .Bar }}",
+			MaxBytes:              1 << 40,
+			MaxFragments:          1000,
+			ReaderFloor:           1e6,
+			TierAfter:             []time.Duration{10 * time.Hour},
+			EncryptionKey:         "other-key",
+			CompressionDictionary: "b1b2b3b4b5b6b7b8b9c0c1c2c3c4c5c6c7c8c9d0",
 		},
 		Flags:         JournalSpec_O_RDONLY,
 		MaxAppendRate: 1e4,
+		MinOffset:     1e6,
+		Suspend:       JournalSpec_Suspend{Offset: 1e6},
 	}
 
 	c.Check(UnionJournalSpecs(JournalSpec{}, model), gc.DeepEquals, model)
@@ -280,6 +381,17 @@ func (s *JournalSuite) TestSetOperations(c *gc.C) {
 
 	c.Check(SubtractJournalSpecs(other, model), gc.DeepEquals, other)
 	c.Check(SubtractJournalSpecs(model, other), gc.DeepEquals, model)
+
+	// Boolean fields have only one non-zero value, and are checked separately.
+	var bools = JournalSpec{QuorumCommit: true, ValidateFraming: true, ValidateSchema: true,
+		Fragment: JournalSpec_Fragment{PruneOversize: true}}
+
+	c.Check(UnionJournalSpecs(JournalSpec{}, bools), gc.DeepEquals, bools)
+	c.Check(UnionJournalSpecs(bools, JournalSpec{}), gc.DeepEquals, bools)
+	c.Check(IntersectJournalSpecs(bools, bools), gc.DeepEquals, bools)
+	c.Check(IntersectJournalSpecs(bools, JournalSpec{}), gc.DeepEquals, JournalSpec{})
+	c.Check(SubtractJournalSpecs(bools, bools), gc.DeepEquals, JournalSpec{})
+	c.Check(SubtractJournalSpecs(bools, JournalSpec{}), gc.DeepEquals, bools)
 }
 
 var _ = gc.Suite(&JournalSuite{})
@@ -79,6 +79,21 @@ const (
 	// The Append is refused because a registers selector was provided with the
 	// request, but it was not matched by current register values of the journal.
 	Status_REGISTER_MISMATCH Status = 13
+	// The Append is refused because its content is not a well-formed sequence
+	// of message frames of the journal's content type, and the journal
+	// validates framing.
+	Status_INVALID_FRAMING Status = 14
+	// The Append is refused because a message of its content doesn't conform
+	// to the schema named by the journal's message-type label, or because no
+	// such schema is registered, and the journal validates its schema.
+	Status_SCHEMA_VIOLATION Status = 15
+	// The ApplySchemas is refused because an updated schema doesn't satisfy its
+	// compatibility rules with respect to the current schema.
+	Status_INCOMPATIBLE_SCHEMA Status = 16
+	// The Read is refused because its requested offset is below the minimum
+	// readable offset of the journal, which was advanced by the Truncate RPC.
+	// The response offset is the journal's minimum readable offset.
+	Status_OFFSET_TRUNCATED Status = 17
 )
 
 var Status_name = map[int32]string{
@@ -96,6 +111,10 @@ var Status_name = map[int32]string{
 	11: "WRONG_APPEND_OFFSET",
 	12: "INDEX_HAS_GREATER_OFFSET",
 	13: "REGISTER_MISMATCH",
+	14: "INVALID_FRAMING",
+	15: "SCHEMA_VIOLATION",
+	16: "INCOMPATIBLE_SCHEMA",
+	17: "OFFSET_TRUNCATED",
 }
 
 var Status_value = map[string]int32{
@@ -113,6 +132,10 @@ var Status_value = map[string]int32{
 	"WRONG_APPEND_OFFSET":          11,
 	"INDEX_HAS_GREATER_OFFSET":     12,
 	"REGISTER_MISMATCH":            13,
+	"INVALID_FRAMING":              14,
+	"SCHEMA_VIOLATION":             15,
+	"INCOMPATIBLE_SCHEMA":          16,
+	"OFFSET_TRUNCATED":             17,
 }
 
 func (x Status) String() string {
@@ -153,6 +176,30 @@ const (
 	// header handling can be subtle and sometimes confusing. It uses the default
 	// suffix ".gzod".
 	CompressionCodec_GZIP_OFFLOAD_DECOMPRESSION CompressionCodec = 5
+	// ZSTANDARD_SEEKABLE encodes Fragments using the ZStandard seekable format:
+	// content is compressed as a sequence of independent ZStandard frames,
+	// followed by a seek table which maps content offsets to frames. Readers
+	// of a Fragment may then begin decompression with the frame covering a
+	// desired offset, rather than at the beginning of the Fragment. Encoded
+	// Fragments remain readable by any ZStandard decoder. It uses the default
+	// suffix ".zsts".
+	CompressionCodec_ZSTANDARD_SEEKABLE CompressionCodec = 6
+	// LZ4 encodes Fragments using the LZ4 frame format, with default suffix
+	// ".lz4". LZ4 offers very fast compression and decompression at a modest
+	// compression ratio, and is well suited to high-throughput journals.
+	CompressionCodec_LZ4 CompressionCodec = 7
+	// BROTLI encodes Fragments using the Brotli format, with default suffix
+	// ".br". Brotli compresses slowly but achieves a high compression ratio,
+	// and is well suited to archival journals.
+	CompressionCodec_BROTLI CompressionCodec = 8
+	// ZSTANDARD_DICT encodes Fragments using the ZStandard library and a
+	// dictionary trained from the Journal's content, with default suffix ".zsd".
+	// Dictionaries substantially improve the compression of Journals having
+	// many small and similar messages. The dictionary is named by the
+	// JournalSpec, and each Fragment records the dictionary it was compressed
+	// with, which is stored alongside Fragments in their fragment store.
+	// See "gazctl journals train-dict --help" for more discussion.
+	CompressionCodec_ZSTANDARD_DICT CompressionCodec = 9
 )
 
 var CompressionCodec_name = map[int32]string{
@@ -162,6 +209,10 @@ var CompressionCodec_name = map[int32]string{
 	3: "ZSTANDARD",
 	4: "SNAPPY",
 	5: "GZIP_OFFLOAD_DECOMPRESSION",
+	6: "ZSTANDARD_SEEKABLE",
+	7: "LZ4",
+	8: "BROTLI",
+	9: "ZSTANDARD_DICT",
 }
 
 var CompressionCodec_value = map[string]int32{
@@ -171,6 +222,10 @@ var CompressionCodec_value = map[string]int32{
 	"ZSTANDARD":                  3,
 	"SNAPPY":                     4,
 	"GZIP_OFFLOAD_DECOMPRESSION": 5,
+	"ZSTANDARD_SEEKABLE":         6,
+	"LZ4":                        7,
+	"BROTLI":                     8,
+	"ZSTANDARD_DICT":             9,
 }
 
 func (x CompressionCodec) String() string {
@@ -220,6 +275,111 @@ func (JournalSpec_Flag) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_0c0999e5af553218, []int{3, 0}
 }
 
+type JournalSpec_Suspend_Level int32
+
+const (
+	// The journal is not suspended.
+	JournalSpec_Suspend_NONE JournalSpec_Suspend_Level = 0
+	// The journal is fully suspended, and has no broker assignments.
+	JournalSpec_Suspend_FULL JournalSpec_Suspend_Level = 1
+)
+
+var JournalSpec_Suspend_Level_name = map[int32]string{
+	0: "NONE",
+	1: "FULL",
+}
+
+var JournalSpec_Suspend_Level_value = map[string]int32{
+	"NONE": 0,
+	"FULL": 1,
+}
+
+func (x JournalSpec_Suspend_Level) String() string {
+	return proto.EnumName(JournalSpec_Suspend_Level_name, int32(x))
+}
+
+func (JournalSpec_Suspend_Level) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{3, 1, 0}
+}
+
+// Type of a schema.
+type SchemaSpec_Type int32
+
+const (
+	// INVALID_TYPE is the zero-valued Type, and is not a valid type.
+	SchemaSpec_INVALID_TYPE SchemaSpec_Type = 0
+	// JSON_SCHEMA is a JSON Schema document, which validates JSON messages.
+	// A subset of JSON Schema is supported: the "type", "properties",
+	// "required", "additionalProperties", "items", "enum", "minimum",
+	// "maximum", "minLength", and "maxLength" keywords, as well as
+	// annotations like "title" and "description". Schemas which use other
+	// keywords (such as "$ref", "oneOf", "anyOf", "pattern" or "format")
+	// are rejected when applied.
+	SchemaSpec_JSON_SCHEMA SchemaSpec_Type = 1
+	// PROTOBUF is a serialized google.protobuf.FileDescriptorSet (as produced
+	// by `protoc --include_imports --descriptor_set_out`), which validates
+	// protobuf messages of the named |message| type.
+	SchemaSpec_PROTOBUF SchemaSpec_Type = 2
+)
+
+var SchemaSpec_Type_name = map[int32]string{
+	0: "INVALID_TYPE",
+	1: "JSON_SCHEMA",
+	2: "PROTOBUF",
+}
+
+var SchemaSpec_Type_value = map[string]int32{
+	"INVALID_TYPE": 0,
+	"JSON_SCHEMA":  1,
+	"PROTOBUF":     2,
+}
+
+func (x SchemaSpec_Type) String() string {
+	return proto.EnumName(SchemaSpec_Type_name, int32(x))
+}
+
+func (SchemaSpec_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{25, 0}
+}
+
+// Compatibility rules which are checked as a schema is updated.
+type SchemaSpec_Compatibility int32
+
+const (
+	// No compatibility is required of updates.
+	SchemaSpec_NONE SchemaSpec_Compatibility = 0
+	// An update must be able to read messages of the prior schema:
+	// every message valid under the prior schema is valid under the update.
+	SchemaSpec_BACKWARD SchemaSpec_Compatibility = 1
+	// The prior schema must be able to read messages of an update:
+	// every message valid under the update is valid under the prior schema.
+	SchemaSpec_FORWARD SchemaSpec_Compatibility = 2
+	// An update must be both BACKWARD and FORWARD compatible.
+	SchemaSpec_FULL SchemaSpec_Compatibility = 3
+)
+
+var SchemaSpec_Compatibility_name = map[int32]string{
+	0: "NONE",
+	1: "BACKWARD",
+	2: "FORWARD",
+	3: "FULL",
+}
+
+var SchemaSpec_Compatibility_value = map[string]int32{
+	"NONE":     0,
+	"BACKWARD": 1,
+	"FORWARD":  2,
+	"FULL":     3,
+}
+
+func (x SchemaSpec_Compatibility) String() string {
+	return proto.EnumName(SchemaSpec_Compatibility_name, int32(x))
+}
+
+func (SchemaSpec_Compatibility) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{25, 1}
+}
+
 // Label defines a key & value pair which can be attached to entities like
 // JournalSpecs and BrokerSpecs. Labels may be used to provide identifying
 // attributes which do not directly imply semantics to the core system, but
@@ -365,6 +525,45 @@ type JournalSpec struct {
 	// rate limit still may be in effect, in which case the effective rate is the
 	// smaller of the journal vs global rate.
 	MaxAppendRate int64 `protobuf:"varint,7,opt,name=max_append_rate,json=maxAppendRate,proto3" json:"max_append_rate,omitempty" yaml:"max_append_rate,omitempty"`
+	// Minimum readable offset of the journal. Reads of offsets below min_offset
+	// are not served, and Fragments which are wholly below min_offset may be
+	// pruned from backing stores regardless of their retention. min_offset is
+	// advanced by the Truncate RPC, and is never decreased by an Apply.
+	MinOffset Offset `protobuf:"varint,8,opt,name=min_offset,json=minOffset,proto3,casttype=Offset" json:"min_offset,omitempty" yaml:"min_offset,omitempty"`
+	// Suspend is managed by brokers. It's ignored by Apply, which retains the
+	// current Suspend of the journal.
+	Suspend JournalSpec_Suspend `protobuf:"bytes,9,opt,name=suspend,proto3" json:"suspend" yaml:",omitempty"`
+	// Quorum commit, if true, commits each append once a majority of the
+	// journal's replicas (including the primary) have acknowledged it, rather
+	// than requiring acknowledgement from every replica. A slow or stalled
+	// replica then doesn't stall appends of the journal, and instead catches up
+	// asynchronously. A replica which falls too far behind, or which fails, is
+	// dropped from the replication pipeline so long as a majority remains, and
+	// rejoins when the pipeline is next re-built.
+	//
+	// Note that a quorum commit is durable only so long as a majority of
+	// replicas remain available until its Fragment is persisted. Journals with
+	// a replication of one or two always require every replica.
+	QuorumCommit bool `protobuf:"varint,10,opt,name=quorum_commit,json=quorumCommit,proto3" json:"quorum_commit,omitempty" yaml:"quorum_commit,omitempty"`
+	// Validate framing, if true, causes brokers to validate that appended
+	// content is a well-formed sequence of message frames of the framing named
+	// by the journal's "content-type" label. Appends having malformed frames,
+	// or which end with a partial frame, are refused with status
+	// INVALID_FRAMING before their content is replicated. JSON lines are
+	// verified to be complete JSON documents, and fixed protobuf frames are
+	// verified to have well-formed headers (their messages are not decoded).
+	// Other content types are not supported, and appends to journals having
+	// them are refused.
+	ValidateFraming bool `protobuf:"varint,11,opt,name=validate_framing,json=validateFraming,proto3" json:"validate_framing,omitempty" yaml:"validate_framing,omitempty"`
+	// Validate schema, if true, causes brokers to validate each message of
+	// appended content against the registered schema named by the journal's
+	// "app.gazette.dev/message-type" label. It implies validate_framing.
+	// Appends having a non-conforming message, or to a journal whose schema
+	// isn't registered, are refused with status SCHEMA_VIOLATION before their
+	// content is replicated. Messages of JSON lines journals are validated
+	// against a JSON_SCHEMA, and messages of fixed protobuf journals against a
+	// PROTOBUF schema.
+	ValidateSchema bool `protobuf:"varint,12,opt,name=validate_schema,json=validateSchema,proto3" json:"validate_schema,omitempty" yaml:"validate_schema,omitempty"`
 }
 
 func (m *JournalSpec) Reset()         { *m = JournalSpec{} }
@@ -467,6 +666,60 @@ type JournalSpec_Fragment struct {
 	//
 	// Which will produce a path postfix like "date=2019-11-19/hour=22".
 	PathPostfixTemplate string `protobuf:"bytes,7,opt,name=path_postfix_template,json=pathPostfixTemplate,proto3" json:"path_postfix_template,omitempty" yaml:"path_postfix_template,omitempty"`
+	// Maximum total size of persisted Fragments of this Journal within the
+	// Fragment stores, in bytes of journal content (which may differ from
+	// the size of stored files, due to compression). Content covered by
+	// overlapping Fragments is counted once. When exceeded, the oldest
+	// Fragments are pruned by `gazctl journals prune` (or by the primary
+	// broker, if prune_oversize is set) until the total is under the limit.
+	// Fragments upon which the journal write head or reader_floor depends
+	// are never pruned. If zero, persisted Fragments are not limited by size.
+	MaxBytes int64 `protobuf:"varint,8,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty" yaml:"max_bytes,omitempty"`
+	// Maximum number of persisted Fragments of this Journal within the
+	// Fragment stores. When exceeded, the oldest Fragments are pruned
+	// as with max_bytes. If zero, the number of Fragments is not limited.
+	MaxFragments int64 `protobuf:"varint,9,opt,name=max_fragments,json=maxFragments,proto3" json:"max_fragments,omitempty" yaml:"max_fragments,omitempty"`
+	// Tier after defines age thresholds for the tiering of persisted Fragments
+	// across stores. A Fragment of stores[N] which is older than tier_after[N]
+	// is copied to stores[N+1] and then removed from stores[N]. For example,
+	// new Fragments may be persisted to a "hot" store and then tiered after
+	// a week to a "cold" store, with tier_after: [168h].
+	//
+	// A zero-valued threshold disables tiering of Fragments from its store,
+	// and tier_after must have fewer entries than stores. As the Journal's
+	// Fragments are the union of all stores, tiering is transparent to readers.
+	// See "gazctl journals tier --help" for more discussion.
+	TierAfter []time.Duration `protobuf:"bytes,10,rep,name=tier_after,json=tierAfter,proto3,stdduration" json:"tier_after" yaml:"tier_after,omitempty"`
+	// Encryption key names a key of the brokers' KMS, under which persisted
+	// Fragments are envelope-encrypted. Each Fragment is encrypted with its
+	// own data key, which is wrapped by the current version of the named key
+	// and stored with the Fragment. If empty, Fragments are not encrypted.
+	//
+	// Encrypted Fragments remain readable after the key is rotated or the
+	// encryption key is changed, so long as the KMS retains the key versions
+	// which wrapped their data keys. Clients which directly read Fragments
+	// from their stores require a KMS holding the same keys, and
+	// GZIP_OFFLOAD_DECOMPRESSION may not be used with encryption.
+	EncryptionKey string `protobuf:"bytes,11,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty" yaml:"encryption_key,omitempty"`
+	// Compression dictionary is the content-addressed name of the dictionary
+	// used to compress Fragments, and is required by (and only permitted with)
+	// the ZSTANDARD_DICT compression codec. Dictionaries are persisted to the
+	// Journal's first store, and are copied alongside Fragments as they're
+	// tiered to other stores. Dictionaries must be retained for as long as
+	// Fragments compressed with them exist.
+	CompressionDictionary string `protobuf:"bytes,12,opt,name=compression_dictionary,json=compressionDictionary,proto3" json:"compression_dictionary,omitempty" yaml:"compression_dictionary,omitempty"`
+	// Reader floor is the offset below which readers of the Journal no longer
+	// require its content, as might be maintained by an application to protect
+	// content not yet read by its consumers. Fragments which include content
+	// at or beyond the reader floor are never pruned for exceeding max_bytes
+	// or max_fragments. If zero, no reader floor is configured.
+	ReaderFloor int64 `protobuf:"varint,13,opt,name=reader_floor,json=readerFloor,proto3" json:"reader_floor,omitempty" yaml:"reader_floor,omitempty"`
+	// Prune oversize opts the Journal into pruning by brokers of Fragments
+	// which exceed max_bytes or max_fragments. If set, the primary broker of
+	// the Journal removes such Fragments from the Fragment stores as it
+	// refreshes its listing of the stores. Otherwise, these limits are
+	// enforced only by `gazctl journals prune`.
+	PruneOversize bool `protobuf:"varint,14,opt,name=prune_oversize,json=pruneOversize,proto3" json:"prune_oversize,omitempty" yaml:"prune_oversize,omitempty"`
 }
 
 func (m *JournalSpec_Fragment) Reset()         { *m = JournalSpec_Fragment{} }
@@ -502,6 +755,52 @@ func (m *JournalSpec_Fragment) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_JournalSpec_Fragment proto.InternalMessageInfo
 
+// Suspend records the suspension state of a journal. A journal which has
+// been idle for a configured period is suspended by its primary broker:
+// its content is persisted to its fragment stores, its broker assignments
+// are released, and its write head is recorded. A suspended journal is
+// transparently resumed by its next Append or Read.
+type JournalSpec_Suspend struct {
+	// Level of the journal's suspension.
+	Level JournalSpec_Suspend_Level `protobuf:"varint,1,opt,name=level,proto3,enum=protocol.JournalSpec_Suspend_Level" json:"level,omitempty" yaml:",omitempty"`
+	// Write head of the journal as of its suspension. When the journal is
+	// resumed, appends continue from this offset.
+	Offset Offset `protobuf:"varint,2,opt,name=offset,proto3,casttype=Offset" json:"offset,omitempty" yaml:",omitempty"`
+}
+
+func (m *JournalSpec_Suspend) Reset()         { *m = JournalSpec_Suspend{} }
+func (m *JournalSpec_Suspend) String() string { return proto.CompactTextString(m) }
+func (*JournalSpec_Suspend) ProtoMessage()    {}
+func (*JournalSpec_Suspend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{3, 1}
+}
+func (m *JournalSpec_Suspend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JournalSpec_Suspend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JournalSpec_Suspend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JournalSpec_Suspend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JournalSpec_Suspend.Merge(m, src)
+}
+func (m *JournalSpec_Suspend) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *JournalSpec_Suspend) XXX_DiscardUnknown() {
+	xxx_messageInfo_JournalSpec_Suspend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JournalSpec_Suspend proto.InternalMessageInfo
+
 // ProcessSpec describes a uniquely identified process and its addressable
 // endpoint.
 type ProcessSpec struct {
@@ -670,6 +969,11 @@ type Fragment struct {
 	// The complete Fragment store path is built from any path components of the
 	// backing store, followed by the journal name, followed by the path postfix.
 	PathPostfix string `protobuf:"bytes,8,opt,name=path_postfix,json=pathPostfix,proto3" json:"path_postfix,omitempty"`
+	// Whether the Fragment is envelope-encrypted within its backing store.
+	Encrypted bool `protobuf:"varint,9,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
+	// Content-addressed name of the dictionary with which the Fragment's
+	// content is compressed, if the compression codec uses one.
+	CompressionDictionary string `protobuf:"bytes,10,opt,name=compression_dictionary,json=compressionDictionary,proto3" json:"compression_dictionary,omitempty"`
 }
 
 func (m *Fragment) Reset()         { *m = Fragment{} }
@@ -770,6 +1074,14 @@ type ReadRequest struct {
 	MetadataOnly bool `protobuf:"varint,6,opt,name=metadata_only,json=metadataOnly,proto3" json:"metadata_only,omitempty"`
 	// Offset to read through. If zero, then the read end offset is unconstrained.
 	EndOffset Offset `protobuf:"varint,7,opt,name=end_offset,json=endOffset,proto3,casttype=Offset" json:"end_offset,omitempty"`
+	// BeginModTime is an optional inclusive lower bound on the modification
+	// timestamps of Fragments to be read, represented as seconds since the epoch.
+	// The read Offset is advanced as needed to skip over persisted Fragments
+	// having a modification time before the bound. As a Fragment is persisted
+	// shortly after its last append, this allows a reader to begin reading from
+	// (approximately) a point in time. Fragments which are not yet persisted
+	// are instead skipped if their last append was before the bound.
+	BeginModTime int64 `protobuf:"varint,8,opt,name=begin_mod_time,json=beginModTime,proto3" json:"begin_mod_time,omitempty"`
 }
 
 func (m *ReadRequest) Reset()         { *m = ReadRequest{} }
@@ -838,6 +1150,9 @@ type ReadResponse struct {
 	FragmentUrl string `protobuf:"bytes,6,opt,name=fragment_url,json=fragmentUrl,proto3" json:"fragment_url,omitempty"`
 	// Content chunks of the read.
 	Content []byte `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
+	// If Fragment is remote and compressed with a dictionary, a URL from which
+	// its compression dictionary may be directly read.
+	DictionaryUrl string `protobuf:"bytes,8,opt,name=dictionary_url,json=dictionaryUrl,proto3" json:"dictionary_url,omitempty"`
 }
 
 func (m *ReadResponse) Reset()         { *m = ReadResponse{} }
@@ -1266,16 +1581,69 @@ func (m *ListResponse_Journal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListResponse_Journal proto.InternalMessageInfo
 
+// WatchResponse is the streamed response message of the broker Watch RPC.
+// The first WatchResponse of a Watch is a snapshot of all journals matching
+// the ListRequest, and subsequent WatchResponses are incremental changes to
+// that set.
+type WatchResponse struct {
+	// Status of the Watch RPC.
+	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=protocol.Status" json:"status,omitempty"`
+	// Header of the response.
+	Header Header `protobuf:"bytes,2,opt,name=header,proto3" json:"header"`
+	// Journals which were created or updated (including updates of their
+	// Routes), or which began to match the ListRequest selector.
+	Upserts []ListResponse_Journal `protobuf:"bytes,3,rep,name=upserts,proto3" json:"upserts"`
+	// Journals which were deleted, or which no longer match the selector.
+	Deletes []Journal `protobuf:"bytes,4,rep,name=deletes,proto3,casttype=Journal" json:"deletes,omitempty"`
+}
+
+func (m *WatchResponse) Reset()         { *m = WatchResponse{} }
+func (m *WatchResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchResponse) ProtoMessage()    {}
+func (*WatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{16}
+}
+func (m *WatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchResponse.Merge(m, src)
+}
+func (m *WatchResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *WatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchResponse proto.InternalMessageInfo
+
 // ApplyRequest is the unary request message of the broker Apply RPC.
 type ApplyRequest struct {
 	Changes []ApplyRequest_Change `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	// If dry_run is true, changes are validated and their expected ModRevisions
+	// are checked, but changes are not committed. The response Status reflects
+	// whether the changes would have applied.
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (m *ApplyRequest) Reset()         { *m = ApplyRequest{} }
 func (m *ApplyRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplyRequest) ProtoMessage()    {}
 func (*ApplyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c0999e5af553218, []int{16}
+	return fileDescriptor_0c0999e5af553218, []int{17}
 }
 func (m *ApplyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1321,7 +1689,7 @@ func (m *ApplyRequest_Change) Reset()         { *m = ApplyRequest_Change{} }
 func (m *ApplyRequest_Change) String() string { return proto.CompactTextString(m) }
 func (*ApplyRequest_Change) ProtoMessage()    {}
 func (*ApplyRequest_Change) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c0999e5af553218, []int{16, 0}
+	return fileDescriptor_0c0999e5af553218, []int{17, 0}
 }
 func (m *ApplyRequest_Change) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1362,7 +1730,7 @@ func (m *ApplyResponse) Reset()         { *m = ApplyResponse{} }
 func (m *ApplyResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplyResponse) ProtoMessage()    {}
 func (*ApplyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c0999e5af553218, []int{17}
+	return fileDescriptor_0c0999e5af553218, []int{18}
 }
 func (m *ApplyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1418,13 +1786,17 @@ type FragmentsRequest struct {
 	// If do_not_proxy is true, the broker will not proxy the request to another
 	// broker on the client's behalf.
 	DoNotProxy bool `protobuf:"varint,8,opt,name=do_not_proxy,json=doNotProxy,proto3" json:"do_not_proxy,omitempty"`
+	// Fragments which lie wholly below the minimum readable offset of the
+	// journal are omitted, unless include_truncated is true. Their content is
+	// no longer readable, and they're listed only to be pruned.
+	IncludeTruncated bool `protobuf:"varint,9,opt,name=include_truncated,json=includeTruncated,proto3" json:"include_truncated,omitempty"`
 }
 
 func (m *FragmentsRequest) Reset()         { *m = FragmentsRequest{} }
 func (m *FragmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*FragmentsRequest) ProtoMessage()    {}
 func (*FragmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c0999e5af553218, []int{18}
+	return fileDescriptor_0c0999e5af553218, []int{19}
 }
 func (m *FragmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1471,7 +1843,7 @@ func (m *FragmentsResponse) Reset()         { *m = FragmentsResponse{} }
 func (m *FragmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*FragmentsResponse) ProtoMessage()    {}
 func (*FragmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c0999e5af553218, []int{19}
+	return fileDescriptor_0c0999e5af553218, []int{20}
 }
 func (m *FragmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1513,7 +1885,7 @@ func (m *FragmentsResponse__Fragment) Reset()         { *m = FragmentsResponse__
 func (m *FragmentsResponse__Fragment) String() string { return proto.CompactTextString(m) }
 func (*FragmentsResponse__Fragment) ProtoMessage()    {}
 func (*FragmentsResponse__Fragment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c0999e5af553218, []int{19, 0}
+	return fileDescriptor_0c0999e5af553218, []int{20, 0}
 }
 func (m *FragmentsResponse__Fragment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1542,30 +1914,31 @@ func (m *FragmentsResponse__Fragment) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FragmentsResponse__Fragment proto.InternalMessageInfo
 
-// Route captures the current topology of an item and the processes serving it.
-type Route struct {
-	// Members of the Route, ordered on ascending ProcessSpec.ID (zone, suffix).
-	Members []ProcessSpec_ID `protobuf:"bytes,1,rep,name=members,proto3" json:"members"`
-	// Index of the ProcessSpec serving as primary within |members|,
-	// or -1 of no member is currently primary.
-	Primary int32 `protobuf:"varint,2,opt,name=primary,proto3" json:"primary,omitempty"`
-	// Endpoints of each Route member. If not empty, |endpoints| has the same
-	// length and order as |members|, and captures the endpoint of each one.
-	Endpoints []Endpoint `protobuf:"bytes,3,rep,name=endpoints,proto3,casttype=Endpoint" json:"endpoints,omitempty"`
+// TruncateRequest is the unary request message of the broker Truncate RPC.
+type TruncateRequest struct {
+	// Header is attached by a proxying broker peer.
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// Journal to be truncated.
+	Journal Journal `protobuf:"bytes,2,opt,name=journal,proto3,casttype=Journal" json:"journal,omitempty"`
+	// Offset which becomes the minimum readable offset of the journal. Content
+	// below |offset| is no longer served, and Fragments wholly below |offset|
+	// become eligible for pruning. Offset may not exceed the journal's current
+	// write head.
+	Offset Offset `protobuf:"varint,3,opt,name=offset,proto3,casttype=Offset" json:"offset,omitempty"`
 }
 
-func (m *Route) Reset()         { *m = Route{} }
-func (m *Route) String() string { return proto.CompactTextString(m) }
-func (*Route) ProtoMessage()    {}
-func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c0999e5af553218, []int{20}
+func (m *TruncateRequest) Reset()         { *m = TruncateRequest{} }
+func (m *TruncateRequest) String() string { return proto.CompactTextString(m) }
+func (*TruncateRequest) ProtoMessage()    {}
+func (*TruncateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{21}
 }
-func (m *Route) XXX_Unmarshal(b []byte) error {
+func (m *TruncateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TruncateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TruncateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1575,43 +1948,41 @@ func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Route) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Route.Merge(m, src)
+func (m *TruncateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateRequest.Merge(m, src)
 }
-func (m *Route) XXX_Size() int {
+func (m *TruncateRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *Route) XXX_DiscardUnknown() {
-	xxx_messageInfo_Route.DiscardUnknown(m)
+func (m *TruncateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Route proto.InternalMessageInfo
+var xxx_messageInfo_TruncateRequest proto.InternalMessageInfo
 
-// Header captures metadata such as the process responsible for processing
-// an RPC, and its effective Etcd state.
-type Header struct {
-	// ID of the process responsible for request processing. May be empty iff
-	// Header is being used within a proxied request, and that request may be
-	// dispatched to any member of the Route.
-	ProcessId ProcessSpec_ID `protobuf:"bytes,1,opt,name=process_id,json=processId,proto3" json:"process_id"`
-	// Route of processes specifically responsible for this RPC, or an empty Route
-	// if any process is capable of serving the RPC.
-	Route Route       `protobuf:"bytes,2,opt,name=route,proto3" json:"route"`
-	Etcd  Header_Etcd `protobuf:"bytes,3,opt,name=etcd,proto3" json:"etcd"`
+// TruncateResponse is the unary response message of the broker Truncate RPC.
+type TruncateResponse struct {
+	// Status of the Truncate RPC.
+	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=protocol.Status" json:"status,omitempty"`
+	// Header of the response.
+	Header Header `protobuf:"bytes,2,opt,name=header,proto3" json:"header"`
+	// Effective minimum readable offset of the journal. It may be larger than
+	// the requested offset, if the journal was previously truncated further.
+	Offset Offset `protobuf:"varint,3,opt,name=offset,proto3,casttype=Offset" json:"offset,omitempty"`
 }
 
-func (m *Header) Reset()         { *m = Header{} }
-func (m *Header) String() string { return proto.CompactTextString(m) }
-func (*Header) ProtoMessage()    {}
-func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c0999e5af553218, []int{21}
+func (m *TruncateResponse) Reset()         { *m = TruncateResponse{} }
+func (m *TruncateResponse) String() string { return proto.CompactTextString(m) }
+func (*TruncateResponse) ProtoMessage()    {}
+func (*TruncateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{22}
 }
-func (m *Header) XXX_Unmarshal(b []byte) error {
+func (m *TruncateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TruncateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Header.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TruncateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1621,50 +1992,41 @@ func (m *Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Header) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Header.Merge(m, src)
+func (m *TruncateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateResponse.Merge(m, src)
 }
-func (m *Header) XXX_Size() int {
+func (m *TruncateResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *Header) XXX_DiscardUnknown() {
-	xxx_messageInfo_Header.DiscardUnknown(m)
+func (m *TruncateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Header proto.InternalMessageInfo
+var xxx_messageInfo_TruncateResponse proto.InternalMessageInfo
 
-// Etcd represents the effective Etcd MVCC state under which a Gazette broker
-// is operating in its processing of requests and responses. Its inclusion
-// allows brokers to reason about relative "happened before" Revision ordering
-// of apparent routing conflicts in proxied or replicated requests, as well
-// as enabling sanity checks over equality of Etcd ClusterId (and precluding,
-// for example, split-brain scenarios where different brokers are backed by
-// different Etcd clusters). Etcd is kept in sync with
-// etcdserverpb.ResponseHeader.
-type Header_Etcd struct {
-	// cluster_id is the ID of the cluster.
-	ClusterId uint64 `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
-	// member_id is the ID of the member.
-	MemberId uint64 `protobuf:"varint,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
-	// revision is the Etcd key-value store revision when the request was
-	// applied.
-	Revision int64 `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
-	// raft_term is the raft term when the request was applied.
-	RaftTerm uint64 `protobuf:"varint,4,opt,name=raft_term,json=raftTerm,proto3" json:"raft_term,omitempty"`
+// StatRequest is the unary request message of the broker Stat RPC.
+type StatRequest struct {
+	// Header is attached by a proxying broker peer.
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// Journal to be inspected.
+	Journal Journal `protobuf:"bytes,2,opt,name=journal,proto3,casttype=Journal" json:"journal,omitempty"`
+	// If do_not_proxy is true, the broker will not proxy the request to another
+	// broker on the client's behalf.
+	DoNotProxy bool `protobuf:"varint,3,opt,name=do_not_proxy,json=doNotProxy,proto3" json:"do_not_proxy,omitempty"`
 }
 
-func (m *Header_Etcd) Reset()         { *m = Header_Etcd{} }
-func (m *Header_Etcd) String() string { return proto.CompactTextString(m) }
-func (*Header_Etcd) ProtoMessage()    {}
-func (*Header_Etcd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c0999e5af553218, []int{21, 0}
+func (m *StatRequest) Reset()         { *m = StatRequest{} }
+func (m *StatRequest) String() string { return proto.CompactTextString(m) }
+func (*StatRequest) ProtoMessage()    {}
+func (*StatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{23}
 }
-func (m *Header_Etcd) XXX_Unmarshal(b []byte) error {
+func (m *StatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Header_Etcd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Header_Etcd.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StatRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1674,2370 +2036,2207 @@ func (m *Header_Etcd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *Header_Etcd) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Header_Etcd.Merge(m, src)
+func (m *StatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatRequest.Merge(m, src)
 }
-func (m *Header_Etcd) XXX_Size() int {
+func (m *StatRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *Header_Etcd) XXX_DiscardUnknown() {
-	xxx_messageInfo_Header_Etcd.DiscardUnknown(m)
+func (m *StatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Header_Etcd proto.InternalMessageInfo
+var xxx_messageInfo_StatRequest proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("protocol.Status", Status_name, Status_value)
-	golang_proto.RegisterEnum("protocol.Status", Status_name, Status_value)
-	proto.RegisterEnum("protocol.CompressionCodec", CompressionCodec_name, CompressionCodec_value)
-	golang_proto.RegisterEnum("protocol.CompressionCodec", CompressionCodec_name, CompressionCodec_value)
-	proto.RegisterEnum("protocol.JournalSpec_Flag", JournalSpec_Flag_name, JournalSpec_Flag_value)
-	golang_proto.RegisterEnum("protocol.JournalSpec_Flag", JournalSpec_Flag_name, JournalSpec_Flag_value)
-	proto.RegisterType((*Label)(nil), "protocol.Label")
-	golang_proto.RegisterType((*Label)(nil), "protocol.Label")
-	proto.RegisterType((*LabelSet)(nil), "protocol.LabelSet")
-	golang_proto.RegisterType((*LabelSet)(nil), "protocol.LabelSet")
-	proto.RegisterType((*LabelSelector)(nil), "protocol.LabelSelector")
-	golang_proto.RegisterType((*LabelSelector)(nil), "protocol.LabelSelector")
-	proto.RegisterType((*JournalSpec)(nil), "protocol.JournalSpec")
-	golang_proto.RegisterType((*JournalSpec)(nil), "protocol.JournalSpec")
-	proto.RegisterType((*JournalSpec_Fragment)(nil), "protocol.JournalSpec.Fragment")
-	golang_proto.RegisterType((*JournalSpec_Fragment)(nil), "protocol.JournalSpec.Fragment")
-	proto.RegisterType((*ProcessSpec)(nil), "protocol.ProcessSpec")
-	golang_proto.RegisterType((*ProcessSpec)(nil), "protocol.ProcessSpec")
-	proto.RegisterType((*ProcessSpec_ID)(nil), "protocol.ProcessSpec.ID")
-	golang_proto.RegisterType((*ProcessSpec_ID)(nil), "protocol.ProcessSpec.ID")
-	proto.RegisterType((*BrokerSpec)(nil), "protocol.BrokerSpec")
-	golang_proto.RegisterType((*BrokerSpec)(nil), "protocol.BrokerSpec")
-	proto.RegisterType((*Fragment)(nil), "protocol.Fragment")
-	golang_proto.RegisterType((*Fragment)(nil), "protocol.Fragment")
-	proto.RegisterType((*SHA1Sum)(nil), "protocol.SHA1Sum")
-	golang_proto.RegisterType((*SHA1Sum)(nil), "protocol.SHA1Sum")
-	proto.RegisterType((*ReadRequest)(nil), "protocol.ReadRequest")
-	golang_proto.RegisterType((*ReadRequest)(nil), "protocol.ReadRequest")
-	proto.RegisterType((*ReadResponse)(nil), "protocol.ReadResponse")
-	golang_proto.RegisterType((*ReadResponse)(nil), "protocol.ReadResponse")
-	proto.RegisterType((*AppendRequest)(nil), "protocol.AppendRequest")
-	golang_proto.RegisterType((*AppendRequest)(nil), "protocol.AppendRequest")
-	proto.RegisterType((*AppendResponse)(nil), "protocol.AppendResponse")
-	golang_proto.RegisterType((*AppendResponse)(nil), "protocol.AppendResponse")
-	proto.RegisterType((*ReplicateRequest)(nil), "protocol.ReplicateRequest")
-	golang_proto.RegisterType((*ReplicateRequest)(nil), "protocol.ReplicateRequest")
-	proto.RegisterType((*ReplicateResponse)(nil), "protocol.ReplicateResponse")
-	golang_proto.RegisterType((*ReplicateResponse)(nil), "protocol.ReplicateResponse")
-	proto.RegisterType((*ListRequest)(nil), "protocol.ListRequest")
-	golang_proto.RegisterType((*ListRequest)(nil), "protocol.ListRequest")
-	proto.RegisterType((*ListResponse)(nil), "protocol.ListResponse")
-	golang_proto.RegisterType((*ListResponse)(nil), "protocol.ListResponse")
-	proto.RegisterType((*ListResponse_Journal)(nil), "protocol.ListResponse.Journal")
-	golang_proto.RegisterType((*ListResponse_Journal)(nil), "protocol.ListResponse.Journal")
-	proto.RegisterType((*ApplyRequest)(nil), "protocol.ApplyRequest")
-	golang_proto.RegisterType((*ApplyRequest)(nil), "protocol.ApplyRequest")
-	proto.RegisterType((*ApplyRequest_Change)(nil), "protocol.ApplyRequest.Change")
-	golang_proto.RegisterType((*ApplyRequest_Change)(nil), "protocol.ApplyRequest.Change")
-	proto.RegisterType((*ApplyResponse)(nil), "protocol.ApplyResponse")
-	golang_proto.RegisterType((*ApplyResponse)(nil), "protocol.ApplyResponse")
-	proto.RegisterType((*FragmentsRequest)(nil), "protocol.FragmentsRequest")
-	golang_proto.RegisterType((*FragmentsRequest)(nil), "protocol.FragmentsRequest")
-	proto.RegisterType((*FragmentsResponse)(nil), "protocol.FragmentsResponse")
-	golang_proto.RegisterType((*FragmentsResponse)(nil), "protocol.FragmentsResponse")
-	proto.RegisterType((*FragmentsResponse__Fragment)(nil), "protocol.FragmentsResponse._Fragment")
-	golang_proto.RegisterType((*FragmentsResponse__Fragment)(nil), "protocol.FragmentsResponse._Fragment")
-	proto.RegisterType((*Route)(nil), "protocol.Route")
-	golang_proto.RegisterType((*Route)(nil), "protocol.Route")
-	proto.RegisterType((*Header)(nil), "protocol.Header")
-	golang_proto.RegisterType((*Header)(nil), "protocol.Header")
-	proto.RegisterType((*Header_Etcd)(nil), "protocol.Header.Etcd")
-	golang_proto.RegisterType((*Header_Etcd)(nil), "protocol.Header.Etcd")
+// StatResponse is the unary response message of the broker Stat RPC.
+type StatResponse struct {
+	// Status of the Stat RPC.
+	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=protocol.Status" json:"status,omitempty"`
+	// Header of the response.
+	Header Header `protobuf:"bytes,2,opt,name=header,proto3" json:"header"`
+	// Write head of the journal, being the offset of its next append.
+	WriteHead Offset `protobuf:"varint,3,opt,name=write_head,json=writeHead,proto3,casttype=Offset" json:"write_head,omitempty"`
+	// Replicas of the journal, in the order of its Route members. The primary
+	// is always in sync. Peers are reported as not in sync if the primary
+	// doesn't currently have a replication pipeline, as is briefly the case
+	// after the journal is assigned or its pipeline fails.
+	Replicas []StatResponse_Replica `protobuf:"bytes,4,rep,name=replicas,proto3" json:"replicas"`
+	// Content bytes of the journal's current Fragment, which have been spooled
+	// by the primary but not yet persisted.
+	SpooledBytes int64 `protobuf:"varint,5,opt,name=spooled_bytes,json=spooledBytes,proto3" json:"spooled_bytes,omitempty"`
+	// Recent rate at which content has been appended to the journal, in bytes
+	// per second. The rate is an exponentially-weighted moving average over
+	// about the last ten seconds, as observed by the primary.
+	AppendRate int64 `protobuf:"varint,6,opt,name=append_rate,json=appendRate,proto3" json:"append_rate,omitempty"`
+	// Number of completed Fragments of the primary which are awaiting
+	// persistence to the journal's fragment store.
+	PersistBacklogFragments int64 `protobuf:"varint,7,opt,name=persist_backlog_fragments,json=persistBacklogFragments,proto3" json:"persist_backlog_fragments,omitempty"`
+	// Total content bytes of Fragments awaiting persistence.
+	PersistBacklogBytes int64 `protobuf:"varint,8,opt,name=persist_backlog_bytes,json=persistBacklogBytes,proto3" json:"persist_backlog_bytes,omitempty"`
+	// Last error encountered by the primary in persisting a Fragment of the
+	// journal, if any.
+	PersistError string `protobuf:"bytes,9,opt,name=persist_error,json=persistError,proto3" json:"persist_error,omitempty"`
+	// Time of |persist_error|, represented as seconds since the epoch.
+	PersistErrorTime int64 `protobuf:"varint,10,opt,name=persist_error_time,json=persistErrorTime,proto3" json:"persist_error_time,omitempty"`
+	// Specification of the journal. Unlike the List RPC, which requires the
+	// LIST capability, the specification is available to callers having READ
+	// capability, who may require its labels to interpret journal content.
+	Spec *JournalSpec `protobuf:"bytes,11,opt,name=spec,proto3" json:"spec,omitempty"`
+}
+
+func (m *StatResponse) Reset()         { *m = StatResponse{} }
+func (m *StatResponse) String() string { return proto.CompactTextString(m) }
+func (*StatResponse) ProtoMessage()    {}
+func (*StatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{24}
+}
+func (m *StatResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() { proto.RegisterFile("broker/protocol/protocol.proto", fileDescriptor_0c0999e5af553218) }
-func init() {
-	golang_proto.RegisterFile("broker/protocol/protocol.proto", fileDescriptor_0c0999e5af553218)
+func (m *StatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-var fileDescriptor_0c0999e5af553218 = []byte{
-	// 2569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4d, 0x70, 0xdb, 0xc6,
-	0x15, 0x16, 0x48, 0x90, 0x04, 0x1f, 0x49, 0x09, 0xda, 0xc4, 0xb6, 0xcc, 0xc4, 0xa2, 0xc2, 0xfc,
-	0x8c, 0xec, 0x24, 0x74, 0xa2, 0xb4, 0x49, 0xea, 0x4e, 0xda, 0x80, 0x22, 0x65, 0xd3, 0xa1, 0x49,
-	0xce, 0x92, 0x8a, 0xe3, 0x1c, 0x8a, 0x81, 0x80, 0x15, 0x85, 0x0a, 0x04, 0x50, 0x00, 0x74, 0xa4,
-	0xdc, 0x72, 0x69, 0x33, 0x99, 0x74, 0xa6, 0xd3, 0x53, 0x4e, 0x9d, 0x5c, 0x7a, 0xee, 0xbd, 0x9d,
-	0xce, 0xf4, 0xe8, 0xde, 0x72, 0xec, 0x4c, 0x5b, 0x75, 0x1a, 0x5f, 0x7a, 0xf6, 0x31, 0xa7, 0xce,
-	0xfe, 0x80, 0x84, 0x28, 0xd2, 0xb2, 0x0f, 0xbe, 0x68, 0xb0, 0xef, 0x6f, 0xdf, 0xbe, 0xf7, 0xf6,
-	0xdb, 0xf7, 0x28, 0x58, 0xdf, 0x0b, 0xbc, 0x43, 0x12, 0x5c, 0xf7, 0x03, 0x2f, 0xf2, 0x4c, 0xcf,
-	0x99, 0x7c, 0xd4, 0xd8, 0x07, 0x52, 0xe2, 0x75, 0xf9, 0xf9, 0xa1, 0x37, 0xf4, 0xd8, 0xea, 0x3a,
-	0xfd, 0xe2, 0xfc, 0xf2, 0xfa, 0xd0, 0xf3, 0x86, 0x0e, 0xe1, 0x6a, 0x7b, 0xe3, 0xfd, 0xeb, 0xd6,
-	0x38, 0x30, 0x22, 0xdb, 0x73, 0x39, 0xbf, 0xfa, 0x1e, 0x64, 0xda, 0xc6, 0x1e, 0x71, 0x10, 0x02,
-	0xd9, 0x35, 0x46, 0x64, 0x4d, 0xda, 0x90, 0x36, 0xf3, 0x98, 0x7d, 0xa3, 0xe7, 0x21, 0x73, 0xdf,
-	0x70, 0xc6, 0x64, 0x2d, 0xc5, 0x88, 0x7c, 0x71, 0x43, 0xfe, 0xdf, 0xb7, 0x15, 0xa9, 0x3a, 0x00,
-	0x85, 0x29, 0xf6, 0x49, 0x84, 0xea, 0x90, 0x75, 0xe8, 0x77, 0xb8, 0x26, 0x6d, 0xa4, 0x37, 0x0b,
-	0x5b, 0x2b, 0xb5, 0x89, 0x97, 0x4c, 0xa6, 0x7e, 0xf9, 0xc1, 0x49, 0x65, 0xe9, 0xd1, 0x49, 0x65,
-	0xf5, 0xd8, 0x18, 0x39, 0x37, 0xaa, 0x6f, 0x78, 0x23, 0x3b, 0x22, 0x23, 0x3f, 0x3a, 0xae, 0x62,
-	0xa1, 0x29, 0xac, 0x7e, 0x21, 0x41, 0x49, 0x98, 0x75, 0x88, 0x19, 0x79, 0x01, 0xda, 0x82, 0x9c,
-	0xed, 0x9a, 0xce, 0xd8, 0xe2, 0xae, 0x15, 0xb6, 0xd0, 0x8c, 0xf1, 0x3e, 0x89, 0xea, 0x32, 0xb5,
-	0x8f, 0x63, 0x41, 0xaa, 0x43, 0x8e, 0xb8, 0x4e, 0xea, 0x3c, 0x1d, 0x21, 0x78, 0x43, 0xf9, 0xe6,
-	0xdb, 0xca, 0x12, 0xf3, 0xe1, 0xeb, 0x3c, 0x14, 0x6e, 0x7b, 0xe3, 0xc0, 0x35, 0x9c, 0xbe, 0x4f,
-	0x4c, 0xf4, 0xa3, 0x64, 0x64, 0xea, 0x1b, 0x73, 0x8f, 0xf1, 0xc3, 0x49, 0x25, 0x27, 0x74, 0x44,
-	0xec, 0xde, 0x83, 0x42, 0x40, 0x7c, 0xc7, 0x36, 0x59, 0xb4, 0x99, 0x1f, 0x99, 0xfa, 0x85, 0xf9,
-	0x31, 0x48, 0x4a, 0xa2, 0xde, 0x24, 0x98, 0xe9, 0x85, 0xbe, 0xbf, 0x42, 0x7d, 0xff, 0xee, 0xa4,
-	0x22, 0x3d, 0x3a, 0xa9, 0xac, 0xcd, 0xda, 0x7b, 0xc3, 0x76, 0x1d, 0xdb, 0x25, 0x93, 0xd0, 0xa2,
-	0x5d, 0x50, 0xf6, 0x03, 0x63, 0x38, 0x22, 0x6e, 0xb4, 0x26, 0x33, 0x9b, 0xeb, 0x53, 0x9b, 0x89,
-	0x93, 0xd6, 0x76, 0x84, 0xd4, 0xe3, 0xf2, 0x35, 0x31, 0x85, 0x7e, 0x0e, 0x99, 0x7d, 0xc7, 0x18,
-	0x86, 0x6b, 0xd9, 0x0d, 0x69, 0xb3, 0x54, 0xbf, 0xba, 0x28, 0x30, 0x6a, 0x62, 0x0b, 0x7d, 0xc7,
-	0x31, 0x86, 0x98, 0xeb, 0xa1, 0x36, 0xac, 0x8c, 0x8c, 0x23, 0xdd, 0xf0, 0x7d, 0xe2, 0x5a, 0x7a,
-	0x60, 0x44, 0x64, 0x2d, 0xb7, 0x21, 0x6d, 0xa6, 0xeb, 0xaf, 0x3c, 0x3a, 0xa9, 0x6c, 0x70, 0x53,
-	0x33, 0x02, 0x49, 0x4f, 0x4a, 0x23, 0xe3, 0x48, 0x63, 0x2c, 0x6c, 0x44, 0xa4, 0xfc, 0x75, 0x06,
-	0x94, 0xf8, 0x00, 0xe8, 0x4d, 0xc8, 0x3a, 0xc4, 0x1d, 0x46, 0x07, 0x2c, 0x6b, 0xe9, 0x45, 0x81,
-	0x17, 0x42, 0xc8, 0x83, 0x55, 0xd3, 0x1b, 0xf9, 0x01, 0x09, 0x43, 0xdb, 0x73, 0x75, 0xd3, 0xb3,
-	0x88, 0xc9, 0x52, 0xb6, 0xbc, 0x55, 0x9e, 0x86, 0x6a, 0x7b, 0x2a, 0xb2, 0x4d, 0x25, 0xea, 0xaf,
-	0x3d, 0x3a, 0xa9, 0x54, 0xb9, 0xd5, 0x33, 0xea, 0xc9, 0x6d, 0x54, 0x73, 0x46, 0x13, 0xfd, 0x0c,
-	0xb2, 0x61, 0xe4, 0x05, 0x84, 0x26, 0x39, 0xbd, 0x99, 0x67, 0x96, 0xe6, 0x06, 0xaf, 0x14, 0x1f,
-	0xa9, 0x4f, 0xc5, 0xb1, 0xd0, 0x42, 0x21, 0xa8, 0x01, 0xd9, 0x0f, 0x48, 0x78, 0xa0, 0xdb, 0x6e,
-	0x44, 0x82, 0xfb, 0x86, 0x23, 0x52, 0x7b, 0xb9, 0xc6, 0x6f, 0x7c, 0x2d, 0xbe, 0xf1, 0xb5, 0x86,
-	0xb8, 0xf1, 0xf5, 0x37, 0x45, 0x56, 0x5f, 0xe2, 0x1b, 0xcd, 0x1a, 0x48, 0x6c, 0xfc, 0xcd, 0x7f,
-	0x2a, 0x12, 0x5e, 0x11, 0x02, 0x2d, 0xc1, 0x47, 0x1f, 0x43, 0x3e, 0x20, 0x11, 0x71, 0x59, 0x41,
-	0x67, 0xce, 0xdb, 0xed, 0xca, 0xc2, 0x1a, 0x62, 0xd6, 0xa7, 0xa6, 0xd0, 0x08, 0x96, 0xf7, 0x9d,
-	0x71, 0xf2, 0x28, 0xd9, 0xf3, 0x8c, 0xbf, 0x2e, 0x8c, 0x57, 0xb8, 0xf1, 0xd3, 0xea, 0xb3, 0x5b,
-	0x95, 0x18, 0x7b, 0x72, 0x8c, 0x5f, 0xc0, 0x05, 0xdf, 0x88, 0x0e, 0x74, 0xdf, 0x0b, 0xa3, 0x7d,
-	0xfb, 0x48, 0xa7, 0xa2, 0x4e, 0x5c, 0x7c, 0xf9, 0xfa, 0xb5, 0x47, 0x27, 0x95, 0xd7, 0xb8, 0xd9,
-	0xb9, 0x62, 0xc9, 0xc4, 0x3e, 0x47, 0x25, 0x7a, 0x5c, 0x60, 0x20, 0xf8, 0x02, 0xc9, 0x34, 0x90,
-	0x69, 0xad, 0xa3, 0x55, 0x28, 0x75, 0xba, 0x03, 0xbd, 0xdf, 0x6b, 0x6e, 0xb7, 0x76, 0x5a, 0xcd,
-	0x86, 0xba, 0x84, 0x8a, 0xa0, 0x74, 0x75, 0xdc, 0xe8, 0x76, 0xda, 0xf7, 0x54, 0x89, 0xaf, 0xee,
-	0x62, 0xb6, 0x4a, 0x21, 0x80, 0x2c, 0xe5, 0xdd, 0xc5, 0xaa, 0x2c, 0x0c, 0xfd, 0x51, 0x82, 0x42,
-	0x2f, 0xf0, 0x4c, 0x12, 0x86, 0x0c, 0x8e, 0x6a, 0x90, 0xb2, 0x2d, 0x81, 0x85, 0x6b, 0xd3, 0xe2,
-	0x4c, 0x88, 0xd4, 0x5a, 0x0d, 0x81, 0x6e, 0x29, 0xdb, 0x42, 0x9b, 0xa0, 0x10, 0xd7, 0xf2, 0x3d,
-	0xdb, 0x8d, 0x38, 0x8e, 0xd7, 0x8b, 0x3f, 0x9c, 0x54, 0x94, 0xa6, 0xa0, 0xe1, 0x09, 0xb7, 0xfc,
-	0x2e, 0xa4, 0x5a, 0x0d, 0xfa, 0x10, 0x7c, 0xee, 0xb9, 0x93, 0x87, 0x80, 0x7e, 0xa3, 0x8b, 0x90,
-	0x0d, 0xc7, 0xfb, 0xfb, 0xf6, 0x91, 0x78, 0x09, 0xc4, 0x8a, 0x7b, 0x78, 0x43, 0xfe, 0x92, 0xfa,
-	0xf9, 0x1b, 0x09, 0xa0, 0xce, 0x1e, 0x2b, 0xe6, 0xe6, 0x00, 0x8a, 0x3e, 0x77, 0x49, 0x0f, 0x7d,
-	0x62, 0x0a, 0x87, 0x2f, 0xcc, 0x75, 0xb8, 0x5e, 0x4e, 0xe0, 0xd9, 0xb2, 0xa8, 0x97, 0x18, 0xc5,
-	0x0a, 0x7e, 0xe2, 0xf0, 0x2f, 0x43, 0xe9, 0x97, 0x1c, 0x4d, 0x74, 0xc7, 0x1e, 0xd9, 0xfc, 0x44,
-	0x25, 0x5c, 0x14, 0xc4, 0x36, 0xa5, 0x55, 0xff, 0x99, 0x4a, 0x20, 0xc1, 0xab, 0x90, 0x13, 0x4c,
-	0x01, 0xe0, 0x85, 0x24, 0x56, 0xc7, 0x3c, 0xb4, 0x01, 0x99, 0x3d, 0x32, 0xb4, 0x39, 0x50, 0xa7,
-	0xeb, 0xf0, 0xc3, 0x49, 0x25, 0xdb, 0xdd, 0xdf, 0x0f, 0x49, 0x84, 0x39, 0x03, 0xbd, 0x08, 0x69,
-	0xe2, 0x5a, 0x0c, 0x94, 0x4f, 0xf3, 0x29, 0x19, 0x5d, 0x85, 0x74, 0x38, 0x1e, 0x89, 0x3b, 0xb8,
-	0x3a, 0x3d, 0x65, 0xff, 0x96, 0xf6, 0x76, 0x7f, 0x3c, 0x12, 0xf9, 0xa0, 0x32, 0xe8, 0xe6, 0x3c,
-	0xb0, 0xc9, 0x9c, 0x07, 0x36, 0x73, 0x40, 0xe4, 0x5d, 0x28, 0xed, 0x19, 0xe6, 0xa1, 0xed, 0x0e,
-	0x75, 0x06, 0x0b, 0xec, 0xda, 0xe4, 0xeb, 0xab, 0x67, 0x61, 0xa3, 0x28, 0xe4, 0xd8, 0x0a, 0x5d,
-	0x06, 0x65, 0xe4, 0x59, 0x7a, 0x64, 0x8f, 0x04, 0xe0, 0xe2, 0xdc, 0xc8, 0xb3, 0x06, 0xf6, 0x88,
-	0xa0, 0x97, 0xa0, 0x98, 0x2c, 0xfa, 0x35, 0x85, 0xa5, 0xbb, 0x90, 0x28, 0xf3, 0xea, 0x47, 0x90,
-	0x13, 0x87, 0xa2, 0xfd, 0x81, 0x6f, 0x04, 0xd1, 0xdb, 0x2c, 0xb2, 0x59, 0xcc, 0x17, 0x31, 0x75,
-	0x8b, 0x85, 0x52, 0x50, 0xb7, 0x62, 0xea, 0x3b, 0x2c, 0x80, 0x39, 0x4e, 0x7d, 0xa7, 0xfa, 0x55,
-	0x0a, 0x0a, 0x98, 0x18, 0x16, 0x26, 0xbf, 0x1a, 0x93, 0x30, 0x42, 0x9b, 0x90, 0x3d, 0x20, 0x86,
-	0x45, 0x02, 0x51, 0x2f, 0xea, 0x34, 0x20, 0xb7, 0x18, 0x1d, 0x0b, 0x7e, 0x32, 0xaf, 0xa9, 0xc7,
-	0xe4, 0xb5, 0x0a, 0x59, 0x8f, 0xa5, 0x69, 0x4e, 0xe2, 0x04, 0x87, 0xba, 0xb6, 0xe7, 0x78, 0xe6,
-	0x21, 0xcb, 0x9e, 0x82, 0xf9, 0x02, 0x6d, 0x40, 0xd1, 0xf2, 0x74, 0xd7, 0x8b, 0x74, 0x3f, 0xf0,
-	0x8e, 0x8e, 0x59, 0x86, 0x14, 0x0c, 0x96, 0xd7, 0xf1, 0xa2, 0x1e, 0xa5, 0xd0, 0x62, 0x1c, 0x91,
-	0xc8, 0xb0, 0x8c, 0xc8, 0xd0, 0x3d, 0xd7, 0x39, 0x66, 0xf1, 0x57, 0x70, 0x31, 0x26, 0x76, 0x5d,
-	0xe7, 0x18, 0x5d, 0x05, 0xa0, 0x8f, 0x97, 0x70, 0x22, 0x77, 0xc6, 0x89, 0x3c, 0x71, 0x2d, 0xfe,
-	0x59, 0xfd, 0x43, 0x0a, 0x8a, 0x3c, 0x18, 0xa1, 0xef, 0xb9, 0x21, 0xa1, 0xd1, 0x08, 0x23, 0x23,
-	0x1a, 0x87, 0x2c, 0x1a, 0xcb, 0xc9, 0x68, 0xf4, 0x19, 0x1d, 0x0b, 0x7e, 0x22, 0x6e, 0xa9, 0x73,
-	0xe2, 0xf6, 0x24, 0x01, 0xb9, 0x0a, 0xf0, 0x59, 0x60, 0x47, 0x44, 0xa7, 0x3a, 0x2c, 0x2a, 0x33,
-	0x3e, 0x33, 0x2e, 0x35, 0x8c, 0x6a, 0x89, 0xde, 0x22, 0x33, 0xdb, 0xaf, 0xc4, 0x45, 0x98, 0x68,
-	0x1a, 0x5e, 0x82, 0x62, 0xfc, 0xad, 0x8f, 0x03, 0x8e, 0xf4, 0x79, 0x5c, 0x88, 0x69, 0xbb, 0x81,
-	0x83, 0xd6, 0x20, 0x67, 0x7a, 0x2e, 0x7d, 0x1c, 0x58, 0xb8, 0x8a, 0x38, 0x5e, 0x56, 0xbf, 0x4c,
-	0x43, 0x49, 0xbc, 0xf8, 0xcf, 0xaa, 0x5e, 0x66, 0xb3, 0x9e, 0x3e, 0x93, 0xf5, 0x69, 0x00, 0x33,
-	0x0b, 0x03, 0xf8, 0x21, 0xac, 0x98, 0x07, 0xc4, 0x3c, 0xd4, 0x03, 0x32, 0xb4, 0xc3, 0x88, 0x04,
-	0xa1, 0x78, 0xd2, 0x2e, 0x9d, 0x69, 0xe6, 0x78, 0x9b, 0x8b, 0x97, 0x99, 0x3c, 0x8e, 0xc5, 0xd1,
-	0x4f, 0x61, 0x65, 0xec, 0x52, 0x78, 0x98, 0x5a, 0xc8, 0x2d, 0x6a, 0x07, 0xf1, 0x32, 0x13, 0x9d,
-	0x2a, 0x6b, 0x80, 0xc2, 0xf1, 0x5e, 0x14, 0x18, 0x66, 0x94, 0xd0, 0x57, 0x16, 0xea, 0xaf, 0xc6,
-	0xd2, 0x53, 0x13, 0x89, 0x24, 0xc8, 0xa7, 0x92, 0x20, 0x5e, 0xa5, 0xdf, 0xa7, 0x60, 0x39, 0x4e,
-	0xc5, 0x53, 0x57, 0x6b, 0xed, 0xbc, 0x6a, 0x15, 0x70, 0x19, 0xe7, 0xee, 0x1a, 0x64, 0x4d, 0x6f,
-	0x44, 0xe1, 0x3e, 0xbd, 0xb0, 0xc4, 0x84, 0x04, 0x7a, 0x8b, 0x36, 0x29, 0xf1, 0x91, 0xe5, 0x85,
-	0x47, 0x9e, 0x0a, 0xd1, 0x92, 0x8c, 0xbc, 0xc8, 0x70, 0x74, 0xf3, 0x60, 0xec, 0x1e, 0x86, 0x3c,
-	0xad, 0xb8, 0xc0, 0x68, 0xdb, 0x8c, 0x84, 0x5e, 0x85, 0x65, 0x8b, 0x38, 0xc6, 0x31, 0xb1, 0x62,
-	0xa1, 0x2c, 0x13, 0x2a, 0x09, 0x2a, 0x17, 0xab, 0xfe, 0x25, 0x05, 0x2a, 0x16, 0xad, 0x3c, 0x79,
-	0xfa, 0x12, 0xad, 0x01, 0x9d, 0xe6, 0x7c, 0x2f, 0x34, 0x9c, 0xc7, 0x1c, 0x74, 0x22, 0x73, 0xfa,
-	0xa8, 0xb9, 0x27, 0x39, 0xea, 0x06, 0x14, 0x0c, 0xf3, 0xd0, 0xf5, 0x3e, 0x73, 0x88, 0x35, 0x24,
-	0x02, 0xaf, 0x92, 0x24, 0x74, 0x03, 0x90, 0x45, 0xfc, 0x80, 0xd0, 0x13, 0x58, 0xfa, 0x63, 0x6e,
-	0xcc, 0xea, 0x54, 0x4c, 0x90, 0x16, 0xd7, 0x0c, 0x45, 0x4a, 0xf1, 0xa9, 0x5b, 0xc4, 0x89, 0x0c,
-	0x11, 0xe3, 0xa2, 0x20, 0x36, 0x28, 0xad, 0xfa, 0x77, 0x09, 0x56, 0x13, 0xd1, 0x7b, 0x86, 0x18,
-	0x98, 0x04, 0xad, 0xf4, 0x13, 0x80, 0xd6, 0x53, 0xd7, 0x54, 0x75, 0x00, 0x85, 0xb6, 0x1d, 0x46,
-	0x71, 0x0d, 0xfc, 0x04, 0x94, 0x50, 0xdc, 0x74, 0x51, 0x05, 0x8b, 0x80, 0x40, 0x54, 0xfe, 0x44,
-	0xfc, 0xb6, 0xac, 0xa4, 0xd4, 0xf4, 0x6d, 0x59, 0x49, 0xab, 0x72, 0xf5, 0xaf, 0x29, 0x28, 0x72,
-	0xb3, 0xcf, 0xfc, 0xca, 0x7d, 0x08, 0x8a, 0x48, 0x3e, 0x1f, 0x51, 0x4e, 0xcd, 0x8c, 0x49, 0x1f,
-	0xe2, 0x01, 0x32, 0x76, 0x3c, 0xd6, 0x2a, 0x7f, 0x25, 0x41, 0x5c, 0x2c, 0xe8, 0x3a, 0xc8, 0xf3,
-	0x9b, 0xc0, 0xc4, 0x68, 0x28, 0x0c, 0x30, 0x41, 0x7a, 0x27, 0x69, 0x8b, 0x12, 0x90, 0xfb, 0x76,
-	0x18, 0x8f, 0xcf, 0x69, 0x5c, 0x18, 0x79, 0x16, 0x16, 0x24, 0xf4, 0x3a, 0x64, 0x02, 0x6f, 0x1c,
-	0x11, 0x91, 0xc1, 0xc4, 0x6f, 0x0e, 0x98, 0x92, 0x85, 0x39, 0x2e, 0x73, 0x5b, 0x56, 0x64, 0x35,
-	0x53, 0xfd, 0x97, 0x04, 0x45, 0xcd, 0xf7, 0x9d, 0xe3, 0x38, 0x2f, 0x1f, 0x40, 0xce, 0x3c, 0x30,
-	0xdc, 0x21, 0x89, 0x7f, 0xb9, 0xb8, 0x32, 0xb5, 0x92, 0x14, 0xac, 0x6d, 0x33, 0xa9, 0xf8, 0x37,
-	0x03, 0xa1, 0x53, 0xfe, 0x5a, 0x82, 0x2c, 0xe7, 0xa0, 0x1a, 0x3c, 0x47, 0x8e, 0x7c, 0x62, 0x46,
-	0xfa, 0x29, 0xbf, 0xd9, 0xf4, 0x89, 0x57, 0x39, 0xeb, 0x4e, 0xc2, 0xfb, 0x37, 0x21, 0x3b, 0xf6,
-	0x43, 0x12, 0x44, 0x22, 0x1f, 0xf3, 0x63, 0x82, 0x85, 0x10, 0x7a, 0x19, 0xb2, 0x16, 0x71, 0x88,
-	0x38, 0xed, 0xcc, 0x55, 0x14, 0xac, 0xaa, 0xcd, 0x5e, 0x47, 0xea, 0xf4, 0xb3, 0x2e, 0x8f, 0xea,
-	0xbf, 0x53, 0xa0, 0xc6, 0x17, 0x25, 0x7c, 0x66, 0x8f, 0xf1, 0x2b, 0xb0, 0xcc, 0x7a, 0x6f, 0x7d,
-	0xd2, 0xae, 0xa6, 0x39, 0x6e, 0x30, 0xea, 0x1d, 0xd1, 0xb3, 0x6e, 0x40, 0x91, 0x76, 0x58, 0x13,
-	0x19, 0xd6, 0xaf, 0x60, 0xda, 0x75, 0xc5, 0x12, 0xaf, 0xc1, 0x8a, 0x4b, 0x8e, 0x22, 0xdd, 0x37,
-	0x86, 0x44, 0x8f, 0xbc, 0x43, 0xe2, 0x0a, 0x00, 0x2a, 0x51, 0x72, 0xcf, 0x18, 0x92, 0x01, 0x25,
-	0xa2, 0x2b, 0x00, 0x4c, 0x84, 0x8f, 0x16, 0x14, 0x1d, 0x33, 0x38, 0x4f, 0x29, 0x6c, 0xae, 0x40,
-	0x37, 0xa1, 0x18, 0xda, 0x43, 0xd7, 0x88, 0xc6, 0x01, 0x19, 0x0c, 0xda, 0x02, 0x72, 0x1f, 0x33,
-	0xa5, 0x2a, 0x0f, 0x4e, 0x2a, 0x12, 0x1b, 0x41, 0x4f, 0x29, 0x9e, 0x69, 0x32, 0x94, 0xd9, 0x26,
-	0xa3, 0xfa, 0xe7, 0x14, 0xac, 0x26, 0xe2, 0xfb, 0xcc, 0xaf, 0x7b, 0x0b, 0xf2, 0x31, 0xda, 0xc5,
-	0xf7, 0xfd, 0xd5, 0xb3, 0x90, 0x38, 0xf1, 0xa4, 0xa6, 0x4f, 0x7e, 0x2a, 0xe2, 0x76, 0xa6, 0xda,
-	0xf3, 0x82, 0x2d, 0xcf, 0x09, 0x76, 0xf9, 0x13, 0xc8, 0x4f, 0xac, 0xa0, 0x37, 0x4e, 0x01, 0xc4,
-	0x1c, 0x34, 0x3e, 0x85, 0x0e, 0x57, 0x00, 0x68, 0x3c, 0x89, 0xc5, 0x5a, 0x48, 0x3e, 0x92, 0xe6,
-	0x39, 0x65, 0x37, 0x70, 0xaa, 0xbf, 0x95, 0x20, 0xc3, 0x30, 0x00, 0xbd, 0x0f, 0xb9, 0x11, 0x19,
-	0xed, 0x51, 0xd8, 0xe6, 0xf7, 0xfb, 0xbc, 0x81, 0x39, 0x16, 0xa7, 0x6f, 0x99, 0x1f, 0xd8, 0x23,
-	0x23, 0x38, 0xe6, 0x3f, 0xdd, 0xe1, 0x78, 0x89, 0xae, 0x41, 0x3e, 0x9e, 0x98, 0xe3, 0x5f, 0x6f,
-	0x4e, 0x0f, 0xd4, 0x53, 0xb6, 0xe8, 0x95, 0xfe, 0x94, 0x82, 0x2c, 0x8f, 0x3a, 0xfa, 0x00, 0x20,
-	0x9e, 0x8a, 0x9f, 0x78, 0x88, 0xcf, 0x0b, 0x8d, 0x96, 0x35, 0xc5, 0xbc, 0xd4, 0xf9, 0x98, 0x47,
-	0x41, 0x97, 0x44, 0xa6, 0x25, 0xf0, 0xf1, 0xc2, 0x6c, 0x05, 0xd4, 0x9a, 0x91, 0x69, 0xc5, 0x61,
-	0xa5, 0x82, 0xe5, 0x2f, 0x24, 0x90, 0x29, 0x91, 0xc6, 0xd7, 0x74, 0xc6, 0xf4, 0x25, 0x8b, 0xbd,
-	0x94, 0x71, 0x5e, 0x50, 0x5a, 0x16, 0x7a, 0x01, 0xf2, 0x3c, 0x4c, 0x94, 0x9b, 0x62, 0x5c, 0x85,
-	0x13, 0x5a, 0x16, 0x2a, 0x83, 0x32, 0x41, 0x3f, 0x7e, 0x5b, 0x27, 0x6b, 0xaa, 0x18, 0x18, 0xfb,
-	0x91, 0x1e, 0x91, 0x80, 0x8f, 0xca, 0x32, 0x56, 0x28, 0x61, 0x40, 0x82, 0x51, 0xfc, 0x5b, 0x02,
-	0xfd, 0x7b, 0xed, 0xfb, 0x14, 0x64, 0x79, 0x45, 0xa3, 0x2c, 0xa4, 0xba, 0x1f, 0xa9, 0x4b, 0xe8,
-	0x02, 0xac, 0xde, 0xee, 0xee, 0xe2, 0x8e, 0xd6, 0xd6, 0x3b, 0xdd, 0x81, 0xbe, 0xd3, 0xdd, 0xed,
-	0x34, 0x54, 0x09, 0x5d, 0x81, 0xcb, 0x9d, 0xae, 0x1e, 0x73, 0x7a, 0xb8, 0x75, 0x47, 0xc3, 0xf7,
-	0xf4, 0x3a, 0xee, 0x7e, 0xd4, 0xc4, 0x6a, 0x0a, 0xad, 0x43, 0x99, 0x4a, 0x2f, 0xe0, 0xa7, 0xd1,
-	0x45, 0x40, 0x49, 0xbe, 0xa0, 0x67, 0xd0, 0x06, 0xbc, 0xd8, 0xea, 0xf4, 0x77, 0x77, 0x76, 0x5a,
-	0xdb, 0xad, 0x66, 0x67, 0x56, 0xa0, 0xaf, 0xca, 0xe8, 0x45, 0x58, 0xeb, 0xee, 0xec, 0xf4, 0x9b,
-	0x03, 0xe6, 0xce, 0xbd, 0xe6, 0x40, 0xd7, 0x3e, 0xd6, 0x5a, 0x6d, 0xad, 0xde, 0x6e, 0xaa, 0x59,
-	0xb4, 0x02, 0x85, 0xbb, 0xb8, 0xdb, 0xb9, 0xa9, 0xe3, 0xee, 0xee, 0xa0, 0xa9, 0xe6, 0xa8, 0xfb,
-	0x3d, 0xdc, 0xed, 0x75, 0xfb, 0x5a, 0x5b, 0xbf, 0xd3, 0xea, 0xdf, 0xd1, 0x06, 0xdb, 0xb7, 0x54,
-	0x05, 0xbd, 0x00, 0x97, 0x9a, 0x83, 0xed, 0x86, 0x3e, 0xc0, 0x5a, 0xa7, 0xaf, 0x6d, 0x0f, 0x5a,
-	0xdd, 0x8e, 0xbe, 0xa3, 0xb5, 0xda, 0xcd, 0x86, 0x9a, 0xa7, 0x46, 0xa8, 0x6d, 0xad, 0xdd, 0xee,
-	0xde, 0x6d, 0x36, 0x54, 0x40, 0x97, 0xe0, 0x39, 0x6e, 0x55, 0xeb, 0xf5, 0x9a, 0x9d, 0x86, 0xce,
-	0x1d, 0x50, 0x0b, 0xd4, 0x99, 0x56, 0xa7, 0xd1, 0xfc, 0x44, 0xbf, 0xa5, 0xf5, 0xf5, 0x9b, 0xb8,
-	0xa9, 0x0d, 0x9a, 0x38, 0xe6, 0x16, 0xe9, 0xde, 0xb8, 0x79, 0xb3, 0xd5, 0xa7, 0xc4, 0xc9, 0xde,
-	0xa5, 0x6b, 0x2e, 0xa8, 0xb3, 0x3f, 0x32, 0xa0, 0x02, 0xe4, 0x5a, 0x9d, 0x8f, 0xb5, 0x76, 0xab,
-	0xa1, 0x2e, 0x21, 0x05, 0xe4, 0x4e, 0xb7, 0xd3, 0x54, 0x25, 0xfa, 0x75, 0xf3, 0xd3, 0x56, 0x4f,
-	0x4d, 0xa1, 0x12, 0xe4, 0x3f, 0xed, 0x0f, 0xb4, 0x4e, 0x43, 0xc3, 0x0d, 0x35, 0x8d, 0x00, 0xb2,
-	0xfd, 0x8e, 0xd6, 0xeb, 0xdd, 0x53, 0x65, 0x1a, 0x6b, 0x2a, 0x44, 0xf7, 0x6d, 0x77, 0xb5, 0x86,
-	0xde, 0x68, 0x6e, 0x77, 0xef, 0xf4, 0x70, 0xb3, 0xdf, 0x6f, 0x75, 0x3b, 0x6a, 0x66, 0xeb, 0xd7,
-	0xe9, 0x69, 0x43, 0xf0, 0x63, 0x90, 0x69, 0x13, 0x81, 0x2e, 0xcc, 0x36, 0x15, 0xec, 0x25, 0x29,
-	0x5f, 0x9c, 0xdf, 0x6b, 0xa0, 0xf7, 0x21, 0xc3, 0x5e, 0x38, 0x74, 0x71, 0xfe, 0x3b, 0x5d, 0xbe,
-	0x74, 0x86, 0x2e, 0x34, 0xdf, 0x03, 0x99, 0x8e, 0xd6, 0xc9, 0x0d, 0x13, 0xbf, 0x3b, 0x24, 0x37,
-	0x4c, 0x4e, 0xe0, 0x6f, 0x49, 0xe8, 0x03, 0xc8, 0xf2, 0x39, 0x07, 0x9d, 0xb6, 0x3d, 0x1d, 0x42,
-	0xcb, 0x6b, 0x67, 0x19, 0x5c, 0x7d, 0x53, 0x42, 0xb7, 0x20, 0x3f, 0xe9, 0x69, 0x51, 0x39, 0xb9,
-	0xcb, 0xe9, 0x31, 0xa1, 0xfc, 0xc2, 0x5c, 0x5e, 0x6c, 0xe7, 0x2d, 0x6a, 0xa9, 0x44, 0x63, 0x31,
-	0xc1, 0xe2, 0xa4, 0xb5, 0xd9, 0xa7, 0x38, 0x69, 0xed, 0x0c, 0x78, 0xd7, 0x9b, 0x0f, 0xfe, 0xbb,
-	0xbe, 0xf4, 0xe0, 0xfb, 0x75, 0xe9, 0xbb, 0xef, 0xd7, 0xa5, 0xdf, 0x3d, 0x5c, 0x5f, 0xfa, 0xf6,
-	0xe1, 0xba, 0xf4, 0xb7, 0x87, 0xeb, 0xd2, 0x77, 0x0f, 0xd7, 0x97, 0xfe, 0xf1, 0x70, 0x7d, 0xe9,
-	0xd3, 0x97, 0x87, 0x5e, 0x6d, 0x68, 0x7c, 0x4e, 0xa2, 0x88, 0xd4, 0x2c, 0x72, 0xff, 0xba, 0xe9,
-	0x05, 0xe4, 0xfa, 0xcc, 0x7f, 0xa2, 0xf6, 0xb2, 0xec, 0xeb, 0x9d, 0xff, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0xbf, 0x7d, 0x08, 0x97, 0xa3, 0x1a, 0x00, 0x00,
+func (m *StatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatResponse.Merge(m, src)
+}
+func (m *StatResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *StatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatResponse.DiscardUnknown(m)
 }
 
-func (this *Label) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+var xxx_messageInfo_StatResponse proto.InternalMessageInfo
 
-	that1, ok := that.(*Label)
-	if !ok {
-		that2, ok := that.(Label)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// Replicas of the journal.
+type StatResponse_Replica struct {
+	// ID of the replica's broker.
+	Id ProcessSpec_ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// Is the replica in sync with the primary? A replica is in sync if its
+	// replication stream is healthy and it has acknowledged all content
+	// committed by the primary.
+	InSync bool `protobuf:"varint,2,opt,name=in_sync,json=inSync,proto3" json:"in_sync,omitempty"`
+	// Error of the replica's replication stream, if it's failed.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *StatResponse_Replica) Reset()         { *m = StatResponse_Replica{} }
+func (m *StatResponse_Replica) String() string { return proto.CompactTextString(m) }
+func (*StatResponse_Replica) ProtoMessage()    {}
+func (*StatResponse_Replica) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{24, 0}
+}
+func (m *StatResponse_Replica) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatResponse_Replica) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatResponse_Replica.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.Value != that1.Value {
-		return false
-	}
-	return true
 }
-func (this *LabelSet) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *StatResponse_Replica) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatResponse_Replica.Merge(m, src)
+}
+func (m *StatResponse_Replica) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *StatResponse_Replica) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatResponse_Replica.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatResponse_Replica proto.InternalMessageInfo
+
+// SchemaSpec describes a registered schema of journal messages. Journals refer
+// to a schema by its name, through their "app.gazette.dev/message-type" label.
+type SchemaSpec struct {
+	// Name of the schema, which is the message-type label value of journals
+	// having messages of this schema.
+	Name string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type SchemaSpec_Type `protobuf:"varint,2,opt,name=type,proto3,enum=protocol.SchemaSpec_Type" json:"type,omitempty"`
+	// Content of the schema, in the encoding of its Type.
+	Content []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// Fully-qualified name of the message type of a PROTOBUF schema,
+	// eg "acme.widgets.Event". Must be empty for other schema types.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Compatibility required of an update, with respect to the current schema.
+	// It's taken from the updated SchemaSpec, so that an update may explicitly
+	// relax (or tighten) the compatibility of the schema.
+	Compatibility SchemaSpec_Compatibility `protobuf:"varint,5,opt,name=compatibility,proto3,enum=protocol.SchemaSpec_Compatibility" json:"compatibility,omitempty"`
+}
+
+func (m *SchemaSpec) Reset()         { *m = SchemaSpec{} }
+func (m *SchemaSpec) String() string { return proto.CompactTextString(m) }
+func (*SchemaSpec) ProtoMessage()    {}
+func (*SchemaSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{25}
+}
+func (m *SchemaSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchemaSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchemaSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *SchemaSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaSpec.Merge(m, src)
+}
+func (m *SchemaSpec) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SchemaSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaSpec.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*LabelSet)
-	if !ok {
-		that2, ok := that.(LabelSet)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_SchemaSpec proto.InternalMessageInfo
+
+// ListSchemasRequest is the unary request message of the broker ListSchemas
+// RPC.
+type ListSchemasRequest struct {
+	// Names of schemas to list. If empty, all schemas are listed.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (m *ListSchemasRequest) Reset()         { *m = ListSchemasRequest{} }
+func (m *ListSchemasRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSchemasRequest) ProtoMessage()    {}
+func (*ListSchemasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{26}
+}
+func (m *ListSchemasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSchemasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSchemasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Labels) != len(that1.Labels) {
-		return false
-	}
-	for i := range this.Labels {
-		if !this.Labels[i].Equal(&that1.Labels[i]) {
-			return false
+}
+func (m *ListSchemasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSchemasRequest.Merge(m, src)
+}
+func (m *ListSchemasRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ListSchemasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSchemasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSchemasRequest proto.InternalMessageInfo
+
+// ListSchemasResponse is the unary response message of the broker ListSchemas
+// RPC.
+type ListSchemasResponse struct {
+	// Status of the ListSchemas RPC.
+	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=protocol.Status" json:"status,omitempty"`
+	// Header of the response.
+	Header  Header                       `protobuf:"bytes,2,opt,name=header,proto3" json:"header"`
+	Schemas []ListSchemasResponse_Schema `protobuf:"bytes,3,rep,name=schemas,proto3" json:"schemas"`
+}
+
+func (m *ListSchemasResponse) Reset()         { *m = ListSchemasResponse{} }
+func (m *ListSchemasResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSchemasResponse) ProtoMessage()    {}
+func (*ListSchemasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{27}
+}
+func (m *ListSchemasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSchemasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSchemasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *LabelSelector) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ListSchemasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSchemasResponse.Merge(m, src)
+}
+func (m *ListSchemasResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ListSchemasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSchemasResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*LabelSelector)
-	if !ok {
-		that2, ok := that.(LabelSelector)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_ListSchemasResponse proto.InternalMessageInfo
+
+// Schemas of the response.
+type ListSchemasResponse_Schema struct {
+	Spec SchemaSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec"`
+	// Current ModRevision of the SchemaSpec.
+	ModRevision int64 `protobuf:"varint,2,opt,name=mod_revision,json=modRevision,proto3" json:"mod_revision,omitempty"`
+}
+
+func (m *ListSchemasResponse_Schema) Reset()         { *m = ListSchemasResponse_Schema{} }
+func (m *ListSchemasResponse_Schema) String() string { return proto.CompactTextString(m) }
+func (*ListSchemasResponse_Schema) ProtoMessage()    {}
+func (*ListSchemasResponse_Schema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{27, 0}
+}
+func (m *ListSchemasResponse_Schema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSchemasResponse_Schema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSchemasResponse_Schema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Include.Equal(&that1.Include) {
-		return false
-	}
-	if !this.Exclude.Equal(&that1.Exclude) {
-		return false
-	}
-	return true
 }
-func (this *JournalSpec) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ListSchemasResponse_Schema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSchemasResponse_Schema.Merge(m, src)
+}
+func (m *ListSchemasResponse_Schema) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ListSchemasResponse_Schema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSchemasResponse_Schema.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*JournalSpec)
-	if !ok {
-		that2, ok := that.(JournalSpec)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_ListSchemasResponse_Schema proto.InternalMessageInfo
+
+// ApplySchemasRequest is the unary request message of the broker ApplySchemas
+// RPC.
+type ApplySchemasRequest struct {
+	Changes []ApplySchemasRequest_Change `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *ApplySchemasRequest) Reset()         { *m = ApplySchemasRequest{} }
+func (m *ApplySchemasRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplySchemasRequest) ProtoMessage()    {}
+func (*ApplySchemasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{28}
+}
+func (m *ApplySchemasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplySchemasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplySchemasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.Replication != that1.Replication {
-		return false
-	}
-	if !this.LabelSet.Equal(&that1.LabelSet) {
-		return false
-	}
-	if !this.Fragment.Equal(&that1.Fragment) {
-		return false
-	}
-	if this.Flags != that1.Flags {
-		return false
-	}
-	if this.MaxAppendRate != that1.MaxAppendRate {
-		return false
-	}
-	return true
 }
-func (this *JournalSpec_Fragment) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ApplySchemasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplySchemasRequest.Merge(m, src)
+}
+func (m *ApplySchemasRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ApplySchemasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplySchemasRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*JournalSpec_Fragment)
-	if !ok {
-		that2, ok := that.(JournalSpec_Fragment)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_ApplySchemasRequest proto.InternalMessageInfo
+
+// Change defines an insertion, update, or deletion to be applied to the set
+// of SchemaSpecs. Exactly one of |upsert| or |delete| must be set.
+type ApplySchemasRequest_Change struct {
+	// Expected ModRevision of the current SchemaSpec. If the schema is being
+	// created, expect_mod_revision is zero. A value of -1 skips the check.
+	ExpectModRevision int64 `protobuf:"varint,1,opt,name=expect_mod_revision,json=expectModRevision,proto3" json:"expect_mod_revision,omitempty"`
+	// SchemaSpec to be updated (if expect_mod_revision > 0) or created
+	// (if expect_mod_revision == 0). An updated SchemaSpec must satisfy its
+	// compatibility rules with respect to the current SchemaSpec.
+	Upsert *SchemaSpec `protobuf:"bytes,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	// Schema to be deleted. expect_mod_revision must not be zero.
+	Delete string `protobuf:"bytes,3,opt,name=delete,proto3" json:"delete,omitempty"`
+}
+
+func (m *ApplySchemasRequest_Change) Reset()         { *m = ApplySchemasRequest_Change{} }
+func (m *ApplySchemasRequest_Change) String() string { return proto.CompactTextString(m) }
+func (*ApplySchemasRequest_Change) ProtoMessage()    {}
+func (*ApplySchemasRequest_Change) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{28, 0}
+}
+func (m *ApplySchemasRequest_Change) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplySchemasRequest_Change) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplySchemasRequest_Change.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Length != that1.Length {
-		return false
-	}
-	if this.CompressionCodec != that1.CompressionCodec {
-		return false
-	}
-	if len(this.Stores) != len(that1.Stores) {
-		return false
-	}
-	for i := range this.Stores {
-		if this.Stores[i] != that1.Stores[i] {
-			return false
-		}
-	}
-	if this.RefreshInterval != that1.RefreshInterval {
-		return false
-	}
-	if this.Retention != that1.Retention {
-		return false
-	}
-	if this.FlushInterval != that1.FlushInterval {
-		return false
-	}
-	if this.PathPostfixTemplate != that1.PathPostfixTemplate {
-		return false
-	}
-	return true
 }
-func (this *ProcessSpec_ID) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ApplySchemasRequest_Change) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplySchemasRequest_Change.Merge(m, src)
+}
+func (m *ApplySchemasRequest_Change) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ApplySchemasRequest_Change) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplySchemasRequest_Change.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ProcessSpec_ID)
-	if !ok {
-		that2, ok := that.(ProcessSpec_ID)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Zone != that1.Zone {
-		return false
-	}
-	if this.Suffix != that1.Suffix {
-		return false
-	}
-	return true
+var xxx_messageInfo_ApplySchemasRequest_Change proto.InternalMessageInfo
+
+// ApplySchemasResponse is the unary response message of the broker
+// ApplySchemas RPC.
+type ApplySchemasResponse struct {
+	// Status of the ApplySchemas RPC.
+	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=protocol.Status" json:"status,omitempty"`
+	// Header of the response.
+	Header Header `protobuf:"bytes,2,opt,name=header,proto3" json:"header"`
+	// If status is INCOMPATIBLE_SCHEMA, error describes the incompatibility.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
-func (this *AppendRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*AppendRequest)
-	if !ok {
-		that2, ok := that.(AppendRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *ApplySchemasResponse) Reset()         { *m = ApplySchemasResponse{} }
+func (m *ApplySchemasResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplySchemasResponse) ProtoMessage()    {}
+func (*ApplySchemasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{29}
+}
+func (m *ApplySchemasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplySchemasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplySchemasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Header.Equal(that1.Header) {
-		return false
-	}
-	if this.Journal != that1.Journal {
-		return false
-	}
-	if this.DoNotProxy != that1.DoNotProxy {
-		return false
-	}
-	if this.Offset != that1.Offset {
-		return false
-	}
-	if !this.CheckRegisters.Equal(that1.CheckRegisters) {
-		return false
-	}
-	if !this.UnionRegisters.Equal(that1.UnionRegisters) {
-		return false
-	}
-	if !this.SubtractRegisters.Equal(that1.SubtractRegisters) {
-		return false
-	}
-	if !bytes.Equal(this.Content, that1.Content) {
-		return false
-	}
-	return true
 }
-func (this *Route) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ApplySchemasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplySchemasResponse.Merge(m, src)
+}
+func (m *ApplySchemasResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ApplySchemasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplySchemasResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Route)
-	if !ok {
-		that2, ok := that.(Route)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Members) != len(that1.Members) {
-		return false
-	}
-	for i := range this.Members {
-		if !this.Members[i].Equal(&that1.Members[i]) {
-			return false
-		}
-	}
-	if this.Primary != that1.Primary {
-		return false
-	}
-	if len(this.Endpoints) != len(that1.Endpoints) {
-		return false
-	}
-	for i := range this.Endpoints {
-		if this.Endpoints[i] != that1.Endpoints[i] {
-			return false
+var xxx_messageInfo_ApplySchemasResponse proto.InternalMessageInfo
+
+// JournalSpecRevision is an immutable record of a change to a JournalSpec,
+// which brokers record with each applied change of an Apply RPC.
+type JournalSpecRevision struct {
+	// Journal which was changed.
+	Journal Journal `protobuf:"bytes,1,opt,name=journal,proto3,casttype=Journal" json:"journal,omitempty"`
+	// Etcd revision at which the change was applied. It's the ModRevision of
+	// the journal's JournalSpec, or of its deletion.
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Time at which the change was applied, represented as seconds since the
+	// epoch.
+	ApplyTime int64 `protobuf:"varint,3,opt,name=apply_time,json=applyTime,proto3" json:"apply_time,omitempty"`
+	// Subject of the authorization which applied the change. Empty if brokers
+	// don't verify authorizations.
+	AppliedBy string `protobuf:"bytes,4,opt,name=applied_by,json=appliedBy,proto3" json:"applied_by,omitempty"`
+	// Network address of the client which applied the change.
+	Client string `protobuf:"bytes,5,opt,name=client,proto3" json:"client,omitempty"`
+	// JournalSpec of the journal prior to the change, as observed by the
+	// applying broker, or nil if the journal didn't exist.
+	Before *JournalSpec `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	// ModRevision of the |before| JournalSpec.
+	BeforeRevision int64 `protobuf:"varint,7,opt,name=before_revision,json=beforeRevision,proto3" json:"before_revision,omitempty"`
+	// JournalSpec of the journal after the change, or nil if the journal was
+	// deleted.
+	After *JournalSpec `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (m *JournalSpecRevision) Reset()         { *m = JournalSpecRevision{} }
+func (m *JournalSpecRevision) String() string { return proto.CompactTextString(m) }
+func (*JournalSpecRevision) ProtoMessage()    {}
+func (*JournalSpecRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{30}
+}
+func (m *JournalSpecRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JournalSpecRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JournalSpecRevision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *Header) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *JournalSpecRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JournalSpecRevision.Merge(m, src)
+}
+func (m *JournalSpecRevision) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *JournalSpecRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_JournalSpecRevision.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Header)
-	if !ok {
-		that2, ok := that.(Header)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.ProcessId.Equal(&that1.ProcessId) {
-		return false
-	}
-	if !this.Route.Equal(&that1.Route) {
-		return false
-	}
-	if !this.Etcd.Equal(&that1.Etcd) {
-		return false
-	}
-	return true
+var xxx_messageInfo_JournalSpecRevision proto.InternalMessageInfo
+
+// HistoryRequest is the unary request message of the broker History RPC.
+type HistoryRequest struct {
+	// Journal whose revisions are to be listed. If empty, revisions of all
+	// journals are listed.
+	Journal Journal `protobuf:"bytes,1,opt,name=journal,proto3,casttype=Journal" json:"journal,omitempty"`
+	// The NextPageToken value returned from a previous, continued
+	// HistoryRequest, if any.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// PageLimit is an optional field specifying how many revisions to return
+	// with the response. The default value for PageLimit is 1000.
+	PageLimit int32 `protobuf:"varint,3,opt,name=page_limit,json=pageLimit,proto3" json:"page_limit,omitempty"`
 }
-func (this *Header_Etcd) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Header_Etcd)
-	if !ok {
-		that2, ok := that.(Header_Etcd)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *HistoryRequest) Reset()         { *m = HistoryRequest{} }
+func (m *HistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*HistoryRequest) ProtoMessage()    {}
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{31}
+}
+func (m *HistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ClusterId != that1.ClusterId {
-		return false
-	}
-	if this.MemberId != that1.MemberId {
-		return false
-	}
-	if this.Revision != that1.Revision {
-		return false
-	}
-	if this.RaftTerm != that1.RaftTerm {
-		return false
-	}
-	return true
+}
+func (m *HistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryRequest.Merge(m, src)
+}
+func (m *HistoryRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *HistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_HistoryRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// HistoryResponse is the unary response message of the broker History RPC.
+type HistoryResponse struct {
+	// Status of the History RPC.
+	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=protocol.Status" json:"status,omitempty"`
+	// Header of the response.
+	Header Header `protobuf:"bytes,2,opt,name=header,proto3" json:"header"`
+	// Revisions of the response, ordered on journal name and then on
+	// ascending revision.
+	Revisions []JournalSpecRevision `protobuf:"bytes,3,rep,name=revisions,proto3" json:"revisions"`
+	// The NextPageToken value to be returned on subsequent History requests.
+	// If empty, there are no more revisions to be returned.
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
 
-// JournalClient is the client API for Journal service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type JournalClient interface {
-	// List Journals, their JournalSpecs and current Routes.
-	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
-	// Apply changes to the collection of Journals managed by the brokers.
-	Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyResponse, error)
-	// Read from a specific Journal.
-	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (Journal_ReadClient, error)
-	// Append content to a specific Journal.
-	Append(ctx context.Context, opts ...grpc.CallOption) (Journal_AppendClient, error)
-	// Replicate appended content of a Journal. Replicate is used between broker
-	// peers in the course of processing Append transactions, but is not intended
-	// for direct use by clients.
-	Replicate(ctx context.Context, opts ...grpc.CallOption) (Journal_ReplicateClient, error)
-	// List Fragments of a Journal.
-	ListFragments(ctx context.Context, in *FragmentsRequest, opts ...grpc.CallOption) (*FragmentsResponse, error)
-}
-
-type journalClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewJournalClient(cc *grpc.ClientConn) JournalClient {
-	return &journalClient{cc}
-}
-
-func (c *journalClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
-	out := new(ListResponse)
-	err := c.cc.Invoke(ctx, "/protocol.Journal/List", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *HistoryResponse) Reset()         { *m = HistoryResponse{} }
+func (m *HistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*HistoryResponse) ProtoMessage()    {}
+func (*HistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{32}
 }
-
-func (c *journalClient) Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyResponse, error) {
-	out := new(ApplyResponse)
-	err := c.cc.Invoke(ctx, "/protocol.Journal/Apply", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *HistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *journalClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (Journal_ReadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Journal_serviceDesc.Streams[0], "/protocol.Journal/Read", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &journalReadClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *HistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
-}
-
-type Journal_ReadClient interface {
-	Recv() (*ReadResponse, error)
-	grpc.ClientStream
 }
-
-type journalReadClient struct {
-	grpc.ClientStream
+func (m *HistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryResponse.Merge(m, src)
 }
-
-func (x *journalReadClient) Recv() (*ReadResponse, error) {
-	m := new(ReadResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *HistoryResponse) XXX_Size() int {
+	return m.ProtoSize()
 }
-
-func (c *journalClient) Append(ctx context.Context, opts ...grpc.CallOption) (Journal_AppendClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Journal_serviceDesc.Streams[1], "/protocol.Journal/Append", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &journalAppendClient{stream}
-	return x, nil
+func (m *HistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryResponse.DiscardUnknown(m)
 }
 
-type Journal_AppendClient interface {
-	Send(*AppendRequest) error
-	CloseAndRecv() (*AppendResponse, error)
-	grpc.ClientStream
-}
+var xxx_messageInfo_HistoryResponse proto.InternalMessageInfo
 
-type journalAppendClient struct {
-	grpc.ClientStream
+// Route captures the current topology of an item and the processes serving it.
+type Route struct {
+	// Members of the Route, ordered on ascending ProcessSpec.ID (zone, suffix).
+	Members []ProcessSpec_ID `protobuf:"bytes,1,rep,name=members,proto3" json:"members"`
+	// Index of the ProcessSpec serving as primary within |members|,
+	// or -1 of no member is currently primary.
+	Primary int32 `protobuf:"varint,2,opt,name=primary,proto3" json:"primary,omitempty"`
+	// Endpoints of each Route member. If not empty, |endpoints| has the same
+	// length and order as |members|, and captures the endpoint of each one.
+	Endpoints []Endpoint `protobuf:"bytes,3,rep,name=endpoints,proto3,casttype=Endpoint" json:"endpoints,omitempty"`
 }
 
-func (x *journalAppendClient) Send(m *AppendRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *Route) Reset()         { *m = Route{} }
+func (m *Route) String() string { return proto.CompactTextString(m) }
+func (*Route) ProtoMessage()    {}
+func (*Route) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{33}
 }
-
-func (x *journalAppendClient) CloseAndRecv() (*AppendResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(AppendResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *Route) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *journalClient) Replicate(ctx context.Context, opts ...grpc.CallOption) (Journal_ReplicateClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Journal_serviceDesc.Streams[2], "/protocol.Journal/Replicate", opts...)
-	if err != nil {
-		return nil, err
+func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &journalReplicateClient{stream}
-	return x, nil
-}
-
-type Journal_ReplicateClient interface {
-	Send(*ReplicateRequest) error
-	Recv() (*ReplicateResponse, error)
-	grpc.ClientStream
-}
-
-type journalReplicateClient struct {
-	grpc.ClientStream
 }
-
-func (x *journalReplicateClient) Send(m *ReplicateRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *Route) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Route.Merge(m, src)
 }
-
-func (x *journalReplicateClient) Recv() (*ReplicateResponse, error) {
-	m := new(ReplicateResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *Route) XXX_Size() int {
+	return m.ProtoSize()
 }
-
-func (c *journalClient) ListFragments(ctx context.Context, in *FragmentsRequest, opts ...grpc.CallOption) (*FragmentsResponse, error) {
-	out := new(FragmentsResponse)
-	err := c.cc.Invoke(ctx, "/protocol.Journal/ListFragments", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *Route) XXX_DiscardUnknown() {
+	xxx_messageInfo_Route.DiscardUnknown(m)
 }
 
-// JournalServer is the server API for Journal service.
-type JournalServer interface {
-	// List Journals, their JournalSpecs and current Routes.
-	List(context.Context, *ListRequest) (*ListResponse, error)
-	// Apply changes to the collection of Journals managed by the brokers.
-	Apply(context.Context, *ApplyRequest) (*ApplyResponse, error)
-	// Read from a specific Journal.
-	Read(*ReadRequest, Journal_ReadServer) error
-	// Append content to a specific Journal.
-	Append(Journal_AppendServer) error
-	// Replicate appended content of a Journal. Replicate is used between broker
-	// peers in the course of processing Append transactions, but is not intended
-	// for direct use by clients.
-	Replicate(Journal_ReplicateServer) error
-	// List Fragments of a Journal.
-	ListFragments(context.Context, *FragmentsRequest) (*FragmentsResponse, error)
-}
+var xxx_messageInfo_Route proto.InternalMessageInfo
 
-// UnimplementedJournalServer can be embedded to have forward compatible implementations.
-type UnimplementedJournalServer struct {
+// Header captures metadata such as the process responsible for processing
+// an RPC, and its effective Etcd state.
+type Header struct {
+	// ID of the process responsible for request processing. May be empty iff
+	// Header is being used within a proxied request, and that request may be
+	// dispatched to any member of the Route.
+	ProcessId ProcessSpec_ID `protobuf:"bytes,1,opt,name=process_id,json=processId,proto3" json:"process_id"`
+	// Route of processes specifically responsible for this RPC, or an empty Route
+	// if any process is capable of serving the RPC.
+	Route Route       `protobuf:"bytes,2,opt,name=route,proto3" json:"route"`
+	Etcd  Header_Etcd `protobuf:"bytes,3,opt,name=etcd,proto3" json:"etcd"`
 }
 
-func (*UnimplementedJournalServer) List(ctx context.Context, req *ListRequest) (*ListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+func (m *Header) Reset()         { *m = Header{} }
+func (m *Header) String() string { return proto.CompactTextString(m) }
+func (*Header) ProtoMessage()    {}
+func (*Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{34}
 }
-func (*UnimplementedJournalServer) Apply(ctx context.Context, req *ApplyRequest) (*ApplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Apply not implemented")
+func (m *Header) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedJournalServer) Read(req *ReadRequest, srv Journal_ReadServer) error {
-	return status.Errorf(codes.Unimplemented, "method Read not implemented")
+func (m *Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Header.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedJournalServer) Append(srv Journal_AppendServer) error {
-	return status.Errorf(codes.Unimplemented, "method Append not implemented")
+func (m *Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Header.Merge(m, src)
 }
-func (*UnimplementedJournalServer) Replicate(srv Journal_ReplicateServer) error {
-	return status.Errorf(codes.Unimplemented, "method Replicate not implemented")
+func (m *Header) XXX_Size() int {
+	return m.ProtoSize()
 }
-func (*UnimplementedJournalServer) ListFragments(ctx context.Context, req *FragmentsRequest) (*FragmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListFragments not implemented")
+func (m *Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_Header.DiscardUnknown(m)
 }
 
-func RegisterJournalServer(s *grpc.Server, srv JournalServer) {
-	s.RegisterService(&_Journal_serviceDesc, srv)
+var xxx_messageInfo_Header proto.InternalMessageInfo
+
+// Etcd represents the effective Etcd MVCC state under which a Gazette broker
+// is operating in its processing of requests and responses. Its inclusion
+// allows brokers to reason about relative "happened before" Revision ordering
+// of apparent routing conflicts in proxied or replicated requests, as well
+// as enabling sanity checks over equality of Etcd ClusterId (and precluding,
+// for example, split-brain scenarios where different brokers are backed by
+// different Etcd clusters). Etcd is kept in sync with
+// etcdserverpb.ResponseHeader.
+type Header_Etcd struct {
+	// cluster_id is the ID of the cluster.
+	ClusterId uint64 `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// member_id is the ID of the member.
+	MemberId uint64 `protobuf:"varint,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	// revision is the Etcd key-value store revision when the request was
+	// applied.
+	Revision int64 `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// raft_term is the raft term when the request was applied.
+	RaftTerm uint64 `protobuf:"varint,4,opt,name=raft_term,json=raftTerm,proto3" json:"raft_term,omitempty"`
 }
 
-func _Journal_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(JournalServer).List(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protocol.Journal/List",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JournalServer).List(ctx, req.(*ListRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *Header_Etcd) Reset()         { *m = Header_Etcd{} }
+func (m *Header_Etcd) String() string { return proto.CompactTextString(m) }
+func (*Header_Etcd) ProtoMessage()    {}
+func (*Header_Etcd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0999e5af553218, []int{34, 0}
 }
-
-func _Journal_Apply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(JournalServer).Apply(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protocol.Journal/Apply",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JournalServer).Apply(ctx, req.(*ApplyRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *Header_Etcd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Journal_Read_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ReadRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *Header_Etcd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Header_Etcd.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return srv.(JournalServer).Read(m, &journalReadServer{stream})
 }
-
-type Journal_ReadServer interface {
-	Send(*ReadResponse) error
-	grpc.ServerStream
+func (m *Header_Etcd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Header_Etcd.Merge(m, src)
 }
-
-type journalReadServer struct {
-	grpc.ServerStream
+func (m *Header_Etcd) XXX_Size() int {
+	return m.ProtoSize()
 }
-
-func (x *journalReadServer) Send(m *ReadResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *Header_Etcd) XXX_DiscardUnknown() {
+	xxx_messageInfo_Header_Etcd.DiscardUnknown(m)
 }
 
-func _Journal_Append_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(JournalServer).Append(&journalAppendServer{stream})
-}
+var xxx_messageInfo_Header_Etcd proto.InternalMessageInfo
 
-type Journal_AppendServer interface {
-	SendAndClose(*AppendResponse) error
-	Recv() (*AppendRequest, error)
-	grpc.ServerStream
+func init() {
+	proto.RegisterEnum("protocol.Status", Status_name, Status_value)
+	golang_proto.RegisterEnum("protocol.Status", Status_name, Status_value)
+	proto.RegisterEnum("protocol.CompressionCodec", CompressionCodec_name, CompressionCodec_value)
+	golang_proto.RegisterEnum("protocol.CompressionCodec", CompressionCodec_name, CompressionCodec_value)
+	proto.RegisterEnum("protocol.JournalSpec_Flag", JournalSpec_Flag_name, JournalSpec_Flag_value)
+	golang_proto.RegisterEnum("protocol.JournalSpec_Flag", JournalSpec_Flag_name, JournalSpec_Flag_value)
+	proto.RegisterEnum("protocol.JournalSpec_Suspend_Level", JournalSpec_Suspend_Level_name, JournalSpec_Suspend_Level_value)
+	golang_proto.RegisterEnum("protocol.JournalSpec_Suspend_Level", JournalSpec_Suspend_Level_name, JournalSpec_Suspend_Level_value)
+	proto.RegisterEnum("protocol.SchemaSpec_Type", SchemaSpec_Type_name, SchemaSpec_Type_value)
+	golang_proto.RegisterEnum("protocol.SchemaSpec_Type", SchemaSpec_Type_name, SchemaSpec_Type_value)
+	proto.RegisterEnum("protocol.SchemaSpec_Compatibility", SchemaSpec_Compatibility_name, SchemaSpec_Compatibility_value)
+	golang_proto.RegisterEnum("protocol.SchemaSpec_Compatibility", SchemaSpec_Compatibility_name, SchemaSpec_Compatibility_value)
+	proto.RegisterType((*Label)(nil), "protocol.Label")
+	golang_proto.RegisterType((*Label)(nil), "protocol.Label")
+	proto.RegisterType((*LabelSet)(nil), "protocol.LabelSet")
+	golang_proto.RegisterType((*LabelSet)(nil), "protocol.LabelSet")
+	proto.RegisterType((*LabelSelector)(nil), "protocol.LabelSelector")
+	golang_proto.RegisterType((*LabelSelector)(nil), "protocol.LabelSelector")
+	proto.RegisterType((*JournalSpec)(nil), "protocol.JournalSpec")
+	golang_proto.RegisterType((*JournalSpec)(nil), "protocol.JournalSpec")
+	proto.RegisterType((*JournalSpec_Fragment)(nil), "protocol.JournalSpec.Fragment")
+	golang_proto.RegisterType((*JournalSpec_Fragment)(nil), "protocol.JournalSpec.Fragment")
+	proto.RegisterType((*JournalSpec_Suspend)(nil), "protocol.JournalSpec.Suspend")
+	golang_proto.RegisterType((*JournalSpec_Suspend)(nil), "protocol.JournalSpec.Suspend")
+	proto.RegisterType((*ProcessSpec)(nil), "protocol.ProcessSpec")
+	golang_proto.RegisterType((*ProcessSpec)(nil), "protocol.ProcessSpec")
+	proto.RegisterType((*ProcessSpec_ID)(nil), "protocol.ProcessSpec.ID")
+	golang_proto.RegisterType((*ProcessSpec_ID)(nil), "protocol.ProcessSpec.ID")
+	proto.RegisterType((*BrokerSpec)(nil), "protocol.BrokerSpec")
+	golang_proto.RegisterType((*BrokerSpec)(nil), "protocol.BrokerSpec")
+	proto.RegisterType((*Fragment)(nil), "protocol.Fragment")
+	golang_proto.RegisterType((*Fragment)(nil), "protocol.Fragment")
+	proto.RegisterType((*SHA1Sum)(nil), "protocol.SHA1Sum")
+	golang_proto.RegisterType((*SHA1Sum)(nil), "protocol.SHA1Sum")
+	proto.RegisterType((*ReadRequest)(nil), "protocol.ReadRequest")
+	golang_proto.RegisterType((*ReadRequest)(nil), "protocol.ReadRequest")
+	proto.RegisterType((*ReadResponse)(nil), "protocol.ReadResponse")
+	golang_proto.RegisterType((*ReadResponse)(nil), "protocol.ReadResponse")
+	proto.RegisterType((*AppendRequest)(nil), "protocol.AppendRequest")
+	golang_proto.RegisterType((*AppendRequest)(nil), "protocol.AppendRequest")
+	proto.RegisterType((*AppendResponse)(nil), "protocol.AppendResponse")
+	golang_proto.RegisterType((*AppendResponse)(nil), "protocol.AppendResponse")
+	proto.RegisterType((*ReplicateRequest)(nil), "protocol.ReplicateRequest")
+	golang_proto.RegisterType((*ReplicateRequest)(nil), "protocol.ReplicateRequest")
+	proto.RegisterType((*ReplicateResponse)(nil), "protocol.ReplicateResponse")
+	golang_proto.RegisterType((*ReplicateResponse)(nil), "protocol.ReplicateResponse")
+	proto.RegisterType((*ListRequest)(nil), "protocol.ListRequest")
+	golang_proto.RegisterType((*ListRequest)(nil), "protocol.ListRequest")
+	proto.RegisterType((*ListResponse)(nil), "protocol.ListResponse")
+	golang_proto.RegisterType((*ListResponse)(nil), "protocol.ListResponse")
+	proto.RegisterType((*ListResponse_Journal)(nil), "protocol.ListResponse.Journal")
+	golang_proto.RegisterType((*ListResponse_Journal)(nil), "protocol.ListResponse.Journal")
+	proto.RegisterType((*WatchResponse)(nil), "protocol.WatchResponse")
+	golang_proto.RegisterType((*WatchResponse)(nil), "protocol.WatchResponse")
+	proto.RegisterType((*ApplyRequest)(nil), "protocol.ApplyRequest")
+	golang_proto.RegisterType((*ApplyRequest)(nil), "protocol.ApplyRequest")
+	proto.RegisterType((*ApplyRequest_Change)(nil), "protocol.ApplyRequest.Change")
+	golang_proto.RegisterType((*ApplyRequest_Change)(nil), "protocol.ApplyRequest.Change")
+	proto.RegisterType((*ApplyResponse)(nil), "protocol.ApplyResponse")
+	golang_proto.RegisterType((*ApplyResponse)(nil), "protocol.ApplyResponse")
+	proto.RegisterType((*FragmentsRequest)(nil), "protocol.FragmentsRequest")
+	golang_proto.RegisterType((*FragmentsRequest)(nil), "protocol.FragmentsRequest")
+	proto.RegisterType((*FragmentsResponse)(nil), "protocol.FragmentsResponse")
+	golang_proto.RegisterType((*FragmentsResponse)(nil), "protocol.FragmentsResponse")
+	proto.RegisterType((*FragmentsResponse__Fragment)(nil), "protocol.FragmentsResponse._Fragment")
+	golang_proto.RegisterType((*FragmentsResponse__Fragment)(nil), "protocol.FragmentsResponse._Fragment")
+	proto.RegisterType((*TruncateRequest)(nil), "protocol.TruncateRequest")
+	golang_proto.RegisterType((*TruncateRequest)(nil), "protocol.TruncateRequest")
+	proto.RegisterType((*TruncateResponse)(nil), "protocol.TruncateResponse")
+	golang_proto.RegisterType((*TruncateResponse)(nil), "protocol.TruncateResponse")
+	proto.RegisterType((*StatRequest)(nil), "protocol.StatRequest")
+	golang_proto.RegisterType((*StatRequest)(nil), "protocol.StatRequest")
+	proto.RegisterType((*StatResponse)(nil), "protocol.StatResponse")
+	golang_proto.RegisterType((*StatResponse)(nil), "protocol.StatResponse")
+	proto.RegisterType((*StatResponse_Replica)(nil), "protocol.StatResponse.Replica")
+	golang_proto.RegisterType((*StatResponse_Replica)(nil), "protocol.StatResponse.Replica")
+	proto.RegisterType((*SchemaSpec)(nil), "protocol.SchemaSpec")
+	golang_proto.RegisterType((*SchemaSpec)(nil), "protocol.SchemaSpec")
+	proto.RegisterType((*ListSchemasRequest)(nil), "protocol.ListSchemasRequest")
+	golang_proto.RegisterType((*ListSchemasRequest)(nil), "protocol.ListSchemasRequest")
+	proto.RegisterType((*ListSchemasResponse)(nil), "protocol.ListSchemasResponse")
+	golang_proto.RegisterType((*ListSchemasResponse)(nil), "protocol.ListSchemasResponse")
+	proto.RegisterType((*ListSchemasResponse_Schema)(nil), "protocol.ListSchemasResponse.Schema")
+	golang_proto.RegisterType((*ListSchemasResponse_Schema)(nil), "protocol.ListSchemasResponse.Schema")
+	proto.RegisterType((*ApplySchemasRequest)(nil), "protocol.ApplySchemasRequest")
+	golang_proto.RegisterType((*ApplySchemasRequest)(nil), "protocol.ApplySchemasRequest")
+	proto.RegisterType((*ApplySchemasRequest_Change)(nil), "protocol.ApplySchemasRequest.Change")
+	golang_proto.RegisterType((*ApplySchemasRequest_Change)(nil), "protocol.ApplySchemasRequest.Change")
+	proto.RegisterType((*ApplySchemasResponse)(nil), "protocol.ApplySchemasResponse")
+	golang_proto.RegisterType((*ApplySchemasResponse)(nil), "protocol.ApplySchemasResponse")
+	proto.RegisterType((*JournalSpecRevision)(nil), "protocol.JournalSpecRevision")
+	golang_proto.RegisterType((*JournalSpecRevision)(nil), "protocol.JournalSpecRevision")
+	proto.RegisterType((*HistoryRequest)(nil), "protocol.HistoryRequest")
+	golang_proto.RegisterType((*HistoryRequest)(nil), "protocol.HistoryRequest")
+	proto.RegisterType((*HistoryResponse)(nil), "protocol.HistoryResponse")
+	golang_proto.RegisterType((*HistoryResponse)(nil), "protocol.HistoryResponse")
+	proto.RegisterType((*Route)(nil), "protocol.Route")
+	golang_proto.RegisterType((*Route)(nil), "protocol.Route")
+	proto.RegisterType((*Header)(nil), "protocol.Header")
+	golang_proto.RegisterType((*Header)(nil), "protocol.Header")
+	proto.RegisterType((*Header_Etcd)(nil), "protocol.Header.Etcd")
+	golang_proto.RegisterType((*Header_Etcd)(nil), "protocol.Header.Etcd")
 }
 
-type journalAppendServer struct {
-	grpc.ServerStream
+func init() { proto.RegisterFile("broker/protocol/protocol.proto", fileDescriptor_0c0999e5af553218) }
+func init() {
+	golang_proto.RegisterFile("broker/protocol/protocol.proto", fileDescriptor_0c0999e5af553218)
 }
 
-func (x *journalAppendServer) SendAndClose(m *AppendResponse) error {
-	return x.ServerStream.SendMsg(m)
+var fileDescriptor_0c0999e5af553218 = []byte{
+	// 3909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4d, 0x8c, 0x1b, 0xd9,
+	0x71, 0x9e, 0xe6, 0x7f, 0x17, 0xc9, 0x99, 0x9e, 0xa7, 0x3f, 0x8a, 0x92, 0x86, 0xb3, 0x94, 0x76,
+	0x57, 0xd2, 0x6a, 0xa9, 0xf5, 0xac, 0xd7, 0xb2, 0x65, 0xec, 0x66, 0xc9, 0x21, 0x47, 0x43, 0x89,
+	0x43, 0x12, 0x4d, 0xce, 0xca, 0xda, 0x00, 0x69, 0xf4, 0x90, 0x6f, 0x38, 0x1d, 0x35, 0xbb, 0xe9,
+	0xee, 0xa6, 0x3c, 0xdc, 0x43, 0x10, 0x5f, 0x1c, 0xc3, 0x48, 0x00, 0x23, 0x87, 0xc0, 0xc7, 0xbd,
+	0xe4, 0x6c, 0x03, 0x39, 0x18, 0xc8, 0x0f, 0x90, 0x4b, 0x80, 0x0d, 0x90, 0xc3, 0x9e, 0x82, 0x00,
+	0x01, 0x26, 0x88, 0x75, 0xc9, 0x79, 0x82, 0x1c, 0xb2, 0xa7, 0xe0, 0xfd, 0xb1, 0xbb, 0xf9, 0x33,
+	0x92, 0x02, 0x8c, 0xf7, 0x42, 0x74, 0x57, 0xd5, 0xab, 0xae, 0x57, 0xf5, 0x5e, 0xd5, 0xf7, 0xea,
+	0x11, 0x36, 0x0e, 0x1c, 0xfb, 0x39, 0x76, 0xee, 0x8f, 0x1c, 0xdb, 0xb3, 0x7b, 0xb6, 0x39, 0x7d,
+	0x28, 0xd1, 0x07, 0x94, 0x12, 0xef, 0xf9, 0x8b, 0x03, 0x7b, 0x60, 0xd3, 0xb7, 0xfb, 0xe4, 0x89,
+	0xf1, 0xf3, 0x1b, 0x03, 0xdb, 0x1e, 0x98, 0x98, 0x0d, 0x3b, 0x18, 0x1f, 0xde, 0xef, 0x8f, 0x1d,
+	0xdd, 0x33, 0x6c, 0x8b, 0xf1, 0x8b, 0x0f, 0x20, 0xde, 0xd0, 0x0f, 0xb0, 0x89, 0x10, 0xc4, 0x2c,
+	0x7d, 0x88, 0x73, 0xd2, 0xa6, 0x74, 0x5b, 0x56, 0xe9, 0x33, 0xba, 0x08, 0xf1, 0x17, 0xba, 0x39,
+	0xc6, 0xb9, 0x08, 0x25, 0xb2, 0x97, 0x87, 0xb1, 0xff, 0xfa, 0xb2, 0x20, 0x15, 0xbb, 0x90, 0xa2,
+	0x03, 0x3b, 0xd8, 0x43, 0x15, 0x48, 0x98, 0xe4, 0xd9, 0xcd, 0x49, 0x9b, 0xd1, 0xdb, 0xe9, 0xad,
+	0xb5, 0xd2, 0xd4, 0x4a, 0x2a, 0x53, 0xb9, 0xfa, 0xd5, 0x49, 0x61, 0xe5, 0xf4, 0xa4, 0xb0, 0x3e,
+	0xd1, 0x87, 0xe6, 0xc3, 0xe2, 0x3d, 0x7b, 0x68, 0x78, 0x78, 0x38, 0xf2, 0x26, 0x45, 0x95, 0x8f,
+	0xe4, 0x5a, 0x7f, 0x2a, 0x41, 0x96, 0xab, 0x35, 0x71, 0xcf, 0xb3, 0x1d, 0xb4, 0x05, 0x49, 0xc3,
+	0xea, 0x99, 0xe3, 0x3e, 0x33, 0x2d, 0xbd, 0x85, 0x66, 0x94, 0x77, 0xb0, 0x57, 0x89, 0x11, 0xfd,
+	0xaa, 0x10, 0x24, 0x63, 0xf0, 0x31, 0x1b, 0x13, 0x79, 0xd5, 0x18, 0x2e, 0xf8, 0x30, 0xf5, 0xab,
+	0x2f, 0x0b, 0x2b, 0xd4, 0x86, 0xff, 0x5d, 0x87, 0xf4, 0x63, 0x7b, 0xec, 0x58, 0xba, 0xd9, 0x19,
+	0xe1, 0x1e, 0xfa, 0x6e, 0xd0, 0x33, 0x95, 0xcd, 0x85, 0xd3, 0xf8, 0xe6, 0xa4, 0x90, 0xe4, 0x63,
+	0xb8, 0xef, 0x1e, 0x40, 0xda, 0xc1, 0x23, 0xd3, 0xe8, 0x51, 0x6f, 0x53, 0x3b, 0xe2, 0x95, 0x4b,
+	0x8b, 0x7d, 0x10, 0x94, 0x44, 0xed, 0xa9, 0x33, 0xa3, 0x4b, 0x6d, 0xbf, 0x45, 0x6c, 0xff, 0xfa,
+	0xa4, 0x20, 0x9d, 0x9e, 0x14, 0x72, 0xb3, 0xfa, 0xee, 0x19, 0x96, 0x69, 0x58, 0x78, 0xea, 0x5a,
+	0xb4, 0x0f, 0xa9, 0x43, 0x47, 0x1f, 0x0c, 0xb1, 0xe5, 0xe5, 0x62, 0x54, 0xe7, 0x86, 0xaf, 0x33,
+	0x30, 0xd3, 0xd2, 0x0e, 0x97, 0x3a, 0x2b, 0x5e, 0x53, 0x55, 0xe8, 0x0f, 0x20, 0x7e, 0x68, 0xea,
+	0x03, 0x37, 0x97, 0xd8, 0x94, 0x6e, 0x67, 0x2b, 0x77, 0x96, 0x39, 0x46, 0x09, 0x7c, 0x42, 0xdb,
+	0x31, 0xf5, 0x81, 0xca, 0xc6, 0xa1, 0x06, 0xac, 0x0d, 0xf5, 0x63, 0x4d, 0x1f, 0x8d, 0xb0, 0xd5,
+	0xd7, 0x1c, 0xdd, 0xc3, 0xb9, 0xe4, 0xa6, 0x74, 0x3b, 0x5a, 0xb9, 0x75, 0x7a, 0x52, 0xd8, 0x64,
+	0xaa, 0x66, 0x04, 0x82, 0x96, 0x64, 0x87, 0xfa, 0x71, 0x99, 0xb2, 0x54, 0xdd, 0xc3, 0x68, 0x17,
+	0x60, 0x68, 0x58, 0x9a, 0x7d, 0x78, 0xe8, 0x62, 0x2f, 0x97, 0xa2, 0x8a, 0x88, 0x4d, 0xd7, 0xb8,
+	0xa2, 0x29, 0x2f, 0x6c, 0x5d, 0xa2, 0x45, 0x89, 0xaa, 0x3c, 0x34, 0x2c, 0xf6, 0x88, 0x54, 0x48,
+	0xba, 0x63, 0x97, 0x28, 0xce, 0xc9, 0xd4, 0x5d, 0x37, 0x16, 0xbb, 0xab, 0xc3, 0x84, 0xce, 0xf2,
+	0x96, 0x50, 0x84, 0x1e, 0x41, 0xf6, 0xc7, 0x63, 0xdb, 0x19, 0x0f, 0xb5, 0x9e, 0x3d, 0x1c, 0x1a,
+	0x5e, 0x0e, 0x36, 0xa5, 0xdb, 0xa9, 0x4a, 0xf1, 0xf4, 0xa4, 0xb0, 0xc1, 0x86, 0x85, 0xd8, 0x41,
+	0x1d, 0x19, 0xc6, 0xd9, 0xa6, 0x0c, 0xd4, 0x06, 0xe5, 0x85, 0x6e, 0x1a, 0x7d, 0xdd, 0xc3, 0xda,
+	0xa1, 0xa3, 0x0f, 0x0d, 0x6b, 0x90, 0x4b, 0x53, 0x5d, 0x6f, 0x9f, 0x9e, 0x14, 0xde, 0x62, 0xba,
+	0x66, 0x25, 0x82, 0xea, 0xd6, 0x04, 0x73, 0x87, 0xf1, 0xd0, 0x1e, 0x4c, 0x49, 0x9a, 0xdb, 0x3b,
+	0xc2, 0x43, 0x3d, 0x97, 0xa1, 0x0a, 0x03, 0x61, 0x98, 0x11, 0x08, 0xea, 0x5b, 0x15, 0xbc, 0x0e,
+	0x65, 0xe5, 0x7f, 0x2b, 0x43, 0x4a, 0x2c, 0x24, 0xf4, 0x3e, 0x24, 0x4c, 0x6c, 0x0d, 0xbc, 0x23,
+	0xba, 0x7b, 0xa2, 0xcb, 0x36, 0x00, 0x17, 0x42, 0x36, 0xac, 0xf7, 0xec, 0xe1, 0xc8, 0xc1, 0xae,
+	0x6b, 0xd8, 0x96, 0xd6, 0xb3, 0xfb, 0xb8, 0x47, 0xb7, 0xce, 0xea, 0x56, 0xde, 0x8f, 0xc1, 0xb6,
+	0x2f, 0xb2, 0x4d, 0x24, 0x2a, 0xef, 0x9c, 0x9e, 0x14, 0x8a, 0x4c, 0xeb, 0xdc, 0xf0, 0xe0, 0x67,
+	0x94, 0xde, 0xcc, 0x48, 0xf4, 0x09, 0x24, 0x5c, 0xcf, 0x76, 0x30, 0xd9, 0x6c, 0xd1, 0xdb, 0x72,
+	0xe5, 0x9d, 0x85, 0xf6, 0x7d, 0x73, 0x52, 0xc8, 0x8a, 0x29, 0x75, 0x88, 0xb8, 0xca, 0x47, 0x21,
+	0x17, 0x14, 0x07, 0x1f, 0x3a, 0xd8, 0x3d, 0xd2, 0x0c, 0xcb, 0xc3, 0xce, 0x0b, 0xdd, 0xe4, 0x5b,
+	0xec, 0x6a, 0x89, 0x65, 0xde, 0x92, 0xc8, 0xbc, 0xa5, 0x2a, 0xcf, 0xbc, 0x95, 0xf7, 0xf9, 0x7a,
+	0xe1, 0xc1, 0x9a, 0x55, 0x10, 0xf8, 0xf0, 0xaf, 0xfe, 0xa3, 0x20, 0xa9, 0x6b, 0x5c, 0xa0, 0xce,
+	0xf9, 0xe8, 0x33, 0x90, 0x1d, 0xec, 0x61, 0x8b, 0x26, 0x96, 0xf8, 0xab, 0xbe, 0x76, 0x63, 0xe9,
+	0xea, 0xa4, 0xda, 0x7d, 0x55, 0x68, 0x08, 0xab, 0x87, 0xe6, 0x38, 0x38, 0x95, 0xc4, 0xab, 0x94,
+	0xbf, 0xc7, 0x95, 0x17, 0x98, 0xf2, 0xf0, 0xf0, 0xd9, 0x4f, 0x65, 0x29, 0x7b, 0x3a, 0x8d, 0x3f,
+	0x82, 0x4b, 0x23, 0xdd, 0x3b, 0xd2, 0x46, 0xb6, 0xeb, 0x1d, 0x1a, 0xc7, 0x1a, 0x11, 0x35, 0x45,
+	0x12, 0x90, 0x2b, 0x77, 0x4f, 0x4f, 0x0a, 0xef, 0x30, 0xb5, 0x0b, 0xc5, 0x82, 0x81, 0xbd, 0x40,
+	0x24, 0xda, 0x4c, 0xa0, 0xcb, 0xf9, 0xe8, 0x87, 0x20, 0x93, 0xec, 0x71, 0x30, 0xf1, 0xb0, 0xcb,
+	0xf3, 0xc1, 0xc6, 0xe9, 0x49, 0x21, 0xef, 0x27, 0x16, 0xca, 0x0a, 0x25, 0xb7, 0xa1, 0x7e, 0x5c,
+	0x21, 0x44, 0xb2, 0x5f, 0x89, 0x84, 0x48, 0x76, 0x2e, 0xcd, 0x04, 0xd1, 0xe0, 0x7e, 0x0d, 0xb1,
+	0x43, 0xfb, 0x75, 0xa8, 0x1f, 0x8b, 0xd5, 0xe2, 0x22, 0x1d, 0xc0, 0x33, 0xb0, 0xa3, 0xe9, 0x87,
+	0x1e, 0x76, 0x72, 0xb0, 0x19, 0x3d, 0xdb, 0xa1, 0xef, 0x72, 0x87, 0xf2, 0xac, 0xe5, 0x0f, 0x9d,
+	0x8b, 0x1b, 0x61, 0x95, 0x09, 0x07, 0x3d, 0x86, 0x55, 0x6c, 0xf5, 0x9c, 0xc9, 0x88, 0x68, 0xd0,
+	0x9e, 0xe3, 0x09, 0x4d, 0x08, 0x72, 0xe5, 0xa6, 0x1f, 0x98, 0x30, 0x3f, 0x94, 0x45, 0x7d, 0xd6,
+	0x13, 0x3c, 0x41, 0x07, 0x70, 0x39, 0xb8, 0x85, 0xfa, 0x46, 0x8f, 0x70, 0x74, 0x67, 0x42, 0x73,
+	0x82, 0x5c, 0x79, 0xef, 0xf4, 0xa4, 0xf0, 0xee, 0xfc, 0x56, 0xf3, 0xe5, 0x82, 0xba, 0x2f, 0x05,
+	0x44, 0xaa, 0x53, 0x09, 0x54, 0x85, 0x8c, 0x83, 0xf5, 0x3e, 0x76, 0xb4, 0x43, 0xd3, 0xb6, 0x9d,
+	0x5c, 0x96, 0xba, 0xf6, 0xad, 0xd3, 0x93, 0xc2, 0x0d, 0xb1, 0x23, 0x7c, 0xee, 0x4c, 0x9d, 0x24,
+	0x8c, 0x1d, 0x42, 0x27, 0xb3, 0x1e, 0x39, 0x63, 0x0b, 0x6b, 0xf6, 0x0b, 0xec, 0xb8, 0xc6, 0x17,
+	0x38, 0xb7, 0x4a, 0xb3, 0x56, 0x60, 0xd6, 0x61, 0x7e, 0x68, 0xd6, 0x94, 0xd5, 0xe2, 0x1c, 0x06,
+	0x3e, 0xf2, 0xbf, 0x95, 0x20, 0xc9, 0x73, 0x3a, 0x6a, 0x43, 0xdc, 0xc4, 0x2f, 0xb0, 0x49, 0xf3,
+	0xd6, 0xea, 0xd6, 0xcd, 0x33, 0x2b, 0x40, 0xa9, 0x41, 0x44, 0x97, 0x25, 0x37, 0xa6, 0x08, 0x3d,
+	0x80, 0x04, 0xaf, 0x4d, 0x11, 0x3a, 0xdf, 0xc2, 0xb2, 0x54, 0x23, 0x2a, 0x12, 0x17, 0x2f, 0x5e,
+	0x83, 0x38, 0xd5, 0x8f, 0x52, 0x10, 0x6b, 0xb6, 0x9a, 0x35, 0x65, 0x85, 0x3c, 0xed, 0xec, 0x37,
+	0x1a, 0x8a, 0xc4, 0x61, 0x53, 0x19, 0x62, 0xa4, 0xb0, 0xa2, 0x75, 0xc8, 0x36, 0x5b, 0x5d, 0xad,
+	0xd3, 0xae, 0x6d, 0xd7, 0x77, 0xea, 0xb5, 0xaa, 0xb2, 0x82, 0x32, 0x90, 0x6a, 0x69, 0x6a, 0xb5,
+	0xd5, 0x6c, 0x3c, 0x53, 0x24, 0xf6, 0xf6, 0x54, 0xa5, 0x6f, 0x11, 0x04, 0x90, 0x20, 0xbc, 0xa7,
+	0xaa, 0x12, 0xe3, 0x8a, 0xfe, 0x5a, 0x82, 0x74, 0xdb, 0xb1, 0x7b, 0xd8, 0x75, 0x29, 0xf6, 0x29,
+	0x41, 0xc4, 0xe8, 0x73, 0xe0, 0x95, 0xf3, 0x7d, 0x10, 0x10, 0x29, 0xd5, 0xab, 0x1c, 0x4a, 0x45,
+	0x8c, 0x3e, 0xba, 0x0d, 0x29, 0x6c, 0xf5, 0x47, 0xb6, 0x61, 0xb1, 0x69, 0xca, 0x95, 0xcc, 0x37,
+	0x27, 0x85, 0x54, 0x8d, 0xd3, 0xd4, 0x29, 0x37, 0xff, 0x3d, 0x88, 0xd4, 0xab, 0x04, 0x75, 0x7e,
+	0x61, 0x5b, 0x53, 0xd4, 0x49, 0x9e, 0xd1, 0x65, 0x48, 0xb8, 0xe3, 0xc3, 0x43, 0xe3, 0x98, 0x69,
+	0x50, 0xf9, 0x1b, 0xb3, 0xf0, 0x61, 0xec, 0xe7, 0xc4, 0xce, 0x3f, 0x93, 0x00, 0x2a, 0x14, 0x19,
+	0x53, 0x33, 0xbb, 0x90, 0x19, 0x31, 0x93, 0x34, 0x77, 0x84, 0x7b, 0xdc, 0xe0, 0x4b, 0x0b, 0x0d,
+	0xae, 0xe4, 0x03, 0xe0, 0x69, 0x95, 0x07, 0x40, 0x40, 0xa6, 0xf4, 0x28, 0x30, 0xf9, 0x9b, 0x90,
+	0xfd, 0x63, 0x16, 0x6c, 0xcd, 0x34, 0x48, 0xcd, 0x26, 0xf6, 0x64, 0xd5, 0x0c, 0x27, 0x36, 0x08,
+	0xad, 0xf8, 0x9b, 0x68, 0xa0, 0xdc, 0xbd, 0x0d, 0x49, 0xce, 0xe4, 0x68, 0x31, 0x1d, 0x04, 0x86,
+	0x82, 0x87, 0x36, 0x21, 0x7e, 0x80, 0x07, 0x86, 0xc5, 0x57, 0x02, 0x04, 0x82, 0xce, 0x18, 0xe8,
+	0x3a, 0x44, 0x09, 0xfc, 0x88, 0xce, 0xf1, 0x09, 0x19, 0xdd, 0x81, 0xa8, 0x3b, 0x1e, 0xf2, 0x42,
+	0xb3, 0xee, 0xcf, 0xb2, 0xb3, 0x5b, 0xfe, 0x4e, 0x67, 0x3c, 0xe4, 0xf1, 0x20, 0x32, 0xe8, 0xd1,
+	0xa2, 0x8a, 0x1a, 0x7f, 0x55, 0x45, 0x5d, 0x50, 0x29, 0xbf, 0x07, 0xd9, 0x03, 0xbd, 0xf7, 0xdc,
+	0xb0, 0x06, 0x1a, 0xad, 0x7d, 0xb4, 0x36, 0xc8, 0x95, 0xf5, 0xf9, 0xda, 0x98, 0xe1, 0x72, 0xf4,
+	0x0d, 0x5d, 0x85, 0xd4, 0xd0, 0xee, 0x6b, 0x9e, 0x31, 0xe4, 0xe8, 0x4e, 0x4d, 0x0e, 0xed, 0x7e,
+	0xd7, 0x18, 0x62, 0xf4, 0x16, 0x64, 0x82, 0x99, 0x9d, 0xe6, 0x68, 0x59, 0x4d, 0x07, 0x72, 0x39,
+	0xba, 0x0e, 0x32, 0xcf, 0x4f, 0x98, 0x81, 0xb1, 0x94, 0xea, 0x13, 0xd0, 0x47, 0x4b, 0x93, 0x15,
+	0x50, 0x55, 0x8b, 0xf3, 0x4f, 0xf1, 0x09, 0x24, 0xb9, 0xa7, 0xc8, 0x09, 0x67, 0xa4, 0x3b, 0xde,
+	0x77, 0x68, 0xb8, 0x12, 0x2a, 0x7b, 0x11, 0xd4, 0xad, 0x5c, 0xc4, 0xa7, 0x6e, 0x09, 0xea, 0x87,
+	0x34, 0x2a, 0x49, 0x46, 0xfd, 0xb0, 0xf8, 0x9b, 0x08, 0xa4, 0x55, 0xac, 0xf7, 0x55, 0xfc, 0xe3,
+	0x31, 0x76, 0x3d, 0x74, 0x1b, 0x12, 0x47, 0x34, 0x4b, 0xf1, 0x45, 0xa8, 0xf8, 0x5e, 0xde, 0xa5,
+	0x74, 0x95, 0xf3, 0x83, 0x8b, 0x25, 0x72, 0xc6, 0x62, 0x29, 0x4e, 0xf3, 0xc6, 0xfc, 0x6a, 0xe0,
+	0x1c, 0x62, 0xda, 0x81, 0x69, 0xf7, 0x9e, 0xd3, 0x25, 0x91, 0x52, 0xd9, 0x0b, 0xda, 0x84, 0x4c,
+	0xdf, 0xd6, 0x2c, 0xdb, 0xd3, 0x46, 0x8e, 0x7d, 0x3c, 0xa1, 0x61, 0x4f, 0xa9, 0xd0, 0xb7, 0x9b,
+	0xb6, 0xd7, 0x26, 0x14, 0xb2, 0xc2, 0x87, 0xd8, 0xd3, 0xfb, 0xba, 0xa7, 0x6b, 0xb6, 0x65, 0x4e,
+	0x68, 0x50, 0x53, 0x6a, 0x46, 0x10, 0x5b, 0x96, 0x39, 0x41, 0x77, 0x00, 0x08, 0xfc, 0xe6, 0x46,
+	0x24, 0xe7, 0x8c, 0x90, 0xb1, 0xd5, 0x67, 0x8f, 0xe8, 0x16, 0xac, 0xd2, 0xf5, 0xab, 0x4d, 0x43,
+	0x4e, 0xeb, 0xae, 0x9a, 0xa1, 0xd4, 0x3d, 0x16, 0xf7, 0xe2, 0x3f, 0x45, 0x20, 0xc3, 0x5c, 0xe6,
+	0x8e, 0x6c, 0xcb, 0xc5, 0xc4, 0x67, 0xae, 0xa7, 0x7b, 0x63, 0x97, 0x67, 0xdb, 0x80, 0xcf, 0x3a,
+	0x94, 0xae, 0x72, 0x7e, 0xc0, 0xbb, 0x91, 0x57, 0x78, 0xf7, 0x75, 0xdc, 0x76, 0x07, 0xe0, 0x27,
+	0x8e, 0xe1, 0x61, 0x8d, 0x8c, 0xc9, 0xc5, 0xe6, 0xe4, 0x64, 0xca, 0x25, 0x8a, 0x51, 0x29, 0x70,
+	0x86, 0x8a, 0xcf, 0x9e, 0xcb, 0xc4, 0xfa, 0x0f, 0x1c, 0x8e, 0xde, 0x82, 0x8c, 0x78, 0xd6, 0xc6,
+	0x0e, 0x43, 0x52, 0xb2, 0x9a, 0x16, 0xb4, 0x7d, 0xc7, 0x44, 0x39, 0x48, 0xf6, 0x6c, 0xcb, 0xc3,
+	0x16, 0x73, 0x6a, 0x46, 0x15, 0xaf, 0xe8, 0x6d, 0x58, 0xf5, 0xd7, 0x32, 0x1d, 0xce, 0xb6, 0x46,
+	0xd6, 0xa7, 0xee, 0x3b, 0x66, 0xf1, 0xe7, 0x51, 0xc8, 0xf2, 0x03, 0xd0, 0x79, 0x2d, 0xbe, 0xd9,
+	0x25, 0x14, 0x9d, 0x5b, 0x42, 0xbe, 0x9f, 0xe3, 0x4b, 0xfd, 0xfc, 0x29, 0xac, 0xf5, 0x8e, 0x70,
+	0xef, 0xb9, 0xe6, 0xe0, 0x81, 0xe1, 0x7a, 0xd8, 0x71, 0x39, 0xb2, 0xbc, 0x32, 0x77, 0xb6, 0x65,
+	0xa7, 0x7e, 0x75, 0x95, 0xca, 0xab, 0x42, 0x1c, 0xfd, 0x10, 0xd6, 0xc6, 0x16, 0xd9, 0xe3, 0xbe,
+	0x86, 0xe4, 0xb2, 0xd3, 0xb1, 0xba, 0x4a, 0x45, 0xfd, 0xc1, 0x65, 0x40, 0xee, 0xf8, 0xc0, 0x73,
+	0xf4, 0x9e, 0x17, 0x18, 0x9f, 0x5a, 0x3a, 0x7e, 0x5d, 0x48, 0xfb, 0x2a, 0x02, 0xb1, 0x8a, 0x85,
+	0x62, 0xc5, 0xeb, 0xe6, 0x5f, 0x46, 0x60, 0x55, 0x84, 0xe2, 0x8d, 0x17, 0x75, 0xe9, 0x55, 0x8b,
+	0x9a, 0x27, 0x74, 0x11, 0xbb, 0xbb, 0x90, 0xe0, 0x87, 0xc8, 0xe8, 0xd2, 0x95, 0xc8, 0x25, 0xd0,
+	0x07, 0xe4, 0xac, 0x20, 0xa6, 0x1c, 0x5b, 0x3a, 0x65, 0x5f, 0x88, 0xac, 0x5c, 0xcf, 0xf6, 0x74,
+	0x53, 0xeb, 0x1d, 0x8d, 0xad, 0xe7, 0x2e, 0x0b, 0xab, 0x9a, 0xa6, 0xb4, 0x6d, 0x4a, 0xa2, 0xeb,
+	0x13, 0x9b, 0xfa, 0x04, 0xf7, 0x85, 0x50, 0x82, 0x0a, 0x65, 0x39, 0x95, 0x89, 0x15, 0xff, 0x2e,
+	0x02, 0x8a, 0xca, 0x3b, 0x1b, 0xf8, 0xcd, 0x97, 0x68, 0x09, 0x48, 0x73, 0x6b, 0x64, 0xbb, 0xba,
+	0x79, 0xc6, 0x44, 0xa7, 0x32, 0xe1, 0xa9, 0x26, 0x5f, 0x67, 0xaa, 0x9b, 0x90, 0xd6, 0x7b, 0xcf,
+	0x2d, 0xfb, 0x27, 0x26, 0xee, 0x0f, 0x30, 0x4f, 0x7e, 0x41, 0x12, 0x7a, 0x08, 0xa8, 0x8f, 0x47,
+	0x0e, 0x26, 0x33, 0xe8, 0x6b, 0x67, 0xec, 0x98, 0x75, 0x5f, 0x8c, 0x93, 0x96, 0xaf, 0x19, 0x92,
+	0x76, 0xf9, 0xa3, 0xd6, 0xc7, 0xa6, 0xa7, 0x73, 0x1f, 0x67, 0x38, 0xb1, 0x4a, 0x68, 0xc5, 0x7f,
+	0x96, 0x60, 0x3d, 0xe0, 0xbd, 0x73, 0x4c, 0x95, 0xc1, 0xdc, 0x16, 0x7d, 0x8d, 0xdc, 0xf6, 0xc6,
+	0x6b, 0xaa, 0xd8, 0x85, 0x74, 0xc3, 0x70, 0x3d, 0xb1, 0x06, 0x7e, 0x00, 0x29, 0x97, 0xef, 0xf4,
+	0x9c, 0x74, 0x66, 0x22, 0xe0, 0x2b, 0x7f, 0x2a, 0xfe, 0x38, 0x96, 0x8a, 0x28, 0xd1, 0xc7, 0xb1,
+	0x54, 0x54, 0x89, 0x15, 0xff, 0x21, 0x02, 0x19, 0xa6, 0xf6, 0xdc, 0xb7, 0xdc, 0xa7, 0x90, 0xe2,
+	0xc1, 0x67, 0x9d, 0x82, 0x50, 0x0b, 0x2d, 0x68, 0x83, 0x38, 0x1e, 0x08, 0xc3, 0xc5, 0xa8, 0xfc,
+	0x2f, 0x24, 0x10, 0x8b, 0x05, 0xdd, 0x87, 0xd8, 0x62, 0x98, 0x1a, 0x38, 0x5b, 0x70, 0x05, 0x54,
+	0x90, 0xec, 0x49, 0x52, 0x51, 0x1d, 0xfc, 0xc2, 0x70, 0x45, 0x37, 0x31, 0xaa, 0xa6, 0x87, 0x76,
+	0x5f, 0xe5, 0x24, 0xf4, 0x1e, 0xc4, 0x1d, 0x7b, 0xec, 0x61, 0x1e, 0xc1, 0x40, 0x0b, 0x56, 0x25,
+	0x64, 0xae, 0x8e, 0xc9, 0x3c, 0x8e, 0xa5, 0x62, 0x4a, 0xbc, 0xf8, 0xb5, 0x04, 0xd9, 0xa7, 0xba,
+	0xd7, 0x3b, 0xfa, 0x3d, 0x38, 0xf0, 0x13, 0x48, 0x8e, 0x47, 0x2e, 0x76, 0xbc, 0x37, 0xf3, 0x9f,
+	0x18, 0x44, 0xea, 0x55, 0x1f, 0x9b, 0x98, 0x1c, 0xe5, 0x63, 0x9b, 0xd1, 0xd9, 0xdd, 0x27, 0x78,
+	0xc5, 0xff, 0x91, 0x20, 0x53, 0x1e, 0x8d, 0xcc, 0x89, 0x58, 0x6a, 0x1f, 0x43, 0xb2, 0x77, 0xa4,
+	0x5b, 0x03, 0x2c, 0x7a, 0xd3, 0x81, 0x5e, 0x5e, 0x50, 0xb0, 0xb4, 0x4d, 0xa5, 0xc4, 0x67, 0xf9,
+	0x18, 0x74, 0x05, 0x92, 0x7d, 0x67, 0xa2, 0x39, 0x63, 0xe6, 0xf3, 0x94, 0x9a, 0xe8, 0x3b, 0x13,
+	0x75, 0x6c, 0xe5, 0xff, 0x5c, 0x82, 0x04, 0x1b, 0x82, 0x4a, 0x70, 0x01, 0x1f, 0x8f, 0x70, 0xcf,
+	0xd3, 0x42, 0x31, 0xa2, 0x0d, 0x2f, 0x75, 0x9d, 0xb1, 0xf6, 0x02, 0x91, 0x7a, 0x1f, 0x12, 0x6c,
+	0x56, 0xb9, 0xc8, 0x19, 0xf1, 0x57, 0xb9, 0x10, 0xba, 0x09, 0x09, 0x36, 0x3b, 0x1a, 0xd9, 0x99,
+	0x89, 0x73, 0x56, 0xd1, 0x80, 0x2c, 0x9f, 0xcd, 0x79, 0x47, 0xb2, 0xf8, 0x8b, 0x28, 0x28, 0xd3,
+	0xf6, 0xc6, 0xb9, 0x01, 0x8f, 0x79, 0x24, 0x19, 0x9d, 0x47, 0x92, 0x04, 0x9e, 0x10, 0x68, 0x3a,
+	0x95, 0xa1, 0x10, 0x4e, 0x25, 0x70, 0x55, 0x48, 0xbc, 0x03, 0x6b, 0x16, 0x3e, 0xf6, 0xb4, 0x91,
+	0x3e, 0xc0, 0x9a, 0x67, 0x3f, 0xc7, 0x16, 0x4f, 0xb6, 0x59, 0x42, 0x6e, 0xeb, 0x03, 0xdc, 0x25,
+	0x44, 0x74, 0x03, 0x80, 0x8a, 0xb0, 0x83, 0x1e, 0xa9, 0x04, 0x71, 0x55, 0x26, 0x14, 0x7a, 0xca,
+	0x43, 0x8f, 0x20, 0xe3, 0x1a, 0x03, 0x4b, 0xf7, 0xc6, 0x0e, 0xee, 0x76, 0x1b, 0xbc, 0xbc, 0x9c,
+	0xd1, 0xc7, 0x49, 0x7d, 0x75, 0x52, 0x90, 0x68, 0xa3, 0x26, 0x34, 0x70, 0x0e, 0x50, 0xa5, 0xe6,
+	0x00, 0xd5, 0x7b, 0xb0, 0xce, 0xef, 0x31, 0x34, 0xcf, 0x19, 0x5b, 0x3d, 0xdd, 0x3f, 0xfa, 0x28,
+	0x9c, 0xd1, 0x15, 0xf4, 0xe2, 0xdf, 0x46, 0x60, 0x3d, 0x10, 0x8c, 0x73, 0xdf, 0xc6, 0x75, 0x90,
+	0xfd, 0x96, 0x18, 0xdb, 0xc8, 0x6f, 0xcf, 0xd7, 0x8a, 0xa9, 0x25, 0x25, 0x4d, 0x90, 0xb8, 0x1e,
+	0x7f, 0xf4, 0xa2, 0xc8, 0xc4, 0x16, 0x44, 0x26, 0xff, 0x23, 0x90, 0xa7, 0x5a, 0xd0, 0xbd, 0x50,
+	0xe6, 0x5c, 0x50, 0xa6, 0x42, 0x69, 0xf3, 0x06, 0x00, 0x71, 0x3e, 0xee, 0x53, 0x0c, 0xcd, 0xba,
+	0x09, 0x32, 0xa3, 0x10, 0xfc, 0xfc, 0x33, 0x09, 0xd6, 0x84, 0x2b, 0xbf, 0xcd, 0xe3, 0x5b, 0xf1,
+	0x97, 0x12, 0x28, 0xbe, 0x21, 0xe7, 0x1e, 0xc4, 0xd7, 0x31, 0xe9, 0x4f, 0x25, 0x48, 0x93, 0xcf,
+	0x7c, 0x7b, 0x27, 0x8b, 0xe2, 0x7f, 0xc7, 0x20, 0xc3, 0x4c, 0x38, 0x77, 0x8f, 0x84, 0x0f, 0x82,
+	0xd1, 0xb3, 0x0e, 0x82, 0x9f, 0x42, 0x8a, 0xdf, 0xd6, 0xb1, 0x4a, 0x14, 0xaa, 0x64, 0x41, 0x73,
+	0x4b, 0x1c, 0xbc, 0x09, 0x24, 0x20, 0x46, 0x11, 0xf4, 0xe7, 0x8e, 0x6c, 0xdb, 0xc4, 0x7d, 0xde,
+	0x9b, 0xe6, 0xe8, 0x8f, 0x13, 0x59, 0xff, 0xb9, 0x00, 0xe9, 0xe0, 0xbd, 0x18, 0xc3, 0xd7, 0xa0,
+	0xfb, 0xd7, 0x5d, 0x0f, 0xe1, 0xea, 0x88, 0x74, 0x2f, 0x5d, 0x4f, 0x23, 0xfd, 0x16, 0xd3, 0x1e,
+	0x04, 0x9a, 0xd5, 0xac, 0xd1, 0x72, 0x85, 0x0b, 0x54, 0x18, 0xdf, 0xef, 0x49, 0x6f, 0xc1, 0xa5,
+	0xd9, 0xb1, 0x81, 0x2e, 0xb9, 0x7a, 0x21, 0x3c, 0x8e, 0x19, 0x74, 0x13, 0xb2, 0x62, 0x0c, 0x76,
+	0x1c, 0xdb, 0xa1, 0x29, 0x49, 0x56, 0x33, 0x9c, 0x58, 0x23, 0x34, 0x74, 0x0f, 0x50, 0x48, 0x88,
+	0x65, 0x65, 0xa0, 0x5a, 0x95, 0xa0, 0x24, 0xcd, 0xcd, 0x77, 0xf8, 0x66, 0x4e, 0x9f, 0x55, 0x06,
+	0xa9, 0x48, 0xfe, 0x08, 0x92, 0xdc, 0x9d, 0x6f, 0xdc, 0x92, 0xbc, 0x42, 0x2e, 0x90, 0x35, 0x77,
+	0x62, 0xf5, 0x44, 0x09, 0x37, 0xac, 0xce, 0xc4, 0xea, 0x91, 0xa6, 0x09, 0x9b, 0x49, 0x94, 0xdd,
+	0x6e, 0xd3, 0x97, 0xe2, 0xbf, 0x44, 0x00, 0xd8, 0x4d, 0x16, 0x51, 0xb5, 0xf0, 0x5a, 0xfc, 0x7d,
+	0x88, 0x79, 0x93, 0x11, 0xe6, 0x17, 0x53, 0x57, 0x03, 0xe1, 0x9f, 0x8e, 0x2b, 0x75, 0x27, 0x23,
+	0xac, 0x52, 0xb1, 0xe0, 0x39, 0x20, 0x1a, 0x3e, 0x07, 0xe4, 0x20, 0x39, 0xc4, 0xae, 0xab, 0x0f,
+	0x58, 0xe5, 0x92, 0x55, 0xf1, 0x8a, 0x76, 0xc9, 0x09, 0x61, 0x38, 0xd2, 0x3d, 0xe3, 0xc0, 0x30,
+	0x0d, 0x6f, 0xc2, 0x5b, 0x76, 0xc5, 0x85, 0xdf, 0xda, 0x0e, 0x4a, 0xaa, 0xe1, 0x81, 0xc5, 0x07,
+	0x10, 0x23, 0xb6, 0x20, 0x05, 0x32, 0xf5, 0xe6, 0x67, 0xe5, 0x46, 0xbd, 0xaa, 0x75, 0x9f, 0xb5,
+	0x49, 0x13, 0x79, 0x0d, 0xd2, 0x8f, 0x3b, 0xad, 0xa6, 0xd6, 0xd9, 0xde, 0xad, 0xed, 0x95, 0x59,
+	0x73, 0xb8, 0xad, 0xb6, 0xba, 0xad, 0xca, 0xfe, 0x8e, 0x12, 0x29, 0x7e, 0x02, 0xd9, 0x90, 0xe2,
+	0x40, 0xfb, 0x39, 0x03, 0xa9, 0x4a, 0x79, 0xfb, 0xc9, 0xd3, 0xb2, 0x5a, 0x55, 0x24, 0x94, 0x86,
+	0xe4, 0x4e, 0x4b, 0xa5, 0x2f, 0x91, 0x69, 0x67, 0x3a, 0xca, 0x0f, 0xc6, 0x77, 0x01, 0x11, 0x78,
+	0xc7, 0xac, 0x9d, 0xc2, 0x85, 0x8b, 0x10, 0x27, 0x9e, 0x64, 0x98, 0x4c, 0x56, 0xd9, 0x0b, 0x39,
+	0x44, 0x5f, 0x08, 0x09, 0x9f, 0xfb, 0xbe, 0xaf, 0x42, 0x92, 0x5d, 0x68, 0x8a, 0x62, 0x76, 0x2b,
+	0x8c, 0x4a, 0x67, 0x2c, 0xe1, 0x4e, 0x17, 0x20, 0x91, 0x0f, 0xcd, 0xff, 0x21, 0x24, 0x18, 0x03,
+	0x95, 0x42, 0xe5, 0xe9, 0xe2, 0xa2, 0x68, 0xbd, 0x21, 0xae, 0x2f, 0xfe, 0xbb, 0x04, 0x17, 0x28,
+	0xb4, 0x9b, 0x71, 0x61, 0x75, 0x16, 0xd8, 0xde, 0x9a, 0x01, 0xb6, 0x61, 0xf9, 0xc5, 0xf8, 0x36,
+	0xff, 0x27, 0xff, 0x6f, 0x14, 0x7b, 0x6f, 0x06, 0xc5, 0x2e, 0x9c, 0xec, 0x14, 0xc4, 0x5e, 0x0e,
+	0x83, 0xd8, 0x29, 0x6e, 0xfd, 0x99, 0x04, 0x17, 0xc3, 0xd6, 0x9e, 0x7b, 0xcc, 0x17, 0x6f, 0xfb,
+	0xbf, 0x89, 0xc0, 0x85, 0x60, 0xda, 0x11, 0xd3, 0x7c, 0xcd, 0x8e, 0x7e, 0x9e, 0x54, 0x85, 0x50,
+	0x10, 0xa7, 0xef, 0x04, 0x85, 0xe8, 0x64, 0x8a, 0x41, 0x18, 0x2b, 0x53, 0x0a, 0xcd, 0x82, 0x9c,
+	0x6d, 0xd0, 0x72, 0xc0, 0xf3, 0x80, 0xcc, 0x29, 0x95, 0x09, 0xf1, 0x5c, 0xcf, 0x34, 0x44, 0xdb,
+	0x51, 0x56, 0xf9, 0x1b, 0x39, 0x45, 0x1c, 0xe0, 0x43, 0xd1, 0x88, 0x5f, 0x7e, 0x8a, 0x60, 0x42,
+	0xe8, 0x5d, 0x58, 0x63, 0x4f, 0x7e, 0x68, 0x59, 0x91, 0x58, 0x65, 0xe4, 0xe0, 0x39, 0x92, 0x5d,
+	0x55, 0xa6, 0xce, 0x52, 0xcb, 0x64, 0x8a, 0x63, 0x58, 0xdd, 0x35, 0x5c, 0xcf, 0x76, 0xa6, 0xe7,
+	0xad, 0xd7, 0xf4, 0x97, 0x80, 0xdb, 0x0c, 0xf7, 0x71, 0x64, 0x36, 0x5a, 0x82, 0xc6, 0xa3, 0x33,
+	0x68, 0xbc, 0xf8, 0xaf, 0x12, 0xac, 0x4d, 0xbf, 0x7b, 0xee, 0x0b, 0xa6, 0x4c, 0xda, 0x1d, 0xcc,
+	0x3b, 0x22, 0x4d, 0x2c, 0xfe, 0x43, 0x88, 0xf0, 0xa1, 0xc0, 0xba, 0xd3, 0x51, 0xcb, 0xb0, 0xae,
+	0x3c, 0x83, 0x75, 0x8b, 0x7f, 0x21, 0x41, 0x9c, 0x1e, 0xd7, 0xd1, 0xf7, 0x49, 0x69, 0x18, 0x1e,
+	0x60, 0x47, 0x6c, 0xef, 0x57, 0x95, 0x3a, 0x21, 0x4e, 0x8a, 0xca, 0xc8, 0x31, 0x86, 0xe4, 0x16,
+	0x84, 0xfe, 0xe9, 0x48, 0x15, 0xaf, 0xe8, 0x2e, 0xc8, 0xe2, 0xfa, 0x4d, 0xfc, 0xdf, 0x21, 0x7c,
+	0x3b, 0xe7, 0xb3, 0x79, 0xf6, 0xfe, 0x75, 0x04, 0x12, 0xcc, 0x27, 0xe8, 0x63, 0x00, 0x71, 0xc5,
+	0xf6, 0xda, 0xe5, 0x57, 0xe6, 0x23, 0xea, 0x7d, 0xbf, 0x3d, 0x11, 0x79, 0x75, 0x7b, 0x82, 0xf4,
+	0x47, 0xb0, 0xd7, 0xeb, 0xe7, 0xa2, 0xb3, 0x4b, 0x90, 0xd9, 0x52, 0xaa, 0x79, 0xbd, 0xbe, 0xc8,
+	0xa3, 0x44, 0x30, 0xff, 0x53, 0x09, 0x62, 0x84, 0x48, 0x16, 0x4e, 0xcf, 0x1c, 0xbb, 0x1e, 0x76,
+	0x84, 0x95, 0x31, 0x55, 0xe6, 0x94, 0x7a, 0x1f, 0x5d, 0x03, 0x99, 0xb9, 0x89, 0x70, 0x23, 0x94,
+	0x9b, 0x62, 0x84, 0x7a, 0x3f, 0xb4, 0x87, 0xa3, 0x33, 0x7b, 0xf8, 0x1a, 0xc8, 0x8e, 0x7e, 0xe8,
+	0x69, 0x1e, 0x76, 0xd8, 0xbd, 0x5b, 0x4c, 0x4d, 0x11, 0x42, 0x17, 0x3b, 0x43, 0x71, 0x31, 0x49,
+	0x7e, 0xef, 0xfe, 0x7d, 0x14, 0x12, 0x6c, 0xbd, 0xa1, 0x04, 0x44, 0x5a, 0x4f, 0x94, 0x15, 0x74,
+	0x09, 0xd6, 0x1f, 0xb7, 0xf6, 0xd5, 0x66, 0xb9, 0xa1, 0x91, 0xcb, 0xd9, 0x9d, 0xd6, 0x7e, 0x93,
+	0x94, 0xcd, 0x1b, 0x70, 0xb5, 0xd9, 0xd2, 0x04, 0xa7, 0xad, 0xd6, 0xf7, 0xca, 0xea, 0x33, 0xad,
+	0xa2, 0xb6, 0x9e, 0xd4, 0x54, 0x25, 0x82, 0x36, 0x20, 0x4f, 0xa4, 0x97, 0xf0, 0xa3, 0xe8, 0x32,
+	0xa0, 0x20, 0x9f, 0xd3, 0xe3, 0x68, 0x13, 0xae, 0xd7, 0x9b, 0x9d, 0xfd, 0x9d, 0x9d, 0xfa, 0x76,
+	0xbd, 0xd6, 0x9c, 0x15, 0xe8, 0x28, 0x31, 0x74, 0x1d, 0x72, 0xad, 0x9d, 0x9d, 0x4e, 0xad, 0x4b,
+	0xcd, 0x79, 0x56, 0xeb, 0x6a, 0xe5, 0xcf, 0xca, 0xf5, 0x46, 0xb9, 0xd2, 0xa8, 0x29, 0x09, 0x82,
+	0x0a, 0xc8, 0xfd, 0xf0, 0x23, 0x4d, 0x6d, 0xed, 0x77, 0x6b, 0x4a, 0x92, 0x98, 0xdf, 0x56, 0x5b,
+	0xed, 0x56, 0xa7, 0xdc, 0xd0, 0xf6, 0xea, 0x9d, 0xbd, 0x72, 0x77, 0x7b, 0x57, 0x49, 0xa1, 0x6b,
+	0x70, 0xa5, 0xd6, 0xdd, 0xae, 0x6a, 0x5d, 0xb5, 0xdc, 0xec, 0x94, 0xb7, 0xbb, 0xf5, 0x56, 0x53,
+	0xdb, 0x29, 0xd7, 0x1b, 0xb5, 0xaa, 0x22, 0x13, 0x25, 0x44, 0x77, 0xb9, 0xd1, 0x68, 0x3d, 0xad,
+	0x55, 0x15, 0x40, 0x57, 0xe0, 0x02, 0xd3, 0x5a, 0x6e, 0xb7, 0x6b, 0xcd, 0xaa, 0xc6, 0x0c, 0x50,
+	0xd2, 0xc4, 0x98, 0x7a, 0xb3, 0x5a, 0xfb, 0x91, 0xb6, 0x5b, 0xee, 0x68, 0x8f, 0xd4, 0x5a, 0xb9,
+	0x5b, 0x53, 0x05, 0x37, 0x43, 0xbe, 0xad, 0xd6, 0x1e, 0xd5, 0x3b, 0x84, 0x38, 0xfd, 0x76, 0x16,
+	0x5d, 0x80, 0x35, 0x81, 0x65, 0x76, 0xd4, 0xf2, 0x5e, 0xbd, 0xf9, 0x48, 0x59, 0x45, 0x17, 0x41,
+	0x61, 0x48, 0x46, 0xfb, 0xac, 0xde, 0x6a, 0x94, 0x89, 0x41, 0xca, 0x1a, 0xf9, 0x70, 0xbd, 0xb9,
+	0xdd, 0xda, 0x6b, 0x97, 0xbb, 0xf5, 0x4a, 0xa3, 0x26, 0xc0, 0x8e, 0x42, 0xc4, 0xb9, 0x17, 0xba,
+	0xea, 0x7e, 0x73, 0xbb, 0xdc, 0xad, 0x55, 0x95, 0xf5, 0xbb, 0xbf, 0x96, 0x40, 0x99, 0xbd, 0x0c,
+	0x25, 0x00, 0x87, 0x7f, 0x4e, 0x59, 0x99, 0xa2, 0x20, 0x89, 0x3c, 0x3d, 0xfa, 0xbc, 0xde, 0x56,
+	0x22, 0x28, 0x0b, 0xf2, 0xe7, 0x9d, 0x6e, 0xb9, 0x59, 0x25, 0x18, 0x28, 0x4a, 0xae, 0xd5, 0x3b,
+	0xcd, 0x72, 0xbb, 0xfd, 0x4c, 0x89, 0x91, 0x30, 0x12, 0x21, 0x32, 0xa5, 0x46, 0xab, 0x5c, 0xd5,
+	0xaa, 0x35, 0x62, 0x8c, 0x5a, 0xeb, 0x74, 0x88, 0x7d, 0x71, 0x12, 0xc6, 0xe9, 0x50, 0xad, 0x53,
+	0xab, 0x3d, 0xe1, 0x61, 0x48, 0x42, 0xb4, 0xf1, 0xf9, 0x77, 0x95, 0x24, 0x51, 0x56, 0x51, 0x5b,
+	0xdd, 0x46, 0x5d, 0x49, 0x21, 0x04, 0xab, 0xbe, 0x70, 0xb5, 0xbe, 0xdd, 0x55, 0xe4, 0xad, 0xbf,
+	0x4a, 0xf8, 0x7d, 0xc5, 0x8f, 0x20, 0x46, 0x50, 0x0b, 0xba, 0x34, 0xdb, 0x5b, 0xa3, 0xb9, 0x39,
+	0x7f, 0x79, 0x71, 0xcb, 0x0d, 0xfd, 0x00, 0xe2, 0xb4, 0x0d, 0xb8, 0x6c, 0x5c, 0xa0, 0x39, 0x1b,
+	0x6a, 0x17, 0x7e, 0x20, 0xa1, 0xef, 0x43, 0x9c, 0x96, 0x6f, 0x74, 0x79, 0x71, 0x5b, 0x2d, 0x7f,
+	0x65, 0x8e, 0xce, 0x3f, 0xfa, 0x09, 0x24, 0x79, 0x0a, 0x47, 0x81, 0x34, 0x12, 0xae, 0x26, 0xf9,
+	0xab, 0x0b, 0x38, 0x7c, 0xfc, 0x03, 0x88, 0x91, 0x3b, 0xc4, 0xa0, 0xcd, 0x81, 0x6b, 0xd8, 0xfc,
+	0xe5, 0x59, 0xf2, 0xd4, 0xe4, 0x8f, 0x21, 0xc1, 0x6e, 0x6a, 0x50, 0xd8, 0x36, 0xff, 0x1a, 0x2d,
+	0x9f, 0x9b, 0x67, 0xb0, 0xe1, 0xb7, 0x25, 0xb4, 0x0b, 0xf2, 0xb4, 0x2b, 0x8f, 0xf2, 0xc1, 0xaf,
+	0x84, 0x2f, 0x3a, 0xf2, 0xd7, 0x16, 0xf2, 0x84, 0x9e, 0x0f, 0x88, 0xa6, 0x2c, 0xf1, 0xb2, 0x7f,
+	0x2c, 0xcb, 0x2f, 0xec, 0xa4, 0xcc, 0x69, 0x9b, 0xef, 0xf7, 0x94, 0x21, 0x25, 0xda, 0x07, 0x28,
+	0xe0, 0xb2, 0x99, 0xde, 0x46, 0x3e, 0xbf, 0x88, 0xc5, 0x55, 0x7c, 0x04, 0x31, 0x92, 0xb6, 0x82,
+	0xee, 0x0c, 0x1c, 0xff, 0xf3, 0x97, 0x67, 0xc9, 0x7c, 0xd8, 0x63, 0xd6, 0xd8, 0xe7, 0xe8, 0x0d,
+	0x5d, 0x5f, 0x02, 0x9f, 0x99, 0x92, 0x1b, 0x67, 0x82, 0x6b, 0xb4, 0xc7, 0x5b, 0xb7, 0x42, 0xd9,
+	0x8d, 0x33, 0x01, 0x6d, 0x7e, 0x63, 0x19, 0x9b, 0xa9, 0xab, 0xd4, 0xbe, 0xfa, 0xcf, 0x8d, 0x95,
+	0xaf, 0x7e, 0xb7, 0x21, 0x7d, 0xfd, 0xbb, 0x0d, 0xe9, 0x97, 0x2f, 0x37, 0x56, 0xbe, 0x7c, 0xb9,
+	0x21, 0xfd, 0xe3, 0xcb, 0x0d, 0xe9, 0xeb, 0x97, 0x1b, 0x2b, 0xff, 0xf6, 0x72, 0x63, 0xe5, 0xf3,
+	0x9b, 0x03, 0xbb, 0x34, 0xd0, 0xbf, 0xc0, 0x9e, 0x87, 0x4b, 0x7d, 0xfc, 0xe2, 0x7e, 0xcf, 0x76,
+	0xf0, 0xfd, 0x99, 0xff, 0x5b, 0x1f, 0x24, 0xe8, 0xd3, 0x87, 0xff, 0x37, 0x00, 0x7c, 0xf5, 0x2b,
+	0x45, 0x89, 0x2d, 0x00, 0x00,
 }
 
-func (x *journalAppendServer) Recv() (*AppendRequest, error) {
-	m := new(AppendRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (this *Label) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return m, nil
-}
-
-func _Journal_Replicate_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(JournalServer).Replicate(&journalReplicateServer{stream})
-}
 
-type Journal_ReplicateServer interface {
-	Send(*ReplicateResponse) error
-	Recv() (*ReplicateRequest, error)
-	grpc.ServerStream
-}
-
-type journalReplicateServer struct {
-	grpc.ServerStream
-}
-
-func (x *journalReplicateServer) Send(m *ReplicateResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *journalReplicateServer) Recv() (*ReplicateRequest, error) {
-	m := new(ReplicateRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+	that1, ok := that.(*Label)
+	if !ok {
+		that2, ok := that.(Label)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return m, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
+func (this *LabelSet) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Journal_ListFragments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FragmentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*LabelSet)
+	if !ok {
+		that2, ok := that.(LabelSet)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(JournalServer).ListFragments(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protocol.Journal/ListFragments",
+	if len(this.Labels) != len(that1.Labels) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JournalServer).ListFragments(ctx, req.(*FragmentsRequest))
+	for i := range this.Labels {
+		if !this.Labels[i].Equal(&that1.Labels[i]) {
+			return false
+		}
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *LabelSelector) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-var _Journal_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "protocol.Journal",
-	HandlerType: (*JournalServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "List",
-			Handler:    _Journal_List_Handler,
-		},
-		{
-			MethodName: "Apply",
-			Handler:    _Journal_Apply_Handler,
-		},
-		{
-			MethodName: "ListFragments",
-			Handler:    _Journal_ListFragments_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Read",
-			Handler:       _Journal_Read_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "Append",
-			Handler:       _Journal_Append_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "Replicate",
-			Handler:       _Journal_Replicate_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "broker/protocol/protocol.proto",
-}
-
-func (m *Label) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*LabelSelector)
+	if !ok {
+		that2, ok := that.(LabelSelector)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Label) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Label) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Include.Equal(&that1.Include) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *LabelSet) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.Exclude.Equal(&that1.Exclude) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LabelSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *JournalSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *LabelSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Labels) > 0 {
-		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Labels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProtocol(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*JournalSpec)
+	if !ok {
+		that2, ok := that.(JournalSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *LabelSelector) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LabelSelector) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *LabelSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Exclude.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	if this.Name != that1.Name {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Include.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	if this.Replication != that1.Replication {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *JournalSpec) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.LabelSet.Equal(&that1.LabelSet) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *JournalSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JournalSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxAppendRate != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.MaxAppendRate))
-		i--
-		dAtA[i] = 0x38
+	if !this.Fragment.Equal(&that1.Fragment) {
+		return false
 	}
-	if m.Flags != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.Flags))
-		i--
-		dAtA[i] = 0x30
+	if this.Flags != that1.Flags {
+		return false
 	}
-	{
-		size, err := m.Fragment.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	if this.MaxAppendRate != that1.MaxAppendRate {
+		return false
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.LabelSet.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	if this.MinOffset != that1.MinOffset {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Replication != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.Replication))
-		i--
-		dAtA[i] = 0x10
+	if !this.Suspend.Equal(&that1.Suspend) {
+		return false
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if this.QuorumCommit != that1.QuorumCommit {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *JournalSpec_Fragment) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.ValidateFraming != that1.ValidateFraming {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *JournalSpec_Fragment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.ValidateSchema != that1.ValidateSchema {
+		return false
+	}
+	return true
 }
+func (this *JournalSpec_Fragment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *JournalSpec_Fragment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PathPostfixTemplate) > 0 {
-		i -= len(m.PathPostfixTemplate)
-		copy(dAtA[i:], m.PathPostfixTemplate)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.PathPostfixTemplate)))
-		i--
-		dAtA[i] = 0x3a
+	that1, ok := that.(*JournalSpec_Fragment)
+	if !ok {
+		that2, ok := that.(JournalSpec_Fragment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.FlushInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.FlushInterval):])
-	if err5 != nil {
-		return 0, err5
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i -= n5
-	i = encodeVarintProtocol(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x32
-	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Retention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Retention):])
-	if err6 != nil {
-		return 0, err6
+	if this.Length != that1.Length {
+		return false
 	}
-	i -= n6
-	i = encodeVarintProtocol(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x2a
-	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RefreshInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RefreshInterval):])
-	if err7 != nil {
-		return 0, err7
+	if this.CompressionCodec != that1.CompressionCodec {
+		return false
 	}
-	i -= n7
-	i = encodeVarintProtocol(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Stores) > 0 {
-		for iNdEx := len(m.Stores) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Stores[iNdEx])
-			copy(dAtA[i:], m.Stores[iNdEx])
-			i = encodeVarintProtocol(dAtA, i, uint64(len(m.Stores[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	if len(this.Stores) != len(that1.Stores) {
+		return false
+	}
+	for i := range this.Stores {
+		if this.Stores[i] != that1.Stores[i] {
+			return false
 		}
 	}
-	if m.CompressionCodec != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.CompressionCodec))
-		i--
-		dAtA[i] = 0x10
+	if this.RefreshInterval != that1.RefreshInterval {
+		return false
 	}
-	if m.Length != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.Length))
-		i--
-		dAtA[i] = 0x8
+	if this.Retention != that1.Retention {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ProcessSpec) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.FlushInterval != that1.FlushInterval {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ProcessSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ProcessSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Endpoint) > 0 {
-		i -= len(m.Endpoint)
-		copy(dAtA[i:], m.Endpoint)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Endpoint)))
-		i--
-		dAtA[i] = 0x12
+	if this.PathPostfixTemplate != that1.PathPostfixTemplate {
+		return false
 	}
-	{
-		size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if this.MaxBytes != that1.MaxBytes {
+		return false
+	}
+	if this.MaxFragments != that1.MaxFragments {
+		return false
+	}
+	if len(this.TierAfter) != len(that1.TierAfter) {
+		return false
+	}
+	for i := range this.TierAfter {
+		if this.TierAfter[i] != that1.TierAfter[i] {
+			return false
 		}
-		i -= size
-		i = encodeVarintProtocol(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *ProcessSpec_ID) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.EncryptionKey != that1.EncryptionKey {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ProcessSpec_ID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ProcessSpec_ID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Suffix) > 0 {
-		i -= len(m.Suffix)
-		copy(dAtA[i:], m.Suffix)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Suffix)))
-		i--
-		dAtA[i] = 0x12
+	if this.CompressionDictionary != that1.CompressionDictionary {
+		return false
 	}
-	if len(m.Zone) > 0 {
-		i -= len(m.Zone)
-		copy(dAtA[i:], m.Zone)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Zone)))
-		i--
-		dAtA[i] = 0xa
+	if this.ReaderFloor != that1.ReaderFloor {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BrokerSpec) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.PruneOversize != that1.PruneOversize {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BrokerSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *BrokerSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.JournalLimit != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.JournalLimit))
-		i--
-		dAtA[i] = 0x10
+func (this *JournalSpec_Suspend) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	{
-		size, err := m.ProcessSpec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+
+	that1, ok := that.(*JournalSpec_Suspend)
+	if !ok {
+		that2, ok := that.(JournalSpec_Suspend)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintProtocol(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Fragment) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Fragment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Level != that1.Level {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	return true
 }
+func (this *ProcessSpec_ID) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Fragment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PathPostfix) > 0 {
-		i -= len(m.PathPostfix)
-		copy(dAtA[i:], m.PathPostfix)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.PathPostfix)))
-		i--
-		dAtA[i] = 0x42
+	that1, ok := that.(*ProcessSpec_ID)
+	if !ok {
+		that2, ok := that.(ProcessSpec_ID)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.ModTime != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.ModTime))
-		i--
-		dAtA[i] = 0x38
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.BackingStore) > 0 {
-		i -= len(m.BackingStore)
-		copy(dAtA[i:], m.BackingStore)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.BackingStore)))
-		i--
-		dAtA[i] = 0x32
+	if this.Zone != that1.Zone {
+		return false
 	}
-	if m.CompressionCodec != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.CompressionCodec))
-		i--
-		dAtA[i] = 0x28
+	if this.Suffix != that1.Suffix {
+		return false
 	}
-	{
-		size, err := m.Sum.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	return true
+}
+func (this *AppendRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AppendRequest)
+	if !ok {
+		that2, ok := that.(AppendRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintProtocol(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.End != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.End))
-		i--
-		dAtA[i] = 0x18
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Begin != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.Begin))
-		i--
-		dAtA[i] = 0x10
+	if !this.Header.Equal(that1.Header) {
+		return false
 	}
-	if len(m.Journal) > 0 {
-		i -= len(m.Journal)
-		copy(dAtA[i:], m.Journal)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Journal)))
-		i--
-		dAtA[i] = 0xa
+	if this.Journal != that1.Journal {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SHA1Sum) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.DoNotProxy != that1.DoNotProxy {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SHA1Sum) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SHA1Sum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Part3 != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.Part3))
-		i--
-		dAtA[i] = 0x1d
+	if this.Offset != that1.Offset {
+		return false
 	}
-	if m.Part2 != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Part2))
-		i--
-		dAtA[i] = 0x11
+	if !this.CheckRegisters.Equal(that1.CheckRegisters) {
+		return false
 	}
-	if m.Part1 != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Part1))
-		i--
-		dAtA[i] = 0x9
+	if !this.UnionRegisters.Equal(that1.UnionRegisters) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ReadRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.SubtractRegisters.Equal(that1.SubtractRegisters) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ReadRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !bytes.Equal(this.Content, that1.Content) {
+		return false
+	}
+	return true
 }
-
-func (m *ReadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EndOffset != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.EndOffset))
-		i--
-		dAtA[i] = 0x38
+func (this *SchemaSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.MetadataOnly {
-		i--
-		if m.MetadataOnly {
-			dAtA[i] = 1
+
+	that1, ok := that.(*SchemaSpec)
+	if !ok {
+		that2, ok := that.(SchemaSpec)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x30
 	}
-	if m.DoNotProxy {
-		i--
-		if m.DoNotProxy {
-			dAtA[i] = 1
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.Content, that1.Content) {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
+	if this.Compatibility != that1.Compatibility {
+		return false
+	}
+	return true
+}
+func (this *Route) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Route)
+	if !ok {
+		that2, ok := that.(Route)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	if m.Block {
-		i--
-		if m.Block {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Members) != len(that1.Members) {
+		return false
+	}
+	for i := range this.Members {
+		if !this.Members[i].Equal(&that1.Members[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x20
 	}
-	if m.Offset != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x18
+	if this.Primary != that1.Primary {
+		return false
 	}
-	if len(m.Journal) > 0 {
-		i -= len(m.Journal)
-		copy(dAtA[i:], m.Journal)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Journal)))
-		i--
-		dAtA[i] = 0x12
+	if len(this.Endpoints) != len(that1.Endpoints) {
+		return false
 	}
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProtocol(dAtA, i, uint64(size))
+	for i := range this.Endpoints {
+		if this.Endpoints[i] != that1.Endpoints[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *Header) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ReadResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*Header)
+	if !ok {
+		that2, ok := that.(Header)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ProcessId.Equal(&that1.ProcessId) {
+		return false
+	}
+	if !this.Route.Equal(&that1.Route) {
+		return false
+	}
+	if !this.Etcd.Equal(&that1.Etcd) {
+		return false
+	}
+	return true
 }
-
-func (m *ReadResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ReadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.FragmentUrl) > 0 {
-		i -= len(m.FragmentUrl)
-		copy(dAtA[i:], m.FragmentUrl)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.FragmentUrl)))
-		i--
-		dAtA[i] = 0x32
+func (this *Header_Etcd) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Fragment != nil {
-		{
-			size, err := m.Fragment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProtocol(dAtA, i, uint64(size))
+
+	that1, ok := that.(*Header_Etcd)
+	if !ok {
+		that2, ok := that.(Header_Etcd)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	if m.WriteHead != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.WriteHead))
-		i--
-		dAtA[i] = 0x20
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Offset != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x18
+	if this.ClusterId != that1.ClusterId {
+		return false
 	}
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProtocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if this.MemberId != that1.MemberId {
+		return false
 	}
-	if m.Status != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+	if this.Revision != that1.Revision {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.RaftTerm != that1.RaftTerm {
+		return false
+	}
+	return true
 }
 
-func (m *AppendRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// JournalClient is the client API for Journal service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type JournalClient interface {
+	// List Journals, their JournalSpecs and current Routes.
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	// Watch Journals, their JournalSpecs and current Routes. Watch streams a
+	// snapshot of matched Journals, followed by incremental changes.
+	Watch(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (Journal_WatchClient, error)
+	// Apply changes to the collection of Journals managed by the brokers.
+	Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyResponse, error)
+	// History lists recorded revisions of JournalSpecs, as changed by Apply.
+	History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	// Read from a specific Journal.
+	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (Journal_ReadClient, error)
+	// Append content to a specific Journal.
+	Append(ctx context.Context, opts ...grpc.CallOption) (Journal_AppendClient, error)
+	// Replicate appended content of a Journal. Replicate is used between broker
+	// peers in the course of processing Append transactions, but is not intended
+	// for direct use by clients.
+	Replicate(ctx context.Context, opts ...grpc.CallOption) (Journal_ReplicateClient, error)
+	// List Fragments of a Journal.
+	ListFragments(ctx context.Context, in *FragmentsRequest, opts ...grpc.CallOption) (*FragmentsResponse, error)
+	// Truncate a Journal, durably advancing its minimum readable offset.
+	Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*TruncateResponse, error)
+	// Stat a Journal, returning its write head, replication and persistence
+	// status as observed by its primary broker.
+	Stat(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*StatResponse, error)
+	// List registered message schemas.
+	ListSchemas(ctx context.Context, in *ListSchemasRequest, opts ...grpc.CallOption) (*ListSchemasResponse, error)
+	// Apply changes to the collection of registered message schemas.
+	ApplySchemas(ctx context.Context, in *ApplySchemasRequest, opts ...grpc.CallOption) (*ApplySchemasResponse, error)
 }
 
-func (m *AppendRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type journalClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *AppendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SubtractRegisters != nil {
-		{
-			size, err := m.SubtractRegisters.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProtocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.UnionRegisters != nil {
-		{
-			size, err := m.UnionRegisters.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProtocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.CheckRegisters != nil {
-		{
-			size, err := m.CheckRegisters.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProtocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Offset != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x28
+func NewJournalClient(cc *grpc.ClientConn) JournalClient {
+	return &journalClient{cc}
+}
+
+func (c *journalClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/protocol.Journal/List", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x22
+	return out, nil
+}
+
+func (c *journalClient) Watch(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (Journal_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Journal_serviceDesc.Streams[0], "/protocol.Journal/Watch", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.DoNotProxy {
-		i--
-		if m.DoNotProxy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	x := &journalWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if len(m.Journal) > 0 {
-		i -= len(m.Journal)
-		copy(dAtA[i:], m.Journal)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Journal)))
-		i--
-		dAtA[i] = 0x12
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProtocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return x, nil
+}
+
+type Journal_WatchClient interface {
+	Recv() (*WatchResponse, error)
+	grpc.ClientStream
+}
+
+type journalWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *journalWatchClient) Recv() (*WatchResponse, error) {
+	m := new(WatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *AppendResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *journalClient) Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyResponse, error) {
+	out := new(ApplyResponse)
+	err := c.cc.Invoke(ctx, "/protocol.Journal/Apply", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AppendResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *journalClient) History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, "/protocol.Journal/History", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *AppendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DelayedChunks != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.DelayedChunks))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.TotalChunks != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.TotalChunks))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Registers != nil {
-		{
-			size, err := m.Registers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProtocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+func (c *journalClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (Journal_ReadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Journal_serviceDesc.Streams[1], "/protocol.Journal/Read", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Commit != nil {
-		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProtocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	x := &journalReadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Status != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+	return x, nil
+}
+
+type Journal_ReadClient interface {
+	Recv() (*ReadResponse, error)
+	grpc.ClientStream
+}
+
+type journalReadClient struct {
+	grpc.ClientStream
+}
+
+func (x *journalReadClient) Recv() (*ReadResponse, error) {
+	m := new(ReadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *ReplicateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *journalClient) Append(ctx context.Context, opts ...grpc.CallOption) (Journal_AppendClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Journal_serviceDesc.Streams[2], "/protocol.Journal/Append", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &journalAppendClient{stream}
+	return x, nil
 }
 
-func (m *ReplicateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Journal_AppendClient interface {
+	Send(*AppendRequest) error
+	CloseAndRecv() (*AppendResponse, error)
+	grpc.ClientStream
 }
 
-func (m *ReplicateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Registers != nil {
-		{
-			size, err := m.Registers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProtocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Acknowledge {
-		i--
-		if m.Acknowledge {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.ContentDelta != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.ContentDelta))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Proposal != nil {
-		{
-			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProtocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DeprecatedJournal) > 0 {
-		i -= len(m.DeprecatedJournal)
-		copy(dAtA[i:], m.DeprecatedJournal)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.DeprecatedJournal)))
-		i--
-		dAtA[i] = 0x12
+type journalAppendClient struct {
+	grpc.ClientStream
+}
+
+func (x *journalAppendClient) Send(m *AppendRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *journalAppendClient) CloseAndRecv() (*AppendResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProtocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	m := new(AppendResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *ReplicateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *journalClient) Replicate(ctx context.Context, opts ...grpc.CallOption) (Journal_ReplicateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Journal_serviceDesc.Streams[3], "/protocol.Journal/Replicate", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &journalReplicateClient{stream}
+	return x, nil
 }
 
-func (m *ReplicateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Journal_ReplicateClient interface {
+	Send(*ReplicateRequest) error
+	Recv() (*ReplicateResponse, error)
+	grpc.ClientStream
 }
 
-func (m *ReplicateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Registers != nil {
-		{
-			size, err := m.Registers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProtocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Fragment != nil {
-		{
-			size, err := m.Fragment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProtocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProtocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Status != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+type journalReplicateClient struct {
+	grpc.ClientStream
+}
+
+func (x *journalReplicateClient) Send(m *ReplicateRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *journalReplicateClient) Recv() (*ReplicateResponse, error) {
+	m := new(ReplicateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *ListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *journalClient) ListFragments(ctx context.Context, in *FragmentsRequest, opts ...grpc.CallOption) (*FragmentsResponse, error) {
+	out := new(FragmentsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.Journal/ListFragments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *journalClient) Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*TruncateResponse, error) {
+	out := new(TruncateResponse)
+	err := c.cc.Invoke(ctx, "/protocol.Journal/Truncate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProtocol(dAtA, i, uint64(size))
+func (c *journalClient) Stat(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*StatResponse, error) {
+	out := new(StatResponse)
+	err := c.cc.Invoke(ctx, "/protocol.Journal/Stat", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *journalClient) ListSchemas(ctx context.Context, in *ListSchemasRequest, opts ...grpc.CallOption) (*ListSchemasResponse, error) {
+	out := new(ListSchemasResponse)
+	err := c.cc.Invoke(ctx, "/protocol.Journal/ListSchemas", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *journalClient) ApplySchemas(ctx context.Context, in *ApplySchemasRequest, opts ...grpc.CallOption) (*ApplySchemasResponse, error) {
+	out := new(ApplySchemasResponse)
+	err := c.cc.Invoke(ctx, "/protocol.Journal/ApplySchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Journals) > 0 {
-		for iNdEx := len(m.Journals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Journals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProtocol(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProtocol(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Status != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// JournalServer is the server API for Journal service.
+type JournalServer interface {
+	// List Journals, their JournalSpecs and current Routes.
+	List(context.Context, *ListRequest) (*ListResponse, error)
+	// Watch Journals, their JournalSpecs and current Routes. Watch streams a
+	// snapshot of matched Journals, followed by incremental changes.
+	Watch(*ListRequest, Journal_WatchServer) error
+	// Apply changes to the collection of Journals managed by the brokers.
+	Apply(context.Context, *ApplyRequest) (*ApplyResponse, error)
+	// History lists recorded revisions of JournalSpecs, as changed by Apply.
+	History(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	// Read from a specific Journal.
+	Read(*ReadRequest, Journal_ReadServer) error
+	// Append content to a specific Journal.
+	Append(Journal_AppendServer) error
+	// Replicate appended content of a Journal. Replicate is used between broker
+	// peers in the course of processing Append transactions, but is not intended
+	// for direct use by clients.
+	Replicate(Journal_ReplicateServer) error
+	// List Fragments of a Journal.
+	ListFragments(context.Context, *FragmentsRequest) (*FragmentsResponse, error)
+	// Truncate a Journal, durably advancing its minimum readable offset.
+	Truncate(context.Context, *TruncateRequest) (*TruncateResponse, error)
+	// Stat a Journal, returning its write head, replication and persistence
+	// status as observed by its primary broker.
+	Stat(context.Context, *StatRequest) (*StatResponse, error)
+	// List registered message schemas.
+	ListSchemas(context.Context, *ListSchemasRequest) (*ListSchemasResponse, error)
+	// Apply changes to the collection of registered message schemas.
+	ApplySchemas(context.Context, *ApplySchemasRequest) (*ApplySchemasResponse, error)
 }
 
-func (m *ListResponse_Journal) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedJournalServer can be embedded to have forward compatible implementations.
+type UnimplementedJournalServer struct {
 }
 
-func (m *ListResponse_Journal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedJournalServer) List(ctx context.Context, req *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedJournalServer) Watch(req *ListRequest, srv Journal_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (*UnimplementedJournalServer) Apply(ctx context.Context, req *ApplyRequest) (*ApplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Apply not implemented")
+}
+func (*UnimplementedJournalServer) History(ctx context.Context, req *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
+func (*UnimplementedJournalServer) Read(req *ReadRequest, srv Journal_ReadServer) error {
+	return status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (*UnimplementedJournalServer) Append(srv Journal_AppendServer) error {
+	return status.Errorf(codes.Unimplemented, "method Append not implemented")
+}
+func (*UnimplementedJournalServer) Replicate(srv Journal_ReplicateServer) error {
+	return status.Errorf(codes.Unimplemented, "method Replicate not implemented")
+}
+func (*UnimplementedJournalServer) ListFragments(ctx context.Context, req *FragmentsRequest) (*FragmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFragments not implemented")
+}
+func (*UnimplementedJournalServer) Truncate(ctx context.Context, req *TruncateRequest) (*TruncateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Truncate not implemented")
+}
+func (*UnimplementedJournalServer) Stat(ctx context.Context, req *StatRequest) (*StatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stat not implemented")
+}
+func (*UnimplementedJournalServer) ListSchemas(ctx context.Context, req *ListSchemasRequest) (*ListSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchemas not implemented")
+}
+func (*UnimplementedJournalServer) ApplySchemas(ctx context.Context, req *ApplySchemasRequest) (*ApplySchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplySchemas not implemented")
 }
 
-func (m *ListResponse_Journal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProtocol(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.ModRevision != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.ModRevision))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProtocol(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func RegisterJournalServer(s *grpc.Server, srv JournalServer) {
+	s.RegisterService(&_Journal_serviceDesc, srv)
 }
 
-func (m *ApplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Journal_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(JournalServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.Journal/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Journal_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JournalServer).Watch(m, &journalWatchServer{stream})
 }
 
-func (m *ApplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Changes) > 0 {
-		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProtocol(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type Journal_WatchServer interface {
+	Send(*WatchResponse) error
+	grpc.ServerStream
 }
 
-func (m *ApplyRequest_Change) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type journalWatchServer struct {
+	grpc.ServerStream
 }
 
-func (m *ApplyRequest_Change) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *journalWatchServer) Send(m *WatchResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *ApplyRequest_Change) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Delete) > 0 {
-		i -= len(m.Delete)
-		copy(dAtA[i:], m.Delete)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Delete)))
-		i--
-		dAtA[i] = 0x1a
+func _Journal_Apply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Upsert != nil {
-		{
-			size, err := m.Upsert.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProtocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(JournalServer).Apply(ctx, in)
 	}
-	if m.ExpectModRevision != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.ExpectModRevision))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.Journal/Apply",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServer).Apply(ctx, req.(*ApplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Journal_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(JournalServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.Journal/History",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServer).History(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Journal_Read_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JournalServer).Read(m, &journalReadServer{stream})
 }
 
-func (m *ApplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProtocol(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Status != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type Journal_ReadServer interface {
+	Send(*ReadResponse) error
+	grpc.ServerStream
 }
 
-func (m *FragmentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+type journalReadServer struct {
+	grpc.ServerStream
+}
+
+func (x *journalReadServer) Send(m *ReadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Journal_Append_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(JournalServer).Append(&journalAppendServer{stream})
+}
+
+type Journal_AppendServer interface {
+	SendAndClose(*AppendResponse) error
+	Recv() (*AppendRequest, error)
+	grpc.ServerStream
+}
+
+type journalAppendServer struct {
+	grpc.ServerStream
+}
+
+func (x *journalAppendServer) SendAndClose(m *AppendResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *journalAppendServer) Recv() (*AppendRequest, error) {
+	m := new(AppendRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *FragmentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Journal_Replicate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(JournalServer).Replicate(&journalReplicateServer{stream})
 }
 
-func (m *FragmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DoNotProxy {
-		i--
-		if m.DoNotProxy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
+type Journal_ReplicateServer interface {
+	Send(*ReplicateResponse) error
+	Recv() (*ReplicateRequest, error)
+	grpc.ServerStream
+}
+
+type journalReplicateServer struct {
+	grpc.ServerStream
+}
+
+func (x *journalReplicateServer) Send(m *ReplicateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *journalReplicateServer) Recv() (*ReplicateRequest, error) {
+	m := new(ReplicateRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.SignatureTTL != nil {
-		n33, err33 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.SignatureTTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.SignatureTTL):])
-		if err33 != nil {
-			return 0, err33
-		}
-		i -= n33
-		i = encodeVarintProtocol(dAtA, i, uint64(n33))
-		i--
-		dAtA[i] = 0x3a
+	return m, nil
+}
+
+func _Journal_ListFragments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FragmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PageLimit != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.PageLimit))
-		i--
-		dAtA[i] = 0x30
+	if interceptor == nil {
+		return srv.(JournalServer).ListFragments(ctx, in)
 	}
-	if m.NextPageToken != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.NextPageToken))
-		i--
-		dAtA[i] = 0x28
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.Journal/ListFragments",
 	}
-	if m.EndModTime != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.EndModTime))
-		i--
-		dAtA[i] = 0x20
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServer).ListFragments(ctx, req.(*FragmentsRequest))
 	}
-	if m.BeginModTime != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.BeginModTime))
-		i--
-		dAtA[i] = 0x18
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Journal_Truncate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TruncateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Journal) > 0 {
-		i -= len(m.Journal)
-		copy(dAtA[i:], m.Journal)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Journal)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(JournalServer).Truncate(ctx, in)
 	}
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProtocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.Journal/Truncate",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServer).Truncate(ctx, req.(*TruncateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FragmentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Journal_Stat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FragmentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(JournalServer).Stat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.Journal/Stat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServer).Stat(ctx, req.(*StatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FragmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextPageToken != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.NextPageToken))
-		i--
-		dAtA[i] = 0x20
+func _Journal_ListSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Fragments) > 0 {
-		for iNdEx := len(m.Fragments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fragments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProtocol(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(JournalServer).ListSchemas(ctx, in)
 	}
-	{
-		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.Journal/ListSchemas",
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Status != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServer).ListSchemas(ctx, req.(*ListSchemasRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FragmentsResponse__Fragment) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Journal_ApplySchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplySchemasRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(JournalServer).ApplySchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.Journal/ApplySchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServer).ApplySchemas(ctx, req.(*ApplySchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FragmentsResponse__Fragment) MarshalTo(dAtA []byte) (int, error) {
+var _Journal_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "protocol.Journal",
+	HandlerType: (*JournalServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Journal_List_Handler,
+		},
+		{
+			MethodName: "Apply",
+			Handler:    _Journal_Apply_Handler,
+		},
+		{
+			MethodName: "History",
+			Handler:    _Journal_History_Handler,
+		},
+		{
+			MethodName: "ListFragments",
+			Handler:    _Journal_ListFragments_Handler,
+		},
+		{
+			MethodName: "Truncate",
+			Handler:    _Journal_Truncate_Handler,
+		},
+		{
+			MethodName: "Stat",
+			Handler:    _Journal_Stat_Handler,
+		},
+		{
+			MethodName: "ListSchemas",
+			Handler:    _Journal_ListSchemas_Handler,
+		},
+		{
+			MethodName: "ApplySchemas",
+			Handler:    _Journal_ApplySchemas_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Journal_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Read",
+			Handler:       _Journal_Read_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Append",
+			Handler:       _Journal_Append_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Replicate",
+			Handler:       _Journal_Replicate_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "broker/protocol/protocol.proto",
+}
+
+func (m *Label) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Label) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FragmentsResponse__Fragment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Label) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SignedUrl) > 0 {
-		i -= len(m.SignedUrl)
-		copy(dAtA[i:], m.SignedUrl)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.SignedUrl)))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Value)))
 		i--
 		dAtA[i] = 0x12
 	}
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Route) Marshal() (dAtA []byte, err error) {
+func (m *LabelSet) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4047,34 +4246,20 @@ func (m *Route) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Route) MarshalTo(dAtA []byte) (int, error) {
+func (m *LabelSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Route) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LabelSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Endpoints) > 0 {
-		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Endpoints[iNdEx])
-			copy(dAtA[i:], m.Endpoints[iNdEx])
-			i = encodeVarintProtocol(dAtA, i, uint64(len(m.Endpoints[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.Primary != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.Primary))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Labels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4088,7 +4273,7 @@ func (m *Route) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Header) Marshal() (dAtA []byte, err error) {
+func (m *LabelSelector) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4098,28 +4283,18 @@ func (m *Header) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Header) MarshalTo(dAtA []byte) (int, error) {
+func (m *LabelSelector) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LabelSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Etcd.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProtocol(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Exclude.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4129,7 +4304,7 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x12
 	{
-		size, err := m.ProcessId.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Include.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4141,7 +4316,7 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Header_Etcd) Marshal() (dAtA []byte, err error) {
+func (m *JournalSpec) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4151,680 +4326,5897 @@ func (m *Header_Etcd) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Header_Etcd) MarshalTo(dAtA []byte) (int, error) {
+func (m *JournalSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Header_Etcd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JournalSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RaftTerm != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.RaftTerm))
+	if m.ValidateSchema {
 		i--
-		dAtA[i] = 0x20
+		if m.ValidateSchema {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.Revision != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.Revision))
+	if m.ValidateFraming {
 		i--
-		dAtA[i] = 0x18
+		if m.ValidateFraming {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.MemberId != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.MemberId))
+	if m.QuorumCommit {
+		i--
+		if m.QuorumCommit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.Suspend.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.MinOffset != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.MinOffset))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxAppendRate != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.MaxAppendRate))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Flags != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Flags))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Fragment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.LabelSet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Replication != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Replication))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.ClusterId != 0 {
-		i = encodeVarintProtocol(dAtA, i, uint64(m.ClusterId))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProtocol(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProtocol(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *JournalSpec_Fragment) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Label) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *JournalSpec_Fragment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JournalSpec_Fragment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
+	if m.PruneOversize {
+		i--
+		if m.PruneOversize {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
-	}
-	return n
-}
-
-func (m *LabelSet) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Labels) > 0 {
-		for _, e := range m.Labels {
-			l = e.ProtoSize()
-			n += 1 + l + sovProtocol(uint64(l))
-		}
+	if m.ReaderFloor != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.ReaderFloor))
+		i--
+		dAtA[i] = 0x68
 	}
-	return n
-}
-
-func (m *LabelSelector) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	if len(m.CompressionDictionary) > 0 {
+		i -= len(m.CompressionDictionary)
+		copy(dAtA[i:], m.CompressionDictionary)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.CompressionDictionary)))
+		i--
+		dAtA[i] = 0x62
 	}
-	var l int
-	_ = l
-	l = m.Include.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	l = m.Exclude.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	return n
-}
-
-func (m *JournalSpec) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	if len(m.EncryptionKey) > 0 {
+		i -= len(m.EncryptionKey)
+		copy(dAtA[i:], m.EncryptionKey)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.EncryptionKey)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
+	if len(m.TierAfter) > 0 {
+		for iNdEx := len(m.TierAfter) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TierAfter[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TierAfter[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintProtocol(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if m.Replication != 0 {
-		n += 1 + sovProtocol(uint64(m.Replication))
+	if m.MaxFragments != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.MaxFragments))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = m.LabelSet.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	l = m.Fragment.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	if m.Flags != 0 {
-		n += 1 + sovProtocol(uint64(m.Flags))
+	if m.MaxBytes != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.MaxAppendRate != 0 {
-		n += 1 + sovProtocol(uint64(m.MaxAppendRate))
+	if len(m.PathPostfixTemplate) > 0 {
+		i -= len(m.PathPostfixTemplate)
+		copy(dAtA[i:], m.PathPostfixTemplate)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.PathPostfixTemplate)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
-
-func (m *JournalSpec_Fragment) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.FlushInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.FlushInterval):])
+	if err6 != nil {
+		return 0, err6
 	}
-	var l int
-	_ = l
-	if m.Length != 0 {
-		n += 1 + sovProtocol(uint64(m.Length))
+	i -= n6
+	i = encodeVarintProtocol(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x32
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Retention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Retention):])
+	if err7 != nil {
+		return 0, err7
 	}
-	if m.CompressionCodec != 0 {
-		n += 1 + sovProtocol(uint64(m.CompressionCodec))
+	i -= n7
+	i = encodeVarintProtocol(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RefreshInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RefreshInterval):])
+	if err8 != nil {
+		return 0, err8
 	}
+	i -= n8
+	i = encodeVarintProtocol(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
 	if len(m.Stores) > 0 {
-		for _, s := range m.Stores {
-			l = len(s)
-			n += 1 + l + sovProtocol(uint64(l))
+		for iNdEx := len(m.Stores) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Stores[iNdEx])
+			copy(dAtA[i:], m.Stores[iNdEx])
+			i = encodeVarintProtocol(dAtA, i, uint64(len(m.Stores[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RefreshInterval)
-	n += 1 + l + sovProtocol(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Retention)
-	n += 1 + l + sovProtocol(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.FlushInterval)
-	n += 1 + l + sovProtocol(uint64(l))
-	l = len(m.PathPostfixTemplate)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
+	if m.CompressionCodec != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.CompressionCodec))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Length != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ProcessSpec) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *JournalSpec_Suspend) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JournalSpec_Suspend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JournalSpec_Suspend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Id.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	l = len(m.Endpoint)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
+	if m.Offset != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Level != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ProcessSpec_ID) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *ProcessSpec) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcessSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProcessSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Zone)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Suffix)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
+	{
+		size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *BrokerSpec) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *ProcessSpec_ID) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcessSpec_ID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProcessSpec_ID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ProcessSpec.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	if m.JournalLimit != 0 {
-		n += 1 + sovProtocol(uint64(m.JournalLimit))
+	if len(m.Suffix) > 0 {
+		i -= len(m.Suffix)
+		copy(dAtA[i:], m.Suffix)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Suffix)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Zone)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Fragment) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *BrokerSpec) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BrokerSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BrokerSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Journal)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
-	}
-	if m.Begin != 0 {
-		n += 1 + sovProtocol(uint64(m.Begin))
-	}
-	if m.End != 0 {
-		n += 1 + sovProtocol(uint64(m.End))
-	}
-	l = m.Sum.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	if m.CompressionCodec != 0 {
-		n += 1 + sovProtocol(uint64(m.CompressionCodec))
-	}
-	l = len(m.BackingStore)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
-	}
-	if m.ModTime != 0 {
-		n += 1 + sovProtocol(uint64(m.ModTime))
+	if m.JournalLimit != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.JournalLimit))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.PathPostfix)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
+	{
+		size, err := m.ProcessSpec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SHA1Sum) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *Fragment) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Fragment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fragment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Part1 != 0 {
-		n += 9
-	}
-	if m.Part2 != 0 {
-		n += 9
+	if len(m.CompressionDictionary) > 0 {
+		i -= len(m.CompressionDictionary)
+		copy(dAtA[i:], m.CompressionDictionary)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.CompressionDictionary)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.Part3 != 0 {
-		n += 5
+	if m.Encrypted {
+		i--
+		if m.Encrypted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	return n
-}
-
-func (m *ReadRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PathPostfix) > 0 {
+		i -= len(m.PathPostfix)
+		copy(dAtA[i:], m.PathPostfix)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.PathPostfix)))
+		i--
+		dAtA[i] = 0x42
 	}
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
+	if m.ModTime != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.ModTime))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Journal)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
+	if len(m.BackingStore) > 0 {
+		i -= len(m.BackingStore)
+		copy(dAtA[i:], m.BackingStore)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.BackingStore)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Offset != 0 {
-		n += 1 + sovProtocol(uint64(m.Offset))
+	if m.CompressionCodec != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.CompressionCodec))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Block {
-		n += 2
+	{
+		size, err := m.Sum.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
 	}
-	if m.DoNotProxy {
-		n += 2
+	i--
+	dAtA[i] = 0x22
+	if m.End != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.MetadataOnly {
-		n += 2
+	if m.Begin != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Begin))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.EndOffset != 0 {
-		n += 1 + sovProtocol(uint64(m.EndOffset))
+	if len(m.Journal) > 0 {
+		i -= len(m.Journal)
+		copy(dAtA[i:], m.Journal)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Journal)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ReadResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *SHA1Sum) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SHA1Sum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SHA1Sum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovProtocol(uint64(m.Status))
-	}
-	if m.Header != nil {
-		l = m.Header.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
-	}
-	if m.Offset != 0 {
-		n += 1 + sovProtocol(uint64(m.Offset))
-	}
-	if m.WriteHead != 0 {
-		n += 1 + sovProtocol(uint64(m.WriteHead))
-	}
-	if m.Fragment != nil {
-		l = m.Fragment.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
+	if m.Part3 != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.Part3))
+		i--
+		dAtA[i] = 0x1d
 	}
-	l = len(m.FragmentUrl)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
+	if m.Part2 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Part2))
+		i--
+		dAtA[i] = 0x11
 	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
+	if m.Part1 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Part1))
+		i--
+		dAtA[i] = 0x9
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AppendRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *ReadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
+	if m.BeginModTime != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.BeginModTime))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Journal)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
+	if m.EndOffset != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.EndOffset))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.DoNotProxy {
-		n += 2
+	if m.MetadataOnly {
+		i--
+		if m.MetadataOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
+	if m.DoNotProxy {
+		i--
+		if m.DoNotProxy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Offset != 0 {
-		n += 1 + sovProtocol(uint64(m.Offset))
+	if m.Block {
+		i--
+		if m.Block {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.CheckRegisters != nil {
-		l = m.CheckRegisters.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
+	if m.Offset != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.UnionRegisters != nil {
-		l = m.UnionRegisters.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
+	if len(m.Journal) > 0 {
+		i -= len(m.Journal)
+		copy(dAtA[i:], m.Journal)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Journal)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.SubtractRegisters != nil {
-		l = m.SubtractRegisters.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AppendResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovProtocol(uint64(m.Status))
-	}
-	l = m.Header.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	if m.Commit != nil {
-		l = m.Commit.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
-	}
-	if m.Registers != nil {
-		l = m.Registers.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
-	}
-	if m.TotalChunks != 0 {
-		n += 1 + sovProtocol(uint64(m.TotalChunks))
-	}
-	if m.DelayedChunks != 0 {
-		n += 1 + sovProtocol(uint64(m.DelayedChunks))
+func (m *ReadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ReplicateRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
-	}
-	l = len(m.DeprecatedJournal)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
-	}
-	if m.Proposal != nil {
-		l = m.Proposal.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
-	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
-	}
-	if m.ContentDelta != 0 {
-		n += 1 + sovProtocol(uint64(m.ContentDelta))
-	}
-	if m.Acknowledge {
-		n += 2
-	}
-	if m.Registers != nil {
-		l = m.Registers.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
-	}
-	return n
+func (m *ReadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReplicateResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ReadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovProtocol(uint64(m.Status))
+	if len(m.DictionaryUrl) > 0 {
+		i -= len(m.DictionaryUrl)
+		copy(dAtA[i:], m.DictionaryUrl)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.DictionaryUrl)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.Header != nil {
-		l = m.Header.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FragmentUrl) > 0 {
+		i -= len(m.FragmentUrl)
+		copy(dAtA[i:], m.FragmentUrl)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.FragmentUrl)))
+		i--
+		dAtA[i] = 0x32
 	}
 	if m.Fragment != nil {
-		l = m.Fragment.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
+		{
+			size, err := m.Fragment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Registers != nil {
-		l = m.Registers.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
+	if m.WriteHead != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.WriteHead))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *ListRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	if m.Offset != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	l = m.Selector.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	return n
-}
-
-func (m *ListResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
 	if m.Status != 0 {
-		n += 1 + sovProtocol(uint64(m.Status))
-	}
-	l = m.Header.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	if len(m.Journals) > 0 {
-		for _, e := range m.Journals {
-			l = e.ProtoSize()
-			n += 1 + l + sovProtocol(uint64(l))
-		}
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListResponse_Journal) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Spec.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	if m.ModRevision != 0 {
-		n += 1 + sovProtocol(uint64(m.ModRevision))
+func (m *AppendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Route.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ApplyRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Changes) > 0 {
-		for _, e := range m.Changes {
-			l = e.ProtoSize()
-			n += 1 + l + sovProtocol(uint64(l))
-		}
-	}
-	return n
+func (m *AppendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplyRequest_Change) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AppendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ExpectModRevision != 0 {
-		n += 1 + sovProtocol(uint64(m.ExpectModRevision))
-	}
-	if m.Upsert != nil {
-		l = m.Upsert.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
-	}
-	l = len(m.Delete)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
-	}
-	return n
-}
-
-func (m *ApplyResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovProtocol(uint64(m.Status))
-	}
-	l = m.Header.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	return n
-}
-
-func (m *FragmentsRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
+	if m.SubtractRegisters != nil {
+		{
+			size, err := m.SubtractRegisters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.Journal)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
+	if m.UnionRegisters != nil {
+		{
+			size, err := m.UnionRegisters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.BeginModTime != 0 {
-		n += 1 + sovProtocol(uint64(m.BeginModTime))
+	if m.CheckRegisters != nil {
+		{
+			size, err := m.CheckRegisters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.EndModTime != 0 {
-		n += 1 + sovProtocol(uint64(m.EndModTime))
+	if m.Offset != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.NextPageToken != 0 {
-		n += 1 + sovProtocol(uint64(m.NextPageToken))
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.PageLimit != 0 {
-		n += 1 + sovProtocol(uint64(m.PageLimit))
+	if m.DoNotProxy {
+		i--
+		if m.DoNotProxy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.SignatureTTL != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.SignatureTTL)
-		n += 1 + l + sovProtocol(uint64(l))
+	if len(m.Journal) > 0 {
+		i -= len(m.Journal)
+		copy(dAtA[i:], m.Journal)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Journal)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.DoNotProxy {
-		n += 2
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FragmentsResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *AppendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AppendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovProtocol(uint64(m.Status))
+	if m.DelayedChunks != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.DelayedChunks))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = m.Header.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	if len(m.Fragments) > 0 {
-		for _, e := range m.Fragments {
-			l = e.ProtoSize()
-			n += 1 + l + sovProtocol(uint64(l))
+	if m.TotalChunks != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.TotalChunks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Registers != nil {
+		{
+			size, err := m.Registers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.NextPageToken != 0 {
-		n += 1 + sovProtocol(uint64(m.NextPageToken))
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *FragmentsResponse__Fragment) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = m.Spec.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	l = len(m.SignedUrl)
-	if l > 0 {
-		n += 1 + l + sovProtocol(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Route) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *ReplicateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.ProtoSize()
-			n += 1 + l + sovProtocol(uint64(l))
+	if m.Registers != nil {
+		{
+			size, err := m.Registers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.Primary != 0 {
-		n += 1 + sovProtocol(uint64(m.Primary))
+	if m.Acknowledge {
+		i--
+		if m.Acknowledge {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.Endpoints) > 0 {
-		for _, s := range m.Endpoints {
-			l = len(s)
-			n += 1 + l + sovProtocol(uint64(l))
+	if m.ContentDelta != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.ContentDelta))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Proposal != nil {
+		{
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DeprecatedJournal) > 0 {
+		i -= len(m.DeprecatedJournal)
+		copy(dAtA[i:], m.DeprecatedJournal)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.DeprecatedJournal)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Registers != nil {
+		{
+			size, err := m.Registers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Fragment != nil {
+		{
+			size, err := m.Fragment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Journals) > 0 {
+		for iNdEx := len(m.Journals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Journals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtocol(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListResponse_Journal) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListResponse_Journal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListResponse_Journal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ModRevision != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.ModRevision))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deletes) > 0 {
+		for iNdEx := len(m.Deletes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Deletes[iNdEx])
+			copy(dAtA[i:], m.Deletes[iNdEx])
+			i = encodeVarintProtocol(dAtA, i, uint64(len(m.Deletes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Upserts) > 0 {
+		for iNdEx := len(m.Upserts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Upserts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtocol(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtocol(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplyRequest_Change) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyRequest_Change) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplyRequest_Change) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delete) > 0 {
+		i -= len(m.Delete)
+		copy(dAtA[i:], m.Delete)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Delete)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Upsert != nil {
+		{
+			size, err := m.Upsert.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ExpectModRevision != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.ExpectModRevision))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FragmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FragmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FragmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeTruncated {
+		i--
+		if m.IncludeTruncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DoNotProxy {
+		i--
+		if m.DoNotProxy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SignatureTTL != nil {
+		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.SignatureTTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.SignatureTTL):])
+		if err35 != nil {
+			return 0, err35
+		}
+		i -= n35
+		i = encodeVarintProtocol(dAtA, i, uint64(n35))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PageLimit != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.PageLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextPageToken != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.NextPageToken))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndModTime != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.EndModTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BeginModTime != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.BeginModTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Journal) > 0 {
+		i -= len(m.Journal)
+		copy(dAtA[i:], m.Journal)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Journal)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FragmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FragmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FragmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextPageToken != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.NextPageToken))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Fragments) > 0 {
+		for iNdEx := len(m.Fragments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fragments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtocol(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FragmentsResponse__Fragment) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FragmentsResponse__Fragment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FragmentsResponse__Fragment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignedUrl) > 0 {
+		i -= len(m.SignedUrl)
+		copy(dAtA[i:], m.SignedUrl)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.SignedUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TruncateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TruncateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TruncateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Journal) > 0 {
+		i -= len(m.Journal)
+		copy(dAtA[i:], m.Journal)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Journal)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TruncateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TruncateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TruncateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DoNotProxy {
+		i--
+		if m.DoNotProxy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Journal) > 0 {
+		i -= len(m.Journal)
+		copy(dAtA[i:], m.Journal)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Journal)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.PersistErrorTime != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.PersistErrorTime))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.PersistError) > 0 {
+		i -= len(m.PersistError)
+		copy(dAtA[i:], m.PersistError)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.PersistError)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.PersistBacklogBytes != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.PersistBacklogBytes))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PersistBacklogFragments != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.PersistBacklogFragments))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AppendRate != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.AppendRate))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SpooledBytes != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.SpooledBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Replicas) > 0 {
+		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Replicas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtocol(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.WriteHead != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.WriteHead))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatResponse_Replica) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatResponse_Replica) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatResponse_Replica) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InSync {
+		i--
+		if m.InSync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SchemaSpec) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchemaSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchemaSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Compatibility != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Compatibility))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSchemasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSchemasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSchemasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintProtocol(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSchemasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSchemasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSchemasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schemas) > 0 {
+		for iNdEx := len(m.Schemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtocol(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSchemasResponse_Schema) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSchemasResponse_Schema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSchemasResponse_Schema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ModRevision != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.ModRevision))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplySchemasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplySchemasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplySchemasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtocol(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplySchemasRequest_Change) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplySchemasRequest_Change) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplySchemasRequest_Change) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delete) > 0 {
+		i -= len(m.Delete)
+		copy(dAtA[i:], m.Delete)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Delete)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Upsert != nil {
+		{
+			size, err := m.Upsert.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ExpectModRevision != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.ExpectModRevision))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplySchemasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplySchemasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplySchemasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JournalSpecRevision) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JournalSpecRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JournalSpecRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.After != nil {
+		{
+			size, err := m.After.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.BeforeRevision != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.BeforeRevision))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Before != nil {
+		{
+			size, err := m.Before.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AppliedBy) > 0 {
+		i -= len(m.AppliedBy)
+		copy(dAtA[i:], m.AppliedBy)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.AppliedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ApplyTime != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.ApplyTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Revision != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Journal) > 0 {
+		i -= len(m.Journal)
+		copy(dAtA[i:], m.Journal)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Journal)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PageLimit != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.PageLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Journal) > 0 {
+		i -= len(m.Journal)
+		copy(dAtA[i:], m.Journal)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Journal)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtocol(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Route) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Route) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Route) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Endpoints[iNdEx])
+			copy(dAtA[i:], m.Endpoints[iNdEx])
+			i = encodeVarintProtocol(dAtA, i, uint64(len(m.Endpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Primary != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Primary))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtocol(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Header) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Etcd.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ProcessId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Header_Etcd) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Header_Etcd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Header_Etcd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RaftTerm != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.RaftTerm))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Revision != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MemberId != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.MemberId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterId != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.ClusterId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProtocol(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProtocol(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Label) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *LabelSet) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.ProtoSize()
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LabelSelector) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Include.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	l = m.Exclude.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	return n
+}
+
+func (m *JournalSpec) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.Replication != 0 {
+		n += 1 + sovProtocol(uint64(m.Replication))
+	}
+	l = m.LabelSet.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	l = m.Fragment.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if m.Flags != 0 {
+		n += 1 + sovProtocol(uint64(m.Flags))
+	}
+	if m.MaxAppendRate != 0 {
+		n += 1 + sovProtocol(uint64(m.MaxAppendRate))
+	}
+	if m.MinOffset != 0 {
+		n += 1 + sovProtocol(uint64(m.MinOffset))
+	}
+	l = m.Suspend.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if m.QuorumCommit {
+		n += 2
+	}
+	if m.ValidateFraming {
+		n += 2
+	}
+	if m.ValidateSchema {
+		n += 2
+	}
+	return n
+}
+
+func (m *JournalSpec_Fragment) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Length != 0 {
+		n += 1 + sovProtocol(uint64(m.Length))
+	}
+	if m.CompressionCodec != 0 {
+		n += 1 + sovProtocol(uint64(m.CompressionCodec))
+	}
+	if len(m.Stores) > 0 {
+		for _, s := range m.Stores {
+			l = len(s)
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RefreshInterval)
+	n += 1 + l + sovProtocol(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Retention)
+	n += 1 + l + sovProtocol(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.FlushInterval)
+	n += 1 + l + sovProtocol(uint64(l))
+	l = len(m.PathPostfixTemplate)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovProtocol(uint64(m.MaxBytes))
+	}
+	if m.MaxFragments != 0 {
+		n += 1 + sovProtocol(uint64(m.MaxFragments))
+	}
+	if len(m.TierAfter) > 0 {
+		for _, e := range m.TierAfter {
+			l = github_com_gogo_protobuf_types.SizeOfStdDuration(e)
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	l = len(m.EncryptionKey)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.CompressionDictionary)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.ReaderFloor != 0 {
+		n += 1 + sovProtocol(uint64(m.ReaderFloor))
+	}
+	if m.PruneOversize {
+		n += 2
+	}
+	return n
+}
+
+func (m *JournalSpec_Suspend) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovProtocol(uint64(m.Level))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovProtocol(uint64(m.Offset))
+	}
+	return n
+}
+
+func (m *ProcessSpec) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *ProcessSpec_ID) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.Suffix)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *BrokerSpec) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProcessSpec.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if m.JournalLimit != 0 {
+		n += 1 + sovProtocol(uint64(m.JournalLimit))
+	}
+	return n
+}
+
+func (m *Fragment) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Journal)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.Begin != 0 {
+		n += 1 + sovProtocol(uint64(m.Begin))
+	}
+	if m.End != 0 {
+		n += 1 + sovProtocol(uint64(m.End))
+	}
+	l = m.Sum.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if m.CompressionCodec != 0 {
+		n += 1 + sovProtocol(uint64(m.CompressionCodec))
+	}
+	l = len(m.BackingStore)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.ModTime != 0 {
+		n += 1 + sovProtocol(uint64(m.ModTime))
+	}
+	l = len(m.PathPostfix)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.Encrypted {
+		n += 2
+	}
+	l = len(m.CompressionDictionary)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *SHA1Sum) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Part1 != 0 {
+		n += 9
+	}
+	if m.Part2 != 0 {
+		n += 9
+	}
+	if m.Part3 != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *ReadRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.Journal)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovProtocol(uint64(m.Offset))
+	}
+	if m.Block {
+		n += 2
+	}
+	if m.DoNotProxy {
+		n += 2
+	}
+	if m.MetadataOnly {
+		n += 2
+	}
+	if m.EndOffset != 0 {
+		n += 1 + sovProtocol(uint64(m.EndOffset))
+	}
+	if m.BeginModTime != 0 {
+		n += 1 + sovProtocol(uint64(m.BeginModTime))
+	}
+	return n
+}
+
+func (m *ReadResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovProtocol(uint64(m.Status))
+	}
+	if m.Header != nil {
+		l = m.Header.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovProtocol(uint64(m.Offset))
+	}
+	if m.WriteHead != 0 {
+		n += 1 + sovProtocol(uint64(m.WriteHead))
+	}
+	if m.Fragment != nil {
+		l = m.Fragment.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.FragmentUrl)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.DictionaryUrl)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *AppendRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.Journal)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.DoNotProxy {
+		n += 2
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovProtocol(uint64(m.Offset))
+	}
+	if m.CheckRegisters != nil {
+		l = m.CheckRegisters.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.UnionRegisters != nil {
+		l = m.UnionRegisters.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.SubtractRegisters != nil {
+		l = m.SubtractRegisters.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *AppendResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovProtocol(uint64(m.Status))
+	}
+	l = m.Header.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if m.Commit != nil {
+		l = m.Commit.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.Registers != nil {
+		l = m.Registers.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.TotalChunks != 0 {
+		n += 1 + sovProtocol(uint64(m.TotalChunks))
+	}
+	if m.DelayedChunks != 0 {
+		n += 1 + sovProtocol(uint64(m.DelayedChunks))
+	}
+	return n
+}
+
+func (m *ReplicateRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.DeprecatedJournal)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.Proposal != nil {
+		l = m.Proposal.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.ContentDelta != 0 {
+		n += 1 + sovProtocol(uint64(m.ContentDelta))
+	}
+	if m.Acknowledge {
+		n += 2
+	}
+	if m.Registers != nil {
+		l = m.Registers.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *ReplicateResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovProtocol(uint64(m.Status))
+	}
+	if m.Header != nil {
+		l = m.Header.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.Fragment != nil {
+		l = m.Fragment.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.Registers != nil {
+		l = m.Registers.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *ListRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Selector.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	return n
+}
+
+func (m *ListResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovProtocol(uint64(m.Status))
+	}
+	l = m.Header.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if len(m.Journals) > 0 {
+		for _, e := range m.Journals {
+			l = e.ProtoSize()
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListResponse_Journal) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Spec.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if m.ModRevision != 0 {
+		n += 1 + sovProtocol(uint64(m.ModRevision))
+	}
+	l = m.Route.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	return n
+}
+
+func (m *WatchResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovProtocol(uint64(m.Status))
+	}
+	l = m.Header.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if len(m.Upserts) > 0 {
+		for _, e := range m.Upserts {
+			l = e.ProtoSize()
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	if len(m.Deletes) > 0 {
+		for _, s := range m.Deletes {
+			l = len(s)
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplyRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.ProtoSize()
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *ApplyRequest_Change) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpectModRevision != 0 {
+		n += 1 + sovProtocol(uint64(m.ExpectModRevision))
+	}
+	if m.Upsert != nil {
+		l = m.Upsert.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.Delete)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplyResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovProtocol(uint64(m.Status))
+	}
+	l = m.Header.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	return n
+}
+
+func (m *FragmentsRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.Journal)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.BeginModTime != 0 {
+		n += 1 + sovProtocol(uint64(m.BeginModTime))
+	}
+	if m.EndModTime != 0 {
+		n += 1 + sovProtocol(uint64(m.EndModTime))
+	}
+	if m.NextPageToken != 0 {
+		n += 1 + sovProtocol(uint64(m.NextPageToken))
+	}
+	if m.PageLimit != 0 {
+		n += 1 + sovProtocol(uint64(m.PageLimit))
+	}
+	if m.SignatureTTL != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.SignatureTTL)
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.DoNotProxy {
+		n += 2
+	}
+	if m.IncludeTruncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *FragmentsResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovProtocol(uint64(m.Status))
+	}
+	l = m.Header.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if len(m.Fragments) > 0 {
+		for _, e := range m.Fragments {
+			l = e.ProtoSize()
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	if m.NextPageToken != 0 {
+		n += 1 + sovProtocol(uint64(m.NextPageToken))
+	}
+	return n
+}
+
+func (m *FragmentsResponse__Fragment) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Spec.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	l = len(m.SignedUrl)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *TruncateRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.Journal)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovProtocol(uint64(m.Offset))
+	}
+	return n
+}
+
+func (m *TruncateResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovProtocol(uint64(m.Status))
+	}
+	l = m.Header.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if m.Offset != 0 {
+		n += 1 + sovProtocol(uint64(m.Offset))
+	}
+	return n
+}
+
+func (m *StatRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.Journal)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.DoNotProxy {
+		n += 2
+	}
+	return n
+}
+
+func (m *StatResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovProtocol(uint64(m.Status))
+	}
+	l = m.Header.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if m.WriteHead != 0 {
+		n += 1 + sovProtocol(uint64(m.WriteHead))
+	}
+	if len(m.Replicas) > 0 {
+		for _, e := range m.Replicas {
+			l = e.ProtoSize()
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	if m.SpooledBytes != 0 {
+		n += 1 + sovProtocol(uint64(m.SpooledBytes))
+	}
+	if m.AppendRate != 0 {
+		n += 1 + sovProtocol(uint64(m.AppendRate))
+	}
+	if m.PersistBacklogFragments != 0 {
+		n += 1 + sovProtocol(uint64(m.PersistBacklogFragments))
+	}
+	if m.PersistBacklogBytes != 0 {
+		n += 1 + sovProtocol(uint64(m.PersistBacklogBytes))
+	}
+	l = len(m.PersistError)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.PersistErrorTime != 0 {
+		n += 1 + sovProtocol(uint64(m.PersistErrorTime))
+	}
+	if m.Spec != nil {
+		l = m.Spec.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *StatResponse_Replica) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if m.InSync {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *SchemaSpec) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovProtocol(uint64(m.Type))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.Compatibility != 0 {
+		n += 1 + sovProtocol(uint64(m.Compatibility))
+	}
+	return n
+}
+
+func (m *ListSchemasRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListSchemasResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovProtocol(uint64(m.Status))
+	}
+	l = m.Header.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if len(m.Schemas) > 0 {
+		for _, e := range m.Schemas {
+			l = e.ProtoSize()
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListSchemasResponse_Schema) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Spec.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if m.ModRevision != 0 {
+		n += 1 + sovProtocol(uint64(m.ModRevision))
+	}
+	return n
+}
+
+func (m *ApplySchemasRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.ProtoSize()
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplySchemasRequest_Change) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpectModRevision != 0 {
+		n += 1 + sovProtocol(uint64(m.ExpectModRevision))
+	}
+	if m.Upsert != nil {
+		l = m.Upsert.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.Delete)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplySchemasResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovProtocol(uint64(m.Status))
+	}
+	l = m.Header.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *JournalSpecRevision) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Journal)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovProtocol(uint64(m.Revision))
+	}
+	if m.ApplyTime != 0 {
+		n += 1 + sovProtocol(uint64(m.ApplyTime))
+	}
+	l = len(m.AppliedBy)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.Before != nil {
+		l = m.Before.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.BeforeRevision != 0 {
+		n += 1 + sovProtocol(uint64(m.BeforeRevision))
+	}
+	if m.After != nil {
+		l = m.After.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *HistoryRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Journal)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.PageLimit != 0 {
+		n += 1 + sovProtocol(uint64(m.PageLimit))
+	}
+	return n
+}
+
+func (m *HistoryResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovProtocol(uint64(m.Status))
+	}
+	l = m.Header.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.ProtoSize()
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *Route) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.ProtoSize()
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	if m.Primary != 0 {
+		n += 1 + sovProtocol(uint64(m.Primary))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, s := range m.Endpoints {
+			l = len(s)
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Header) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProcessId.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	l = m.Route.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	l = m.Etcd.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	return n
+}
+
+func (m *Header_Etcd) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterId != 0 {
+		n += 1 + sovProtocol(uint64(m.ClusterId))
+	}
+	if m.MemberId != 0 {
+		n += 1 + sovProtocol(uint64(m.MemberId))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovProtocol(uint64(m.Revision))
+	}
+	if m.RaftTerm != 0 {
+		n += 1 + sovProtocol(uint64(m.RaftTerm))
+	}
+	return n
+}
+
+func sovProtocol(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProtocol(x uint64) (n int) {
+	return sovProtocol(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Label) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Label: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Label: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabelSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, Label{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabelSelector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelSelector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Include.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Exclude.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JournalSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JournalSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JournalSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = Journal(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replication", wireType)
+			}
+			m.Replication = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replication |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LabelSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fragment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fragment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			m.Flags = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Flags |= JournalSpec_Flag(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAppendRate", wireType)
+			}
+			m.MaxAppendRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAppendRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOffset", wireType)
+			}
+			m.MinOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOffset |= Offset(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Suspend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumCommit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuorumCommit = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidateFraming", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidateFraming = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidateSchema", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidateSchema = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JournalSpec_Fragment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fragment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fragment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionCodec", wireType)
+			}
+			m.CompressionCodec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressionCodec |= CompressionCodec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stores = append(m.Stores, FragmentStore(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RefreshInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Retention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlushInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.FlushInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathPostfixTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathPostfixTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFragments", wireType)
+			}
+			m.MaxFragments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFragments |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TierAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TierAfter = append(m.TierAfter, time.Duration(0))
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&(m.TierAfter[len(m.TierAfter)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptionKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionDictionary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompressionDictionary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReaderFloor", wireType)
+			}
+			m.ReaderFloor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReaderFloor |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneOversize", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PruneOversize = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JournalSpec_Suspend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Suspend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Suspend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= JournalSpec_Suspend_Level(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= Offset(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = Endpoint(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessSpec_ID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suffix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BrokerSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BrokerSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BrokerSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProcessSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JournalLimit", wireType)
+			}
+			m.JournalLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JournalLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fragment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fragment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fragment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Journal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Journal = Journal(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+			}
+			m.Begin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Begin |= Offset(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= Offset(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionCodec", wireType)
+			}
+			m.CompressionCodec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressionCodec |= CompressionCodec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingStore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackingStore = FragmentStore(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModTime", wireType)
+			}
+			m.ModTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathPostfix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathPostfix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encrypted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Encrypted = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionDictionary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompressionDictionary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SHA1Sum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SHA1Sum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SHA1Sum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Part1", wireType)
+			}
+			m.Part1 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Part1 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Part2", wireType)
+			}
+			m.Part2 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Part2 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Part3", wireType)
+			}
+			m.Part3 = 0
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Part3 = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Journal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Journal = Journal(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= Offset(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Block = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoNotProxy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DoNotProxy = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MetadataOnly = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndOffset", wireType)
+			}
+			m.EndOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndOffset |= Offset(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginModTime", wireType)
+			}
+			m.BeginModTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeginModTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= Offset(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteHead", wireType)
+			}
+			m.WriteHead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteHead |= Offset(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fragment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fragment == nil {
+				m.Fragment = &Fragment{}
+			}
+			if err := m.Fragment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FragmentUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FragmentUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
+			if m.Content == nil {
+				m.Content = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DictionaryUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DictionaryUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *Header) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ProcessId.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	l = m.Route.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	l = m.Etcd.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	return n
-}
 
-func (m *Header_Etcd) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ClusterId != 0 {
-		n += 1 + sovProtocol(uint64(m.ClusterId))
-	}
-	if m.MemberId != 0 {
-		n += 1 + sovProtocol(uint64(m.MemberId))
-	}
-	if m.Revision != 0 {
-		n += 1 + sovProtocol(uint64(m.Revision))
-	}
-	if m.RaftTerm != 0 {
-		n += 1 + sovProtocol(uint64(m.RaftTerm))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovProtocol(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProtocol(x uint64) (n int) {
-	return sovProtocol(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Label) Unmarshal(dAtA []byte) error {
+func (m *AppendRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4847,15 +10239,51 @@ func (m *Label) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Label: wiretype end group for non-group")
+			return fmt.Errorf("proto: AppendRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Label: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AppendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Journal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4883,13 +10311,158 @@ func (m *Label) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Journal = Journal(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoNotProxy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DoNotProxy = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
+			if m.Content == nil {
+				m.Content = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= Offset(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckRegisters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CheckRegisters == nil {
+				m.CheckRegisters = &LabelSelector{}
+			}
+			if err := m.CheckRegisters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnionRegisters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnionRegisters == nil {
+				m.UnionRegisters = &LabelSet{}
+			}
+			if err := m.UnionRegisters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubtractRegisters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -4899,23 +10472,27 @@ func (m *Label) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if m.SubtractRegisters == nil {
+				m.SubtractRegisters = &LabelSet{}
+			}
+			if err := m.SubtractRegisters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4938,7 +10515,7 @@ func (m *Label) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LabelSet) Unmarshal(dAtA []byte) error {
+func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4961,15 +10538,34 @@ func (m *LabelSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LabelSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: AppendResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AppendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4996,64 +10592,13 @@ func (m *LabelSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, Label{})
-			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProtocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LabelSelector) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProtocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LabelSelector: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelSelector: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5080,13 +10625,16 @@ func (m *LabelSelector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Include.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Commit == nil {
+				m.Commit = &Fragment{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Registers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5113,10 +10661,51 @@ func (m *LabelSelector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Exclude.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Registers == nil {
+				m.Registers = &LabelSet{}
+			}
+			if err := m.Registers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalChunks", wireType)
+			}
+			m.TotalChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalChunks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedChunks", wireType)
+			}
+			m.DelayedChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayedChunks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtocol(dAtA[iNdEx:])
@@ -5138,7 +10727,7 @@ func (m *LabelSelector) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JournalSpec) Unmarshal(dAtA []byte) error {
+func (m *ReplicateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5161,17 +10750,17 @@ func (m *JournalSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JournalSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JournalSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -5181,29 +10770,33 @@ func (m *JournalSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = Journal(dAtA[iNdEx:postIndex])
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replication", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedJournal", wireType)
 			}
-			m.Replication = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -5213,14 +10806,27 @@ func (m *JournalSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Replication |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeprecatedJournal = Journal(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5247,15 +10853,18 @@ func (m *JournalSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LabelSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Proposal == nil {
+				m.Proposal = &Fragment{}
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fragment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -5265,30 +10874,50 @@ func (m *JournalSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fragment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
+			if m.Content == nil {
+				m.Content = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentDelta", wireType)
+			}
+			m.ContentDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContentDelta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledge", wireType)
 			}
-			m.Flags = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -5298,16 +10927,17 @@ func (m *JournalSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Flags |= JournalSpec_Flag(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Acknowledge = bool(v != 0)
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAppendRate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registers", wireType)
 			}
-			m.MaxAppendRate = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -5317,11 +10947,28 @@ func (m *JournalSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxAppendRate |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Registers == nil {
+				m.Registers = &LabelSet{}
+			}
+			if err := m.Registers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtocol(dAtA[iNdEx:])
@@ -5343,7 +10990,7 @@ func (m *JournalSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JournalSpec_Fragment) Unmarshal(dAtA []byte) error {
+func (m *ReplicateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5366,17 +11013,17 @@ func (m *JournalSpec_Fragment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Fragment: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Fragment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Length = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -5386,16 +11033,16 @@ func (m *JournalSpec_Fragment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Length |= int64(b&0x7F) << shift
+				m.Status |= Status(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompressionCodec", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.CompressionCodec = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -5405,16 +11052,33 @@ func (m *JournalSpec_Fragment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompressionCodec |= CompressionCodec(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fragment", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -5424,27 +11088,31 @@ func (m *JournalSpec_Fragment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stores = append(m.Stores, FragmentStore(dAtA[iNdEx:postIndex]))
+			if m.Fragment == nil {
+				m.Fragment = &Fragment{}
+			}
+			if err := m.Fragment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Registers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5471,13 +11139,66 @@ func (m *JournalSpec_Fragment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RefreshInterval, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Registers == nil {
+				m.Registers = &LabelSet{}
+			}
+			if err := m.Registers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5504,13 +11225,82 @@ func (m *JournalSpec_Fragment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Retention, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlushInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5537,15 +11327,15 @@ func (m *JournalSpec_Fragment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.FlushInterval, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathPostfixTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Journals", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -5555,23 +11345,25 @@ func (m *JournalSpec_Fragment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathPostfixTemplate = string(dAtA[iNdEx:postIndex])
+			m.Journals = append(m.Journals, ListResponse_Journal{})
+			if err := m.Journals[len(m.Journals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5594,7 +11386,7 @@ func (m *JournalSpec_Fragment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessSpec) Unmarshal(dAtA []byte) error {
+func (m *ListResponse_Journal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5617,15 +11409,15 @@ func (m *ProcessSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: Journal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Journal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5652,15 +11444,34 @@ func (m *ProcessSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModRevision", wireType)
+			}
+			m.ModRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModRevision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -5670,23 +11481,24 @@ func (m *ProcessSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = Endpoint(dAtA[iNdEx:postIndex])
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5709,7 +11521,7 @@ func (m *ProcessSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessSpec_ID) Unmarshal(dAtA []byte) error {
+func (m *WatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5732,17 +11544,36 @@ func (m *ProcessSpec_ID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ID: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -5752,27 +11583,62 @@ func (m *ProcessSpec_ID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Zone = string(dAtA[iNdEx:postIndex])
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upserts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Upserts = append(m.Upserts, ListResponse_Journal{})
+			if err := m.Upserts[len(m.Upserts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deletes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5800,7 +11666,7 @@ func (m *ProcessSpec_ID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Suffix = string(dAtA[iNdEx:postIndex])
+			m.Deletes = append(m.Deletes, Journal(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5823,7 +11689,7 @@ func (m *ProcessSpec_ID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BrokerSpec) Unmarshal(dAtA []byte) error {
+func (m *ApplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5846,15 +11712,15 @@ func (m *BrokerSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BrokerSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BrokerSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5881,15 +11747,16 @@ func (m *BrokerSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProcessSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Changes = append(m.Changes, ApplyRequest_Change{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JournalLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			m.JournalLimit = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -5899,11 +11766,12 @@ func (m *BrokerSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JournalLimit |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtocol(dAtA[iNdEx:])
@@ -5925,7 +11793,7 @@ func (m *BrokerSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Fragment) Unmarshal(dAtA []byte) error {
+func (m *ApplyRequest_Change) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5948,68 +11816,17 @@ func (m *Fragment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Fragment: wiretype end group for non-group")
+			return fmt.Errorf("proto: Change: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Fragment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Change: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Journal", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProtocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Journal = Journal(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
-			}
-			m.Begin = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProtocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Begin |= Offset(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectModRevision", wireType)
 			}
-			m.End = 0
+			m.ExpectModRevision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -6019,14 +11836,14 @@ func (m *Fragment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.End |= Offset(b&0x7F) << shift
+				m.ExpectModRevision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6050,86 +11867,19 @@ func (m *Fragment) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Sum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompressionCodec", wireType)
-			}
-			m.CompressionCodec = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProtocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CompressionCodec |= CompressionCodec(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingStore", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProtocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BackingStore = FragmentStore(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModTime", wireType)
-			}
-			m.ModTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProtocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ModTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Upsert == nil {
+				m.Upsert = &JournalSpec{}
+			}
+			if err := m.Upsert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathPostfix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6157,7 +11907,7 @@ func (m *Fragment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathPostfix = string(dAtA[iNdEx:postIndex])
+			m.Delete = Journal(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6180,7 +11930,7 @@ func (m *Fragment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SHA1Sum) Unmarshal(dAtA []byte) error {
+func (m *ApplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6203,42 +11953,64 @@ func (m *SHA1Sum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SHA1Sum: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SHA1Sum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Part1", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Part1 = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Part1 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
 		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Part2", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.Part2 = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Part2 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Part3", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
 			}
-			m.Part3 = 0
-			if (iNdEx + 4) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Part3 = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtocol(dAtA[iNdEx:])
@@ -6260,7 +12032,7 @@ func (m *SHA1Sum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadRequest) Unmarshal(dAtA []byte) error {
+func (m *FragmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6283,10 +12055,10 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FragmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FragmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6359,9 +12131,9 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginModTime", wireType)
 			}
-			m.Offset = 0
+			m.BeginModTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -6371,16 +12143,16 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= Offset(b&0x7F) << shift
+				m.BeginModTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndModTime", wireType)
 			}
-			var v int
+			m.EndModTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -6390,17 +12162,16 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.EndModTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Block = bool(v != 0)
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoNotProxy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var v int
+			m.NextPageToken = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -6410,15 +12181,69 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NextPageToken |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DoNotProxy = bool(v != 0)
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageLimit", wireType)
+			}
+			m.PageLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureTTL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignatureTTL == nil {
+				m.SignatureTTL = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.SignatureTTL, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoNotProxy", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -6435,12 +12260,12 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.MetadataOnly = bool(v != 0)
-		case 7:
+			m.DoNotProxy = bool(v != 0)
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeTruncated", wireType)
 			}
-			m.EndOffset = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -6450,11 +12275,12 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndOffset |= Offset(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IncludeTruncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtocol(dAtA[iNdEx:])
@@ -6476,7 +12302,7 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadResponse) Unmarshal(dAtA []byte) error {
+func (m *FragmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6499,10 +12325,10 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FragmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FragmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6553,18 +12379,15 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &Header{}
-			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fragments", wireType)
 			}
-			m.Offset = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -6574,16 +12397,31 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= Offset(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fragments = append(m.Fragments, FragmentsResponse__Fragment{})
+			if err := m.Fragments[len(m.Fragments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WriteHead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			m.WriteHead = 0
+			m.NextPageToken = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -6593,14 +12431,64 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WriteHead |= Offset(b&0x7F) << shift
+				m.NextPageToken |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FragmentsResponse__Fragment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: _Fragment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: _Fragment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fragment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6627,16 +12515,13 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fragment == nil {
-				m.Fragment = &Fragment{}
-			}
-			if err := m.Fragment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FragmentUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6664,41 +12549,7 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FragmentUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProtocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
-			if m.Content == nil {
-				m.Content = []byte{}
-			}
+			m.SignedUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6721,7 +12572,7 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppendRequest) Unmarshal(dAtA []byte) error {
+func (m *TruncateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6744,10 +12595,10 @@ func (m *AppendRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppendRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TruncateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TruncateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6820,9 +12671,9 @@ func (m *AppendRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoNotProxy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var v int
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -6832,51 +12683,66 @@ func (m *AppendRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Offset |= Offset(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DoNotProxy = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProtocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProtocol
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TruncateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
-			if m.Content == nil {
-				m.Content = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TruncateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TruncateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Offset = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -6886,14 +12752,14 @@ func (m *AppendRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= Offset(b&0x7F) << shift
+				m.Status |= Status(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckRegisters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6920,16 +12786,82 @@ func (m *AppendRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CheckRegisters == nil {
-				m.CheckRegisters = &LabelSelector{}
-			}
-			if err := m.CheckRegisters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= Offset(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnionRegisters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6956,18 +12888,18 @@ func (m *AppendRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UnionRegisters == nil {
-				m.UnionRegisters = &LabelSet{}
+			if m.Header == nil {
+				m.Header = &Header{}
 			}
-			if err := m.UnionRegisters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubtractRegisters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Journal", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -6977,28 +12909,44 @@ func (m *AppendRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubtractRegisters == nil {
-				m.SubtractRegisters = &LabelSet{}
+			m.Journal = Journal(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoNotProxy", wireType)
 			}
-			if err := m.SubtractRegisters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.DoNotProxy = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtocol(dAtA[iNdEx:])
@@ -7020,7 +12968,7 @@ func (m *AppendRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppendResponse) Unmarshal(dAtA []byte) error {
+func (m *StatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7043,10 +12991,10 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7102,10 +13050,10 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteHead", wireType)
 			}
-			var msglen int
+			m.WriteHead = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -7115,31 +13063,14 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WriteHead |= Offset(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Commit == nil {
-				m.Commit = &Fragment{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Registers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7166,18 +13097,16 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Registers == nil {
-				m.Registers = &LabelSet{}
-			}
-			if err := m.Registers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Replicas = append(m.Replicas, StatResponse_Replica{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalChunks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpooledBytes", wireType)
 			}
-			m.TotalChunks = 0
+			m.SpooledBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -7187,16 +13116,16 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalChunks |= int64(b&0x7F) << shift
+				m.SpooledBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelayedChunks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppendRate", wireType)
 			}
-			m.DelayedChunks = 0
+			m.AppendRate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -7206,66 +13135,16 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelayedChunks |= int64(b&0x7F) << shift
+				m.AppendRate |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProtocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReplicateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProtocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistBacklogFragments", wireType)
 			}
-			var msglen int
+			m.PersistBacklogFragments = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -7275,31 +13154,33 @@ func (m *ReplicateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PersistBacklogFragments |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &Header{}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistBacklogBytes", wireType)
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PersistBacklogBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PersistBacklogBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedJournal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7327,11 +13208,30 @@ func (m *ReplicateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeprecatedJournal = Journal(dAtA[iNdEx:postIndex])
+			m.PersistError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistErrorTime", wireType)
+			}
+			m.PersistErrorTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PersistErrorTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7358,18 +13258,68 @@ func (m *ReplicateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proposal == nil {
-				m.Proposal = &Fragment{}
+			if m.Spec == nil {
+				m.Spec = &JournalSpec{}
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatResponse_Replica) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Replica: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Replica: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -7379,48 +13329,28 @@ func (m *ReplicateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
-			if m.Content == nil {
-				m.Content = []byte{}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentDelta", wireType)
-			}
-			m.ContentDelta = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProtocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ContentDelta |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InSync", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -7437,12 +13367,12 @@ func (m *ReplicateRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Acknowledge = bool(v != 0)
-		case 7:
+			m.InSync = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Registers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -7452,27 +13382,23 @@ func (m *ReplicateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Registers == nil {
-				m.Registers = &LabelSet{}
-			}
-			if err := m.Registers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7495,7 +13421,7 @@ func (m *ReplicateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicateResponse) Unmarshal(dAtA []byte) error {
+func (m *SchemaSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7518,17 +13444,17 @@ func (m *ReplicateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -7538,16 +13464,29 @@ func (m *ReplicateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= Status(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -7557,33 +13496,16 @@ func (m *ReplicateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= SchemaSpec_Type(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &Header{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fragment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -7593,33 +13515,31 @@ func (m *ReplicateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fragment == nil {
-				m.Fragment = &Fragment{}
-			}
-			if err := m.Fragment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
+			if m.Content == nil {
+				m.Content = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Registers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -7629,28 +13549,43 @@ func (m *ReplicateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Registers == nil {
-				m.Registers = &LabelSet{}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compatibility", wireType)
 			}
-			if err := m.Registers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Compatibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compatibility |= SchemaSpec_Compatibility(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtocol(dAtA[iNdEx:])
@@ -7672,7 +13607,7 @@ func (m *ReplicateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRequest) Unmarshal(dAtA []byte) error {
+func (m *ListSchemasRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7695,17 +13630,17 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListSchemasRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListSchemasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -7715,24 +13650,23 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7755,7 +13689,7 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListResponse) Unmarshal(dAtA []byte) error {
+func (m *ListSchemasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7778,10 +13712,10 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListSchemasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListSchemasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7838,7 +13772,7 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Journals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schemas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7865,8 +13799,8 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Journals = append(m.Journals, ListResponse_Journal{})
-			if err := m.Journals[len(m.Journals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schemas = append(m.Schemas, ListSchemasResponse_Schema{})
+			if err := m.Schemas[len(m.Schemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7891,7 +13825,7 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListResponse_Journal) Unmarshal(dAtA []byte) error {
+func (m *ListSchemasResponse_Schema) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7914,10 +13848,10 @@ func (m *ListResponse_Journal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Journal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Schema: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Journal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Schema: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7972,39 +13906,6 @@ func (m *ListResponse_Journal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProtocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtocol(dAtA[iNdEx:])
@@ -8026,7 +13927,7 @@ func (m *ListResponse_Journal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplyRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplySchemasRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8049,10 +13950,10 @@ func (m *ApplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplySchemasRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplySchemasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8084,7 +13985,7 @@ func (m *ApplyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Changes = append(m.Changes, ApplyRequest_Change{})
+			m.Changes = append(m.Changes, ApplySchemasRequest_Change{})
 			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -8110,7 +14011,7 @@ func (m *ApplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplyRequest_Change) Unmarshal(dAtA []byte) error {
+func (m *ApplySchemasRequest_Change) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8188,7 +14089,7 @@ func (m *ApplyRequest_Change) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Upsert == nil {
-				m.Upsert = &JournalSpec{}
+				m.Upsert = &SchemaSpec{}
 			}
 			if err := m.Upsert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8224,7 +14125,7 @@ func (m *ApplyRequest_Change) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delete = Journal(dAtA[iNdEx:postIndex])
+			m.Delete = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8247,7 +14148,7 @@ func (m *ApplyRequest_Change) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplyResponse) Unmarshal(dAtA []byte) error {
+func (m *ApplySchemasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8270,10 +14171,10 @@ func (m *ApplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplySchemasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplySchemasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8328,61 +14229,11 @@ func (m *ApplyResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProtocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FragmentsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProtocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FragmentsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FragmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -8392,29 +14243,75 @@ func (m *FragmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JournalSpecRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &Header{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JournalSpecRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JournalSpecRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Journal", wireType)
 			}
@@ -8446,11 +14343,11 @@ func (m *FragmentsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Journal = Journal(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeginModTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			m.BeginModTime = 0
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -8460,16 +14357,16 @@ func (m *FragmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BeginModTime |= int64(b&0x7F) << shift
+				m.Revision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndModTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyTime", wireType)
 			}
-			m.EndModTime = 0
+			m.ApplyTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -8479,16 +14376,16 @@ func (m *FragmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndModTime |= int64(b&0x7F) << shift
+				m.ApplyTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedBy", wireType)
 			}
-			m.NextPageToken = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -8498,16 +14395,29 @@ func (m *FragmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextPageToken |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageLimit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
 			}
-			m.PageLimit = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppliedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -8517,14 +14427,27 @@ func (m *FragmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PageLimit |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignatureTTL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8551,18 +14474,18 @@ func (m *FragmentsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SignatureTTL == nil {
-				m.SignatureTTL = new(time.Duration)
+			if m.Before == nil {
+				m.Before = &JournalSpec{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.SignatureTTL, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoNotProxy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeRevision", wireType)
 			}
-			var v int
+			m.BeforeRevision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -8572,12 +14495,47 @@ func (m *FragmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BeforeRevision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DoNotProxy = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.After == nil {
+				m.After = &JournalSpec{}
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtocol(dAtA[iNdEx:])
@@ -8599,7 +14557,7 @@ func (m *FragmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FragmentsResponse) Unmarshal(dAtA []byte) error {
+func (m *HistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8622,36 +14580,17 @@ func (m *FragmentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FragmentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FragmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProtocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= Status(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Journal", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -8661,30 +14600,29 @@ func (m *FragmentsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Journal = Journal(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fragments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -8694,31 +14632,29 @@ func (m *FragmentsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProtocol
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fragments = append(m.Fragments, FragmentsResponse__Fragment{})
-			if err := m.Fragments[len(m.Fragments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageLimit", wireType)
 			}
-			m.NextPageToken = 0
+			m.PageLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -8728,7 +14664,7 @@ func (m *FragmentsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextPageToken |= int64(b&0x7F) << shift
+				m.PageLimit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8754,7 +14690,7 @@ func (m *FragmentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FragmentsResponse__Fragment) Unmarshal(dAtA []byte) error {
+func (m *HistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8777,15 +14713,34 @@ func (m *FragmentsResponse__Fragment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: _Fragment: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: _Fragment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8812,13 +14767,47 @@ func (m *FragmentsResponse__Fragment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, JournalSpecRevision{})
+			if err := m.Revisions[len(m.Revisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8846,7 +14835,7 @@ func (m *FragmentsResponse__Fragment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignedUrl = string(dAtA[iNdEx:postIndex])
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35,6 +35,8 @@ func (m *ReadRequest) Validate() error {
 		return NewValidationError("invalid Offset (%d; expected -1 <= Offset <= MaxInt64)", m.Offset)
 	} else if m.EndOffset < 0 || m.EndOffset != 0 && m.EndOffset < m.Offset {
 		return NewValidationError("invalid EndOffset (%d; expected 0 or Offset <= EndOffset)", m.EndOffset)
+	} else if m.BeginModTime < 0 {
+		return NewValidationError("invalid BeginModTime (%d; expected >= 0)", m.BeginModTime)
 	}
 
 	// Block, DoNotProxy, and MetadataOnly (each type bool) require no extra validation.
@@ -60,6 +62,8 @@ func (m *ReadResponse) Validate() error {
 			return NewValidationError("unexpected Fragment with Content (%s)", m.Fragment)
 		} else if m.FragmentUrl != "" {
 			return NewValidationError("unexpected FragmentUrl with Content (%s)", m.FragmentUrl)
+		} else if m.DictionaryUrl != "" {
+			return NewValidationError("unexpected DictionaryUrl with Content (%s)", m.DictionaryUrl)
 		}
 		return nil
 	}
@@ -88,11 +92,22 @@ func (m *ReadResponse) Validate() error {
 				return ExtendContext(&ValidationError{Err: err}, "FragmentUrl")
 			}
 		}
+		if m.DictionaryUrl != "" {
+			if m.FragmentUrl == "" {
+				return NewValidationError("unexpected DictionaryUrl without FragmentUrl (%s)", m.DictionaryUrl)
+			} else if m.Fragment.CompressionDictionary == "" {
+				return NewValidationError("unexpected DictionaryUrl without Fragment CompressionDictionary (%s)", m.DictionaryUrl)
+			} else if _, err := url.Parse(m.DictionaryUrl); err != nil {
+				return ExtendContext(&ValidationError{Err: err}, "DictionaryUrl")
+			}
+		}
 	} else {
 		if m.Status == Status_OK && m.Offset != 0 {
 			return NewValidationError("unexpected Offset without Fragment or Content (%d)", m.Offset)
 		} else if m.FragmentUrl != "" {
 			return NewValidationError("unexpected FragmentUrl without Fragment (%s)", m.FragmentUrl)
+		} else if m.DictionaryUrl != "" {
+			return NewValidationError("unexpected DictionaryUrl without Fragment (%s)", m.DictionaryUrl)
 		}
 	}
 	return nil
@@ -305,6 +320,25 @@ func (m *ListResponse_Journal) Validate() error {
 	return nil
 }
 
+func (m *WatchResponse) Validate() error {
+	if err := m.Status.Validate(); err != nil {
+		return ExtendContext(err, "Status")
+	} else if err = m.Header.Validate(); err != nil {
+		return ExtendContext(err, "Header")
+	}
+	for i, j := range m.Upserts {
+		if err := j.Validate(); err != nil {
+			return ExtendContext(err, "Upserts[%d]", i)
+		}
+	}
+	for i, j := range m.Deletes {
+		if err := j.Validate(); err != nil {
+			return ExtendContext(err, "Deletes[%d]", i)
+		}
+	}
+	return nil
+}
+
 func (m *ApplyRequest) Validate() error {
 	for i, u := range m.Changes {
 		if err := u.Validate(); err != nil {
@@ -344,6 +378,58 @@ func (m *ApplyResponse) Validate() error {
 	return nil
 }
 
+// Validate returns an error if the HistoryRequest is not well-formed.
+func (m *HistoryRequest) Validate() error {
+	if m.Journal != "" {
+		if err := m.Journal.Validate(); err != nil {
+			return ExtendContext(err, "Journal")
+		}
+	}
+	if m.PageLimit < 0 || m.PageLimit > 10000 {
+		return NewValidationError("invalid PageLimit (%v; must be >= 0 and <= 10000)", m.PageLimit)
+	}
+	return nil
+}
+
+// Validate returns an error if the HistoryResponse is not well-formed.
+func (m *HistoryResponse) Validate() error {
+	if err := m.Status.Validate(); err != nil {
+		return ExtendContext(err, "Status")
+	} else if err = m.Header.Validate(); err != nil {
+		return ExtendContext(err, "Header")
+	}
+	for i, r := range m.Revisions {
+		if err := r.Validate(); err != nil {
+			return ExtendContext(err, "Revisions[%d]", i)
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the JournalSpecRevision is not well-formed.
+func (m *JournalSpecRevision) Validate() error {
+	if err := m.Journal.Validate(); err != nil {
+		return ExtendContext(err, "Journal")
+	} else if m.Revision <= 0 {
+		return NewValidationError("invalid Revision (%d; expected > 0)", m.Revision)
+	} else if m.BeforeRevision < 0 {
+		return NewValidationError("invalid BeforeRevision (%d; expected >= 0)", m.BeforeRevision)
+	}
+	for _, spec := range []struct {
+		field string
+		spec  *JournalSpec
+	}{{"Before", m.Before}, {"After", m.After}} {
+		if spec.spec == nil {
+			continue
+		} else if err := spec.spec.Validate(); err != nil {
+			return ExtendContext(err, spec.field)
+		} else if spec.spec.Name != m.Journal {
+			return NewValidationError("%s.Name doesn't match Journal (%s vs %s)", spec.field, spec.spec.Name, m.Journal)
+		}
+	}
+	return nil
+}
+
 func (m *FragmentsRequest) Validate() error {
 	if m.Header != nil {
 		if err := m.Header.Validate(); err != nil {
@@ -390,6 +476,154 @@ func (m *FragmentsResponse__Fragment) Validate() error {
 	return nil
 }
 
+// Validate returns an error if the TruncateRequest is not well-formed.
+func (m *TruncateRequest) Validate() error {
+	if m.Header != nil {
+		if err := m.Header.Validate(); err != nil {
+			return ExtendContext(err, "Header")
+		}
+	}
+	if err := m.Journal.Validate(); err != nil {
+		return ExtendContext(err, "Journal")
+	} else if m.Offset < 0 {
+		return NewValidationError("invalid Offset (%d; expected >= 0)", m.Offset)
+	}
+	return nil
+}
+
+// Validate returns an error if the TruncateResponse is not well-formed.
+func (m *TruncateResponse) Validate() error {
+	if err := m.Status.Validate(); err != nil {
+		return ExtendContext(err, "Status")
+	} else if err = m.Header.Validate(); err != nil {
+		return ExtendContext(err, "Header")
+	} else if m.Offset < 0 {
+		return NewValidationError("invalid Offset (%d; expected >= 0)", m.Offset)
+	}
+	return nil
+}
+
+// Validate returns an error if the StatRequest is not well-formed.
+func (m *StatRequest) Validate() error {
+	if m.Header != nil {
+		if err := m.Header.Validate(); err != nil {
+			return ExtendContext(err, "Header")
+		}
+	}
+	if err := m.Journal.Validate(); err != nil {
+		return ExtendContext(err, "Journal")
+	}
+	return nil
+}
+
+// Validate returns an error if the StatResponse is not well-formed.
+func (m *StatResponse) Validate() error {
+	if err := m.Status.Validate(); err != nil {
+		return ExtendContext(err, "Status")
+	} else if err = m.Header.Validate(); err != nil {
+		return ExtendContext(err, "Header")
+	} else if m.WriteHead < 0 {
+		return NewValidationError("invalid WriteHead (%d; expected >= 0)", m.WriteHead)
+	}
+	for i, r := range m.Replicas {
+		if err := r.Id.Validate(); err != nil {
+			return ExtendContext(err, "Replicas[%d].Id", i)
+		}
+	}
+	if m.SpooledBytes < 0 {
+		return NewValidationError("invalid SpooledBytes (%d; expected >= 0)", m.SpooledBytes)
+	} else if m.AppendRate < 0 {
+		return NewValidationError("invalid AppendRate (%d; expected >= 0)", m.AppendRate)
+	} else if m.PersistBacklogFragments < 0 {
+		return NewValidationError("invalid PersistBacklogFragments (%d; expected >= 0)", m.PersistBacklogFragments)
+	} else if m.PersistBacklogBytes < 0 {
+		return NewValidationError("invalid PersistBacklogBytes (%d; expected >= 0)", m.PersistBacklogBytes)
+	}
+	if m.Spec != nil {
+		if err := m.Spec.Validate(); err != nil {
+			return ExtendContext(err, "Spec")
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the ListSchemasRequest is not well-formed.
+func (m *ListSchemasRequest) Validate() error {
+	for i, name := range m.Names {
+		if err := ValidateSchemaName(name); err != nil {
+			return ExtendContext(err, "Names[%d]", i)
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the ListSchemasResponse is not well-formed.
+func (m *ListSchemasResponse) Validate() error {
+	if err := m.Status.Validate(); err != nil {
+		return ExtendContext(err, "Status")
+	} else if err = m.Header.Validate(); err != nil {
+		return ExtendContext(err, "Header")
+	}
+	for i, s := range m.Schemas {
+		if err := s.Validate(); err != nil {
+			return ExtendContext(err, "Schemas[%d]", i)
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the ListSchemasResponse_Schema is not well-formed.
+func (m *ListSchemasResponse_Schema) Validate() error {
+	if err := m.Spec.Validate(); err != nil {
+		return ExtendContext(err, "Spec")
+	} else if m.ModRevision <= 0 {
+		return NewValidationError("invalid ModRevision (%d; expected > 0)", m.ModRevision)
+	}
+	return nil
+}
+
+// Validate returns an error if the ApplySchemasRequest is not well-formed.
+func (m *ApplySchemasRequest) Validate() error {
+	for i, u := range m.Changes {
+		if err := u.Validate(); err != nil {
+			return ExtendContext(err, "Changes[%d]", i)
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the ApplySchemasRequest_Change is not well-formed.
+func (m *ApplySchemasRequest_Change) Validate() error {
+	if m.Upsert != nil {
+		if m.Delete != "" {
+			return NewValidationError("both Upsert and Delete are set (expected exactly one)")
+		} else if err := m.Upsert.Validate(); err != nil {
+			return ExtendContext(err, "Upsert")
+		} else if m.ExpectModRevision < 0 && (m.ExpectModRevision != -1) {
+			return NewValidationError("invalid ExpectModRevision (%d; expected >= 0 or -1)", m.ExpectModRevision)
+		}
+	} else if m.Delete != "" {
+		if err := ValidateSchemaName(m.Delete); err != nil {
+			return ExtendContext(err, "Delete")
+		} else if m.ExpectModRevision <= 0 && (m.ExpectModRevision != -1) {
+			return NewValidationError("invalid ExpectModRevision (%d; expected > 0 or -1)", m.ExpectModRevision)
+		}
+	} else {
+		return NewValidationError("neither Upsert nor Delete are set (expected exactly one)")
+	}
+	return nil
+}
+
+// Validate returns an error if the ApplySchemasResponse is not well-formed.
+func (m *ApplySchemasResponse) Validate() error {
+	if err := m.Status.Validate(); err != nil {
+		return ExtendContext(err, "Status")
+	} else if err = m.Header.Validate(); err != nil {
+		return ExtendContext(err, "Header")
+	}
+	return nil
+}
+
 func (x Status) Validate() error {
 	if _, ok := Status_name[int32(x)]; !ok {
 		return NewValidationError("invalid status (%s)", x)
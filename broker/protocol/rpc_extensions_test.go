@@ -37,6 +37,11 @@ func (s *RPCSuite) TestReadRequestValidation(c *gc.C) {
 	req.EndOffset = 0
 	c.Check(req.Validate(), gc.IsNil)
 
+	req.BeginModTime = -1
+	c.Check(req.Validate(), gc.ErrorMatches, `invalid BeginModTime \(-1; expected >= 0\)`)
+	req.BeginModTime = 1234
+	c.Check(req.Validate(), gc.IsNil)
+
 	// Block, DoNotProxy, and MetadataOnly have no validation.
 }
 
@@ -73,6 +78,20 @@ func (s *RPCSuite) TestReadResponseValidationCases(c *gc.C) {
 
 	c.Check(resp.Validate(), gc.IsNil) // Success.
 
+	resp.DictionaryUrl = ":/bad/url"
+	c.Check(resp.Validate(), gc.ErrorMatches, `unexpected DictionaryUrl without Fragment CompressionDictionary \(:/bad/url\)`)
+	frag.CompressionCodec = CompressionCodec_ZSTANDARD_DICT
+	frag.CompressionDictionary = "0102030405060708090a0b0c0d0e0f1011121314"
+
+	c.Check(resp.Validate(), gc.ErrorMatches, `DictionaryUrl: parse ":/bad/url": missing protocol scheme`)
+	resp.DictionaryUrl = "http://bar"
+
+	c.Check(resp.Validate(), gc.IsNil) // Success.
+
+	resp.FragmentUrl = ""
+	c.Check(resp.Validate(), gc.ErrorMatches, `unexpected DictionaryUrl without FragmentUrl \(http://bar\)`)
+	resp.FragmentUrl = "http://foo"
+
 	// Remove Fragment.
 	resp.Fragment = nil
 	resp.WriteHead = -1
@@ -86,6 +105,9 @@ func (s *RPCSuite) TestReadResponseValidationCases(c *gc.C) {
 	c.Check(resp.Validate(), gc.ErrorMatches, `unexpected FragmentUrl without Fragment \(http://foo\)`)
 	resp.FragmentUrl = ""
 
+	c.Check(resp.Validate(), gc.ErrorMatches, `unexpected DictionaryUrl without Fragment \(http://bar\)`)
+	resp.DictionaryUrl = ""
+
 	c.Check(resp.Validate(), gc.IsNil) // Success.
 
 	// Set Content.
@@ -110,6 +132,10 @@ func (s *RPCSuite) TestReadResponseValidationCases(c *gc.C) {
 	c.Check(resp.Validate(), gc.ErrorMatches, `unexpected FragmentUrl with Content \(http://foo\)`)
 	resp.FragmentUrl = ""
 
+	resp.DictionaryUrl = "http://bar"
+	c.Check(resp.Validate(), gc.ErrorMatches, `unexpected DictionaryUrl with Content \(http://bar\)`)
+	resp.DictionaryUrl = ""
+
 	c.Check(resp.Validate(), gc.IsNil)
 }
 
@@ -345,6 +371,41 @@ func (s *RPCSuite) TestListResponseValidationCases(c *gc.C) {
 	c.Check(resp.Validate(), gc.IsNil)
 }
 
+func (s *RPCSuite) TestWatchResponseValidationCases(c *gc.C) {
+	var resp = WatchResponse{
+		Status: 9101,
+		Header: *badHeaderFixture(),
+		Upserts: []ListResponse_Journal{
+			{
+				ModRevision: 0,
+				Spec: JournalSpec{
+					Name:        "a/journal",
+					Replication: 1,
+					Fragment: JournalSpec_Fragment{
+						Length:           1024,
+						CompressionCodec: CompressionCodec_NONE,
+						RefreshInterval:  time.Minute,
+						Retention:        time.Hour,
+					},
+				},
+				Route: Route{Primary: -1},
+			},
+		},
+		Deletes: []Journal{"another/journal", "invalid journal"},
+	}
+
+	c.Check(resp.Validate(), gc.ErrorMatches, `Status: invalid status \(9101\)`)
+	resp.Status = Status_OK
+	c.Check(resp.Validate(), gc.ErrorMatches, `Header.Etcd: invalid ClusterId .*`)
+	resp.Header.Etcd.ClusterId = 1234
+	c.Check(resp.Validate(), gc.ErrorMatches, `Upserts\[0\]: invalid ModRevision \(0; expected > 0\)`)
+	resp.Upserts[0].ModRevision = 1
+	c.Check(resp.Validate(), gc.ErrorMatches, `Deletes\[1\]: not a valid token \(.*\)`)
+	resp.Deletes[1] = "a/journal"
+
+	c.Check(resp.Validate(), gc.IsNil)
+}
+
 func (s *RPCSuite) TestApplyRequestValidationCases(c *gc.C) {
 	var req = ApplyRequest{
 		Changes: []ApplyRequest_Change{
@@ -403,6 +464,66 @@ func (s *RPCSuite) TestApplyResponseValidationCases(c *gc.C) {
 	c.Check(resp.Validate(), gc.IsNil)
 }
 
+func (s *RPCSuite) TestHistoryRequestValidationCases(c *gc.C) {
+	var req = HistoryRequest{Journal: "/bad"}
+
+	c.Check(req.Validate(), gc.ErrorMatches, `Journal: cannot begin with '/' \(/bad\)`)
+	req.Journal = "good"
+	c.Check(req.Validate(), gc.IsNil)
+	req.Journal = "" // All journals.
+	c.Check(req.Validate(), gc.IsNil)
+	req.PageLimit = 10001
+	c.Check(req.Validate(), gc.ErrorMatches, `invalid PageLimit \(10001; must be >= 0 and <= 10000\)`)
+}
+
+func (s *RPCSuite) TestHistoryResponseValidationCases(c *gc.C) {
+	var spec = JournalSpec{
+		Name:        "a/journal",
+		Replication: 1,
+		Fragment: JournalSpec_Fragment{
+			Length:           1024,
+			CompressionCodec: CompressionCodec_NONE,
+			RefreshInterval:  time.Minute,
+			Retention:        time.Hour,
+		},
+	}
+	var resp = HistoryResponse{
+		Status: 9101,
+		Header: *badHeaderFixture(),
+		Revisions: []JournalSpecRevision{
+			{Journal: "a/journal", Revision: 10, After: &spec},
+			{
+				Journal:        "/bad",
+				Revision:       0,
+				Before:         &JournalSpec{Name: "a/journal"},
+				BeforeRevision: -1,
+				After:          &JournalSpec{Name: "other/journal"},
+			},
+		},
+	}
+
+	c.Check(resp.Validate(), gc.ErrorMatches, `Status: invalid status \(9101\)`)
+	resp.Status = Status_OK
+	c.Check(resp.Validate(), gc.ErrorMatches, `Header.Etcd: invalid ClusterId .*`)
+	resp.Header.Etcd.ClusterId = 1234
+	c.Check(resp.Validate(), gc.ErrorMatches, `Revisions\[1\].Journal: cannot begin with '/' \(/bad\)`)
+	resp.Revisions[1].Journal = "a/journal"
+	c.Check(resp.Validate(), gc.ErrorMatches, `Revisions\[1\]: invalid Revision \(0; expected > 0\)`)
+	resp.Revisions[1].Revision = 12
+	c.Check(resp.Validate(), gc.ErrorMatches, `Revisions\[1\]: invalid BeforeRevision \(-1; expected >= 0\)`)
+	resp.Revisions[1].BeforeRevision = 10
+	c.Check(resp.Validate(), gc.ErrorMatches, `Revisions\[1\].Before: invalid Replication .*`)
+	resp.Revisions[1].Before = &spec
+	c.Check(resp.Validate(), gc.ErrorMatches, `Revisions\[1\].After: invalid Replication .*`)
+	var other = spec
+	other.Name = "other/journal"
+	resp.Revisions[1].After = &other
+	c.Check(resp.Validate(), gc.ErrorMatches, `Revisions\[1\]: After.Name doesn't match Journal \(other/journal vs a/journal\)`)
+	resp.Revisions[1].After = nil // Deletion.
+
+	c.Check(resp.Validate(), gc.IsNil)
+}
+
 func (s *RPCSuite) TestFragmentsRequestValidationCases(c *gc.C) {
 	var signatureTTL time.Duration
 
@@ -457,6 +578,164 @@ func (s *RPCSuite) TestFragmentsResponseValidationCases(c *gc.C) {
 	c.Check(resp.Validate(), gc.IsNil)
 }
 
+func (s *RPCSuite) TestTruncateRequestValidationCases(c *gc.C) {
+	var req = TruncateRequest{
+		Header:  badHeaderFixture(),
+		Journal: "/bad",
+		Offset:  -1,
+	}
+
+	c.Check(req.Validate(), gc.ErrorMatches, `Header.Etcd: invalid ClusterId .*`)
+	req.Header.Etcd.ClusterId = 12
+	c.Check(req.Validate(), gc.ErrorMatches, `Journal: cannot begin with '/' \(/bad\)`)
+	req.Journal = "good"
+	c.Check(req.Validate(), gc.ErrorMatches, `invalid Offset \(-1; expected >= 0\)`)
+	req.Offset = 1234
+
+	c.Check(req.Validate(), gc.IsNil)
+}
+
+func (s *RPCSuite) TestTruncateResponseValidationCases(c *gc.C) {
+	var resp = TruncateResponse{
+		Status: 9101,
+		Header: *badHeaderFixture(),
+		Offset: -1,
+	}
+
+	c.Check(resp.Validate(), gc.ErrorMatches, `Status: invalid status \(9101\)`)
+	resp.Status = Status_OK
+	c.Check(resp.Validate(), gc.ErrorMatches, `Header.Etcd: invalid ClusterId .*`)
+	resp.Header.Etcd.ClusterId = 1234
+	c.Check(resp.Validate(), gc.ErrorMatches, `invalid Offset \(-1; expected >= 0\)`)
+	resp.Offset = 1234
+
+	c.Check(resp.Validate(), gc.IsNil)
+}
+
+func (s *RPCSuite) TestStatRequestValidationCases(c *gc.C) {
+	var req = StatRequest{
+		Header:  badHeaderFixture(),
+		Journal: "/bad",
+	}
+
+	c.Check(req.Validate(), gc.ErrorMatches, `Header.Etcd: invalid ClusterId .*`)
+	req.Header.Etcd.ClusterId = 12
+	c.Check(req.Validate(), gc.ErrorMatches, `Journal: cannot begin with '/' \(/bad\)`)
+	req.Journal = "good"
+
+	c.Check(req.Validate(), gc.IsNil)
+}
+
+func (s *RPCSuite) TestStatResponseValidationCases(c *gc.C) {
+	var resp = StatResponse{
+		Status:    9101,
+		Header:    *badHeaderFixture(),
+		WriteHead: -1,
+		Replicas: []StatResponse_Replica{
+			{Id: ProcessSpec_ID{Zone: "zone", Suffix: "primary"}, InSync: true},
+			{Id: ProcessSpec_ID{Zone: "zone", Suffix: "bad suffix"}},
+		},
+		SpooledBytes:            -1,
+		AppendRate:              -1,
+		PersistBacklogFragments: -1,
+		PersistBacklogBytes:     -1,
+	}
+
+	c.Check(resp.Validate(), gc.ErrorMatches, `Status: invalid status \(9101\)`)
+	resp.Status = Status_OK
+	c.Check(resp.Validate(), gc.ErrorMatches, `Header.Etcd: invalid ClusterId .*`)
+	resp.Header.Etcd.ClusterId = 1234
+	c.Check(resp.Validate(), gc.ErrorMatches, `invalid WriteHead \(-1; expected >= 0\)`)
+	resp.WriteHead = 1234
+	c.Check(resp.Validate(), gc.ErrorMatches, `Replicas\[1\].Id.Suffix: not a valid token \(bad suffix\)`)
+	resp.Replicas[1].Id.Suffix = "replica"
+	c.Check(resp.Validate(), gc.ErrorMatches, `invalid SpooledBytes \(-1; expected >= 0\)`)
+	resp.SpooledBytes = 12
+	c.Check(resp.Validate(), gc.ErrorMatches, `invalid AppendRate \(-1; expected >= 0\)`)
+	resp.AppendRate = 34
+	c.Check(resp.Validate(), gc.ErrorMatches, `invalid PersistBacklogFragments \(-1; expected >= 0\)`)
+	resp.PersistBacklogFragments = 1
+	c.Check(resp.Validate(), gc.ErrorMatches, `invalid PersistBacklogBytes \(-1; expected >= 0\)`)
+	resp.PersistBacklogBytes = 56
+
+	resp.Spec = &JournalSpec{Name: "a/journal"}
+	c.Check(resp.Validate(), gc.ErrorMatches, `Spec: invalid Replication \(0; .*`)
+	resp.Spec = nil
+
+	c.Check(resp.Validate(), gc.IsNil)
+}
+
+func (s *RPCSuite) TestListSchemasValidationCases(c *gc.C) {
+	var req = ListSchemasRequest{Names: []string{"a/schema", "bad name"}}
+	c.Check(req.Validate(), gc.ErrorMatches, `Names\[1\]: not a valid token \(bad name\)`)
+	req.Names[1] = "other.Schema"
+	c.Check(req.Validate(), gc.IsNil)
+
+	var resp = ListSchemasResponse{
+		Status: 9101,
+		Header: *badHeaderFixture(),
+		Schemas: []ListSchemasResponse_Schema{
+			{Spec: SchemaSpec{Name: "a/schema", Type: SchemaSpec_JSON_SCHEMA}},
+		},
+	}
+	c.Check(resp.Validate(), gc.ErrorMatches, `Status: invalid status \(9101\)`)
+	resp.Status = Status_OK
+	c.Check(resp.Validate(), gc.ErrorMatches, `Header.Etcd: invalid ClusterId .*`)
+	resp.Header.Etcd.ClusterId = 1234
+	c.Check(resp.Validate(), gc.ErrorMatches, `Schemas\[0\].Spec: expected Content`)
+	resp.Schemas[0].Spec.Content = []byte(`{}`)
+	c.Check(resp.Validate(), gc.ErrorMatches, `Schemas\[0\]: invalid ModRevision \(0; expected > 0\)`)
+	resp.Schemas[0].ModRevision = 1
+
+	c.Check(resp.Validate(), gc.IsNil)
+}
+
+func (s *RPCSuite) TestApplySchemasValidationCases(c *gc.C) {
+	var req = ApplySchemasRequest{
+		Changes: []ApplySchemasRequest_Change{
+			{
+				ExpectModRevision: -2,
+				Upsert:            &SchemaSpec{Name: "a/schema"},
+				Delete:            "a/schema",
+			},
+			{
+				ExpectModRevision: 0,
+				Delete:            "a/schema invalid name",
+			},
+			{
+				ExpectModRevision: 1,
+			},
+		},
+	}
+
+	c.Check(req.Validate(), gc.ErrorMatches, `Changes\[0\]: both Upsert and Delete are set \(expected exactly one\)`)
+	req.Changes[0].Delete = ""
+
+	c.Check(req.Validate(), gc.ErrorMatches, `Changes\[0\].Upsert.Type: invalid type \(INVALID_TYPE\)`)
+	req.Changes[0].Upsert = &SchemaSpec{Name: "a/schema", Type: SchemaSpec_JSON_SCHEMA, Content: []byte(`{}`)}
+	c.Check(req.Validate(), gc.ErrorMatches, `Changes\[0\]: invalid ExpectModRevision \(-2; expected >= 0 or -1\)`)
+	req.Changes[0].ExpectModRevision = 0
+	c.Check(req.Validate(), gc.ErrorMatches, `Changes\[1\].Delete: not a valid token \(.*\)`)
+	req.Changes[1].Delete = "a/schema"
+	c.Check(req.Validate(), gc.ErrorMatches, `Changes\[1\]: invalid ExpectModRevision \(0; expected > 0 or -1\)`)
+	req.Changes[1].ExpectModRevision = 1
+	c.Check(req.Validate(), gc.ErrorMatches, `Changes\[2\]: neither Upsert nor Delete are set \(expected exactly one\)`)
+	req.Changes[2].Delete = "other/schema"
+
+	c.Check(req.Validate(), gc.IsNil)
+
+	var resp = ApplySchemasResponse{
+		Status: 9101,
+		Header: *badHeaderFixture(),
+	}
+	c.Check(resp.Validate(), gc.ErrorMatches, `Status: invalid status \(9101\)`)
+	resp.Status = Status_INCOMPATIBLE_SCHEMA
+	c.Check(resp.Validate(), gc.ErrorMatches, `Header.Etcd: invalid ClusterId .*`)
+	resp.Header.Etcd.ClusterId = 1234
+
+	c.Check(resp.Validate(), gc.IsNil)
+}
+
 func badHeaderFixture() *Header {
 	return &Header{
 		ProcessId: ProcessSpec_ID{Zone: "zone", Suffix: "name"},
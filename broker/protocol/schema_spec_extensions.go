@@ -0,0 +1,112 @@
+package protocol
+
+import (
+	"fmt"
+)
+
+// ValidateSchemaName returns an error if |name| is not a valid SchemaSpec
+// name. Schema names are label values (of the journal message-type label).
+func ValidateSchemaName(name string) error {
+	return ValidateToken(name, pathSymbols, 1, maxLabelValueLen)
+}
+
+// Validate returns an error if the SchemaSpec is not well-formed. It doesn't
+// verify that the schema Content is well-formed with respect to its Type,
+// which is the responsibility of package broker/schema.
+func (m *SchemaSpec) Validate() error {
+	if err := ValidateSchemaName(m.Name); err != nil {
+		return ExtendContext(err, "Name")
+	} else if err = m.Type.Validate(); err != nil {
+		return ExtendContext(err, "Type")
+	} else if len(m.Content) == 0 {
+		return NewValidationError("expected Content")
+	} else if err = m.Compatibility.Validate(); err != nil {
+		return ExtendContext(err, "Compatibility")
+	}
+
+	if m.Type == SchemaSpec_PROTOBUF {
+		if err := ValidateToken(m.Message, TokenSymbols, 1, maxLabelValueLen); err != nil {
+			return ExtendContext(err, "Message")
+		}
+	} else if m.Message != "" {
+		return NewValidationError("unexpected Message (%s; expected none with Type %s)", m.Message, m.Type)
+	}
+	return nil
+}
+
+// MarshalString returns the marshaled encoding of the SchemaSpec as a string.
+func (m *SchemaSpec) MarshalString() string {
+	var d, err = m.Marshal()
+	if err != nil {
+		panic(err.Error()) // Cannot happen, as we use no custom marshalling.
+	}
+	return string(d)
+}
+
+// Validate returns an error if the SchemaSpec_Type is not a valid, non-zero type.
+func (x SchemaSpec_Type) Validate() error {
+	if _, ok := SchemaSpec_Type_name[int32(x)]; !ok || x == SchemaSpec_INVALID_TYPE {
+		return NewValidationError("invalid type (%s)", x)
+	}
+	return nil
+}
+
+// MarshalYAML maps the SchemaSpec_Type to its enum name.
+func (x SchemaSpec_Type) MarshalYAML() (interface{}, error) {
+	return x.String(), nil
+}
+
+// UnmarshalYAML maps a YAML string to the Type of corresponding enum name.
+func (x *SchemaSpec_Type) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if tag, ok := SchemaSpec_Type_value[str]; !ok {
+		return fmt.Errorf("%q is not a valid SchemaSpec_Type (options are %v)", str, SchemaSpec_Type_value)
+	} else {
+		*x = SchemaSpec_Type(tag)
+		return nil
+	}
+}
+
+// Validate returns an error if the SchemaSpec_Compatibility is not valid.
+func (x SchemaSpec_Compatibility) Validate() error {
+	if _, ok := SchemaSpec_Compatibility_name[int32(x)]; !ok {
+		return NewValidationError("invalid compatibility (%s)", x)
+	}
+	return nil
+}
+
+// Backward returns whether the Compatibility requires that an update be
+// able to read messages of the prior schema.
+func (x SchemaSpec_Compatibility) Backward() bool {
+	return x == SchemaSpec_BACKWARD || x == SchemaSpec_FULL
+}
+
+// Forward returns whether the Compatibility requires that the prior schema
+// be able to read messages of an update.
+func (x SchemaSpec_Compatibility) Forward() bool {
+	return x == SchemaSpec_FORWARD || x == SchemaSpec_FULL
+}
+
+// MarshalYAML maps the SchemaSpec_Compatibility to its enum name.
+func (x SchemaSpec_Compatibility) MarshalYAML() (interface{}, error) {
+	return x.String(), nil
+}
+
+// UnmarshalYAML maps a YAML string to the Compatibility of corresponding enum name.
+func (x *SchemaSpec_Compatibility) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if tag, ok := SchemaSpec_Compatibility_value[str]; !ok {
+		return fmt.Errorf("%q is not a valid SchemaSpec_Compatibility (options are %v)", str, SchemaSpec_Compatibility_value)
+	} else {
+		*x = SchemaSpec_Compatibility(tag)
+		return nil
+	}
+}
@@ -0,0 +1,70 @@
+package protocol
+
+import (
+	gc "gopkg.in/check.v1"
+	"gopkg.in/yaml.v2"
+)
+
+type SchemaSpecSuite struct{}
+
+func (s *SchemaSpecSuite) TestValidationCases(c *gc.C) {
+	var spec = SchemaSpec{
+		Name:          "bad name",
+		Type:          SchemaSpec_INVALID_TYPE,
+		Message:       "acme.Event",
+		Compatibility: 9999,
+	}
+	c.Check(spec.Validate(), gc.ErrorMatches, `Name: not a valid token \(bad name\)`)
+	spec.Name = "acme/Event"
+	c.Check(spec.Validate(), gc.ErrorMatches, `Type: invalid type \(INVALID_TYPE\)`)
+	spec.Type = SchemaSpec_JSON_SCHEMA
+	c.Check(spec.Validate(), gc.ErrorMatches, `expected Content`)
+	spec.Content = []byte(`{"type": "object"}`)
+	c.Check(spec.Validate(), gc.ErrorMatches, `Compatibility: invalid compatibility \(9999\)`)
+	spec.Compatibility = SchemaSpec_BACKWARD
+	c.Check(spec.Validate(), gc.ErrorMatches, `unexpected Message \(acme.Event; expected none with Type JSON_SCHEMA\)`)
+	spec.Message = ""
+	c.Check(spec.Validate(), gc.IsNil)
+
+	// PROTOBUF schemas must name their message type.
+	spec.Type = SchemaSpec_PROTOBUF
+	c.Check(spec.Validate(), gc.ErrorMatches, `Message: invalid length \(0; expected 1 <= .*`)
+	spec.Message = "acme.Event"
+	c.Check(spec.Validate(), gc.IsNil)
+}
+
+func (s *SchemaSpecSuite) TestCompatibility(c *gc.C) {
+	for _, tc := range []struct {
+		c                 SchemaSpec_Compatibility
+		backward, forward bool
+	}{
+		{SchemaSpec_NONE, false, false},
+		{SchemaSpec_BACKWARD, true, false},
+		{SchemaSpec_FORWARD, false, true},
+		{SchemaSpec_FULL, true, true},
+	} {
+		c.Check(tc.c.Backward(), gc.Equals, tc.backward)
+		c.Check(tc.c.Forward(), gc.Equals, tc.forward)
+	}
+}
+
+func (s *SchemaSpecSuite) TestYAMLRoundTrip(c *gc.C) {
+	var fixture = struct {
+		Type          SchemaSpec_Type
+		Compatibility SchemaSpec_Compatibility
+	}{SchemaSpec_PROTOBUF, SchemaSpec_FORWARD}
+
+	var b, err = yaml.Marshal(fixture)
+	c.Check(err, gc.IsNil)
+	c.Check(string(b), gc.Equals, "type: PROTOBUF\ncompatibility: FORWARD\n")
+
+	var out = fixture
+	out.Type, out.Compatibility = 0, 0
+	c.Check(yaml.UnmarshalStrict(b, &out), gc.IsNil)
+	c.Check(out, gc.DeepEquals, fixture)
+
+	c.Check(yaml.UnmarshalStrict([]byte("type: OTHER\n"), &out), gc.ErrorMatches,
+		`"OTHER" is not a valid SchemaSpec_Type .*`)
+}
+
+var _ = gc.Suite(&SchemaSpecSuite{})
@@ -33,27 +33,42 @@ func (svc *Service) Read(req *pb.ReadRequest, stream pb.Journal_ReadServer) (err
 		}
 	}()
 
+	var claims pb.Claims
 	if err = req.Validate(); err != nil {
 		return err
+	} else if claims, err = svc.verify(stream.Context()); err != nil {
+		return err
 	}
 
-	resolved, err = svc.resolver.resolve(resolveArgs{
+	// A read of a suspended journal resumes it, unless it's a blocking read
+	// at or beyond the journal's suspension offset. Such a read is awaiting
+	// future appends, and it waits for an Append to resume the journal.
+	resolved, err = svc.resolveOrResume(resolveArgs{
 		ctx:            stream.Context(),
 		journal:        req.Journal,
 		mayProxy:       !req.DoNotProxy,
 		requirePrimary: false,
 		proxyHeader:    req.Header,
+		claims:         claims,
+		require:        pb.Capability_READ,
+	}, func(spec *pb.JournalSpec) bool {
+		return !req.Block || (req.Offset != -1 && req.Offset < spec.Suspend.Offset)
 	})
 
 	if err != nil {
 		return err
-	} else if resolved.status != pb.Status_OK {
+	} else if redirectOutOfSyncRead(resolved, !req.DoNotProxy); resolved.status != pb.Status_OK {
 		return stream.Send(&pb.ReadResponse{Status: resolved.status, Header: &resolved.Header})
 	} else if !resolved.journalSpec.Flags.MayRead() {
 		return stream.Send(&pb.ReadResponse{Status: pb.Status_NOT_ALLOWED, Header: &resolved.Header})
 	} else if resolved.ProcessId != resolved.localID {
 		req.Header = &resolved.Header // Attach resolved Header to |req|, which we'll forward.
-		return proxyRead(stream, req, svc.jc, svc.stopProxyReadsCh)
+
+		var ctx context.Context
+		if ctx, err = svc.authorize(stream.Context(), claims); err != nil {
+			return err
+		}
+		return proxyRead(ctx, stream, req, svc.jc, svc.stopProxyReadsCh)
 	}
 
 	err = serveRead(stream, req, &resolved.Header, resolved.replica.index)
@@ -71,9 +86,28 @@ func (svc *Service) Read(req *pb.ReadRequest, stream pb.Journal_ReadServer) (err
 	return err
 }
 
+// redirectOutOfSyncRead updates an OK resolution of a Read which would be
+// served by a local replica that's out of sync with its quorum pipeline, as
+// the replica may be missing committed content. The Read is instead proxied
+// to the primary or, if it may not be proxied, fails with NOT_JOURNAL_BROKER.
+func redirectOutOfSyncRead(res *resolution, mayProxy bool) {
+	if res.status != pb.Status_OK ||
+		res.ProcessId != res.localID ||
+		res.replica == nil ||
+		!res.replica.isOutOfSync() ||
+		res.Route.Primary == -1 {
+		return
+	} else if mayProxy {
+		res.ProcessId = res.Route.Members[res.Route.Primary]
+	} else {
+		res.status = pb.Status_NOT_JOURNAL_BROKER
+	}
+}
+
 // proxyRead forwards a ReadRequest to a resolved peer broker.
-func proxyRead(stream grpc.ServerStream, req *pb.ReadRequest, jc pb.JournalClient, stopCh <-chan struct{}) error {
-	var ctx = pb.WithDispatchRoute(stream.Context(), req.Header.Route, req.Header.ProcessId)
+// |ctx| is derived from the |stream| Context, and authorizes the peer RPC.
+func proxyRead(ctx context.Context, stream grpc.ServerStream, req *pb.ReadRequest, jc pb.JournalClient, stopCh <-chan struct{}) error {
+	ctx = pb.WithDispatchRoute(ctx, req.Header.Route, req.Header.ProcessId)
 
 	// We use the |stream| context for this RPC, which means a cancellation from
 	// our client automatically propagates to the proxy |client| stream.
@@ -161,9 +195,10 @@ func serveRead(stream grpc.ServerStream, req *pb.ReadRequest, hdr *pb.Header, in
 			reader = ioutil.NopCloser(io.NewSectionReader(
 				file, req.Offset-resp.Fragment.Begin, resp.Fragment.End-req.Offset))
 		} else {
-			if reader, err = fragment.Open(stream.Context(), *resp.Fragment); err != nil {
+			var begin int64
+			if reader, begin, err = fragment.OpenAt(stream.Context(), *resp.Fragment, req.Offset); err != nil {
 				return err
-			} else if reader, err = client.NewFragmentReader(reader, *resp.Fragment, req.Offset); err != nil {
+			} else if reader, err = client.NewFragmentReaderAt(reader, *resp.Fragment, begin, req.Offset); err != nil {
 				return err
 			}
 		}
@@ -3,6 +3,8 @@ package broker
 import (
 	"context"
 	"io"
+	"math"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -32,6 +34,14 @@ type replica struct {
 	// by an Append RPC which is in appendFSM stateStreamContent (and there can be
 	// at most one such RPC).
 	appendFlowControl appendFlowControl
+	// appendRate tracks the recent rate of content appended to the journal.
+	appendRate appendRate
+	// pulseCh is signaled to request a prompt pulse of the journal.
+	pulseCh chan struct{}
+	// outOfSync is non-zero if the replica was dropped from the quorum pipeline
+	// of its primary, and may be missing committed content. It's cleared when
+	// the replica is again synchronized by the primary.
+	outOfSync int32
 }
 
 func newReplica(journal pb.Journal) *replica {
@@ -44,6 +54,7 @@ func newReplica(journal pb.Journal) *replica {
 		index:      fragment.NewIndex(ctx),
 		spoolCh:    make(chan fragment.Spool, 1),
 		pipelineCh: make(chan *pipeline, 1),
+		pulseCh:    make(chan struct{}, 1),
 	}
 
 	r.spoolCh <- fragment.NewSpool(journal, struct {
@@ -56,9 +67,33 @@ func newReplica(journal pb.Journal) *replica {
 	return r
 }
 
+// requestPulse requests a prompt pulse of the replica's journal.
+func (r *replica) requestPulse() {
+	select {
+	case r.pulseCh <- struct{}{}:
+	default: // Already requested.
+	}
+}
+
+// setOutOfSync marks whether the replica may be missing committed content.
+func (r *replica) setOutOfSync(outOfSync bool) {
+	var v int32
+	if outOfSync {
+		v = 1
+	}
+	atomic.StoreInt32(&r.outOfSync, v)
+}
+
+// isOutOfSync returns whether the replica may be missing committed content.
+func (r *replica) isOutOfSync() bool { return atomic.LoadInt32(&r.outOfSync) != 0 }
+
 // fragmentRefreshDaemon periodically refreshes the local index of replica
-// fragments from configured remote stores, at configured intervals.
-func fragmentRefreshDaemon(ks *keyspace.KeySpace, r *replica) {
+// fragments from configured remote stores, at configured intervals. If the
+// journal opts into PruneOversize and the local broker is its primary, it
+// also prunes remote fragments which exceed the journal's MaxBytes or
+// MaxFragments.
+func fragmentRefreshDaemon(state *allocator.State, r *replica) {
+	var ks = state.KS
 	var timer = time.NewTimer(0) // Fires immediately.
 	defer timer.Stop()
 
@@ -70,11 +105,13 @@ func fragmentRefreshDaemon(ks *keyspace.KeySpace, r *replica) {
 		}
 
 		var spec *pb.JournalSpec
+		var primary bool
 
 		ks.Mu.RLock()
 		if item, ok := allocator.LookupItem(ks, r.journal.String()); ok {
 			spec = item.ItemValue.(*pb.JournalSpec)
 		}
+		primary = isLocalPrimary(state, r.journal)
 		ks.Mu.RUnlock()
 
 		if spec == nil {
@@ -84,6 +121,9 @@ func fragmentRefreshDaemon(ks *keyspace.KeySpace, r *replica) {
 		}
 
 		if set, err := fragment.WalkAllStores(r.ctx, spec.Name, spec.Fragment.Stores); err == nil {
+			if primary && spec.Fragment.PruneOversize {
+				set = pruneOversizeFragments(r.ctx, spec, set)
+			}
 			r.index.ReplaceRemote(set)
 		} else {
 			log.WithFields(log.Fields{
@@ -96,17 +136,68 @@ func fragmentRefreshDaemon(ks *keyspace.KeySpace, r *replica) {
 	}
 }
 
+// isLocalPrimary returns whether the local broker of the allocator.State holds
+// the primary assignment of |journal|. The KeySpace lock must be held.
+func isLocalPrimary(state *allocator.State, journal pb.Journal) bool {
+	for _, kv := range state.KS.Prefixed(allocator.ItemAssignmentsPrefix(state.KS, journal.String())) {
+		var a = kv.Decoded.(allocator.Assignment)
+
+		if a.Slot == 0 && allocator.MemberKey(state.KS, a.MemberZone, a.MemberSuffix) == state.LocalKey {
+			return true
+		}
+	}
+	return false
+}
+
+// pruneOversizeFragments removes the oldest Fragments of the remote CoverSet
+// which exceed the MaxBytes or MaxFragments of the JournalSpec from their
+// backing stores, and returns the CoverSet which remains. Fragments which
+// include content at or beyond the ReaderFloor of the JournalSpec are kept.
+func pruneOversizeFragments(ctx context.Context, spec *pb.JournalSpec, set fragment.CoverSet) fragment.CoverSet {
+	var floor int64 = math.MaxInt64
+	if spec.Fragment.ReaderFloor != 0 {
+		floor = spec.Fragment.ReaderFloor
+	}
+	for _, f := range set.Oversize(spec.Fragment.MaxBytes, spec.Fragment.MaxFragments, floor) {
+		if err := fragment.Remove(ctx, f.Fragment); err != nil {
+			log.WithFields(log.Fields{
+				"name":     spec.Name,
+				"fragment": f.ContentPath(),
+				"store":    f.BackingStore,
+				"err":      err,
+			}).Warn("failed to prune oversize fragment (will retry)")
+			break
+		}
+		log.WithFields(log.Fields{
+			"name":     spec.Name,
+			"fragment": f.ContentPath(),
+			"store":    f.BackingStore,
+			"size":     f.ContentLength(),
+		}).Info("pruned oversize fragment")
+
+		set = set[1:]
+	}
+	return set
+}
+
 // pulseDaemon performs periodic and on-demand invocations of a zero-byte
 // append FSM sequence. This action drives re-establishment of advertised
 // consistency in Etcd, and these pulses ensure that process happens even in
 // the absence of client-initiated Append RPCs. On-demand pulses are performed
 // on changes to the replica Route. Additional periodic pulses ensure problems
 // with the peer set (eg, half-broken connections) are detected proactively.
+// Pulses also re-build a quorum pipeline which has dropped peers, so that they
+// re-synchronize, and are requested as soon as a peer is dropped.
+//
+// Pulses also track the activity of the journal. If SuspendAfter is set and
+// no content has been appended to the journal in that time, its current
+// Fragment is flushed and, once persisted, the journal is suspended.
 func pulseDaemon(svc *Service, r *replica) {
 	var timer = time.NewTimer(0) // Fires immediately.
 	defer timer.Stop()
 
 	var invalidateCh <-chan struct{}
+	var lastEnd, activeAt = int64(-1), timeNow()
 	for {
 		select {
 		case _ = <-r.ctx.Done():
@@ -115,6 +206,7 @@ func pulseDaemon(svc *Service, r *replica) {
 			timer.Reset(healthCheckInterval)
 		case _ = <-invalidateCh:
 			invalidateCh = nil
+		case _ = <-r.pulseCh:
 		}
 
 		var ctx, _ = context.WithTimeout(r.ctx, healthCheckInterval)
@@ -125,8 +217,33 @@ func pulseDaemon(svc *Service, r *replica) {
 				Journal:    r.journal,
 				DoNotProxy: true,
 			},
+			resync: true,
 		}
 		if fsm.runTo(stateStreamContent) {
+			var spool = fsm.pln.spool
+			if spool.End != lastEnd {
+				lastEnd, activeAt = spool.End, timeNow()
+			}
+			var idle = SuspendAfter != 0 && timeNow().Sub(activeAt) >= SuspendAfter
+
+			if idle && spool.ContentLength() == 0 {
+				// Suspend while we hold the pipeline, so that no append may
+				// race the suspension. Errors are logged, and we retry on the
+				// next pulse.
+				if _, err := maybeSuspendJournal(ctx, svc, fsm.resolved, spool); err != nil {
+					log.WithFields(log.Fields{
+						"err":     err,
+						"journal": r.journal,
+					}).Warn("failed to suspend idle journal (will retry)")
+				}
+			} else if err := maybeClearSuspendOffset(ctx, svc, fsm.resolved, spool); err != nil {
+				log.WithFields(log.Fields{
+					"err":     err,
+					"journal": r.journal,
+				}).Warn("failed to clear suspension offset (will retry)")
+			}
+			fsm.flush = idle // Persist the idle journal's current Fragment.
+
 			fsm.onStreamContent(&pb.AppendRequest{}, nil) // Intend to commit.
 			fsm.onStreamContent(nil, io.EOF)              // Commit.
 			fsm.onReadAcknowledgements()
@@ -265,6 +382,7 @@ func maybeRollFragment(cur fragment.Spool, rollToOffset int64, spec pb.JournalSp
 		next.Begin = next.End
 		next.Sum = pb.SHA1Sum{}
 		next.CompressionCodec = spec.CompressionCodec
+		next.CompressionDictionary = spec.CompressionDictionary
 
 		return next
 	}
@@ -2,6 +2,9 @@ package broker
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -187,3 +190,82 @@ func TestReplicaNextProposalCases(t *testing.T) {
 		require.Equal(t, proposal, test.out)
 	}
 }
+
+func TestReplicaPrunesOversizeFragments(t *testing.T) {
+	var ctx, etcd = context.Background(), etcdtest.TestClient()
+	defer etcdtest.Cleanup()
+
+	var broker = newTestBroker(t, etcd, pb.ProcessSpec_ID{Zone: "local", Suffix: "broker"})
+	var peer = newTestBroker(t, etcd, pb.ProcessSpec_ID{Zone: "peer", Suffix: "broker"})
+
+	var spec = pb.JournalSpec{
+		Name:        "a/journal",
+		Replication: 2,
+		Fragment: pb.JournalSpec_Fragment{
+			Stores:       []pb.FragmentStore{"file:///"},
+			MaxBytes:     250,
+			MaxFragments: 3,
+		},
+	}
+	setTestJournal(broker, spec, broker.id, peer.id)
+
+	// Only the primary broker prunes oversize fragments.
+	for _, tc := range []struct {
+		bk     *testBroker
+		expect bool
+	}{{broker, true}, {peer, false}} {
+		var state = tc.bk.svc.resolver.state
+
+		state.KS.Mu.RLock()
+		require.Equal(t, tc.expect, isLocalPrimary(state, "a/journal"))
+		state.KS.Mu.RUnlock()
+	}
+
+	var tmpDir, err = ioutil.TempDir("", "ReplicaSuite")
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, os.RemoveAll(tmpDir)) }()
+	defer func(s string) { fragment.FileSystemStoreRoot = s }(fragment.FileSystemStoreRoot)
+	fragment.FileSystemStoreRoot = tmpDir
+
+	// Build fixtures of persisted fragments, where [150, 250) overlaps others.
+	for _, r := range [][2]int64{{0, 100}, {100, 200}, {150, 250}, {200, 300}, {300, 400}} {
+		var frag = pb.Fragment{
+			Journal:          "a/journal",
+			Begin:            r[0],
+			End:              r[1],
+			CompressionCodec: pb.CompressionCodec_NONE,
+			BackingStore:     "file:///",
+		}
+		var path = filepath.Join(tmpDir, frag.ContentPath())
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, ioutil.WriteFile(path, []byte("content"), 0600))
+	}
+	set, err := fragment.WalkAllStores(ctx, "a/journal", spec.Fragment.Stores)
+	require.NoError(t, err)
+	require.Len(t, set, 5)
+
+	// Fragments which include content at or beyond the ReaderFloor are kept.
+	spec.Fragment.ReaderFloor = 150
+	set = pruneOversizeFragments(ctx, &spec, set)
+	require.Len(t, set, 4)
+	require.Equal(t, int64(100), set[0].Begin)
+
+	// Expect the CoverSet is pruned to within MaxBytes (counting the overlap
+	// once) and MaxFragments, and the pruned fragments are removed from the store.
+	spec.Fragment.ReaderFloor = 0
+	set = pruneOversizeFragments(ctx, &spec, set)
+	require.Len(t, set, 3)
+	require.Equal(t, []int64{150, 200, 300}, []int64{set[0].Begin, set[1].Begin, set[2].Begin})
+
+	listed, err := fragment.WalkAllStores(ctx, "a/journal", spec.Fragment.Stores)
+	require.NoError(t, err)
+	require.Equal(t, set, listed)
+
+	// A JournalSpec without limits prunes nothing.
+	spec.Fragment.MaxBytes, spec.Fragment.MaxFragments = 0, 0
+	require.Equal(t, set, pruneOversizeFragments(ctx, &spec, set))
+
+	broker.cleanup()
+	peer.cleanup()
+}
@@ -30,12 +30,15 @@ func (svc *Service) Replicate(stream pb.Journal_ReplicateServer) (err error) {
 		}
 	}()
 
+	var claims pb.Claims
 	if req, err = stream.Recv(); err != nil {
 		return err
 	} else if err = req.Validate(); err != nil {
 		return err
 	} else if req.Header == nil {
 		return fmt.Errorf("expected first ReplicateRequest to have Header")
+	} else if claims, err = svc.verify(stream.Context()); err != nil {
+		return err
 	}
 
 	var spool fragment.Spool
@@ -46,6 +49,8 @@ func (svc *Service) Replicate(stream pb.Journal_ReplicateServer) (err error) {
 			mayProxy:       false,
 			requirePrimary: false,
 			proxyHeader:    req.Header,
+			claims:         claims,
+			require:        pb.Capability_REPLICATE,
 		})
 		if err != nil {
 			return err
@@ -71,7 +76,14 @@ func (svc *Service) Replicate(stream pb.Journal_ReplicateServer) (err error) {
 
 	// Serve the long-lived replication pipeline. When it completes, roll-back
 	// any uncommitted content and release ownership of Spool.
-	spool, err = serveReplicate(stream, *req, spool, &resolved.Header)
+	spool, err = serveReplicate(stream, *req, spool, &resolved.Header, resolved.replica)
+
+	// A quorum pipeline may commit content without us. If our pipeline
+	// failed (rather than being closed by the primary) we may have been
+	// dropped, and are out of sync until the primary re-synchronizes us.
+	if err != nil && resolved.journalSpec.QuorumCommit {
+		resolved.replica.setOutOfSync(true)
+	}
 
 	spool.MustApply(&pb.ReplicateRequest{
 		Proposal:  &spool.Fragment.Fragment,
@@ -83,7 +95,9 @@ func (svc *Service) Replicate(stream pb.Journal_ReplicateServer) (err error) {
 }
 
 // serveReplicate evaluates a client's Replicate RPC against the local Spool.
-func serveReplicate(stream pb.Journal_ReplicateServer, req pb.ReplicateRequest, spool fragment.Spool, hdr *pb.Header) (fragment.Spool, error) {
+// Once a proposal of the primary is acknowledged as OK, the |replica| is in
+// sync with the primary.
+func serveReplicate(stream pb.Journal_ReplicateServer, req pb.ReplicateRequest, spool fragment.Spool, hdr *pb.Header, replica *replica) (fragment.Spool, error) {
 	var (
 		resp = new(pb.ReplicateResponse)
 		err  error
@@ -93,6 +107,9 @@ func serveReplicate(stream pb.Journal_ReplicateServer, req pb.ReplicateRequest,
 			return spool, err
 		}
 		if req.Acknowledge {
+			if resp.Status == pb.Status_OK {
+				replica.setOutOfSync(false) // We agree with the primary's proposal.
+			}
 			resp.Header, hdr = hdr, nil // Send Header with first ReplicateResponse.
 
 			if err = stream.SendMsg(resp); err != nil {
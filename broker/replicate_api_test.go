@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.gazette.dev/core/allocator"
@@ -224,6 +225,126 @@ func TestReplicateBlockingRestart(t *testing.T) {
 	broker.cleanup()
 }
 
+func TestReplicateOutOfSyncReadsAreRedirected(t *testing.T) {
+	var ctx, etcd = pb.WithDispatchDefault(context.Background()), etcdtest.TestClient()
+	defer etcdtest.Cleanup()
+
+	var broker = newTestBroker(t, etcd, pb.ProcessSpec_ID{Zone: "local", Suffix: "broker"})
+	var peer = newMockBroker(t, etcd, pb.ProcessSpec_ID{Zone: "peer", Suffix: "broker"})
+	setTestJournal(broker, pb.JournalSpec{Name: "a/journal", Replication: 3, QuorumCommit: true},
+		peer.id, pb.ProcessSpec_ID{Zone: "other", Suffix: "broker"}, broker.id)
+	var expectHeader = broker.header("a/journal")
+
+	var fragment = func(begin, end int64, content string) *pb.Fragment {
+		var f = &pb.Fragment{
+			Journal:          "a/journal",
+			Begin:            begin,
+			End:              end,
+			CompressionCodec: pb.CompressionCodec_NONE,
+		}
+		if content != "" {
+			f.Sum = pb.SHA1SumOf(content)
+		}
+		return f
+	}
+
+	// Sync, replicate, and commit "foo".
+	var streamCtx, streamCancel = context.WithCancel(ctx)
+	var stream, _ = broker.client().Replicate(streamCtx)
+	require.NoError(t, stream.Send(&pb.ReplicateRequest{
+		DeprecatedJournal: "a/journal",
+		Header:            expectHeader,
+		Proposal:          fragment(0, 0, ""),
+		Registers:         boxLabels(),
+		Acknowledge:       true,
+	}))
+	expectReplResponse(t, stream, &pb.ReplicateResponse{Status: pb.Status_OK, Header: expectHeader})
+
+	require.NoError(t, stream.Send(&pb.ReplicateRequest{Content: []byte("foo")}))
+	require.NoError(t, stream.Send(&pb.ReplicateRequest{
+		Proposal:    fragment(0, 3, "foo"),
+		Registers:   boxLabels(),
+		Acknowledge: true,
+	}))
+	expectReplResponse(t, stream, &pb.ReplicateResponse{Status: pb.Status_OK})
+
+	var replica = broker.replica("a/journal")
+	require.False(t, replica.isOutOfSync())
+
+	// The stream fails, as when the primary drops this replica from its pipeline.
+	streamCancel()
+	for !replica.isOutOfSync() {
+		time.Sleep(time.Millisecond)
+	}
+
+	// Case: the out-of-sync replica proxies reads to the primary.
+	var req = pb.ReadRequest{Journal: "a/journal", Offset: 0}
+	var readStream, _ = broker.client().Read(ctx, &req)
+
+	req.Header = boxHeaderProcessID(*expectHeader, peer.id)
+	require.Equal(t, req, <-peer.ReadReqCh)
+	peer.ReadRespCh <- pb.ReadResponse{Offset: 1234}
+	peer.WriteLoopErrCh <- nil // EOF.
+
+	expectReadResponse(t, readStream, pb.ReadResponse{Offset: 1234})
+	var _, err = readStream.Recv()
+	require.Equal(t, io.EOF, err)
+
+	// Case: if the read may not be proxied, it fails.
+	readStream, _ = broker.client().Read(ctx, &pb.ReadRequest{Journal: "a/journal", DoNotProxy: true})
+	expectReadResponse(t, readStream, pb.ReadResponse{
+		Status: pb.Status_NOT_JOURNAL_BROKER,
+		Header: expectHeader,
+	})
+
+	// The primary re-synchronizes the replica, which is missing content
+	// through offset 6 and rolls forward to the primary's proposal.
+	stream, _ = broker.client().Replicate(ctx)
+	require.NoError(t, stream.Send(&pb.ReplicateRequest{
+		DeprecatedJournal: "a/journal",
+		Header:            expectHeader,
+		Proposal:          fragment(0, 6, "foobar"),
+		Registers:         boxLabels(),
+		Acknowledge:       true,
+	}))
+	expectReplResponse(t, stream, &pb.ReplicateResponse{
+		Status:    pb.Status_PROPOSAL_MISMATCH,
+		Header:    expectHeader,
+		Fragment:  fragment(6, 6, ""),
+		Registers: boxLabels(),
+	})
+	require.True(t, replica.isOutOfSync())
+
+	require.NoError(t, stream.Send(&pb.ReplicateRequest{
+		Proposal:    fragment(6, 6, ""),
+		Registers:   boxLabels(),
+		Acknowledge: true,
+	}))
+	expectReplResponse(t, stream, &pb.ReplicateResponse{Status: pb.Status_OK})
+	require.False(t, replica.isOutOfSync())
+
+	// Case: reads are again served by the replica.
+	readStream, _ = broker.client().Read(ctx, &pb.ReadRequest{
+		Journal:      "a/journal",
+		DoNotProxy:   true,
+		MetadataOnly: true,
+	})
+	expectReadResponse(t, readStream, pb.ReadResponse{
+		Status:    pb.Status_OK,
+		Header:    expectHeader,
+		Offset:    0,
+		WriteHead: 3,
+		Fragment:  fragment(0, 3, "foo"),
+	})
+
+	require.NoError(t, stream.CloseSend())
+	_, err = stream.Recv()
+	require.Equal(t, io.EOF, err)
+
+	broker.cleanup()
+	peer.Cleanup()
+}
+
 func expectReplResponse(t require.TestingT, stream pb.Journal_ReplicateClient, expect *pb.ReplicateResponse) {
 	var resp, err = stream.Recv()
 	require.NoError(t, err)
@@ -31,6 +31,7 @@ type resolver struct {
 type resolverReplica struct {
 	*replica
 	assignments keyspace.KeyValues
+	suspend     pb.JournalSpec_Suspend
 	signalCh    chan struct{}
 }
 
@@ -58,6 +59,11 @@ type resolveArgs struct {
 	minEtcdRevision int64
 	// Optional Header attached to the request from a proxying peer.
 	proxyHeader *pb.Header
+	// Claims of the caller, which must grant the |require|d Capability
+	// over the journal. Zero-valued Claims and Capability are used
+	// for resolutions made by the broker itself.
+	claims  pb.Claims
+	require pb.Capability
 }
 
 type resolution struct {
@@ -175,6 +181,8 @@ func (r *resolver) resolve(args resolveArgs) (res *resolution, err error) {
 	// Select a response Status code.
 	if res.journalSpec == nil {
 		res.status = pb.Status_JOURNAL_NOT_FOUND
+	} else if !args.claims.Allows(args.require, journalAuthLabels(res.journalSpec)) {
+		res.status = pb.Status_NOT_ALLOWED
 	} else if args.requirePrimary && res.Route.Primary == -1 {
 		res.status = pb.Status_NO_JOURNAL_PRIMARY_BROKER
 	} else if len(res.Route.Members) == 0 {
@@ -219,6 +227,7 @@ func (r *resolver) updateResolutions() {
 			replica = &resolverReplica{
 				replica:     r.newReplica(name), // Newly assigned journal.
 				assignments: li.Assignments.Copy(),
+				suspend:     item.ItemValue.(*pb.JournalSpec).Suspend,
 				signalCh:    make(chan struct{}),
 			}
 
@@ -235,10 +244,18 @@ func (r *resolver) updateResolutions() {
 		}
 		next[name] = replica
 
-		if !li.Assignments.EqualKeyRevisions(replica.assignments) {
+		var spec = item.ItemValue.(*pb.JournalSpec)
+		// Track the current minimum readable offset of the journal.
+		replica.index.SetMinOffset(spec.MinOffset)
+
+		// Resolutions are invalidated by changes of assignments, and also by
+		// a suspension or resumption of the journal, so that RPCs which are
+		// awaiting the replica pipeline don't proceed with a stale JournalSpec.
+		if !li.Assignments.EqualKeyRevisions(replica.assignments) || spec.Suspend != replica.suspend {
 			close(replica.signalCh)
 			replica.signalCh = make(chan struct{})
 			replica.assignments = li.Assignments.Copy()
+			replica.suspend = spec.Suspend
 		}
 	}
 
@@ -0,0 +1,444 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+// jsonSchema is a compiled JSON Schema. It supports a subset of JSON Schema
+// keywords, and compilation fails on keywords which aren't supported.
+// Zero-valued constraints are unset, and a zero-valued jsonSchema accepts
+// any JSON value.
+type jsonSchema struct {
+	never      bool                   // The schema is `false`, and accepts no value.
+	types      []string               // Allowed types ("type"), or nil if any type is allowed.
+	properties map[string]*jsonSchema // Schemas of named object properties ("properties").
+	required   []string               // Required object properties ("required").
+	noAddl     bool                   // Additional properties are disallowed ("additionalProperties": false).
+	addl       *jsonSchema            // Schema of additional properties, if constrained.
+	items      *jsonSchema            // Schema of array items ("items"), if constrained.
+	enum       []interface{}          // Allowed values ("enum"), or nil if any value is allowed.
+	minimum    *float64               // Inclusive minimum of numbers ("minimum").
+	maximum    *float64               // Inclusive maximum of numbers ("maximum").
+	minLength  *int                   // Minimum rune length of strings ("minLength").
+	maxLength  *int                   // Maximum rune length of strings ("maxLength").
+}
+
+func compileJSONSchema(content []byte) (*jsonSchema, error) {
+	var doc interface{}
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("decoding JSON schema: %w", err)
+	}
+	return buildJSONSchema(doc, "$")
+}
+
+func buildJSONSchema(doc interface{}, path string) (*jsonSchema, error) {
+	var out = new(jsonSchema)
+
+	switch d := doc.(type) {
+	case bool:
+		out.never = !d
+		return out, nil
+	case map[string]interface{}:
+		for _, keyword := range sortedKeys(d) {
+			if err := out.setKeyword(keyword, d[keyword], path); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("%s: expected a schema object or boolean", path)
+	}
+}
+
+func (s *jsonSchema) setKeyword(keyword string, v interface{}, path string) error {
+	var err error
+
+	switch keyword {
+	case "$schema", "$id", "$comment", "title", "description", "default",
+		"examples", "deprecated", "readOnly", "writeOnly":
+		// Annotations, which don't constrain values.
+
+	case "type":
+		if s.types, err = buildTypes(v); err != nil {
+			return fmt.Errorf("%s.type: %w", path, err)
+		}
+	case "properties":
+		var props, ok = v.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s.properties: expected an object", path)
+		}
+		s.properties = make(map[string]*jsonSchema, len(props))
+
+		for _, name := range sortedKeys(props) {
+			if s.properties[name], err = buildJSONSchema(props[name], propertyPath(path, name)); err != nil {
+				return err
+			}
+		}
+	case "required":
+		var arr, ok = v.([]interface{})
+		for _, r := range arr {
+			if name, isStr := r.(string); !isStr {
+				ok = false
+			} else {
+				s.required = append(s.required, name)
+			}
+		}
+		if !ok {
+			return fmt.Errorf("%s.required: expected an array of strings", path)
+		}
+	case "additionalProperties":
+		if b, ok := v.(bool); ok {
+			s.noAddl = !b
+		} else if s.addl, err = buildJSONSchema(v, path+".additionalProperties"); err != nil {
+			return err
+		}
+	case "items":
+		if s.items, err = buildJSONSchema(v, path+"[*]"); err != nil {
+			return err
+		}
+	case "enum":
+		var arr, ok = v.([]interface{})
+		if !ok || len(arr) == 0 {
+			return fmt.Errorf("%s.enum: expected a non-empty array", path)
+		}
+		s.enum = arr
+	case "minimum", "maximum":
+		var f, ok = v.(float64)
+		if !ok {
+			return fmt.Errorf("%s.%s: expected a number", path, keyword)
+		} else if keyword == "minimum" {
+			s.minimum = &f
+		} else {
+			s.maximum = &f
+		}
+	case "minLength", "maxLength":
+		var f, ok = v.(float64)
+		if !ok || f < 0 || f != math.Trunc(f) {
+			return fmt.Errorf("%s.%s: expected a non-negative integer", path, keyword)
+		}
+		var n = int(f)
+		if keyword == "minLength" {
+			s.minLength = &n
+		} else {
+			s.maxLength = &n
+		}
+	default:
+		return fmt.Errorf("%s: keyword %q is not supported", path, keyword)
+	}
+	return nil
+}
+
+func buildTypes(v interface{}) ([]string, error) {
+	var names []interface{}
+
+	switch t := v.(type) {
+	case string:
+		names = []interface{}{t}
+	case []interface{}:
+		names = t
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("expected a type name or non-empty array of type names")
+	}
+	var out []string
+	for _, n := range names {
+		var name, _ = n.(string)
+
+		switch name {
+		case "null", "boolean", "object", "array", "number", "integer", "string":
+			out = append(out, name)
+		default:
+			return nil, fmt.Errorf("invalid type name (%v)", n)
+		}
+	}
+	return out, nil
+}
+
+// validateDocument returns an error if the JSON |doc| doesn't conform to the schema.
+func (s *jsonSchema) validateDocument(doc []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(doc, &v); err != nil {
+		return err
+	}
+	return s.validate(v, "$")
+}
+
+func (s *jsonSchema) validate(v interface{}, path string) error {
+	if s.never {
+		return fmt.Errorf("%s: no value is allowed", path)
+	}
+	var typ = jsonTypeOf(v)
+
+	if s.types != nil && !typesAllow(s.types, typ, v) {
+		return fmt.Errorf("%s: expected type %s (got %s)", path, typeList(s.types), typ)
+	}
+	if s.enum != nil {
+		var found bool
+		for _, e := range s.enum {
+			if reflect.DeepEqual(e, v) {
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s: value is not one of the schema enum", path)
+		}
+	}
+
+	switch t := v.(type) {
+	case float64:
+		if s.minimum != nil && t < *s.minimum {
+			return fmt.Errorf("%s: %v is less than minimum %v", path, t, *s.minimum)
+		} else if s.maximum != nil && t > *s.maximum {
+			return fmt.Errorf("%s: %v is greater than maximum %v", path, t, *s.maximum)
+		}
+	case string:
+		var n = utf8.RuneCountInString(t)
+
+		if s.minLength != nil && n < *s.minLength {
+			return fmt.Errorf("%s: length %d is less than minLength %d", path, n, *s.minLength)
+		} else if s.maxLength != nil && n > *s.maxLength {
+			return fmt.Errorf("%s: length %d is greater than maxLength %d", path, n, *s.maxLength)
+		}
+	case map[string]interface{}:
+		for _, name := range s.required {
+			if _, ok := t[name]; !ok {
+				return fmt.Errorf("%s: missing required property %q", path, name)
+			}
+		}
+		for _, name := range sortedKeys(t) {
+			var ps, ok = s.properties[name]
+			if !ok && s.noAddl {
+				return fmt.Errorf("%s: additional property %q is not allowed", path, name)
+			} else if !ok {
+				ps = s.addl
+			}
+			if ps == nil {
+				continue
+			} else if err := ps.validate(t[name], propertyPath(path, name)); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		if s.items == nil {
+			break
+		}
+		for i, item := range t {
+			if err := s.items.validate(item, path+"["+strconv.Itoa(i)+"]"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// jsonSubsumes returns an error if a value allowed by schema |w| may not be
+// allowed by schema |r|. Either may be nil, which allows any value.
+func jsonSubsumes(r, w *jsonSchema, path string) error {
+	if r == nil {
+		return nil // |r| allows any value.
+	} else if w == nil {
+		w = new(jsonSchema)
+	}
+	if w.never {
+		return nil // |w| allows no value.
+	} else if r.never {
+		return fmt.Errorf("%s: no value is allowed by the reading schema", path)
+	}
+
+	if r.types != nil {
+		if w.types == nil {
+			return fmt.Errorf("%s: reading schema requires type %s, but writing schema allows any type",
+				path, typeList(r.types))
+		}
+		for _, t := range w.types {
+			if !typesAllow(r.types, t, nil) {
+				return fmt.Errorf("%s: reading schema requires type %s, but writing schema allows %s",
+					path, typeList(r.types), t)
+			}
+		}
+	}
+	if r.enum != nil {
+		if w.enum == nil {
+			return fmt.Errorf("%s: reading schema requires an enum value, but writing schema doesn't", path)
+		}
+		for _, we := range w.enum {
+			var found bool
+			for _, re := range r.enum {
+				if reflect.DeepEqual(re, we) {
+					found = true
+				}
+			}
+			if !found {
+				return fmt.Errorf("%s: writing schema enum value %v is not allowed by the reading schema", path, we)
+			}
+		}
+	}
+
+	if w.allows("number") {
+		if err := boundSubsumes(r.minimum, w.minimum, false, path, "minimum"); err != nil {
+			return err
+		} else if err = boundSubsumes(r.maximum, w.maximum, true, path, "maximum"); err != nil {
+			return err
+		}
+	}
+	if w.allows("string") {
+		if err := boundSubsumes(intToFloat(r.minLength), intToFloat(w.minLength), false, path, "minLength"); err != nil {
+			return err
+		} else if err = boundSubsumes(intToFloat(r.maxLength), intToFloat(w.maxLength), true, path, "maxLength"); err != nil {
+			return err
+		}
+	}
+	if w.allows("object") {
+		if err := jsonObjectSubsumes(r, w, path); err != nil {
+			return err
+		}
+	}
+	if w.allows("array") {
+		if err := jsonSubsumes(r.items, w.items, path+"[*]"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func jsonObjectSubsumes(r, w *jsonSchema, path string) error {
+	for _, name := range r.required {
+		if !containsString(w.required, name) {
+			return fmt.Errorf("%s: reading schema requires property %q, but writing schema doesn't",
+				path, name)
+		}
+	}
+	// Properties which are named by |r| must be compatible with their
+	// schema in |w|, which may be its schema of additional properties.
+	for _, name := range sortedKeys(r.properties) {
+		var ws, ok = w.properties[name]
+		if !ok && w.noAddl {
+			continue // |w| doesn't write the property.
+		} else if !ok {
+			ws = w.addl
+		}
+		if err := jsonSubsumes(r.properties[name], ws, propertyPath(path, name)); err != nil {
+			return err
+		}
+	}
+	// Properties which are named by |w|, but not by |r|, as well as
+	// additional properties of |w| must be allowed by |r| as additional
+	// properties.
+	if !r.noAddl && r.addl == nil {
+		return nil // |r| allows any additional property.
+	}
+	for _, name := range sortedKeys(w.properties) {
+		if _, ok := r.properties[name]; ok {
+			continue
+		} else if r.noAddl && !w.properties[name].never {
+			return fmt.Errorf("%s: reading schema disallows property %q, but writing schema allows it",
+				path, name)
+		} else if err := jsonSubsumes(r.addl, w.properties[name], propertyPath(path, name)); err != nil {
+			return err
+		}
+	}
+	if w.noAddl {
+		return nil
+	} else if r.noAddl {
+		return fmt.Errorf("%s: reading schema disallows additional properties, but writing schema allows them", path)
+	}
+	return jsonSubsumes(r.addl, w.addl, path+".*")
+}
+
+// boundSubsumes returns an error if the bound |w| is looser than bound |r|.
+func boundSubsumes(r, w *float64, upper bool, path, keyword string) error {
+	if r == nil {
+		return nil
+	} else if w == nil {
+		return fmt.Errorf("%s: reading schema has %s %v, but writing schema has none", path, keyword, *r)
+	} else if (upper && *w > *r) || (!upper && *w < *r) {
+		return fmt.Errorf("%s: reading schema has %s %v, but writing schema has %v", path, keyword, *r, *w)
+	}
+	return nil
+}
+
+// allows returns whether the schema may allow values of type |typ|.
+func (s *jsonSchema) allows(typ string) bool {
+	if s.types == nil {
+		return true
+	}
+	for _, t := range s.types {
+		if t == typ || (typ == "number" && t == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+// typesAllow returns whether |types| allow type |typ|. If |v| is a number,
+// it's allowed by "integer" if it has no fractional part.
+func typesAllow(types []string, typ string, v interface{}) bool {
+	for _, t := range types {
+		if t == typ {
+			return true
+		} else if t == "number" && typ == "integer" {
+			return true
+		} else if f, ok := v.(float64); ok && t == "integer" && f == math.Trunc(f) {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonTypeOf(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+func typeList(types []string) string {
+	if len(types) == 1 {
+		return types[0]
+	}
+	return fmt.Sprintf("%v", types)
+}
+
+func propertyPath(path, name string) string { return path + "." + name }
+
+func intToFloat(i *int) *float64 {
+	if i == nil {
+		return nil
+	}
+	var f = float64(*i)
+	return &f
+}
+
+func containsString(s []string, v string) bool {
+	for _, ss := range s {
+		if ss == v {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m interface{}) []string {
+	var out []string
+	for _, k := range reflect.ValueOf(m).MapKeys() {
+		out = append(out, k.String())
+	}
+	sort.Strings(out)
+	return out
+}
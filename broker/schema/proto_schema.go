@@ -0,0 +1,263 @@
+package schema
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// protoSchema is a compiled PROTOBUF schema: an index of the message types of
+// a FileDescriptorSet, and the root message type of validated messages.
+type protoSchema struct {
+	root     string                   // Fully-qualified name of the root message type.
+	messages map[string]*protoMessage // Message types, keyed on fully-qualified name.
+}
+
+type protoMessage struct {
+	fields map[int32]*descriptor.FieldDescriptorProto // Fields, keyed on field number.
+	proto3 bool                                       // Was the message declared with proto3 syntax?
+}
+
+func compileProtoSchema(content []byte, root string) (*protoSchema, error) {
+	var set descriptor.FileDescriptorSet
+	if err := proto.Unmarshal(content, &set); err != nil {
+		return nil, fmt.Errorf("decoding FileDescriptorSet: %w", err)
+	}
+	var out = &protoSchema{
+		root:     strings.TrimPrefix(root, "."),
+		messages: make(map[string]*protoMessage),
+	}
+	for _, file := range set.File {
+		var prefix = file.GetPackage()
+		if prefix != "" {
+			prefix += "."
+		}
+		for _, msg := range file.MessageType {
+			out.index(prefix, msg, file.GetSyntax() == "proto3")
+		}
+	}
+
+	if _, ok := out.messages[out.root]; !ok {
+		return nil, fmt.Errorf("message type %s is not defined by the FileDescriptorSet", out.root)
+	} else if err := out.checkReachable(out.root, make(map[string]bool)); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// checkReachable returns an error if a message type which is reachable from
+// message type |name| is undefined, or has a group field.
+func (s *protoSchema) checkReachable(name string, visited map[string]bool) error {
+	if visited[name] {
+		return nil
+	}
+	visited[name] = true
+
+	var m = s.messages[name]
+	for _, num := range sortedFieldNumbers(m) {
+		var f = m.fields[num]
+
+		if f.GetType() == descriptor.FieldDescriptorProto_TYPE_GROUP {
+			return fmt.Errorf("%s.%s: groups are not supported", name, f.GetName())
+		} else if f.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+			continue
+		} else if _, ok := s.messages[typeName(f)]; !ok {
+			return fmt.Errorf("%s.%s: message type %s is not defined by the FileDescriptorSet",
+				name, f.GetName(), typeName(f))
+		} else if err := s.checkReachable(typeName(f), visited); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// index |msg| and its nested message types, having name |prefix|.
+func (s *protoSchema) index(prefix string, msg *descriptor.DescriptorProto, proto3 bool) {
+	var name = prefix + msg.GetName()
+	var m = &protoMessage{
+		fields: make(map[int32]*descriptor.FieldDescriptorProto, len(msg.Field)),
+		proto3: proto3,
+	}
+	for _, f := range msg.Field {
+		m.fields[f.GetNumber()] = f
+	}
+	s.messages[name] = m
+
+	for _, nested := range msg.NestedType {
+		s.index(name+".", nested, proto3)
+	}
+}
+
+func (s *protoSchema) validate(b []byte) error {
+	return s.validateMessage(b, s.root, s.root)
+}
+
+// validateMessage returns an error if |b| isn't a well-formed wire encoding of
+// message type |name|. Field wire types must match the types of their declared
+// fields, and embedded messages are recursively validated. Fields which aren't
+// declared by the message type are permitted, as they would be by a reader.
+func (s *protoSchema) validateMessage(b []byte, name, path string) error {
+	var m = s.messages[name]
+	var seen = make(map[int32]bool)
+
+	for len(b) != 0 {
+		var tag, n = binary.Uvarint(b)
+		if n <= 0 {
+			return fmt.Errorf("%s: malformed field tag", path)
+		}
+		b = b[n:]
+
+		var num, wt = int32(tag >> 3), int(tag & 0x7)
+		var value []byte
+
+		if num <= 0 {
+			return fmt.Errorf("%s: invalid field number %d", path, num)
+		}
+		switch wt {
+		case wireVarint:
+			if _, n = binary.Uvarint(b); n <= 0 {
+				return fmt.Errorf("%s: field %d has a malformed varint", path, num)
+			}
+		case wireFixed64, wireFixed32:
+			if n = 8; wt == wireFixed32 {
+				n = 4
+			}
+			if len(b) < n {
+				return fmt.Errorf("%s: field %d is truncated", path, num)
+			}
+		case wireBytes:
+			var l, ln = binary.Uvarint(b)
+			if ln <= 0 || uint64(len(b)-ln) < l {
+				return fmt.Errorf("%s: field %d has a malformed length", path, num)
+			}
+			value, n = b[ln:ln+int(l)], ln+int(l)
+		default:
+			return fmt.Errorf("%s: field %d has unsupported wire type %d", path, num, wt)
+		}
+		b = b[n:]
+
+		var f, ok = m.fields[num]
+		if !ok {
+			continue // Unknown fields are allowed.
+		}
+		var fieldPath = path + "." + f.GetName()
+		seen[num] = true
+
+		if expect := wireTypeOf(f.GetType()); wt != expect && !(wt == wireBytes && isPackable(f)) {
+			return fmt.Errorf("%s: unexpected wire type %d (expected %d)", fieldPath, wt, expect)
+		} else if f.GetType() == descriptor.FieldDescriptorProto_TYPE_STRING && m.proto3 && !utf8.Valid(value) {
+			return fmt.Errorf("%s: invalid UTF-8 string", fieldPath)
+		} else if f.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+			continue
+		} else if err := s.validateMessage(value, typeName(f), fieldPath); err != nil {
+			return err
+		}
+	}
+
+	for _, num := range sortedFieldNumbers(m) {
+		var f = m.fields[num]
+		if f.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REQUIRED && !seen[num] {
+			return fmt.Errorf("%s: missing required field %s", path, f.GetName())
+		}
+	}
+	return nil
+}
+
+// protoSubsumes returns an error if message type |rName| of schema |r| may be
+// unable to read a message of type |wName| of schema |w|. Fields are matched
+// on field number, and must have the same type and cardinality. Fields of
+// only one of the message types are compatible (readers ignore unknown
+// fields), unless the field is required by the reader.
+func protoSubsumes(r *protoSchema, rName string, w *protoSchema, wName string, visited map[[2]string]bool) error {
+	if visited[[2]string{rName, wName}] {
+		return nil // Already checked (or being checked, for recursive types).
+	}
+	visited[[2]string{rName, wName}] = true
+
+	var rm, wm = r.messages[rName], w.messages[wName]
+
+	for _, num := range sortedFieldNumbers(rm) {
+		var rf, wf = rm.fields[num], wm.fields[num]
+		var rRequired = rf.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REQUIRED
+
+		if wf == nil {
+			if rRequired {
+				return fmt.Errorf("%s.%s: field %d is required by the reading schema, but isn't written",
+					rName, rf.GetName(), num)
+			}
+			continue
+		}
+		var wRequired = wf.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REQUIRED
+		var rRepeated = rf.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED
+		var wRepeated = wf.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED
+
+		if rf.GetType() != wf.GetType() {
+			return fmt.Errorf("%s.%s: field %d has type %s in the reading schema, but %s in the writing schema",
+				rName, rf.GetName(), num, rf.GetType(), wf.GetType())
+		} else if rRepeated != wRepeated {
+			return fmt.Errorf("%s.%s: field %d has label %s in the reading schema, but %s in the writing schema",
+				rName, rf.GetName(), num, rf.GetLabel(), wf.GetLabel())
+		} else if rRequired && !wRequired {
+			return fmt.Errorf("%s.%s: field %d is required by the reading schema, but not the writing schema",
+				rName, rf.GetName(), num)
+		} else if rf.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+			continue
+		} else if err := protoSubsumes(r, typeName(rf), w, typeName(wf), visited); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// wireTypeOf returns the wire type of a non-packed field of type |t|.
+func wireTypeOf(t descriptor.FieldDescriptorProto_Type) int {
+	switch t {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return wireFixed64
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		return wireFixed32
+	case descriptor.FieldDescriptorProto_TYPE_STRING,
+		descriptor.FieldDescriptorProto_TYPE_BYTES,
+		descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		return wireBytes
+	default:
+		return wireVarint
+	}
+}
+
+// isPackable returns whether the field is a repeated scalar, which may be
+// encoded in packed form.
+func isPackable(f *descriptor.FieldDescriptorProto) bool {
+	return f.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED && wireTypeOf(f.GetType()) != wireBytes
+}
+
+// typeName returns the fully-qualified message type name of field |f|.
+func typeName(f *descriptor.FieldDescriptorProto) string {
+	return strings.TrimPrefix(f.GetTypeName(), ".")
+}
+
+func sortedFieldNumbers(m *protoMessage) []int32 {
+	var out []int32
+	for num := range m.fields {
+		out = append(out, num)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// Protobuf wire types.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
@@ -0,0 +1,89 @@
+// Package schema implements the message schemas of Gazette's schema registry.
+// SchemaSpecs are registered with brokers through the ApplySchemas RPC, and
+// journals refer to a schema through their "app.gazette.dev/message-type"
+// label. Brokers use a compiled Schema to validate the messages of appends to
+// journals which opt-in via their JournalSpec, and to check that schema updates
+// satisfy their compatibility rules.
+package schema
+
+import (
+	"fmt"
+
+	pb "go.gazette.dev/core/broker/protocol"
+)
+
+// Schema is a compiled SchemaSpec.
+type Schema struct {
+	// Spec of the Schema.
+	Spec pb.SchemaSpec
+
+	json  *jsonSchema  // Compiled JSON_SCHEMA.
+	proto *protoSchema // Compiled PROTOBUF schema.
+}
+
+// Compile the SchemaSpec into a Schema, or return an error if its Content
+// is not a well-formed schema of its Type.
+func Compile(spec *pb.SchemaSpec) (*Schema, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	var out = &Schema{Spec: *spec}
+	var err error
+
+	switch spec.Type {
+	case pb.SchemaSpec_JSON_SCHEMA:
+		out.json, err = compileJSONSchema(spec.Content)
+	case pb.SchemaSpec_PROTOBUF:
+		out.proto, err = compileProtoSchema(spec.Content, spec.Message)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("compiling schema %s: %w", spec.Name, err)
+	}
+	return out, nil
+}
+
+// Validate returns an error if |message| doesn't conform to the Schema.
+// A message of a JSON_SCHEMA is a JSON document, and a message of a PROTOBUF
+// schema is the wire encoding of its message type.
+func (s *Schema) Validate(message []byte) error {
+	if s.json != nil {
+		return s.json.validateDocument(message)
+	}
+	return s.proto.validate(message)
+}
+
+// CheckCompatible returns an error if Schema |next|, which updates Schema
+// |prior|, doesn't satisfy the Compatibility of its SchemaSpec with respect
+// to |prior|. A change of schema Type is compatible only if |next| requires
+// no compatibility.
+func CheckCompatible(prior, next *Schema) error {
+	var c = next.Spec.Compatibility
+
+	if c == pb.SchemaSpec_NONE {
+		return nil
+	} else if prior.Spec.Type != next.Spec.Type {
+		return fmt.Errorf("schema type cannot change from %s to %s with %s compatibility",
+			prior.Spec.Type, next.Spec.Type, c)
+	}
+	if c.Backward() {
+		if err := subsumes(next, prior); err != nil {
+			return fmt.Errorf("not BACKWARD compatible (updated schema cannot read prior messages): %w", err)
+		}
+	}
+	if c.Forward() {
+		if err := subsumes(prior, next); err != nil {
+			return fmt.Errorf("not FORWARD compatible (prior schema cannot read updated messages): %w", err)
+		}
+	}
+	return nil
+}
+
+// subsumes returns an error if Schema |reader| may be unable to read a
+// message which was written under Schema |writer|. Checks are conservative:
+// an error may be returned for schemas which are, in fact, compatible.
+func subsumes(reader, writer *Schema) error {
+	if reader.json != nil {
+		return jsonSubsumes(reader.json, writer.json, "$")
+	}
+	return protoSubsumes(reader.proto, reader.proto.root, writer.proto, writer.proto.root, make(map[[2]string]bool))
+}
@@ -0,0 +1,270 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+)
+
+func TestJSONSchemaValidation(t *testing.T) {
+	var s, err = Compile(jsonSpec(`{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"title": "An event",
+		"type": "object",
+		"properties": {
+			"id":    {"type": "integer", "minimum": 1},
+			"kind":  {"enum": ["click", "view"]},
+			"name":  {"type": "string", "minLength": 2, "maxLength": 4},
+			"tags":  {"type": "array", "items": {"type": "string"}},
+			"extra": {"type": ["string", "null"]}
+		},
+		"required": ["id", "kind"],
+		"additionalProperties": false
+	}`, pb.SchemaSpec_NONE))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		doc    string
+		expect string
+	}{
+		{`{"id": 1, "kind": "click"}`, ""},
+		{`{"id": 12, "kind": "view", "name": "abc", "tags": ["a", "b"], "extra": null}`, ""},
+		{`[1, 2]`, "$: expected type object (got array)"},
+		{`{"kind": "click"}`, `$: missing required property "id"`},
+		{`{"id": 1.5, "kind": "click"}`, "$.id: expected type integer (got number)"},
+		{`{"id": 0, "kind": "click"}`, "$.id: 0 is less than minimum 1"},
+		{`{"id": 1, "kind": "other"}`, "$.kind: value is not one of the schema enum"},
+		{`{"id": 1, "kind": "view", "name": "a"}`, "$.name: length 1 is less than minLength 2"},
+		{`{"id": 1, "kind": "view", "name": "abcde"}`, "$.name: length 5 is greater than maxLength 4"},
+		{`{"id": 1, "kind": "view", "tags": ["a", 2]}`, "$.tags[1]: expected type string (got number)"},
+		{`{"id": 1, "kind": "view", "extra": 1}`, "$.extra: expected type [string null] (got number)"},
+		{`{"id": 1, "kind": "view", "other": 1}`, `$: additional property "other" is not allowed`},
+		{`{"id": 1,`, "unexpected end of JSON input"},
+	} {
+		if err := s.Validate([]byte(tc.doc)); tc.expect == "" {
+			require.NoError(t, err, tc.doc)
+		} else {
+			require.EqualError(t, err, tc.expect, tc.doc)
+		}
+	}
+
+	// Boolean schemas accept everything, or nothing.
+	s, err = Compile(jsonSpec(`{"properties": {"any": true, "none": false}}`, pb.SchemaSpec_NONE))
+	require.NoError(t, err)
+	require.NoError(t, s.Validate([]byte(`{"any": [1, {}]}`)))
+	require.EqualError(t, s.Validate([]byte(`{"none": 1}`)), "$.none: no value is allowed")
+}
+
+func TestJSONSchemaCompileErrors(t *testing.T) {
+	for _, tc := range []struct {
+		schema string
+		expect string
+	}{
+		{`{"type": "object"`, "decoding JSON schema: unexpected end of JSON input"},
+		{`"object"`, "$: expected a schema object or boolean"},
+		{`{"type": "other"}`, "$.type: invalid type name (other)"},
+		{`{"type": []}`, "$.type: expected a type name or non-empty array of type names"},
+		{`{"properties": {"a": {"$ref": "#/b"}}}`, `$.a: keyword "$ref" is not supported`},
+		{`{"oneOf": [{"type": "string"}, {"type": "integer"}]}`, `$: keyword "oneOf" is not supported`},
+		{`{"items": {"anyOf": [true]}}`, `$[*]: keyword "anyOf" is not supported`},
+		{`{"type": "string", "pattern": "^a"}`, `$: keyword "pattern" is not supported`},
+		{`{"additionalProperties": {"format": "date-time"}}`, `$.additionalProperties: keyword "format" is not supported`},
+		{`{"required": ["a", 1]}`, "$.required: expected an array of strings"},
+		{`{"enum": []}`, "$.enum: expected a non-empty array"},
+		{`{"minimum": "1"}`, "$.minimum: expected a number"},
+		{`{"maxLength": 1.5}`, "$.maxLength: expected a non-negative integer"},
+		{`{"items": 1}`, "$[*]: expected a schema object or boolean"},
+	} {
+		var _, err = Compile(jsonSpec(tc.schema, pb.SchemaSpec_NONE))
+		require.EqualError(t, err, "compiling schema a/schema: "+tc.expect, tc.schema)
+	}
+}
+
+func TestJSONSchemaCompatibility(t *testing.T) {
+	var base = `{
+		"type": "object",
+		"properties": {"id": {"type": "integer"}, "name": {"type": "string"}},
+		"required": ["id"]
+	}`
+	for _, tc := range []struct {
+		next              string
+		backward, forward string
+	}{
+		// Identical schemas are fully compatible.
+		{base, "", ""},
+		// Adding an optional property is fully compatible, as additional
+		// properties are allowed.
+		{`{
+			"type": "object",
+			"properties": {"id": {"type": "integer"}, "name": {"type": "string"}, "age": {"type": "integer"}},
+			"required": ["id"]
+		}`,
+			"$.age: reading schema requires type integer, but writing schema allows any type",
+			""},
+		// Adding a required property breaks reading of prior messages.
+		{`{
+			"type": "object",
+			"properties": {"id": {"type": "integer"}, "name": {"type": "string"}},
+			"required": ["id", "name"]
+		}`,
+			`$: reading schema requires property "name", but writing schema doesn't`,
+			""},
+		// Widening a type may be read by the update, but not the prior schema.
+		{`{
+			"type": "object",
+			"properties": {"id": {"type": "number"}, "name": {"type": "string"}},
+			"required": ["id"]
+		}`,
+			"",
+			"$.id: reading schema requires type integer, but writing schema allows number"},
+		// Disallowing additional properties is not backward compatible.
+		{`{
+			"type": "object",
+			"properties": {"id": {"type": "integer"}, "name": {"type": "string"}},
+			"required": ["id"],
+			"additionalProperties": false
+		}`,
+			"$: reading schema disallows additional properties, but writing schema allows them",
+			""},
+	} {
+		var prior, err = Compile(jsonSpec(base, pb.SchemaSpec_NONE))
+		require.NoError(t, err)
+
+		for _, c := range []pb.SchemaSpec_Compatibility{pb.SchemaSpec_BACKWARD, pb.SchemaSpec_FORWARD} {
+			var next, err = Compile(jsonSpec(tc.next, c))
+			require.NoError(t, err)
+
+			var expect, prefix = tc.backward, "not BACKWARD compatible (updated schema cannot read prior messages): "
+			if c == pb.SchemaSpec_FORWARD {
+				expect, prefix = tc.forward, "not FORWARD compatible (prior schema cannot read updated messages): "
+			}
+			if err = CheckCompatible(prior, next); expect == "" {
+				require.NoError(t, err, tc.next)
+			} else {
+				require.EqualError(t, err, prefix+expect, tc.next)
+			}
+		}
+	}
+
+	// A schema Type may change only without compatibility requirements.
+	var prior, _ = Compile(jsonSpec(base, pb.SchemaSpec_NONE))
+	var next, _ = Compile(protoSpec(t, nil, pb.SchemaSpec_FULL))
+	require.EqualError(t, CheckCompatible(prior, next),
+		"schema type cannot change from JSON_SCHEMA to PROTOBUF with FULL compatibility")
+	next.Spec.Compatibility = pb.SchemaSpec_NONE
+	require.NoError(t, CheckCompatible(prior, next))
+}
+
+func TestProtoSchemaValidation(t *testing.T) {
+	var s, err = Compile(protoSpec(t, nil, pb.SchemaSpec_NONE))
+	require.NoError(t, err)
+
+	var valid, _ = (&pb.Fragment{
+		Journal: "a/journal",
+		Begin:   100,
+		End:     200,
+		Sum:     pb.SHA1Sum{Part1: 1234},
+	}).Marshal()
+	require.NoError(t, s.Validate(valid))
+
+	// Unknown fields are allowed.
+	var unknown = append(append([]byte(nil), valid...), 0xf8, 0x01, 0x01) // Field 31, varint 1.
+	require.NoError(t, s.Validate(unknown))
+
+	// A field having a truncated value.
+	require.EqualError(t, s.Validate([]byte{0x0a, 0x01}), // Field 1 (journal), length 1.
+		"protocol.Fragment: field 1 has a malformed length")
+	// A field having the wrong wire type.
+	require.EqualError(t, s.Validate([]byte{0x08, 0x01}), // Field 1 (journal), varint 1.
+		"protocol.Fragment.journal: unexpected wire type 0 (expected 2)")
+	// A nested message which is malformed.
+	require.EqualError(t, s.Validate([]byte{0x22, 0x02, 0x0a, 0x00}), // Field 4 (sum), having field 1 (bytes).
+		"protocol.Fragment.sum.part1: unexpected wire type 2 (expected 1)")
+	// Truncated content.
+	require.EqualError(t, s.Validate(valid[:len(valid)-1]), "protocol.Fragment: field 4 has a malformed length")
+	// Invalid UTF-8 of a proto3 string.
+	require.EqualError(t, s.Validate([]byte{0x0a, 0x01, 0xff}), "protocol.Fragment.journal: invalid UTF-8 string")
+
+	// The root message type must be defined.
+	_, err = Compile(protoSpec(t, nil, pb.SchemaSpec_NONE, "protocol.Other"))
+	require.EqualError(t, err, "compiling schema a/schema: message type protocol.Other is not defined by the FileDescriptorSet")
+}
+
+func TestProtoSchemaCompatibility(t *testing.T) {
+	var prior, err = Compile(protoSpec(t, nil, pb.SchemaSpec_NONE))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		update func(*descriptor.DescriptorProto)
+		expect string
+	}{
+		// Adding a field is compatible.
+		{func(m *descriptor.DescriptorProto) {
+			m.Field = append(m.Field, &descriptor.FieldDescriptorProto{
+				Name:   proto.String("added"),
+				Number: proto.Int32(31),
+				Label:  descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:   descriptor.FieldDescriptorProto_TYPE_STRING.Enum(),
+			})
+		}, ""},
+		// Removing a field is compatible.
+		{func(m *descriptor.DescriptorProto) { m.Field = m.Field[1:] }, ""},
+		// Changing the type of a field is not.
+		{func(m *descriptor.DescriptorProto) {
+			m.Field[1].Type = descriptor.FieldDescriptorProto_TYPE_UINT64.Enum()
+		}, "protocol.Fragment.begin: field 2 has type TYPE_UINT64 in the reading schema, but TYPE_INT64 in the writing schema"},
+		// Nor is making a field repeated.
+		{func(m *descriptor.DescriptorProto) {
+			m.Field[1].Label = descriptor.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		}, "protocol.Fragment.begin: field 2 has label LABEL_REPEATED in the reading schema, but LABEL_OPTIONAL in the writing schema"},
+	} {
+		var next, err = Compile(protoSpec(t, tc.update, pb.SchemaSpec_FULL))
+		require.NoError(t, err)
+
+		if err = CheckCompatible(prior, next); tc.expect == "" {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expect)
+		}
+	}
+}
+
+func jsonSpec(content string, c pb.SchemaSpec_Compatibility) *pb.SchemaSpec {
+	return &pb.SchemaSpec{
+		Name:          "a/schema",
+		Type:          pb.SchemaSpec_JSON_SCHEMA,
+		Content:       []byte(content),
+		Compatibility: c,
+	}
+}
+
+// protoSpec returns a PROTOBUF SchemaSpec of the protocol.Fragment message
+// of the broker protocol, after applying |update| to its descriptor.
+func protoSpec(t *testing.T, update func(*descriptor.DescriptorProto), c pb.SchemaSpec_Compatibility, message ...string) *pb.SchemaSpec {
+	var file, _ = descriptor.ForMessage(&pb.Fragment{})
+
+	if update != nil {
+		for _, m := range file.MessageType {
+			if m.GetName() == "Fragment" {
+				update(m)
+			}
+		}
+	}
+	var content, err = proto.Marshal(&descriptor.FileDescriptorSet{File: []*descriptor.FileDescriptorProto{file}})
+	require.NoError(t, err)
+
+	if message == nil {
+		message = []string{"protocol.Fragment"}
+	}
+	return &pb.SchemaSpec{
+		Name:          "a/schema",
+		Type:          pb.SchemaSpec_PROTOBUF,
+		Content:       content,
+		Message:       message[0],
+		Compatibility: c,
+	}
+}
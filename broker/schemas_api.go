@@ -0,0 +1,177 @@
+package broker
+
+import (
+	"context"
+	"net"
+
+	log "github.com/sirupsen/logrus"
+	"go.etcd.io/etcd/client/v3"
+	pb "go.gazette.dev/core/broker/protocol"
+	pbx "go.gazette.dev/core/broker/protocol/ext"
+	"go.gazette.dev/core/broker/schema"
+	"go.gazette.dev/core/keyspace"
+	"google.golang.org/grpc/peer"
+)
+
+// ListSchemas dispatches the JournalServer.ListSchemas API.
+func (svc *Service) ListSchemas(ctx context.Context, req *pb.ListSchemasRequest) (resp *pb.ListSchemasResponse, err error) {
+	defer instrumentJournalServerRPC("ListSchemas", &err, nil)()
+
+	defer func() {
+		if err != nil {
+			var addr net.Addr
+			if p, ok := peer.FromContext(ctx); ok {
+				addr = p.Addr
+			}
+			log.WithFields(log.Fields{"err": err, "req": req, "client": addr}).
+				Warn("served ListSchemas RPC failed")
+		}
+	}()
+
+	var s = svc.resolver.state
+
+	resp = &pb.ListSchemasResponse{
+		Status: pb.Status_OK,
+		Header: pbx.NewUnroutedHeader(s),
+	}
+	var claims pb.Claims
+	if err = req.Validate(); err != nil {
+		return resp, err
+	} else if claims, err = svc.verify(ctx); err != nil {
+		return resp, err
+	} else if claims.Capability&pb.Capability_LIST == 0 {
+		resp.Status = pb.Status_NOT_ALLOWED
+		return resp, nil
+	}
+
+	var names = make(map[string]bool, len(req.Names))
+	for _, name := range req.Names {
+		names[name] = true
+	}
+
+	defer s.KS.Mu.RUnlock()
+	s.KS.Mu.RLock()
+
+	for _, kv := range s.KS.Prefixed(s.KS.Root + SchemasPrefix) {
+		var spec = kv.Decoded.(*schema.Schema).Spec
+
+		if len(names) != 0 && !names[spec.Name] {
+			continue
+		} else if !claims.Allows(pb.Capability_LIST, schemaAuthLabels(spec.Name)) {
+			continue // Schema is not visible to the caller.
+		}
+		resp.Schemas = append(resp.Schemas, pb.ListSchemasResponse_Schema{
+			Spec:        spec,
+			ModRevision: kv.Raw.ModRevision,
+		})
+	}
+	return resp, nil
+}
+
+// ApplySchemas dispatches the JournalServer.ApplySchemas API.
+func (svc *Service) ApplySchemas(ctx context.Context, req *pb.ApplySchemasRequest) (resp *pb.ApplySchemasResponse, err error) {
+	defer instrumentJournalServerRPC("ApplySchemas", &err, nil)()
+
+	defer func() {
+		if err != nil {
+			var addr net.Addr
+			if p, ok := peer.FromContext(ctx); ok {
+				addr = p.Addr
+			}
+			log.WithFields(log.Fields{"err": err, "req": req, "client": addr}).
+				Warn("served ApplySchemas RPC failed")
+		}
+	}()
+
+	var claims pb.Claims
+	if err = req.Validate(); err != nil {
+		return new(pb.ApplySchemasResponse), err
+	} else if claims, err = svc.verify(ctx); err != nil {
+		return new(pb.ApplySchemasResponse), err
+	}
+
+	var cmp []clientv3.Cmp
+	var ops []clientv3.Op
+	var s = svc.resolver.state
+
+	resp = &pb.ApplySchemasResponse{
+		Status: pb.Status_OK,
+		Header: pbx.NewUnroutedHeader(s),
+	}
+
+	for _, change := range req.Changes {
+		var name = change.Delete
+		if change.Upsert != nil {
+			name = change.Upsert.Name
+		}
+		if !claims.Allows(pb.Capability_APPLY, schemaAuthLabels(name)) {
+			resp.Status = pb.Status_NOT_ALLOWED
+			return resp, nil
+		}
+		var key = SchemaKey(s.KS, name)
+
+		if change.Upsert != nil {
+			var next *schema.Schema
+			var priorRevision int64
+
+			if next, err = schema.Compile(change.Upsert); err != nil {
+				return resp, err
+			} else if priorRevision, err = checkSchemaCompatible(s.KS, next); err != nil {
+				resp.Status = pb.Status_INCOMPATIBLE_SCHEMA
+				resp.Error = err.Error()
+				return resp, nil
+			}
+			ops = append(ops, clientv3.OpPut(key, change.Upsert.MarshalString()))
+
+			// Compatibility was checked against the Schema of our KeySpace,
+			// which may be stale. Require that it's still current.
+			if next.Spec.Compatibility != pb.SchemaSpec_NONE {
+				cmp = append(cmp, clientv3.Compare(clientv3.ModRevision(key), "=", priorRevision))
+			}
+		} else {
+			ops = append(ops, clientv3.OpDelete(key))
+		}
+
+		// Allow caller to explicitly ignore revision comparison
+		// by passing a value of -1 for revision.
+		if change.ExpectModRevision != -1 {
+			cmp = append(cmp, clientv3.Compare(clientv3.ModRevision(key), "=", change.ExpectModRevision))
+		}
+	}
+
+	var txnResp clientv3.OpResponse
+	if txnResp, err = svc.etcd.Do(ctx, clientv3.OpTxn(cmp, ops, nil)); err != nil {
+		return resp, err
+	} else if !txnResp.Txn().Succeeded {
+		resp.Status = pb.Status_ETCD_TRANSACTION_FAILED
+	} else if len(ops) != 0 {
+		// If we made changes, delay responding until we have read our own Etcd write.
+		s.KS.Mu.RLock()
+		err = s.KS.WaitForRevision(ctx, txnResp.Txn().Header.Revision)
+		s.KS.Mu.RUnlock()
+	}
+	resp.Header.Etcd.Revision = txnResp.Txn().Header.Revision
+	return resp, err
+}
+
+// checkSchemaCompatible returns an error if Schema |next| doesn't satisfy its
+// compatibility rules with respect to the current Schema of the same name
+// in the KeySpace. A Schema which doesn't yet exist is trivially compatible.
+// The ModRevision of the current Schema (or zero, if it doesn't exist) is
+// returned, for comparison within the Etcd transaction of the ApplySchemas.
+func checkSchemaCompatible(ks *keyspace.KeySpace, next *schema.Schema) (int64, error) {
+	defer ks.Mu.RUnlock()
+	ks.Mu.RLock()
+
+	if ind, ok := ks.Search(SchemaKey(ks, next.Spec.Name)); ok {
+		var kv = ks.KeyValues[ind]
+		return kv.Raw.ModRevision, schema.CheckCompatible(kv.Decoded.(*schema.Schema), next)
+	}
+	return 0, nil
+}
+
+// schemaAuthLabels returns the LabelSet against which Claims over the named
+// schema are evaluated.
+func schemaAuthLabels(name string) pb.LabelSet {
+	return pb.MustLabelSet("name", name)
+}
@@ -0,0 +1,128 @@
+package broker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/broker/schema"
+	"go.gazette.dev/core/etcdtest"
+)
+
+func TestSchemasListAndApplyCases(t *testing.T) {
+	var ctx, etcd = pb.WithDispatchDefault(context.Background()), etcdtest.TestClient()
+	defer etcdtest.Cleanup()
+
+	var specA = pb.SchemaSpec{
+		Name:          "schema/A",
+		Type:          pb.SchemaSpec_JSON_SCHEMA,
+		Content:       []byte(`{"type": "object", "required": ["id"]}`),
+		Compatibility: pb.SchemaSpec_BACKWARD,
+	}
+	var specB = pb.SchemaSpec{
+		Name:    "schema/B",
+		Type:    pb.SchemaSpec_JSON_SCHEMA,
+		Content: []byte(`{"type": "array"}`),
+	}
+	var broker = newTestBroker(t, etcd, pb.ProcessSpec_ID{Zone: "local", Suffix: "broker"})
+
+	var list = func(names ...string) []pb.ListSchemasResponse_Schema {
+		var resp, err = broker.client().ListSchemas(ctx, &pb.ListSchemasRequest{Names: names})
+		require.NoError(t, err)
+		require.Equal(t, pb.Status_OK, resp.Status)
+		return resp.Schemas
+	}
+	var apply = func(changes ...pb.ApplySchemasRequest_Change) *pb.ApplySchemasResponse {
+		var resp, err = broker.client().ApplySchemas(ctx, &pb.ApplySchemasRequest{Changes: changes})
+		require.NoError(t, err)
+		return resp
+	}
+
+	// Case: Create new specs A & B.
+	require.Equal(t, pb.Status_OK, apply(
+		pb.ApplySchemasRequest_Change{Upsert: &specA},
+		pb.ApplySchemasRequest_Change{Upsert: &specB},
+	).Status)
+
+	var all = list()
+	require.Len(t, all, 2)
+	require.Equal(t, specA, all[0].Spec)
+	require.Equal(t, specB, all[1].Spec)
+	require.Equal(t, all[1:], list("schema/B"))
+
+	// Brokers compile and index registered schemas.
+	broker.ks.Mu.RLock()
+	var compiled, ok = LookupSchema(broker.ks, "schema/A")
+	broker.ks.Mu.RUnlock()
+	require.True(t, ok)
+	require.NoError(t, compiled.Validate([]byte(`{"id": 1}`)))
+	require.Error(t, compiled.Validate([]byte(`{}`)))
+
+	// Case: A compatible update of A succeeds.
+	var updated = specA
+	updated.Content = []byte(`{"type": "object"}`)
+
+	require.Equal(t, pb.Status_OK, apply(
+		pb.ApplySchemasRequest_Change{Upsert: &updated, ExpectModRevision: all[0].ModRevision},
+	).Status)
+
+	// Case: An incompatible update of A is refused.
+	var incompatible = specA
+	incompatible.Content = []byte(`{"type": "array"}`)
+
+	var resp = apply(pb.ApplySchemasRequest_Change{Upsert: &incompatible, ExpectModRevision: -1})
+	require.Equal(t, pb.Status_INCOMPATIBLE_SCHEMA, resp.Status)
+	require.Equal(t, "not BACKWARD compatible (updated schema cannot read prior messages): "+
+		"$: reading schema requires type array, but writing schema allows object", resp.Error)
+
+	// Case: Relaxing the compatibility of the update allows it.
+	incompatible.Compatibility = pb.SchemaSpec_NONE
+	require.Equal(t, pb.Status_OK, apply(
+		pb.ApplySchemasRequest_Change{Upsert: &incompatible, ExpectModRevision: -1},
+	).Status)
+
+	// Case: Deletion at wrong revision fails.
+	require.Equal(t, pb.Status_ETCD_TRANSACTION_FAILED, apply(
+		pb.ApplySchemasRequest_Change{Delete: "schema/B", ExpectModRevision: all[1].ModRevision - 1},
+	).Status)
+
+	// Case: Deletion at the current revision succeeds.
+	require.Equal(t, pb.Status_OK, apply(
+		pb.ApplySchemasRequest_Change{Delete: "schema/B", ExpectModRevision: all[1].ModRevision},
+	).Status)
+	require.Len(t, list(), 1)
+
+	// Case: A schema which fails to compile is an error.
+	var malformed = specB
+	malformed.Content = []byte(`{"type": "other"}`)
+
+	var _, err = broker.client().ApplySchemas(ctx, &pb.ApplySchemasRequest{
+		Changes: []pb.ApplySchemasRequest_Change{{Upsert: &malformed}},
+	})
+	require.Regexp(t, `compiling schema schema/B: \$.type: invalid type name \(other\)`, err)
+
+	// Case: A schema using an unsupported keyword is an error.
+	malformed.Content = []byte(`{"type": "string", "pattern": "^a"}`)
+
+	_, err = broker.client().ApplySchemas(ctx, &pb.ApplySchemasRequest{
+		Changes: []pb.ApplySchemasRequest_Change{{Upsert: &malformed}},
+	})
+	require.Regexp(t, `compiling schema schema/B: \$: keyword "pattern" is not supported`, err)
+
+	// Compatibility is checked against the current Schema of the KeySpace,
+	// and its revision is returned for comparison in the Etcd transaction.
+	all = list()
+	var next, _ = schema.Compile(&incompatible)
+
+	rev, err := checkSchemaCompatible(broker.ks, next)
+	require.NoError(t, err)
+	require.Equal(t, all[0].ModRevision, rev)
+
+	next.Spec.Name = "schema/C" // Doesn't exist.
+	rev, err = checkSchemaCompatible(broker.ks, next)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), rev)
+
+	broker.cleanup()
+}
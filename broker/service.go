@@ -2,6 +2,7 @@ package broker
 
 import (
 	"context"
+	"time"
 
 	"go.etcd.io/etcd/client/v3"
 	"go.gazette.dev/core/allocator"
@@ -21,13 +22,37 @@ type Service struct {
 	etcd     *clientv3.Client
 	resolver *resolver
 
+	// Auth verifies the authorizations of RPCs, and authorizes RPCs to peers.
+	// If nil, RPCs are not authorized. Otherwise, RPCs must present an
+	// authorization which grants the capability required by the RPC over the
+	// requested journal. Auth must be set before the Service is served.
+	Auth pb.Auth
+
+	// NewFrameValidator returns a FrameValidator of content having the given
+	// content-type, which calls |validate| (if non-nil) with each complete
+	// message. It's used to validate appends of journals which enable
+	// ValidateFraming or ValidateSchema, and if nil, such appends are refused.
+	// The gazette broker uses message.NewFrameValidator.
+	NewFrameValidator func(contentType string, validate func(message []byte) error) (FrameValidator, error)
+
 	// stopProxyReadsCh is closed when the Service is beginning shutdown.
 	// All other RPCs are allowed to gracefully complete as per usual, but
-	// because proxy reads can be very long lived, we must inject an EOF
-	// to ensure timely Service shutdown.
+	// because proxy reads and watches can be very long lived, we must inject
+	// an EOF or end them to ensure timely Service shutdown.
 	stopProxyReadsCh chan struct{}
 }
 
+// FrameValidator incrementally validates the message framing of content
+// appended to a journal. Validate is called with each chunk of content, which
+// continues prior chunks, and returns an error and the offset (relative to the
+// chunk) of the frame if a frame is malformed. Partial returns the length of
+// a trailing partial frame of the content, which may be completed by the next
+// chunk.
+type FrameValidator interface {
+	Validate(content []byte) (int, error)
+	Partial() int
+}
+
 // NewService constructs a new broker Service, driven by allocator.State.
 func NewService(state *allocator.State, jc pb.JournalClient, etcd *clientv3.Client) *Service {
 	var svc = &Service{
@@ -38,7 +63,7 @@ func NewService(state *allocator.State, jc pb.JournalClient, etcd *clientv3.Clie
 
 	svc.resolver = newResolver(state, func(journal pb.Journal) *replica {
 		var rep = newReplica(journal)
-		go fragmentRefreshDaemon(state.KS, rep)
+		go fragmentRefreshDaemon(state, rep)
 		go pulseDaemon(svc, rep)
 		return rep
 	})
@@ -61,8 +86,8 @@ func (svc *Service) QueueTasks(tasks *task.Group, server *server.Server, finishF
 	tasks.Queue("service.GracefulStop", func() error {
 		<-tasks.Context().Done()
 
-		// Signal that proxy reads should stop, so that our gRPC server may
-		// gracefully stop, and then drain all ongoing RPCs.
+		// Signal that proxy reads and watches should stop, so that our gRPC
+		// server may gracefully stop, and then drain all ongoing RPCs.
 		close(svc.stopProxyReadsCh)
 		// Similarly, ensure all local replicas are stopped. Under nominal
 		// shutdown the allocator would already assure this, but if we're in the
@@ -120,6 +145,31 @@ func (svc *Service) UpdateRoute(string, *pb.Route) {} // No-op.
 // IsNoopRouter returns false.
 func (svc *Service) IsNoopRouter() bool { return false }
 
+// verify the authorization of an incoming RPC, returning its Claims.
+// If the Service doesn't authorize RPCs, Claims grant all capabilities.
+func (svc *Service) verify(ctx context.Context) (pb.Claims, error) {
+	if svc.Auth == nil {
+		return pb.Claims{Capability: pb.Capability_ALL}, nil
+	}
+	return svc.Auth.Verify(ctx)
+}
+
+// authorize an outgoing RPC to a peer broker, which is made on behalf of a
+// caller having |claims|. The peer will itself verify the caller's Claims.
+func (svc *Service) authorize(ctx context.Context, claims pb.Claims) (context.Context, error) {
+	if svc.Auth == nil {
+		return ctx, nil
+	}
+	return svc.Auth.Authorize(ctx, claims, peerAuthorizationTTL)
+}
+
+// journalAuthLabels returns the LabelSet of the JournalSpec against which
+// Claims are evaluated, which is its labels unioned with its meta-labels.
+func journalAuthLabels(spec *pb.JournalSpec) pb.LabelSet {
+	var meta = pb.ExtractJournalSpecMetaLabels(spec, pb.LabelSet{})
+	return pb.UnionLabelSets(meta, spec.LabelSet, pb.LabelSet{})
+}
+
 func addTrace(ctx context.Context, format string, args ...interface{}) {
 	if tr, ok := trace.FromContext(ctx); ok {
 		tr.LazyPrintf(format, args...)
@@ -139,3 +189,8 @@ func instrumentJournalServerRPC(op string, err *error, res **resolution) func()
 		journalServerCompleted.WithLabelValues(op, status).Inc()
 	}
 }
+
+// peerAuthorizationTTL is the lifetime of authorizations of RPCs made to peers.
+// Authorizations are verified only as an RPC begins, and a peer RPC is started
+// immediately after it's authorized.
+var peerAuthorizationTTL = time.Minute
@@ -0,0 +1,195 @@
+package broker
+
+import (
+	"context"
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/fragment"
+	pb "go.gazette.dev/core/broker/protocol"
+	"google.golang.org/grpc/peer"
+)
+
+// Stat dispatches the JournalServer.Stat API.
+func (svc *Service) Stat(ctx context.Context, req *pb.StatRequest) (resp *pb.StatResponse, err error) {
+	var res *resolution
+	defer instrumentJournalServerRPC("Stat", &err, &res)()
+
+	defer func() {
+		if err != nil {
+			var addr net.Addr
+			if p, ok := peer.FromContext(ctx); ok {
+				addr = p.Addr
+			}
+			log.WithFields(log.Fields{"err": err, "req": req, "client": addr}).
+				Warn("served Stat RPC failed")
+		}
+	}()
+
+	var claims pb.Claims
+	if err = req.Validate(); err != nil {
+		return nil, err
+	} else if claims, err = svc.verify(ctx); err != nil {
+		return nil, err
+	}
+
+	// Stat is served by the journal primary, which owns the journal's
+	// replication pipeline and persists its completed Fragments.
+	// A suspended journal has no primary, and is resumed to serve the request.
+	res, err = svc.resolveOrResume(resolveArgs{
+		ctx:            ctx,
+		journal:        req.Journal,
+		mayProxy:       !req.DoNotProxy,
+		requirePrimary: true,
+		proxyHeader:    req.Header,
+		claims:         claims,
+		require:        pb.Capability_READ,
+	}, mayAlwaysResume)
+
+	if err != nil {
+		return nil, err
+	} else if res.status != pb.Status_OK {
+		return &pb.StatResponse{Status: res.status, Header: res.Header}, nil
+	} else if res.replica == nil {
+		req.Header = &res.Header // Attach resolved Header to |req|, which we'll forward.
+		if ctx, err = svc.authorize(ctx, claims); err != nil {
+			return nil, err
+		}
+		ctx = pb.WithDispatchRoute(ctx, req.Header.Route, req.Header.ProcessId)
+		return svc.jc.Stat(ctx, req)
+	}
+
+	resp = &pb.StatResponse{
+		Status:     pb.Status_OK,
+		Header:     res.Header,
+		AppendRate: int64(res.replica.appendRate.estimate(timeNow())),
+		Spec:       res.journalSpec,
+	}
+	if err = statReplication(ctx, res, resp); err != nil {
+		return nil, err
+	}
+
+	var status = sharedPersister.Status(req.Journal)
+	resp.PersistBacklogFragments = status.BacklogSpools
+	resp.PersistBacklogBytes = status.BacklogBytes
+
+	if status.LastErr != nil {
+		resp.PersistError = status.LastErr.Error()
+		resp.PersistErrorTime = status.LastErrTime.Unix()
+	}
+	return resp, nil
+}
+
+// statReplication populates the write head, spooled bytes, and Replicas of
+// the StatResponse from the Spool and pipeline of the resolved primary replica.
+func statReplication(ctx context.Context, res *resolution, resp *pb.StatResponse) error {
+	var members = res.Route.Members
+
+	resp.Replicas = make([]pb.StatResponse_Replica, len(members))
+	for i := range members {
+		resp.Replicas[i] = pb.StatResponse_Replica{
+			Id:     members[i],
+			InSync: i == int(res.Route.Primary),
+		}
+	}
+
+	var pln *pipeline
+	select {
+	case pln = <-res.replica.pipelineCh:
+		addTrace(ctx, "<-replica.pipelineCh => %s", pln)
+	case <-ctx.Done():
+		return errors.WithMessage(ctx.Err(), "waiting for pipeline")
+	}
+
+	if pln == nil {
+		// There's no current pipeline, and the replica holds its Spool.
+		// Peers are not in sync, as none are replicating.
+		var spool fragment.Spool
+		select {
+		case spool = <-res.replica.spoolCh:
+			addTrace(ctx, "<-replica.spoolCh => %s", spool)
+		case <-ctx.Done():
+			res.replica.pipelineCh <- nil
+			return errors.WithMessage(ctx.Err(), "waiting for spool")
+		}
+		resp.WriteHead, resp.SpooledBytes = spool.End, spool.ContentLength()
+
+		res.replica.spoolCh <- spool
+		res.replica.pipelineCh <- nil
+		return nil
+	}
+
+	// We own the send-side of |pln|, and may read its Spool and send errors.
+	resp.WriteHead, resp.SpooledBytes = pln.spool.End, pln.spool.ContentLength()
+	var errs = append([]error(nil), pln.sendErrs...)
+
+	// Release the send-side, and wait for our turn to own the receive-side.
+	var waitFor, closeAfter = pln.barrier()
+	res.replica.pipelineCh <- pln
+	<-waitFor
+
+	for i, s := range pln.streams {
+		if s == nil {
+			continue // Local Spool of the primary.
+		}
+		// Read owed responses of a quorum pipeline which are ready.
+		for pln.commitsOnQuorum() && pln.owed[i] != 0 && pln.recvErrs[i] == nil && s.(*asyncStream).ready() {
+			pln.recvOwed(i)
+		}
+		if pln.recvErrs[i] != nil {
+			errs[i] = pln.recvErrs[i]
+		}
+
+		for j := range members {
+			if members[j] != pln.Route.Members[i] {
+				continue
+			} else if errs[i] != nil {
+				resp.Replicas[j].Error = errs[i].Error()
+			} else {
+				resp.Replicas[j].InSync = pln.owed[i] == 0
+			}
+		}
+	}
+	close(closeAfter)
+
+	return nil
+}
+
+// appendRate is an exponentially-weighted moving average of the rate at
+// which content is appended to a journal, in bytes per second. Appended
+// content decays from the average with time constant |appendRateWindow|.
+type appendRate struct {
+	mu   sync.Mutex
+	rate float64   // Average rate, as of |last|.
+	last time.Time // Time of the last update of |rate|.
+}
+
+// add |n| appended bytes to the average at time |now|.
+func (r *appendRate) add(n int64, now time.Time) {
+	r.mu.Lock()
+	r.rate = r.decayed(now) + float64(n)/appendRateWindow.Seconds()
+	r.last = now
+	r.mu.Unlock()
+}
+
+// estimate returns the average rate at time |now|.
+func (r *appendRate) estimate(now time.Time) float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.decayed(now)
+}
+
+func (r *appendRate) decayed(now time.Time) float64 {
+	if r.last.IsZero() || !now.After(r.last) {
+		return r.rate
+	}
+	return r.rate * math.Exp(-now.Sub(r.last).Seconds()/appendRateWindow.Seconds())
+}
+
+// appendRateWindow is the time constant of appendRate averages.
+var appendRateWindow = 10 * time.Second
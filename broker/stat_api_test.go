@@ -0,0 +1,126 @@
+package broker
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/etcdtest"
+)
+
+func TestStatCases(t *testing.T) {
+	var ctx, etcd = pb.WithDispatchDefault(context.Background()), etcdtest.TestClient()
+	defer etcdtest.Cleanup()
+
+	var broker = newTestBroker(t, etcd, pb.ProcessSpec_ID{Zone: "local", Suffix: "broker"})
+	setTestJournal(broker, pb.JournalSpec{Name: "a/journal", Replication: 1}, broker.id)
+	broker.initialFragmentLoad()
+
+	// Case: Request validation error.
+	var _, err = broker.client().Stat(ctx, &pb.StatRequest{Journal: "/invalid"})
+	require.EqualError(t, err, `rpc error: code = Unknown desc = Journal: cannot begin with '/' (/invalid)`)
+
+	// Case: Resolution error.
+	resp, err := broker.client().Stat(ctx, &pb.StatRequest{Journal: "a/missing/journal"})
+	require.NoError(t, err)
+	require.Equal(t, &pb.StatResponse{
+		Status: pb.Status_JOURNAL_NOT_FOUND,
+		Header: *broker.header("a/missing/journal"),
+	}, resp)
+
+	// Case: Stat of a journal without a pipeline.
+	resp, err = broker.client().Stat(ctx, &pb.StatRequest{Journal: "a/journal"})
+	require.NoError(t, err)
+	require.Equal(t, &pb.StatResponse{
+		Status:   pb.Status_OK,
+		Header:   *broker.header("a/journal"),
+		Replicas: []pb.StatResponse_Replica{{Id: broker.id, InSync: true}},
+		Spec:     broker.resolve("a/journal").journalSpec,
+	}, resp)
+
+	// Case: Stat reflects appended content.
+	var stream, _ = broker.client().Append(ctx)
+	require.NoError(t, stream.Send(&pb.AppendRequest{Journal: "a/journal"}))
+	require.NoError(t, stream.Send(&pb.AppendRequest{Content: bytes.Repeat([]byte("x"), 1000)}))
+	require.NoError(t, stream.Send(&pb.AppendRequest{})) // Intend to commit.
+	_, err = stream.CloseAndRecv()
+	require.NoError(t, err)
+
+	resp, err = broker.client().Stat(ctx, &pb.StatRequest{Journal: "a/journal"})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OK, resp.Status)
+	require.Equal(t, pb.Offset(1000), resp.WriteHead)
+	require.Equal(t, int64(1000), resp.SpooledBytes)
+	require.Equal(t, []pb.StatResponse_Replica{{Id: broker.id, InSync: true}}, resp.Replicas)
+	require.True(t, resp.AppendRate > 0 && resp.AppendRate <= 100)
+	require.Equal(t, int64(0), resp.PersistBacklogFragments)
+	require.Equal(t, "", resp.PersistError)
+
+	// Case: Stat of a suspended journal resumes it.
+	_, _, err = updateJournalSpec(ctx, etcd, broker.ks, "a/journal", func(spec *pb.JournalSpec) bool {
+		spec.Suspend = pb.JournalSpec_Suspend{Level: pb.JournalSpec_Suspend_FULL, Offset: 1000}
+		return true
+	})
+	require.NoError(t, err)
+
+	resp, err = broker.client().Stat(ctx, &pb.StatRequest{Journal: "a/journal"})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OK, resp.Status)
+	require.Equal(t, pb.JournalSpec_Suspend{Offset: 1000}, broker.resolve("a/journal").journalSpec.Suspend)
+
+	// Case: Journal primary is a peer, and we may not proxy.
+	var peer = newMockBroker(t, etcd, pb.ProcessSpec_ID{Zone: "peer", Suffix: "broker"})
+	setTestJournal(broker, pb.JournalSpec{Name: "proxy/journal", Replication: 2}, peer.id, broker.id)
+	var proxyHeader = broker.header("proxy/journal")
+
+	resp, err = broker.client().Stat(ctx, &pb.StatRequest{Journal: "proxy/journal", DoNotProxy: true})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_NOT_JOURNAL_PRIMARY_BROKER, resp.Status)
+
+	// Case: Proxy request to peer.
+	peer.StatFunc = func(ctx context.Context, req *pb.StatRequest) (*pb.StatResponse, error) {
+		require.Equal(t, &pb.StatRequest{
+			Header:  proxyHeader,
+			Journal: "proxy/journal",
+		}, req)
+		return &pb.StatResponse{
+			Status:    pb.Status_OK,
+			Header:    *proxyHeader,
+			WriteHead: 1234,
+		}, nil
+	}
+
+	resp, err = broker.client().Stat(ctx, &pb.StatRequest{Journal: "proxy/journal"})
+	require.NoError(t, err)
+	require.Equal(t, &pb.StatResponse{
+		Status:    pb.Status_OK,
+		Header:    *proxyHeader,
+		WriteHead: 1234,
+	}, resp)
+
+	broker.cleanup()
+}
+
+func TestAppendRateEstimates(t *testing.T) {
+	var r appendRate
+	var now = time.Unix(1000, 0)
+
+	require.Equal(t, 0.0, r.estimate(now))
+
+	// A steady rate converges to that rate.
+	for i := 0; i != 600; i++ {
+		now = now.Add(100 * time.Millisecond)
+		r.add(100, now) // 1,000 bytes per second.
+	}
+	require.InDelta(t, 1000.0, r.estimate(now), 10.0)
+
+	// Rates decay over time, with the |appendRateWindow| time constant.
+	require.InDelta(t, 367.9, r.estimate(now.Add(appendRateWindow)), 5.0)
+	require.InDelta(t, 0.0, r.estimate(now.Add(10*appendRateWindow)), 0.1)
+
+	// Times prior to the last update don't decay the rate.
+	require.InDelta(t, 1000.0, r.estimate(now.Add(-time.Second)), 10.0)
+}
@@ -0,0 +1,189 @@
+package broker
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.etcd.io/etcd/client/v3"
+	"go.gazette.dev/core/allocator"
+	"go.gazette.dev/core/broker/fragment"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/keyspace"
+)
+
+// SuspendAfter is the duration for which a journal must be idle (having no
+// appended content) before it's suspended by its primary broker. Suspended
+// journals release their broker assignments, and are not counted against
+// the journal limit of any broker. If zero, journals are never suspended.
+var SuspendAfter time.Duration = 0
+
+// resolveOrResume resolves |args| and, if the resolved journal is suspended
+// and |mayResume| returns true for its JournalSpec, resumes the journal and
+// awaits its re-assignment to brokers before returning a final resolution.
+// If |mayResume| returns false for a suspended journal having no current
+// assignments, resolveOrResume instead blocks until it's resumed by another
+// caller (or |args.ctx| is cancelled). If |mayResume| is nil, suspended
+// journals are resolved as-is.
+func (svc *Service) resolveOrResume(args resolveArgs, mayResume func(*pb.JournalSpec) bool) (*resolution, error) {
+	var awaiting bool
+
+	for {
+		var res, err = svc.resolver.resolve(args)
+		if err != nil || mayResume == nil || res.journalSpec == nil || res.status == pb.Status_NOT_ALLOWED {
+			return res, err
+		}
+		var suspended = res.journalSpec.Suspend.Level == pb.JournalSpec_Suspend_FULL
+		var unassigned = res.status == pb.Status_NO_JOURNAL_PRIMARY_BROKER ||
+			res.status == pb.Status_INSUFFICIENT_JOURNAL_BROKERS
+
+		if suspended && mayResume(res.journalSpec) {
+			var rev int64
+			if rev, err = svc.resumeJournal(args.ctx, args.journal.StripMeta()); err != nil {
+				return nil, err
+			}
+			// If our resume raced with another update of the journal,
+			// |rev| is zero and we'll read through the next revision.
+			if args.minEtcdRevision = res.Etcd.Revision + 1; rev > args.minEtcdRevision {
+				args.minEtcdRevision = rev
+			}
+			awaiting = true
+		} else if suspended && unassigned {
+			addTrace(args.ctx, " ... journal is suspended; awaiting resume")
+			args.minEtcdRevision = res.Etcd.Revision + 1
+			awaiting = true
+		} else if awaiting && (unassigned || len(res.Route.Members) < res.journalSpec.DesiredReplication()) {
+			addTrace(args.ctx, " ... awaiting assignment of resumed journal")
+			args.minEtcdRevision = res.Etcd.Revision + 1
+		} else {
+			return res, nil
+		}
+	}
+}
+
+// mayAlwaysResume is a resolveOrResume policy which resumes a suspended
+// journal for any request.
+func mayAlwaysResume(*pb.JournalSpec) bool { return true }
+
+// resumeJournal updates the JournalSpec of a suspended |journal| to resume
+// it, and returns the Etcd revision of the update. Its suspension Offset is
+// retained, and allows the resumed journal to continue from that offset.
+// If the journal isn't suspended, or was concurrently updated, a zero
+// revision is returned.
+func (svc *Service) resumeJournal(ctx context.Context, journal pb.Journal) (int64, error) {
+	var _, rev, err = updateJournalSpec(ctx, svc.etcd, svc.resolver.state.KS, journal,
+		func(spec *pb.JournalSpec) bool {
+			if spec.Suspend.Level != pb.JournalSpec_Suspend_FULL {
+				return false
+			}
+			log.WithFields(log.Fields{
+				"journal": journal,
+				"offset":  spec.Suspend.Offset,
+			}).Info("resuming suspended journal")
+
+			spec.Suspend.Level = pb.JournalSpec_Suspend_NONE
+			return true
+		})
+	return rev, err
+}
+
+// maybeSuspendJournal is called by the primary broker of |res| while it holds
+// the journal pipeline, with the current |spool|, and having determined that
+// the journal has been idle for at least SuspendAfter. The journal is suspended
+// if all of its content has been persisted to its fragment stores. It returns
+// the Etcd revision of the suspension, or zero if the journal wasn't suspended.
+func maybeSuspendJournal(ctx context.Context, svc *Service, res *resolution, spool fragment.Spool) (int64, error) {
+	var spec = res.journalSpec
+
+	if spec.Suspend.Level == pb.JournalSpec_Suspend_FULL {
+		return 0, nil // Already suspended.
+	} else if len(spec.Fragment.Stores) == 0 || fragment.DisableStores {
+		return 0, nil // Content cannot be persisted, and the journal cannot be suspended.
+	} else if spool.ContentLength() != 0 || !res.replica.index.Persisted() {
+		return 0, nil // Content is not yet persisted.
+	}
+
+	var _, rev, err = updateJournalSpec(ctx, svc.etcd, svc.resolver.state.KS, spec.Name,
+		func(spec *pb.JournalSpec) bool {
+			spec.Suspend = pb.JournalSpec_Suspend{
+				Level:  pb.JournalSpec_Suspend_FULL,
+				Offset: spool.End,
+			}
+			return true
+		})
+
+	if err == nil && rev != 0 {
+		log.WithFields(log.Fields{
+			"journal": spec.Name,
+			"offset":  spool.End,
+		}).Info("suspended idle journal")
+	}
+	return rev, err
+}
+
+// maybeClearSuspendOffset removes the retained suspension Offset of a resumed
+// journal, once the journal primary's |spool| has been synchronized through
+// that offset. Thereafter, the journal's brokers no longer roll forward to
+// the suspension offset in the course of recovering the journal's write head.
+func maybeClearSuspendOffset(ctx context.Context, svc *Service, res *resolution, spool fragment.Spool) error {
+	var offset = res.journalSpec.Suspend.Offset
+
+	if res.journalSpec.Suspend.Level != pb.JournalSpec_Suspend_NONE || offset == 0 || spool.End < offset {
+		return nil
+	}
+	var _, _, err = updateJournalSpec(ctx, svc.etcd, svc.resolver.state.KS, res.journalSpec.Name,
+		func(spec *pb.JournalSpec) bool {
+			if spec.Suspend != (pb.JournalSpec_Suspend{Offset: offset}) {
+				return false
+			}
+			spec.Suspend = pb.JournalSpec_Suspend{}
+			return true
+		})
+	return err
+}
+
+// updateJournalSpec applies |fn| to a copy of the current JournalSpec of
+// |journal|, and writes the updated JournalSpec as an Etcd transaction
+// which asserts the JournalSpec is unchanged. If |fn| returns false, no
+// update is made. On a successful update, updateJournalSpec reads through
+// the revision of the update before returning it. A zero revision is
+// returned if no update was made, along with a JOURNAL_NOT_FOUND or
+// ETCD_TRANSACTION_FAILED Status if the journal doesn't exist or was
+// concurrently modified.
+func updateJournalSpec(ctx context.Context, etcd clientv3.KV, ks *keyspace.KeySpace,
+	journal pb.Journal, fn func(*pb.JournalSpec) bool) (pb.Status, int64, error) {
+
+	var key = allocator.ItemKey(ks, journal.String())
+	var spec pb.JournalSpec
+	var modRevision int64
+
+	ks.Mu.RLock()
+	if ind, ok := ks.KeyValues.Search(key); ok {
+		spec = *ks.KeyValues[ind].Decoded.(allocator.Item).ItemValue.(*pb.JournalSpec)
+		modRevision = ks.KeyValues[ind].Raw.ModRevision
+	}
+	ks.Mu.RUnlock()
+
+	if modRevision == 0 {
+		return pb.Status_JOURNAL_NOT_FOUND, 0, nil
+	} else if !fn(&spec) {
+		return pb.Status_OK, 0, nil
+	}
+
+	var resp, err = etcd.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(key), "=", modRevision)).
+		Then(clientv3.OpPut(key, spec.MarshalString())).
+		Commit()
+
+	if err != nil {
+		return pb.Status_OK, 0, err
+	} else if !resp.Succeeded {
+		return pb.Status_ETCD_TRANSACTION_FAILED, 0, nil
+	}
+
+	ks.Mu.RLock()
+	err = ks.WaitForRevision(ctx, resp.Header.Revision)
+	ks.Mu.RUnlock()
+
+	return pb.Status_OK, resp.Header.Revision, err
+}
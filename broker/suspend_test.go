@@ -0,0 +1,173 @@
+package broker
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/etcdtest"
+)
+
+func TestSuspendedJournalIsResumedByAppend(t *testing.T) {
+	var ctx, etcd = pb.WithDispatchDefault(context.Background()), etcdtest.TestClient()
+	defer etcdtest.Cleanup()
+
+	var broker = newTestBroker(t, etcd, pb.ProcessSpec_ID{Zone: "local", Suffix: "broker"})
+	setTestJournal(broker, pb.JournalSpec{
+		Name:        "a/journal",
+		Replication: 1,
+		Suspend:     pb.JournalSpec_Suspend{Level: pb.JournalSpec_Suspend_FULL, Offset: 1024},
+	}, broker.id)
+	broker.initialFragmentLoad()
+
+	// A resolution which may not resume the journal returns it as-is.
+	var res, err = broker.svc.resolveOrResume(resolveArgs{ctx: ctx, journal: "a/journal"},
+		func(*pb.JournalSpec) bool { return false })
+	require.NoError(t, err)
+	require.Equal(t, pb.JournalSpec_Suspend_FULL, res.journalSpec.Suspend.Level)
+
+	var chunks = []appendChunk{
+		{req: &pb.AppendRequest{Content: []byte("foo")}},
+		{req: &pb.AppendRequest{}},
+		{err: io.EOF},
+	}
+	var fsm = appendFSM{
+		svc:       broker.svc,
+		ctx:       ctx,
+		req:       pb.AppendRequest{Journal: "a/journal"},
+		mayResume: true,
+	}
+	fsm.run(func() (req *pb.AppendRequest, err error) {
+		req, err, chunks = chunks[0].req, chunks[0].err, chunks[1:]
+		return
+	})
+
+	// Expect the journal was resumed, and the append was written at the
+	// offset of the journal's suspension.
+	require.Equal(t, stateFinished, fsm.state)
+	require.Equal(t, pb.JournalSpec_Suspend{Offset: 1024}, fsm.resolved.journalSpec.Suspend)
+	require.Equal(t, &pb.Fragment{
+		Journal:          "a/journal",
+		Begin:            1024,
+		End:              1027,
+		Sum:              pb.SHA1SumOf("foo"),
+		CompressionCodec: pb.CompressionCodec_SNAPPY,
+	}, fsm.clientFragment)
+
+	// Once the spool is synchronized through the suspension offset,
+	// the offset is cleared.
+	var spool = <-fsm.resolved.replica.spoolCh
+	require.NoError(t, maybeClearSuspendOffset(ctx, broker.svc, fsm.resolved, spool))
+	fsm.resolved.replica.spoolCh <- spool
+
+	res, err = broker.svc.resolver.resolve(resolveArgs{ctx: ctx, journal: "a/journal"})
+	require.NoError(t, err)
+	require.Equal(t, pb.JournalSpec_Suspend{}, res.journalSpec.Suspend)
+
+	broker.cleanup()
+}
+
+func TestSuspendedJournalIsResumedByListFragmentsAndTruncate(t *testing.T) {
+	var ctx, etcd = pb.WithDispatchDefault(context.Background()), etcdtest.TestClient()
+	defer etcdtest.Cleanup()
+
+	var broker = newTestBroker(t, etcd, pb.ProcessSpec_ID{Zone: "local", Suffix: "broker"})
+	var suspend = func(spec *pb.JournalSpec) bool {
+		spec.Suspend = pb.JournalSpec_Suspend{Level: pb.JournalSpec_Suspend_FULL, Offset: 1024}
+		return true
+	}
+	setTestJournal(broker, pb.JournalSpec{Name: "a/journal", Replication: 1}, broker.id)
+	broker.replica("a/journal").index.ReplaceRemote(buildFragmentSet(buildFragmentsFixture()))
+
+	// Case: ListFragments of a suspended journal resumes it.
+	var _, _, err = updateJournalSpec(ctx, etcd, broker.ks, "a/journal", suspend)
+	require.NoError(t, err)
+
+	fragResp, err := broker.client().ListFragments(ctx, &pb.FragmentsRequest{Journal: "a/journal"})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OK, fragResp.Status)
+	require.NotEmpty(t, fragResp.Fragments)
+	require.Equal(t, pb.JournalSpec_Suspend{Offset: 1024}, broker.resolve("a/journal").journalSpec.Suspend)
+
+	// Case: Truncate of a suspended journal resumes it.
+	_, _, err = updateJournalSpec(ctx, etcd, broker.ks, "a/journal", suspend)
+	require.NoError(t, err)
+
+	truncResp, err := broker.client().Truncate(ctx, &pb.TruncateRequest{Journal: "a/journal", Offset: 30})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OK, truncResp.Status)
+	require.Equal(t, int64(30), truncResp.Offset)
+
+	var res = broker.resolve("a/journal")
+	require.Equal(t, pb.JournalSpec_Suspend{Offset: 1024}, res.journalSpec.Suspend)
+	require.Equal(t, int64(30), res.journalSpec.MinOffset)
+
+	broker.cleanup()
+}
+
+func TestUpdateJournalSpecCases(t *testing.T) {
+	var ctx, etcd = pb.WithDispatchDefault(context.Background()), etcdtest.TestClient()
+	defer etcdtest.Cleanup()
+
+	var broker = newTestBroker(t, etcd, pb.ProcessSpec_ID{Zone: "local", Suffix: "broker"})
+	setTestJournal(broker, pb.JournalSpec{Name: "a/journal", Replication: 1}, broker.id)
+
+	var suspend = func(spec *pb.JournalSpec) bool {
+		spec.Suspend = pb.JournalSpec_Suspend{Level: pb.JournalSpec_Suspend_FULL, Offset: 123}
+		return true
+	}
+
+	// Case: journal doesn't exist.
+	var status, rev, err = updateJournalSpec(ctx, etcd, broker.ks, "a/missing/journal", suspend)
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_JOURNAL_NOT_FOUND, status)
+	require.Equal(t, int64(0), rev)
+
+	// Case: |fn| declines to update the journal.
+	status, rev, err = updateJournalSpec(ctx, etcd, broker.ks, "a/journal",
+		func(*pb.JournalSpec) bool { return false })
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OK, status)
+	require.Equal(t, int64(0), rev)
+
+	// Case: journal is updated, and the update is read through.
+	status, rev, err = updateJournalSpec(ctx, etcd, broker.ks, "a/journal", suspend)
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OK, status)
+	require.NotZero(t, rev)
+
+	var res = broker.resolve("a/journal")
+	require.Equal(t, rev, res.Etcd.Revision)
+	require.Equal(t, pb.JournalSpec_Suspend{Level: pb.JournalSpec_Suspend_FULL, Offset: 123},
+		res.journalSpec.Suspend)
+
+	// Case: an Apply of the journal retains its current Suspend.
+	var spec = *res.journalSpec
+	spec.Suspend = pb.JournalSpec_Suspend{}
+	spec.MaxAppendRate = 1234
+
+	applyResp, err := broker.client().Apply(ctx, &pb.ApplyRequest{
+		Changes: []pb.ApplyRequest_Change{{Upsert: &spec, ExpectModRevision: -1}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OK, applyResp.Status)
+
+	res = broker.resolve("a/journal")
+	require.Equal(t, int64(1234), res.journalSpec.MaxAppendRate)
+	require.Equal(t, pb.JournalSpec_Suspend_FULL, res.journalSpec.Suspend.Level)
+
+	// Case: the journal is resumed, retaining its suspension offset.
+	rev, err = broker.svc.resumeJournal(ctx, "a/journal")
+	require.NoError(t, err)
+	require.NotZero(t, rev)
+	require.Equal(t, pb.JournalSpec_Suspend{Offset: 123}, broker.resolve("a/journal").journalSpec.Suspend)
+
+	// Case: resuming a journal which isn't suspended is a no-op.
+	rev, err = broker.svc.resumeJournal(ctx, "a/journal")
+	require.NoError(t, err)
+	require.Zero(t, rev)
+
+	broker.cleanup()
+}
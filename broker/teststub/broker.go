@@ -10,6 +10,8 @@ import (
 	pb "go.gazette.dev/core/broker/protocol"
 	"go.gazette.dev/core/server"
 	"go.gazette.dev/core/task"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Broker stubs the read and write loops of broker RPCs, routing them onto
@@ -29,9 +31,15 @@ type Broker struct {
 	AppendReqCh    chan pb.AppendRequest     // Chan from which tests read AppendRequest.
 	AppendRespCh   chan pb.AppendResponse    // Chan to which tests write AppendResponse.
 
-	ListFunc          func(context.Context, *pb.ListRequest) (*pb.ListResponse, error)           // List implementation.
-	ApplyFunc         func(context.Context, *pb.ApplyRequest) (*pb.ApplyResponse, error)         // Apply implementation.
-	ListFragmentsFunc func(context.Context, *pb.FragmentsRequest) (*pb.FragmentsResponse, error) // ListFragments implementation.
+	ListFunc          func(context.Context, *pb.ListRequest) (*pb.ListResponse, error)                 // List implementation.
+	WatchFunc         func(*pb.ListRequest, pb.Journal_WatchServer) error                              // Watch implementation.
+	ApplyFunc         func(context.Context, *pb.ApplyRequest) (*pb.ApplyResponse, error)               // Apply implementation.
+	HistoryFunc       func(context.Context, *pb.HistoryRequest) (*pb.HistoryResponse, error)           // History implementation.
+	ListFragmentsFunc func(context.Context, *pb.FragmentsRequest) (*pb.FragmentsResponse, error)       // ListFragments implementation.
+	TruncateFunc      func(context.Context, *pb.TruncateRequest) (*pb.TruncateResponse, error)         // Truncate implementation.
+	StatFunc          func(context.Context, *pb.StatRequest) (*pb.StatResponse, error)                 // Stat implementation.
+	ListSchemasFunc   func(context.Context, *pb.ListSchemasRequest) (*pb.ListSchemasResponse, error)   // ListSchemas implementation.
+	ApplySchemasFunc  func(context.Context, *pb.ApplySchemasRequest) (*pb.ApplySchemasResponse, error) // ApplySchemas implementation.
 }
 
 // NewBroker returns a Broker instance served by a local gRPC server.
@@ -184,16 +192,50 @@ func (b *Broker) List(ctx context.Context, req *pb.ListRequest) (*pb.ListRespons
 	return b.ListFunc(ctx, req)
 }
 
+// Watch implements the JournalServer interface by proxying through WatchFunc.
+// If WatchFunc is nil, Watch is unimplemented.
+func (b *Broker) Watch(req *pb.ListRequest, srv pb.Journal_WatchServer) error {
+	if b.WatchFunc == nil {
+		return status.Error(codes.Unimplemented, "Watch is not implemented")
+	}
+	return b.WatchFunc(req, srv)
+}
+
 // Apply implements the JournalServer interface by proxying through ApplyFunc.
 func (b *Broker) Apply(ctx context.Context, req *pb.ApplyRequest) (*pb.ApplyResponse, error) {
 	return b.ApplyFunc(ctx, req)
 }
 
+// History implements the JournalServer interface by proxying through HistoryFunc.
+func (b *Broker) History(ctx context.Context, req *pb.HistoryRequest) (*pb.HistoryResponse, error) {
+	return b.HistoryFunc(ctx, req)
+}
+
 // ListFragments implements the JournalServer interface by proxying through FragmentsFunc.
 func (b *Broker) ListFragments(ctx context.Context, req *pb.FragmentsRequest) (*pb.FragmentsResponse, error) {
 	return b.ListFragmentsFunc(ctx, req)
 }
 
+// Truncate implements the JournalServer interface by proxying through TruncateFunc.
+func (b *Broker) Truncate(ctx context.Context, req *pb.TruncateRequest) (*pb.TruncateResponse, error) {
+	return b.TruncateFunc(ctx, req)
+}
+
+// Stat implements the JournalServer interface by proxying through StatFunc.
+func (b *Broker) Stat(ctx context.Context, req *pb.StatRequest) (*pb.StatResponse, error) {
+	return b.StatFunc(ctx, req)
+}
+
+// ListSchemas implements the JournalServer interface by proxying through ListSchemasFunc.
+func (b *Broker) ListSchemas(ctx context.Context, req *pb.ListSchemasRequest) (*pb.ListSchemasResponse, error) {
+	return b.ListSchemasFunc(ctx, req)
+}
+
+// ApplySchemas implements the JournalServer interface by proxying through ApplySchemasFunc.
+func (b *Broker) ApplySchemas(ctx context.Context, req *pb.ApplySchemasRequest) (*pb.ApplySchemasResponse, error) {
+	return b.ApplySchemasFunc(ctx, req)
+}
+
 func init() { pb.RegisterGRPCDispatcher("local") }
 
 const timeout = time.Minute
@@ -0,0 +1,99 @@
+package broker
+
+import (
+	"context"
+	"net"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	pb "go.gazette.dev/core/broker/protocol"
+	"google.golang.org/grpc/peer"
+)
+
+// Truncate dispatches the JournalServer.Truncate API.
+func (svc *Service) Truncate(ctx context.Context, req *pb.TruncateRequest) (resp *pb.TruncateResponse, err error) {
+	var res *resolution
+	defer instrumentJournalServerRPC("Truncate", &err, &res)()
+
+	defer func() {
+		if err != nil {
+			var addr net.Addr
+			if p, ok := peer.FromContext(ctx); ok {
+				addr = p.Addr
+			}
+			log.WithFields(log.Fields{"err": err, "req": req, "client": addr}).
+				Warn("served Truncate RPC failed")
+		}
+	}()
+
+	var claims pb.Claims
+	if err = req.Validate(); err != nil {
+		return nil, err
+	} else if claims, err = svc.verify(ctx); err != nil {
+		return nil, err
+	}
+
+	// Truncate is served by the journal primary, which has the authoritative
+	// view of the journal's write head. A suspended journal has no primary,
+	// and is resumed to serve the request.
+	res, err = svc.resolveOrResume(resolveArgs{
+		ctx:            ctx,
+		journal:        req.Journal,
+		mayProxy:       true,
+		requirePrimary: true,
+		proxyHeader:    req.Header,
+		claims:         claims,
+		require:        pb.Capability_APPLY,
+	}, mayAlwaysResume)
+
+	if err != nil {
+		return nil, err
+	} else if res.status != pb.Status_OK {
+		return &pb.TruncateResponse{Status: res.status, Header: res.Header}, nil
+	} else if res.replica == nil {
+		req.Header = &res.Header // Attach resolved Header to |req|, which we'll forward.
+		if ctx, err = svc.authorize(ctx, claims); err != nil {
+			return nil, err
+		}
+		ctx = pb.WithDispatchRoute(ctx, req.Header.Route, req.Header.ProcessId)
+		return svc.jc.Truncate(ctx, req)
+	}
+
+	// Ensure an initial refresh of the remote store(s) has completed,
+	// so that the index reflects the journal's true write head.
+	select {
+	case <-res.replica.index.FirstRefreshCh():
+		// Pass.
+	case <-ctx.Done():
+		return nil, errors.WithMessage(ctx.Err(), "waiting for index refresh")
+	}
+
+	resp = &pb.TruncateResponse{
+		Status: pb.Status_OK,
+		Header: res.Header,
+	}
+	if req.Offset > res.replica.index.EndOffset() {
+		resp.Status = pb.Status_OFFSET_NOT_YET_AVAILABLE
+		resp.Offset = res.replica.index.MinOffset()
+		return resp, nil
+	}
+
+	var rev int64
+	resp.Status, rev, err = updateJournalSpec(ctx, svc.etcd, svc.resolver.state.KS, req.Journal.StripMeta(),
+		func(spec *pb.JournalSpec) bool {
+			if spec.MinOffset >= req.Offset {
+				resp.Offset = spec.MinOffset // Already truncated through |req.Offset|.
+				return false
+			}
+			spec.MinOffset = req.Offset
+			return true
+		})
+
+	// On success, we've read through our own Etcd write, which also updates
+	// the minimum offset of the local replica index.
+	if rev != 0 {
+		resp.Offset = req.Offset
+		resp.Header.Etcd.Revision = rev
+	}
+	return resp, err
+}
@@ -0,0 +1,102 @@
+package broker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/etcdtest"
+)
+
+func TestTruncateCases(t *testing.T) {
+	var ctx, etcd = pb.WithDispatchDefault(context.Background()), etcdtest.TestClient()
+	defer etcdtest.Cleanup()
+
+	var broker = newTestBroker(t, etcd, pb.ProcessSpec_ID{Zone: "local", Suffix: "broker"})
+	setTestJournal(broker, pb.JournalSpec{Name: "a/journal", Replication: 1}, broker.id)
+	broker.replica("a/journal").index.ReplaceRemote(buildFragmentSet(buildFragmentsFixture()))
+
+	var fetchSpec = func() pb.JournalSpec {
+		var resp, err = broker.client().List(ctx, &pb.ListRequest{
+			Selector: pb.LabelSelector{Include: pb.MustLabelSet("name", "a/journal")},
+		})
+		require.NoError(t, err)
+		return resp.Journals[0].Spec
+	}
+
+	// Case: Request validation error.
+	var _, err = broker.client().Truncate(ctx, &pb.TruncateRequest{Journal: "a/journal", Offset: -1})
+	require.EqualError(t, err, `rpc error: code = Unknown desc = invalid Offset (-1; expected >= 0)`)
+
+	// Case: Resolution error.
+	resp, err := broker.client().Truncate(ctx, &pb.TruncateRequest{Journal: "a/missing/journal"})
+	require.NoError(t, err)
+	require.Equal(t, &pb.TruncateResponse{
+		Status: pb.Status_JOURNAL_NOT_FOUND,
+		Header: *broker.header("a/missing/journal"),
+	}, resp)
+
+	// Case: Offset is beyond the journal write head.
+	resp, err = broker.client().Truncate(ctx, &pb.TruncateRequest{Journal: "a/journal", Offset: 1000})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OFFSET_NOT_YET_AVAILABLE, resp.Status)
+
+	// Case: Truncation succeeds, and is reflected in the spec and replica index.
+	resp, err = broker.client().Truncate(ctx, &pb.TruncateRequest{Journal: "a/journal", Offset: 30})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OK, resp.Status)
+	require.Equal(t, int64(30), resp.Offset)
+
+	require.Equal(t, int64(30), fetchSpec().MinOffset)
+	require.Equal(t, int64(30), broker.replica("a/journal").index.MinOffset())
+
+	// Case: A lesser offset is a no-op, which returns the effective offset.
+	resp, err = broker.client().Truncate(ctx, &pb.TruncateRequest{Journal: "a/journal", Offset: 10})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OK, resp.Status)
+	require.Equal(t, int64(30), resp.Offset)
+
+	// Case: An Apply of the spec doesn't roll back its MinOffset.
+	var spec = fetchSpec()
+	spec.MinOffset = 0
+	spec.MaxAppendRate = 1234
+
+	applyResp, err := broker.client().Apply(ctx, &pb.ApplyRequest{
+		Changes: []pb.ApplyRequest_Change{{Upsert: &spec, ExpectModRevision: -1}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OK, applyResp.Status)
+
+	spec = fetchSpec()
+	require.Equal(t, int64(1234), spec.MaxAppendRate)
+	require.Equal(t, int64(30), spec.MinOffset)
+
+	// Case: Proxy request to peer.
+	var peer = newMockBroker(t, etcd, pb.ProcessSpec_ID{Zone: "peer", Suffix: "broker"})
+	setTestJournal(broker, pb.JournalSpec{Name: "proxy/journal", Replication: 1}, peer.id)
+	var proxyHeader = broker.header("proxy/journal")
+
+	peer.TruncateFunc = func(ctx context.Context, req *pb.TruncateRequest) (*pb.TruncateResponse, error) {
+		require.Equal(t, &pb.TruncateRequest{
+			Header:  proxyHeader,
+			Journal: "proxy/journal",
+			Offset:  1234,
+		}, req)
+		return &pb.TruncateResponse{
+			Status: pb.Status_OK,
+			Header: *proxyHeader,
+			Offset: 1234,
+		}, nil
+	}
+
+	resp, err = broker.client().Truncate(ctx, &pb.TruncateRequest{Journal: "proxy/journal", Offset: 1234})
+	require.NoError(t, err)
+	require.Equal(t, &pb.TruncateResponse{
+		Status: pb.Status_OK,
+		Header: *proxyHeader,
+		Offset: 1234,
+	}, resp)
+
+	broker.cleanup()
+}
@@ -0,0 +1,95 @@
+package gazctlcmd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	mbp "go.gazette.dev/core/mainboilerplate"
+	"gopkg.in/yaml.v2"
+)
+
+// writeSpecDiff writes a per-field diff of the |current| and |applied|
+// specification of item |name|. |current| is nil if the item doesn't yet
+// exist, and |applied| is nil if the item is to be deleted.
+func writeSpecDiff(w io.Writer, name string, current, applied map[string]string, revision, expectRevision int64) {
+	switch {
+	case current == nil && applied == nil:
+		fmt.Fprintf(w, "- %s (does not exist)\n", name)
+		return
+	case current == nil:
+		fmt.Fprintf(w, "+ %s\n", name)
+	case applied == nil:
+		fmt.Fprintf(w, "- %s (revision %d)\n", name, revision)
+	default:
+		fmt.Fprintf(w, "~ %s (revision %d)\n", name, revision)
+	}
+
+	if expectRevision != -1 && expectRevision != revision {
+		fmt.Fprintf(w, "    ! expected revision %d, but current revision is %d\n", expectRevision, revision)
+	}
+
+	var paths []string
+	for p := range current {
+		paths = append(paths, p)
+	}
+	for p := range applied {
+		if _, ok := current[p]; !ok {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	for _, p := range paths {
+		var from, fromOK = current[p]
+		var to, toOK = applied[p]
+
+		switch {
+		case applied == nil:
+			// Fields of deleted items are elided.
+		case !fromOK:
+			fmt.Fprintf(w, "    + %s: %s\n", p, to)
+		case !toOK:
+			fmt.Fprintf(w, "    - %s: %s\n", p, from)
+		case from != to:
+			fmt.Fprintf(w, "    ~ %s: %s -> %s\n", p, from, to)
+		}
+	}
+}
+
+// flattenSpecFields returns the fields of YAML-encoded specification |spec|,
+// keyed on their dotted path (eg, "fragment.length" or "labels[0].name").
+// It returns nil if |spec| is a nil pointer.
+func flattenSpecFields(spec interface{}) map[string]string {
+	var b, err = yaml.Marshal(spec)
+	mbp.Must(err, "failed to encode specification")
+
+	var doc interface{}
+	mbp.Must(yaml.Unmarshal(b, &doc), "failed to decode specification")
+
+	if doc == nil {
+		return nil
+	}
+	var out = make(map[string]string)
+	flattenFields(out, "", doc)
+	return out
+}
+
+func flattenFields(out map[string]string, path string, v interface{}) {
+	switch vv := v.(type) {
+	case map[interface{}]interface{}:
+		for k, c := range vv {
+			var p = fmt.Sprint(k)
+			if path != "" {
+				p = path + "." + p
+			}
+			flattenFields(out, p, c)
+		}
+	case []interface{}:
+		for i, c := range vv {
+			flattenFields(out, fmt.Sprintf("%s[%d]", path, i), c)
+		}
+	default:
+		out[path] = fmt.Sprint(vv)
+	}
+}
@@ -0,0 +1,53 @@
+package gazctlcmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+)
+
+func TestSpecDiffRendering(t *testing.T) {
+	var current = &pb.JournalSpec{
+		Name:        "a/journal",
+		Replication: 1,
+		LabelSet:    pb.MustLabelSet("foo", "bar", "baz", "1"),
+	}
+	var applied = &pb.JournalSpec{
+		Name:        "a/journal",
+		Replication: 3,
+		LabelSet:    pb.MustLabelSet("foo", "bar"),
+		Flags:       pb.JournalSpec_O_RDONLY,
+	}
+	var fields = flattenSpecFields(current)
+	require.Equal(t, map[string]string{
+		"name":            "a/journal",
+		"replication":     "1",
+		"labels[0].name":  "baz",
+		"labels[0].value": "1",
+		"labels[1].name":  "foo",
+		"labels[1].value": "bar",
+	}, fields)
+	require.Nil(t, flattenSpecFields((*pb.JournalSpec)(nil)))
+
+	var buf bytes.Buffer
+	writeSpecDiff(&buf, "a/journal", fields, flattenSpecFields(applied), 10, 10)
+	writeSpecDiff(&buf, "a/journal", fields, nil, 10, 5)
+	writeSpecDiff(&buf, "a/created", nil, map[string]string{"name": "a/created"}, 0, 0)
+	writeSpecDiff(&buf, "a/missing", nil, nil, 0, 1)
+
+	require.Equal(t, `~ a/journal (revision 10)
+    + flags: O_RDONLY
+    ~ labels[0].name: baz -> foo
+    ~ labels[0].value: 1 -> bar
+    - labels[1].name: foo
+    - labels[1].value: bar
+    ~ replication: 1 -> 3
+- a/journal (revision 10)
+    ! expected revision 5, but current revision is 10
++ a/created
+    + name: a/created
+- a/missing (does not exist)
+`, buf.String())
+}
@@ -0,0 +1,71 @@
+package gazctlcmd
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.gazette.dev/core/auth"
+	pb "go.gazette.dev/core/broker/protocol"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAuthTokenIssue(t *testing.T) {
+	var path = filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, ioutil.WriteFile(path,
+		[]byte(base64.StdEncoding.EncodeToString([]byte("secret"))), 0600))
+
+	var keyed, err = auth.NewKeyedAuthFromFile(path)
+	require.NoError(t, err)
+
+	var verify = func(token string) pb.Claims {
+		var ctx = metadata.NewIncomingContext(context.Background(),
+			metadata.Pairs("authorization", "Bearer "+token))
+		var claims, err = keyed.Verify(ctx)
+		require.NoError(t, err)
+		return claims
+	}
+
+	var cmd = cmdAuthToken{
+		KeysFile:   path,
+		Subject:    "my-service",
+		Capability: "read,APPEND",
+		Selector:   "prefix=examples/foobar/",
+		TTL:        time.Hour,
+	}
+	token, err := cmd.issue()
+	require.NoError(t, err)
+
+	var claims = verify(token)
+	require.Equal(t, "my-service", claims.Subject)
+	require.Equal(t, pb.Capability_READ|pb.Capability_APPEND, claims.Capability)
+	require.Equal(t, "prefix=examples/foobar/", claims.Selector.String())
+	require.WithinDuration(t, time.Now().Add(time.Hour), claims.ExpiresAt, time.Minute)
+
+	// A zero TTL issues a token which doesn't expire.
+	cmd.TTL = 0
+	token, err = cmd.issue()
+	require.NoError(t, err)
+	require.True(t, verify(token).ExpiresAt.IsZero())
+
+	// Invalid flags are errors.
+	cmd.Capability = "read,frobulate"
+	_, err = cmd.issue()
+	require.EqualError(t, err, "parsing --capability: unknown capability (frobulate)")
+
+	cmd.Capability = ""
+	_, err = cmd.issue()
+	require.EqualError(t, err, "--capability must grant at least one capability")
+
+	cmd.Capability, cmd.Selector = "READ", "foo=bar,,"
+	_, err = cmd.issue()
+	require.Error(t, err)
+
+	cmd.Selector, cmd.KeysFile = "", filepath.Join(t.TempDir(), "missing")
+	_, err = cmd.issue()
+	require.Error(t, err)
+}
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 
+	"go.gazette.dev/core/broker/envelope"
 	"go.gazette.dev/core/broker/protocol"
 	mbp "go.gazette.dev/core/mainboilerplate"
 	"gopkg.in/yaml.v2"
@@ -17,16 +18,29 @@ const (
 In the event that this command generates more changes than are possible in a
 single Etcd transaction given the current server configuration (default 128),
 gazctl supports a flag which will send changes in batches of at most
---max-txn-size. However, this means the entire apply is no longer issued as
-a single Etcd transaction and it should therefore be used with caution.
+--max-txn-size Etcd operations. Each change also records a revision of its
+specification, and uses two operations of the transaction. However, this means
+the entire apply is no longer issued as a single Etcd transaction and it should
+therefore be used with caution.
 If possible, prefer to use label selectors to limit the number of changes.`
+
+	// applyOpsPerChange is the number of Etcd operations used by each applied
+	// change: one to apply the specification, and another to record its revision.
+	applyOpsPerChange = 2
 )
 
 var (
+	AuthCfg = new(struct {
+		BaseConfig
+	})
 	JournalsCfg = new(struct {
 		BaseConfig
 		Broker mbp.ClientConfig `group:"Broker" namespace:"broker" env-namespace:"BROKER"`
 	})
+	SchemasCfg = new(struct {
+		BaseConfig
+		Broker mbp.ClientConfig `group:"Broker" namespace:"broker" env-namespace:"BROKER"`
+	})
 	ShardsCfg = new(struct {
 		BaseConfig
 		Consumer mbp.ClientConfig `group:"Consumer" namespace:"consumer" env-namespace:"CONSUMER"`
@@ -40,7 +54,8 @@ var (
 // BaseConfig for gazctl
 type BaseConfig struct {
 	mbp.ZoneConfig
-	Log mbp.LogConfig `group:"Logging" namespace:"log" env-namespace:"LOG"`
+	Keyring string        `long:"keyring" env:"KEYRING" description:"Path to a keyring file of the keys which encrypt and decrypt journal fragments (optional)"`
+	Log     mbp.LogConfig `group:"Logging" namespace:"log" env-namespace:"LOG"`
 }
 
 // ListConfig is common configuration of list operations.
@@ -56,14 +71,15 @@ type ListConfig struct {
 // ApplyConfig is common configuration of apply operations.
 type ApplyConfig struct {
 	SpecsPath  string `long:"specs" default:"-" description:"Input specifications path to apply. Use '-' for stdin"`
-	DryRun     bool   `long:"dry-run" description:"Perform a dry-run of the apply"`
-	MaxTxnSize int    `long:"max-txn-size" default:"0" description:"maximum number of specs to be processed within an apply transaction. If 0, the default, all changes are issued in a single transaction"`
+	DryRun     bool   `long:"dry-run" description:"Perform a dry-run of the apply, which is validated but not committed"`
+	Diff       bool   `long:"diff" description:"Print a diff of current and applied specifications, without applying"`
+	MaxTxnSize int    `long:"max-txn-size" default:"0" description:"maximum number of Etcd operations within an apply transaction. Each change uses two operations. If 0, the default, all changes are issued in a single transaction"`
 }
 
 // EditConfig is common configuration for exit operations.
 type EditConfig struct {
 	Selector   string `long:"selector" short:"l" required:"true" description:"Label Selector query to filter on" no-ini:"true"`
-	MaxTxnSize int    `long:"max-txn-size" default:"0" description:"maximum number of specs to be processed within an apply transaction. If 0, the default, all changes are issued in a single transaction"`
+	MaxTxnSize int    `long:"max-txn-size" default:"0" description:"maximum number of Etcd operations within an apply transaction. Each change uses two operations. If 0, the default, all changes are issued in a single transaction"`
 }
 
 type pruneConfig struct {
@@ -94,4 +110,21 @@ func startup(baseConfig BaseConfig) {
 	mbp.InitLog(baseConfig.Log)
 	protocol.RegisterGRPCDispatcher(baseConfig.Zone)
 
+	if baseConfig.Keyring != "" {
+		var kr, err = envelope.NewKeyring(baseConfig.Keyring)
+		mbp.Must(err, "failed to load keyring")
+		envelope.DefaultKMS = kr
+	}
+}
+
+// changesPerTxn returns the number of changes to apply within each
+// transaction, given a --max-txn-size in Etcd operations. Each transaction
+// applies at least one change.
+func changesPerTxn(maxTxnSize int) int {
+	if maxTxnSize == 0 {
+		return 0
+	} else if maxTxnSize < applyOpsPerChange {
+		return 1
+	}
+	return maxTxnSize / applyOpsPerChange
 }
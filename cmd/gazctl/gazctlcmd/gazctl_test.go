@@ -0,0 +1,22 @@
+package gazctlcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangesPerTxn(t *testing.T) {
+	for _, tc := range []struct {
+		maxTxnSize, expect int
+	}{
+		{0, 0},
+		{1, 1},
+		{2, 1},
+		{3, 1},
+		{128, 64},
+		{129, 64},
+	} {
+		require.Equal(t, tc.expect, changesPerTxn(tc.maxTxnSize))
+	}
+}
@@ -2,6 +2,7 @@ package gazctlcmd
 
 import (
 	"context"
+	"io"
 	"os"
 
 	"github.com/gogo/protobuf/proto"
@@ -44,6 +45,11 @@ journals or parents thereof in the hierarchy. Note that deleted parent prefixes
 will cascade only to JournalSpecs *explicitly listed* as children of the prefix
 in the YAML, and not to other JournalSpecs which may exist with the prefix but
 are not enumerated.
+
+Use --diff to print a per-field diff of the current JournalSpecs and those of
+the YAML hierarchy, without applying any changes. Use --dry-run to print the
+ApplyRequest and have brokers validate it, including its expected revisions,
+without committing it.
 `+maxTxnSizeWarning, &cmdJournalsApply{})
 }
 
@@ -55,22 +61,51 @@ func (cmd *cmdJournalsApply) Execute([]string) error {
 	mbp.Must(cmd.decode(&tree), "failed to decode journal tree")
 	mbp.Must(tree.Validate(), "journal tree failed to validate")
 
+	var ctx = context.Background()
+	if cmd.Diff {
+		writeJournalSpecDiffs(os.Stdout, &tree)
+		return nil
+	}
+
 	var req = newJournalSpecApplyRequest(&tree)
 	mbp.Must(req.Validate(), "failed to validate ApplyRequest")
 
 	if cmd.DryRun {
 		_ = proto.MarshalText(os.Stdout, req)
-		return nil
+		req.DryRun = true
 	}
 
-	var ctx = context.Background()
-	var resp, err = client.ApplyJournalsInBatches(ctx, JournalsCfg.Broker.MustJournalClient(ctx), req, cmd.MaxTxnSize)
+	var resp, err = client.ApplyJournalsInBatches(ctx, JournalsCfg.Broker.MustJournalClient(ctx), req, changesPerTxn(cmd.MaxTxnSize))
 	mbp.Must(err, "failed to apply journals")
-	log.WithField("revision", resp.Header.Etcd.Revision).Info("successfully applied")
 
+	if cmd.DryRun {
+		log.WithField("revision", resp.Header.Etcd.Revision).Info("dry-run succeeded")
+	} else {
+		log.WithField("revision", resp.Header.Etcd.Revision).Info("successfully applied")
+	}
 	return nil
 }
 
+// writeJournalSpecDiffs lists the current JournalSpecs of journals in the
+// journal specification |tree|, and writes their diffs with the |tree|.
+func writeJournalSpecDiffs(w io.Writer, tree *journalspace.Node) {
+	var req pb.ListRequest
+	_ = tree.WalkTerminalNodes(func(node *journalspace.Node) error {
+		req.Selector.Include.AddValue("name", node.Spec.Name.String())
+		return nil
+	})
+
+	var ctx = context.Background()
+	var resp, err = client.ListAllJournals(ctx, JournalsCfg.Broker.MustJournalClient(ctx), req)
+	mbp.Must(err, "failed to list journals")
+
+	var current = journalspace.FromListResponse(resp)
+	for _, diff := range journalspace.DiffTrees(&current, tree) {
+		writeSpecDiff(w, diff.Name.String(), flattenSpecFields(diff.Current),
+			flattenSpecFields(diff.Applied), diff.Revision, diff.ExpectRevision)
+	}
+}
+
 // newJournalSpecApplyRequest flattens a journal specification tree into
 // concrete JournalSpecs and builds the request.
 func newJournalSpecApplyRequest(tree *journalspace.Node) *pb.ApplyRequest {
@@ -0,0 +1,137 @@
+package gazctlcmd
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/fragment"
+	pb "go.gazette.dev/core/broker/protocol"
+	mbp "go.gazette.dev/core/mainboilerplate"
+)
+
+type cmdJournalsCompact struct {
+	Selector   string        `long:"selector" short:"l" required:"true" description:"Label Selector query to filter on"`
+	DryRun     bool          `long:"dry-run" description:"Log fragments which would be compacted, without merging or removing them"`
+	TargetSize int64         `long:"target-size" description:"Target content size of compacted fragments. If zero, the fragment length of each journal is used"`
+	Timeout    time.Duration `long:"timeout" default:"5m" description:"Maximum duration to wait for brokers to list compacted fragments, before giving up on removing the fragments they replace"`
+}
+
+func init() {
+	CommandRegistry.AddCommand("journals", "compact", "Merge small adjacent fragments into larger ones", `
+Compact persisted fragments of matching journals, by merging runs of small and
+adjacent fragments into larger fragments.
+
+Short flush intervals and broker churn can leave a journal with many small
+fragments, which are slow to list and to read. Compaction reads each run of
+adjacent fragments of a fragment store, verifying the SHA1 sum of each, and
+writes their combined content as a single fragment to the same store, encoded
+with the journal's current compression codec and dictionary, and encrypted
+under its current encryption key, if any. The combined fragment is read back
+and verified, and once brokers list it, the fragments it replaces are removed.
+
+Readers don't observe a gap during compaction: a listed fragment which covers
+the offsets of other fragments replaces them, and fragments are removed only
+after brokers list the fragment which replaces them. Brokers list fragment
+stores on each journal's "refresh_interval", and --timeout bounds how long to
+wait for them to do so.
+
+Runs of fragments are merged until the next fragment would exceed
+--target-size, which defaults to the journal's fragment "length".
+
+Use --selector to supply a LabelSelector to select journals to compact.
+See "journals list --help" for details and examples.
+`, &cmdJournalsCompact{})
+}
+
+func (cmd *cmdJournalsCompact) Execute([]string) error {
+	startup(JournalsCfg.BaseConfig)
+
+	var ctx = context.Background()
+	var resp = listJournals(cmd.Selector)
+	if len(resp.Journals) == 0 {
+		log.WithField("selector", cmd.Selector).Panic("no journals match selector")
+	}
+
+	for _, j := range resp.Journals {
+		var target = cmd.TargetSize
+		if target == 0 {
+			target = j.Spec.Fragment.Length
+		}
+		var merged, replaced []pb.Fragment
+
+		for _, run := range selectCompactions(fetchFragments(ctx, j.Spec.Name, false), target) {
+			log.WithFields(log.Fields{
+				"journal":   j.Spec.Name,
+				"store":     run[0].BackingStore,
+				"begin":     run[0].Begin,
+				"end":       run[len(run)-1].End,
+				"fragments": len(run),
+			}).Info("compacting fragments")
+
+			if cmd.DryRun {
+				continue
+			}
+			var f, err = fragment.Merge(ctx, run, run[0].BackingStore,
+				j.Spec.Fragment.CompressionCodec, j.Spec.Fragment.CompressionDictionary,
+				j.Spec.Fragment.EncryptionKey)
+			mbp.Must(err, "failed to merge fragments", "journal", j.Spec.Name)
+
+			merged = append(merged, f)
+			replaced = append(replaced, run...)
+		}
+
+		if len(merged) != 0 {
+			awaitListedFragments(ctx, j.Spec.Name, merged, cmd.Timeout)
+		}
+		for _, f := range replaced {
+			var err = fragment.Remove(ctx, f)
+			mbp.Must(err, "error removing compacted fragment", "path", f.ContentPath(), "store", f.BackingStore)
+		}
+
+		log.WithFields(log.Fields{
+			"journal":   j.Spec.Name,
+			"merged":    len(merged),
+			"compacted": len(replaced),
+		}).Info("compacted journal")
+	}
+	return nil
+}
+
+// selectCompactions returns runs of persisted and adjacent |fragments| of a
+// common store, each having at least two fragments and a total content size
+// of no more than |target|.
+func selectCompactions(fragments []pb.FragmentsResponse__Fragment, target int64) [][]pb.Fragment {
+	var out [][]pb.Fragment
+	var run []pb.Fragment
+	var size int64
+
+	var flush = func() {
+		if len(run) > 1 {
+			out = append(out, run)
+		}
+		run, size = nil, 0
+	}
+
+	for _, f := range fragments {
+		var spec = f.Spec
+
+		if spec.BackingStore == "" || spec.ContentLength() >= target {
+			flush()
+			continue
+		}
+		if len(run) != 0 {
+			var last = run[len(run)-1]
+
+			if last.End != spec.Begin || last.BackingStore != spec.BackingStore ||
+				size+spec.ContentLength() > target {
+				flush()
+			}
+		}
+		run = append(run, spec)
+		size += spec.ContentLength()
+	}
+	flush()
+
+	return out
+}
@@ -0,0 +1,50 @@
+package gazctlcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+)
+
+func TestSelectCompactions(t *testing.T) {
+	var fixture = func(begin, end int64, store pb.FragmentStore) pb.FragmentsResponse__Fragment {
+		return pb.FragmentsResponse__Fragment{Spec: pb.Fragment{
+			Journal:      "a/journal",
+			Begin:        begin,
+			End:          end,
+			BackingStore: store,
+		}}
+	}
+	var fragments = []pb.FragmentsResponse__Fragment{
+		fixture(0, 10, "s3://bucket/"),
+		fixture(10, 20, "s3://bucket/"),
+		fixture(20, 30, "s3://bucket/"),
+		fixture(30, 40, "s3://bucket/"),
+		fixture(40, 140, "s3://bucket/"), // Too large.
+		fixture(140, 150, "s3://bucket/"),
+		fixture(150, 160, "gs://other/"), // Different store.
+		fixture(160, 170, "gs://other/"),
+		fixture(175, 180, "gs://other/"), // Not adjacent.
+		fixture(180, 190, "gs://other/"),
+		fixture(190, 200, ""), // Not persisted.
+		fixture(200, 210, "gs://other/"),
+	}
+	var verify = func(target int64, expect ...[2]int64) {
+		var actual [][2]int64
+		for _, run := range selectCompactions(fragments, target) {
+			for i := 1; i != len(run); i++ {
+				require.Equal(t, run[i-1].End, run[i].Begin)
+				require.Equal(t, run[i-1].BackingStore, run[i].BackingStore)
+			}
+			actual = append(actual, [2]int64{run[0].Begin, run[len(run)-1].End})
+		}
+		require.Equal(t, expect, actual)
+	}
+
+	verify(1000, [2]int64{0, 150}, [2]int64{150, 170}, [2]int64{175, 190})
+	verify(100, [2]int64{0, 40}, [2]int64{150, 170}, [2]int64{175, 190})
+	verify(25, [2]int64{0, 20}, [2]int64{20, 40}, [2]int64{150, 170}, [2]int64{175, 190})
+	verify(15, [2]int64{175, 190})
+	verify(10)
+}
@@ -59,7 +59,7 @@ func (cmd *cmdJournalsEdit) applySpecs(b []byte) error {
 	}
 
 	var ctx = context.Background()
-	var resp, err = client.ApplyJournalsInBatches(ctx, JournalsCfg.Broker.MustJournalClient(ctx), req, cmd.MaxTxnSize)
+	var resp, err = client.ApplyJournalsInBatches(ctx, JournalsCfg.Broker.MustJournalClient(ctx), req, changesPerTxn(cmd.MaxTxnSize))
 	mbp.Must(err, "failed to apply journals")
 	log.WithField("revision", resp.Header.Etcd.Revision).Info("successfully applied")
 
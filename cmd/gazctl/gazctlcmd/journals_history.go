@@ -0,0 +1,98 @@
+package gazctlcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/olekukonko/tablewriter"
+	"go.gazette.dev/core/broker/client"
+	pb "go.gazette.dev/core/broker/protocol"
+	mbp "go.gazette.dev/core/mainboilerplate"
+)
+
+type cmdJournalsHistory struct {
+	Journal string `long:"journal" short:"j" description:"Name of the journal. If omitted, revisions of all journals are shown"`
+	Format  string `long:"format" short:"o" choice:"table" choice:"diff" choice:"json" choice:"proto" default:"table" description:"Output format"`
+}
+
+func init() {
+	CommandRegistry.AddCommand("journals", "history", "Show the revision history of journal specifications", `
+Show recorded revisions of JournalSpecs. Each time a JournalSpec is created,
+updated, or deleted through the Apply API, brokers record the change together
+with its Etcd revision, the time at which it was applied, the subject of the
+authorizing claims, and the address of the applying client.
+
+Use --journal to show only revisions of the named journal. Revisions are shown
+in the order in which they were applied.
+
+Results can be output in a variety of --format options:
+table: Prints as a table.
+diff:  Prints the per-field changes of each revision.
+json:  Prints the HistoryResponse encoded as JSON.
+proto: Prints the HistoryResponse in protobuf text format.
+
+The Revision of a recorded change may be passed to "journals rollback" to
+restore the journal to its specification as of that revision.
+
+For example:
+
+>  gazctl journals history --journal my/journal --format diff
+`, &cmdJournalsHistory{})
+}
+
+func (cmd *cmdJournalsHistory) Execute([]string) error {
+	startup(JournalsCfg.BaseConfig)
+
+	var ctx = context.Background()
+	var resp, err = client.JournalHistory(ctx, JournalsCfg.Broker.MustJournalClient(ctx), pb.Journal(cmd.Journal))
+	mbp.Must(err, "failed to fetch journal history", "journal", cmd.Journal)
+
+	switch cmd.Format {
+	case "table":
+		var table = tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Revision", "Journal", "Change", "Applied", "Applied By", "Client"})
+
+		for _, r := range resp.Revisions {
+			table.Append([]string{
+				fmt.Sprintf("%d", r.Revision),
+				r.Journal.String(),
+				revisionChange(r.Before == nil, r.After == nil),
+				humanize.Time(time.Unix(r.ApplyTime, 0)),
+				r.AppliedBy,
+				r.Client,
+			})
+		}
+		table.Render()
+	case "diff":
+		for _, r := range resp.Revisions {
+			fmt.Fprintf(os.Stdout, "# revision %d applied %s by %q from %s\n", r.Revision,
+				time.Unix(r.ApplyTime, 0).UTC().Format(time.RFC3339), r.AppliedBy, r.Client)
+			writeSpecDiff(os.Stdout, r.Journal.String(), flattenSpecFields(r.Before),
+				flattenSpecFields(r.After), r.BeforeRevision, -1)
+		}
+	case "json":
+		var m = jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+		mbp.Must(m.Marshal(os.Stdout, resp), "failed to encode to json")
+	case "proto":
+		mbp.Must(proto.MarshalText(os.Stdout, resp), "failed to write output")
+	}
+	return nil
+}
+
+// revisionChange describes a recorded specification change as a create,
+// update, or delete, given whether its before and after specs are absent.
+func revisionChange(noBefore, noAfter bool) string {
+	switch {
+	case noBefore:
+		return "create"
+	case noAfter:
+		return "delete"
+	default:
+		return "update"
+	}
+}
@@ -2,10 +2,10 @@ package gazctlcmd
 
 import (
 	"context"
+	"math"
 	"time"
 
 	log "github.com/sirupsen/logrus"
-	"go.gazette.dev/core/broker/client"
 	"go.gazette.dev/core/broker/fragment"
 	pb "go.gazette.dev/core/broker/protocol"
 	mbp "go.gazette.dev/core/mainboilerplate"
@@ -13,12 +13,17 @@ import (
 
 type cmdJournalsPrune struct {
 	pruneConfig
+	ReaderFloors map[string]int64 `long:"reader-floor" description:"Journal and offset (as journal:offset) below which readers of the journal no longer require content, in addition to its configured reader_floor. Fragments at or beyond the offset are never pruned for size. May be repeated"`
 }
 
 func init() {
-	CommandRegistry.AddCommand("journals", "prune", "Deletes fragments older than the configured retention", `
+	CommandRegistry.AddCommand("journals", "prune", "Deletes fragments beyond the configured retention", `
 Deletes fragments across all configured fragment stores of matching journals that are older than the configured retention.
 
+Fragments which lie wholly below the minimum readable offset of a journal (see "journals truncate --help") are also deleted, regardless of their age.
+
+If a journal configures a "max_bytes" or "max_fragments" fragment retention, its oldest remaining fragments are then deleted until the total content size and number of its persisted fragments are within those limits. Sizes and counts are those of the covering set of persisted fragments, where content of overlapping fragments is counted once. Size-based pruning never deletes the persisted fragment having the greatest end offset, upon which the journal's write head depends. Nor does it delete fragments which include content at or beyond a reader floor of the journal, as might be used to protect content not yet read by a consumer. The reader floor is the lesser of the journal's configured "reader_floor" and any given by --reader-floor. Once it reaches such a fragment, the journal may remain over its limits. Journals which set "prune_oversize" are also pruned for size by brokers, with regard to their configured "reader_floor".
+
 There is a caveat when pruning journals. For a given journal, there could be multiple fragments covering the same offset. These fragments contain identical data at a given offset, but the brokers are tracking only the largest fragment, i.e. the fragment that covers the largest span of offsets. As a result, the prune command will delete only this tracked fragment, leaving the smaller fragments untouched. As a workaround, operators can wait for the fragment listing to refresh and prune the journals again.
 
 Use --selector to supply a LabelSelector to select journals to prune.
@@ -37,7 +42,11 @@ func (cmd *cmdJournalsPrune) Execute([]string) error {
 	var m = journalsPruneMetrics{journalsTotal: len(resp.Journals)}
 	var now = time.Now()
 	for _, j := range resp.Journals {
-		for _, f := range fetchAgedFragments(j.Spec, now, &m) {
+		var floor, ok = cmd.ReaderFloors[j.Spec.Name.String()]
+		if !ok {
+			floor = math.MaxInt64
+		}
+		for _, f := range selectPrunedFragments(j.Spec, fetchFragments(context.Background(), j.Spec.Name, true), now, floor, &m) {
 			log.WithFields(log.Fields{
 				"journal": f.Journal,
 				"name":    f.ContentName(),
@@ -94,18 +103,28 @@ func logJournalsPruneMetrics(metrics journalsPruneMetrics, journal pb.Journal, m
 	log.WithFields(f).Info(message)
 }
 
-// fetchAgedFragments returns fragments of the journal that are older than the
-// configured retention.
-func fetchAgedFragments(spec pb.JournalSpec, now time.Time, metrics *journalsPruneMetrics) []pb.Fragment {
-	var ctx = context.Background()
-	var jc = JournalsCfg.Broker.MustRoutedJournalClient(ctx)
-	resp, err := client.ListAllFragments(ctx, jc, pb.FragmentsRequest{Journal: spec.Name})
-	mbp.Must(err, "failed to fetch fragments")
+// selectPrunedFragments returns persisted |fragments| of the journal which
+// are older than the configured retention, or which are wholly below the
+// journal's MinOffset. Then, while the CoverSet of remaining persisted
+// fragments exceeds the journal's MaxBytes or MaxFragments, its oldest
+// fragments are also returned (see fragment.CoverSet.Oversize), along with
+// any remaining fragments which they cover. The reader floor is the lesser of
+// |floor| and the journal's configured ReaderFloor.
+func selectPrunedFragments(spec pb.JournalSpec, fragments []pb.FragmentsResponse__Fragment,
+	now time.Time, floor pb.Offset, metrics *journalsPruneMetrics) []pb.Fragment {
 
 	var retention = spec.Fragment.Retention
+	var minOffset = spec.MinOffset
+
+	if f := spec.Fragment.ReaderFloor; f != 0 && f < floor {
+		floor = f
+	}
 
 	var aged = make([]pb.Fragment, 0)
-	for _, f := range resp.Fragments {
+	var kept []pb.Fragment
+	var set fragment.CoverSet
+
+	for _, f := range fragments {
 		var spec = f.Spec
 		metrics.fragmentsTotal++
 		metrics.bytesTotal += int(spec.End - spec.Begin)
@@ -113,16 +132,32 @@ func fetchAgedFragments(spec pb.JournalSpec, now time.Time, metrics *journalsPru
 			continue
 		}
 		var age = now.Sub(time.Unix(spec.ModTime, 0))
-		if age >= retention {
+		if age >= retention || spec.End <= minOffset {
 			aged = append(aged, spec)
+		} else {
+			kept = append(kept, spec)
+			set, _ = set.Add(fragment.Fragment{Fragment: spec})
+		}
+	}
+	var numAged = len(aged)
+
+	var oversize = set.Oversize(spec.Fragment.MaxBytes, spec.Fragment.MaxFragments, floor)
+	if len(oversize) != 0 {
+		var end = oversize.EndOffset()
+
+		for _, f := range kept {
+			if f.End <= end {
+				aged = append(aged, f)
+			}
 		}
 	}
 
 	log.WithFields(log.Fields{
-		"journal": spec.Name,
-		"total":   len(resp.Fragments),
-		"aged":    len(aged),
-	}).Info("fetched aged fragments")
+		"journal":  spec.Name,
+		"total":    len(fragments),
+		"aged":     numAged,
+		"oversize": len(aged) - numAged,
+	}).Info("selected fragments to prune")
 
 	return aged
 }
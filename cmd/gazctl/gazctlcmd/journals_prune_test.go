@@ -0,0 +1,79 @@
+package gazctlcmd
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+)
+
+func TestSelectPrunedFragments(t *testing.T) {
+	var now = time.Unix(10000, 0)
+	var fragments = []pb.FragmentsResponse__Fragment{
+		{Spec: pb.Fragment{Journal: "a/journal", Begin: 0, End: 100, ModTime: 1000, BackingStore: "s3://bucket/"}},
+		{Spec: pb.Fragment{Journal: "a/journal", Begin: 100, End: 200, ModTime: 2000, BackingStore: "s3://bucket/"}},
+		{Spec: pb.Fragment{Journal: "a/journal", Begin: 150, End: 250, ModTime: 2500, BackingStore: "gs://bucket/"}}, // Overlaps [150, 200).
+		{Spec: pb.Fragment{Journal: "a/journal", Begin: 200, End: 300, ModTime: 3000, BackingStore: "s3://bucket/"}},
+		{Spec: pb.Fragment{Journal: "a/journal", Begin: 300, End: 400, ModTime: 4000, BackingStore: "s3://bucket/"}},
+		{Spec: pb.Fragment{Journal: "a/journal", Begin: 400, End: 500, ModTime: 5000, BackingStore: "s3://bucket/"}},
+		{Spec: pb.Fragment{Journal: "a/journal", Begin: 500, End: 600}}, // Not yet persisted.
+	}
+
+	for _, tc := range []struct {
+		retention    time.Duration
+		minOffset    pb.Offset
+		maxBytes     int64
+		maxFragments int64
+		readerFloor  int64
+		floor        pb.Offset
+		expect       []int64
+	}{
+		// No fragments are aged, and there are no size limits.
+		{floor: math.MaxInt64},
+		// Fragments are aged, or are below the MinOffset.
+		{retention: 7500 * time.Second, minOffset: 300, floor: math.MaxInt64, expect: []int64{0, 100, 150, 200}},
+		// Oldest fragments are pruned until under MaxBytes. Overlapping
+		// content is counted once.
+		{maxBytes: 300, floor: math.MaxInt64, expect: []int64{0, 100, 150}},
+		{maxBytes: 400, floor: math.MaxInt64, expect: []int64{0}},
+		// Oldest fragments are pruned until under MaxFragments.
+		{maxFragments: 5, floor: math.MaxInt64, expect: []int64{0}},
+		{maxFragments: 4, floor: math.MaxInt64, expect: []int64{0, 100}},
+		// Limits are combined, and size-based pruning follows age-based pruning.
+		{minOffset: 100, maxBytes: 350, maxFragments: 3, floor: math.MaxInt64, expect: []int64{0, 100, 150}},
+		// The fragment upon which the write head depends is never pruned.
+		{maxFragments: 1, floor: math.MaxInt64, expect: []int64{0, 100, 150, 200, 300}},
+		// Fragments which end beyond the reader floor are never pruned.
+		{maxFragments: 1, floor: 275, expect: []int64{0, 100, 150}},
+		{maxFragments: 1, floor: 200, expect: []int64{0, 100}},
+		{maxFragments: 1, floor: 0},
+		// The lesser of the given floor and the configured ReaderFloor applies.
+		{maxFragments: 1, readerFloor: 200, floor: math.MaxInt64, expect: []int64{0, 100}},
+		{maxFragments: 1, readerFloor: 275, floor: 200, expect: []int64{0, 100}},
+	} {
+		var spec = pb.JournalSpec{
+			Name:      "a/journal",
+			MinOffset: tc.minOffset,
+			Fragment: pb.JournalSpec_Fragment{
+				Retention:    24 * time.Hour,
+				MaxBytes:     tc.maxBytes,
+				MaxFragments: tc.maxFragments,
+				ReaderFloor:  tc.readerFloor,
+			},
+		}
+		if tc.retention != 0 {
+			spec.Fragment.Retention = tc.retention
+		}
+
+		var m journalsPruneMetrics
+		var begins []int64
+		for _, f := range selectPrunedFragments(spec, fragments, now, tc.floor, &m) {
+			begins = append(begins, f.Begin)
+		}
+		require.Equal(t, tc.expect, begins)
+		require.Equal(t, len(fragments), m.fragmentsTotal)
+		require.Equal(t, 700, m.bytesTotal)
+	}
+}
@@ -23,7 +23,9 @@ type cmdJournalRead struct {
 	OffsetsPath    string `long:"offsets" description:"Path from which initial journal offsets are read at startup"`
 	OffsetsOutPath string `long:"offsets-out" description:"Path to which final journal offsets are written at exit"`
 	FileRoot       string `long:"file-root" description:"Filesystem path which roots file:// fragment store"`
+	FromTime       string `long:"from-time" description:"Skip fragments persisted before this time, as RFC 3339 (eg 2019-10-31T09:00:00Z) or unix seconds since epoch"`
 
+	beginModTime int64                             // Parsed --from-time.
 	pumpCh       chan pumpResult                   // Chan into which completed read pumps are sent.
 	beginOffsets map[pb.Journal]int64              // Contents of initial --offsets.
 	endOffsets   map[pb.Journal]int64              // Collected --offsets-out.
@@ -64,6 +66,14 @@ If --tail is specified and a journal is not present in --offsets, then its read
 begins at its current write-head. This option generally only makes sense with
 --block, but can also be used to initialize --offsets-out.
 
+If --from-time is specified, reads skip over fragments which were persisted to
+their backing store (or, if not yet persisted, last appended to) before the
+given time. As a fragment is persisted shortly after its last append, this
+begins reads at approximately the given time (the
+first fragment read may include some records appended before it). The time may
+be an RFC 3339 timestamp, or unix seconds since the epoch. --from-time applies
+in addition to --offsets: reads begin at whichever is later.
+
 When running in high-volume production settings, be sure to set a non-zero
 --broker.cache.size to significantly reduce broker load. Aside from controlling
 the cache size itself, a non-zero value will:
@@ -84,6 +94,9 @@ gazctl journals read -l name=my/journal
 # Streaming read from tail of current (and future) journals matching my-label:
 gazctl journals read -l my-label --block --tail
 
+# Read journal content appended since 9am (UTC) of October 31st:
+gazctl journals read -l name=my/journal --from-time 2019-10-31T09:00:00Z
+
 # Read new content from matched journals since the last invocation. Dispatch to
 # brokers in our same availability zone where available, and directly read
 # persisted fragments from their respective stores:
@@ -103,6 +116,12 @@ func (cmd *cmdJournalRead) Execute([]string) error {
 	cmd.endOffsets = make(map[pb.Journal]int64)
 	cmd.buffer = make([]byte, 32*1024)
 
+	if cmd.FromTime != "" {
+		var err error
+		cmd.beginModTime, err = pb.ParseModTime(cmd.FromTime)
+		mbp.Must(err, "failed to parse --from-time", "from-time", cmd.FromTime)
+	}
+
 	if cmd.OffsetsPath != "" {
 		var fin, err = os.Open(cmd.OffsetsPath)
 		mbp.Must(err, "failed to open offsets for reading")
@@ -218,10 +237,11 @@ func (cmd *cmdJournalRead) listRefreshed(ctx context.Context, rjc pb.RoutedJourn
 		var subCtx, fn = context.WithCancel(ctx)
 
 		go pumpReader(client.NewRetryReader(subCtx, rjc, pb.ReadRequest{
-			Journal:    j.Spec.Name,
-			Offset:     offset,
-			Block:      cmd.Block,
-			DoNotProxy: !rjc.IsNoopRouter(),
+			Journal:      j.Spec.Name,
+			Offset:       offset,
+			Block:        cmd.Block,
+			DoNotProxy:   !rjc.IsNoopRouter(),
+			BeginModTime: cmd.beginModTime,
 		}), cmd.pumpCh)
 		nextFns[j.Spec.Name] = fn
 	}
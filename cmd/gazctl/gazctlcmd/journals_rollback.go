@@ -0,0 +1,104 @@
+package gazctlcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/client"
+	pb "go.gazette.dev/core/broker/protocol"
+	mbp "go.gazette.dev/core/mainboilerplate"
+)
+
+type cmdJournalsRollback struct {
+	Journal    string `long:"journal" short:"j" required:"true" description:"Name of the journal to roll back"`
+	ToRevision int64  `long:"to-revision" required:"true" description:"Etcd revision of the journal specification to restore"`
+	DryRun     bool   `long:"dry-run" description:"Perform a dry-run of the rollback"`
+}
+
+func init() {
+	CommandRegistry.AddCommand("journals", "rollback", "Roll back a journal specification to a prior revision", `
+Restore the JournalSpec of a journal to its specification as of a prior
+revision, as recorded in the journal's history (see "journals history").
+
+The JournalSpec in effect at --to-revision is applied, with an expectation
+of the journal's current revision. If the journal didn't exist at
+--to-revision, it's deleted. A diff of the current and restored specification
+is printed before the rollback is applied.
+
+Use --dry-run to print the diff and verify the rollback, without applying it.
+
+For example:
+
+>  gazctl journals rollback --journal my/journal --to-revision 123456
+`, &cmdJournalsRollback{})
+}
+
+func (cmd *cmdJournalsRollback) Execute([]string) error {
+	startup(JournalsCfg.BaseConfig)
+
+	var ctx = context.Background()
+	var jc = JournalsCfg.Broker.MustJournalClient(ctx)
+
+	var history, err = client.JournalHistory(ctx, jc, pb.Journal(cmd.Journal))
+	mbp.Must(err, "failed to fetch journal history", "journal", cmd.Journal)
+
+	target, err := journalSpecAtRevision(history.Revisions, cmd.ToRevision)
+	mbp.Must(err, "failed to resolve journal specification", "journal", cmd.Journal)
+
+	var listReq pb.ListRequest
+	listReq.Selector.Include.AddValue("name", cmd.Journal)
+
+	listResp, err := client.ListAllJournals(ctx, jc, listReq)
+	mbp.Must(err, "failed to list journals")
+
+	var current *pb.JournalSpec
+	var revision int64
+	if len(listResp.Journals) != 0 {
+		current, revision = &listResp.Journals[0].Spec, listResp.Journals[0].ModRevision
+	}
+	writeSpecDiff(os.Stdout, cmd.Journal, flattenSpecFields(current),
+		flattenSpecFields(target), revision, -1)
+
+	var change = pb.ApplyRequest_Change{ExpectModRevision: revision}
+	if target != nil {
+		change.Upsert = target
+	} else if current != nil {
+		change.Delete = pb.Journal(cmd.Journal)
+	} else {
+		log.Info("journal does not exist, and did not exist at revision")
+		return nil
+	}
+
+	var req = &pb.ApplyRequest{Changes: []pb.ApplyRequest_Change{change}, DryRun: cmd.DryRun}
+	mbp.Must(req.Validate(), "failed to validate ApplyRequest")
+
+	resp, err := client.ApplyJournals(ctx, jc, req)
+	mbp.Must(err, "failed to apply journals")
+
+	if cmd.DryRun {
+		log.WithField("revision", resp.Header.Etcd.Revision).Info("dry-run succeeded")
+	} else {
+		log.WithField("revision", resp.Header.Etcd.Revision).Info("successfully rolled back")
+	}
+	return nil
+}
+
+// journalSpecAtRevision returns the JournalSpec which was in effect at Etcd
+// |revision|, given the ordered JournalSpecRevisions of a journal. It returns
+// nil if the journal didn't exist at |revision|, and an error if |revisions|
+// don't record its specification at |revision|.
+func journalSpecAtRevision(revisions []pb.JournalSpecRevision, revision int64) (*pb.JournalSpec, error) {
+	for i := len(revisions) - 1; i >= 0; i-- {
+		if revisions[i].Revision <= revision {
+			return revisions[i].After, nil
+		}
+	}
+	// |revision| precedes all recorded revisions. The specification prior to
+	// the first recorded revision may still have been in effect at |revision|.
+	if len(revisions) != 0 && revisions[0].BeforeRevision <= revision {
+		return revisions[0].Before, nil
+	}
+	return nil, fmt.Errorf("no recorded revision at or before %d", revision)
+}
@@ -0,0 +1,51 @@
+package gazctlcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+)
+
+func TestJournalSpecAtRevision(t *testing.T) {
+	var specA = &pb.JournalSpec{Name: "a/journal", Replication: 1}
+	var specB = &pb.JournalSpec{Name: "a/journal", Replication: 2}
+
+	// The journal existed at revision 5 (prior to recorded history), was
+	// updated at 10, deleted at 20, and re-created at 30.
+	var revisions = []pb.JournalSpecRevision{
+		{Journal: "a/journal", Revision: 10, Before: specA, BeforeRevision: 5, After: specB},
+		{Journal: "a/journal", Revision: 20, Before: specB, BeforeRevision: 10},
+		{Journal: "a/journal", Revision: 30, After: specA},
+	}
+
+	for _, tc := range []struct {
+		revision int64
+		expect   *pb.JournalSpec
+		err      string
+	}{
+		{revision: 4, err: "no recorded revision at or before 4"},
+		{revision: 5, expect: specA},
+		{revision: 9, expect: specA},
+		{revision: 10, expect: specB},
+		{revision: 19, expect: specB},
+		{revision: 20, expect: nil},
+		{revision: 35, expect: specA},
+	} {
+		var spec, err = journalSpecAtRevision(revisions, tc.revision)
+		if tc.err != "" {
+			require.EqualError(t, err, tc.err)
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, spec)
+		}
+	}
+
+	// A journal created within recorded history didn't exist before it.
+	var spec, err = journalSpecAtRevision(revisions[2:], 25)
+	require.NoError(t, err)
+	require.Nil(t, spec)
+
+	_, err = journalSpecAtRevision(nil, 25)
+	require.EqualError(t, err, "no recorded revision at or before 25")
+}
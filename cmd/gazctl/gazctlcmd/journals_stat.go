@@ -0,0 +1,99 @@
+package gazctlcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/olekukonko/tablewriter"
+	"go.gazette.dev/core/broker/client"
+	pb "go.gazette.dev/core/broker/protocol"
+	mbp "go.gazette.dev/core/mainboilerplate"
+)
+
+type cmdJournalsStat struct {
+	Journal string `long:"journal" short:"j" required:"true" description:"Name of the journal to stat"`
+	Format  string `long:"format" short:"o" choice:"table" choice:"json" choice:"proto" default:"table" description:"Output format"`
+}
+
+func init() {
+	CommandRegistry.AddCommand("journals", "stat", "Show the status of a journal", `
+Show the replication and persistence status of a journal, as observed by its
+primary broker:
+
+- The journal's write head, being the offset of its next append.
+- Which replicas are in sync with the primary. A replica is in sync if its
+  replication stream is healthy, and it has acknowledged all content
+  committed by the primary.
+- Content which has been spooled by the primary but not yet persisted,
+  including completed fragments which are awaiting persistence to the
+  journal's fragment store.
+- The recent rate at which content has been appended.
+- The last error encountered in persisting a fragment of the journal.
+
+Results can be output in a variety of --format options:
+json: Prints the StatResponse encoded as JSON.
+proto: Prints the StatResponse in protobuf text format.
+table: Prints as humanized tables.
+
+For example:
+
+>  gazctl journals stat --journal my/journal
+`, &cmdJournalsStat{})
+}
+
+func (cmd *cmdJournalsStat) Execute([]string) error {
+	startup(JournalsCfg.BaseConfig)
+
+	var ctx = context.Background()
+	var rjc = JournalsCfg.Broker.MustRoutedJournalClient(ctx)
+
+	var resp, err = client.StatJournal(ctx, rjc, pb.Journal(cmd.Journal))
+	mbp.Must(err, "failed to stat journal", "journal", cmd.Journal)
+
+	switch cmd.Format {
+	case "table":
+		var table = tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Journal", "Write Head", "Spooled", "Append Rate", "Persist Backlog", "Persist Error"})
+
+		var persistErr string
+		if resp.PersistError != "" {
+			persistErr = fmt.Sprintf("%s (%s)", resp.PersistError,
+				humanize.Time(time.Unix(resp.PersistErrorTime, 0)))
+		}
+		table.Append([]string{
+			cmd.Journal,
+			fmt.Sprintf("%d", resp.WriteHead),
+			humanize.IBytes(uint64(resp.SpooledBytes)),
+			humanize.IBytes(uint64(resp.AppendRate)) + "/s",
+			fmt.Sprintf("%d (%s)", resp.PersistBacklogFragments,
+				humanize.IBytes(uint64(resp.PersistBacklogBytes))),
+			persistErr,
+		})
+		table.Render()
+
+		table = tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Broker", "Zone", "Primary", "In Sync", "Error"})
+
+		for i, r := range resp.Replicas {
+			table.Append([]string{
+				r.Id.Suffix,
+				r.Id.Zone,
+				fmt.Sprintf("%t", i == int(resp.Header.Route.Primary)),
+				fmt.Sprintf("%t", r.InSync),
+				r.Error,
+			})
+		}
+		table.Render()
+	case "json":
+		var m = jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+		mbp.Must(m.Marshal(os.Stdout, resp), "failed to encode to json")
+	case "proto":
+		mbp.Must(proto.MarshalText(os.Stdout, resp), "failed to write output")
+	}
+	return nil
+}
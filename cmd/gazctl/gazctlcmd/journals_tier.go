@@ -0,0 +1,173 @@
+package gazctlcmd
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/fragment"
+	pb "go.gazette.dev/core/broker/protocol"
+	mbp "go.gazette.dev/core/mainboilerplate"
+)
+
+type cmdJournalsTier struct {
+	Selector string        `long:"selector" short:"l" required:"true" description:"Label Selector query to filter on"`
+	DryRun   bool          `long:"dry-run" description:"Log fragments which would be tiered, without copying or removing them"`
+	Timeout  time.Duration `long:"timeout" default:"5m" description:"Maximum duration to wait for brokers to list copied fragments, before giving up on removing their originals"`
+}
+
+func init() {
+	CommandRegistry.AddCommand("journals", "tier", "Move aged fragments to colder fragment stores", `
+Move persisted fragments of matching journals across their configured fragment
+stores, according to the journal's "tier_after" age thresholds.
+
+A fragment of stores[N] which is older than tier_after[N] is copied to
+stores[N+1]. If the fragment is also older than tier_after[N+1], it's instead
+copied to stores[N+2], and so on. Fragment content is verified against its
+SHA1 sum as it's read from the original store, and the persisted copy is read
+back and verified again. Copies are encrypted under the journal's current
+encryption key, if any, and the compression dictionary of a fragment is
+copied alongside it.
+
+As the fragments of a journal are the union of all of its stores, readers
+are unaffected by tiering. Brokers prefer a fragment of a later store over an
+identical fragment of an earlier one, and once brokers list a copied fragment,
+its original is removed. Brokers list fragment stores on each journal's
+"refresh_interval", and --timeout bounds how long to wait for them to do so.
+
+For example, to persist new fragments to a local file:// store, tier them to
+a standard S3 bucket after a day, and then to an archival bucket after a month:
+
+>  fragment:
+>    stores:
+>      - file:///hot/
+>      - s3://warm-bucket/
+>      - s3://cold-bucket/?storage_class=GLACIER_IR
+>    tier_after: [24h, 720h]
+
+Note that tiering may be run alongside "journals prune", and a fragment which
+is beyond its journal's retention is pruned rather than tiered.
+
+Use --selector to supply a LabelSelector to select journals to tier.
+See "journals list --help" for details and examples.
+`, &cmdJournalsTier{})
+}
+
+func (cmd *cmdJournalsTier) Execute([]string) error {
+	startup(JournalsCfg.BaseConfig)
+
+	var ctx = context.Background()
+	var resp = listJournals(cmd.Selector)
+	if len(resp.Journals) == 0 {
+		log.WithField("selector", cmd.Selector).Panic("no journals match selector")
+	}
+
+	var now = time.Now()
+	for _, j := range resp.Journals {
+		var copies, tiered []pb.Fragment
+
+		for _, f := range fetchFragments(ctx, j.Spec.Name, false) {
+			var to, ok = selectFragmentTier(&j.Spec, f.Spec, now)
+			if !ok {
+				continue
+			}
+			log.WithFields(log.Fields{
+				"journal": f.Spec.Journal,
+				"name":    f.Spec.ContentName(),
+				"from":    f.Spec.BackingStore,
+				"to":      to,
+				"mod":     f.Spec.ModTime,
+			}).Info("tiering fragment")
+
+			if cmd.DryRun {
+				continue
+			}
+			var copied, err = fragment.Copy(ctx, f.Spec, to, j.Spec.Fragment.EncryptionKey)
+			mbp.Must(err, "failed to copy fragment", "path", f.Spec.ContentPath(), "to", to)
+
+			copies = append(copies, copied)
+			tiered = append(tiered, f.Spec)
+		}
+
+		if len(copies) != 0 {
+			awaitListedFragments(ctx, j.Spec.Name, copies, cmd.Timeout)
+		}
+		for _, f := range tiered {
+			var err = fragment.Remove(ctx, f)
+			mbp.Must(err, "error removing tiered fragment", "path", f.ContentPath(), "store", f.BackingStore)
+		}
+
+		log.WithFields(log.Fields{
+			"journal": j.Spec.Name,
+			"tiered":  len(tiered),
+		}).Info("tiered journal")
+	}
+	return nil
+}
+
+// selectFragmentTier returns the FragmentStore to which persisted Fragment
+// |f| of the JournalSpec should be tiered, or false if it should not be.
+func selectFragmentTier(spec *pb.JournalSpec, f pb.Fragment, now time.Time) (pb.FragmentStore, bool) {
+	var stores, tierAfter = spec.Fragment.Stores, spec.Fragment.TierAfter
+	var age = now.Sub(time.Unix(f.ModTime, 0))
+
+	var from = -1
+	for i := range stores {
+		if stores[i] == f.BackingStore {
+			from = i
+		}
+	}
+	if from == -1 {
+		return "", false // Not persisted, or not of a configured store.
+	} else if spec.Fragment.Retention > 0 && age >= spec.Fragment.Retention {
+		return "", false // Fragment is to be pruned.
+	}
+
+	var to = from
+	for to < len(tierAfter) && tierAfter[to] != 0 && age >= tierAfter[to] {
+		to++
+	}
+	if to == from {
+		return "", false
+	}
+	return stores[to], true
+}
+
+// awaitListedFragments blocks until brokers list each of the |expect|ed
+// Fragments of the journal, from the Fragment's BackingStore.
+func awaitListedFragments(ctx context.Context, journal pb.Journal, expect []pb.Fragment, timeout time.Duration) {
+	var deadline = time.Now().Add(timeout)
+
+	for {
+		var listed = make(map[pb.Fragment]struct{})
+		for _, f := range fetchFragments(ctx, journal, false) {
+			var key = f.Spec
+			key.ModTime = 0
+			listed[key] = struct{}{}
+		}
+
+		var pending int
+		for _, f := range expect {
+			f.ModTime = 0
+			if _, ok := listed[f]; !ok {
+				pending++
+			}
+		}
+
+		if pending == 0 {
+			return
+		} else if time.Now().After(deadline) {
+			log.WithFields(log.Fields{
+				"journal": journal,
+				"pending": pending,
+				"timeout": timeout,
+			}).Fatal("timeout waiting for brokers to list fragments")
+		}
+		log.WithFields(log.Fields{
+			"journal": journal,
+			"pending": pending,
+		}).Info("waiting for brokers to list fragments")
+
+		time.Sleep(time.Second * 5)
+	}
+}
@@ -0,0 +1,51 @@
+package gazctlcmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+)
+
+func TestSelectFragmentTier(t *testing.T) {
+	var now = time.Unix(1000000, 0)
+	var spec = pb.JournalSpec{
+		Name: "a/journal",
+		Fragment: pb.JournalSpec_Fragment{
+			Stores:    []pb.FragmentStore{"file:///hot/", "s3://warm/", "s3://cold/"},
+			TierAfter: []time.Duration{time.Hour, 24 * time.Hour},
+		},
+	}
+	var verify = func(store pb.FragmentStore, age time.Duration, expect pb.FragmentStore) {
+		var to, ok = selectFragmentTier(&spec, pb.Fragment{
+			Journal:      "a/journal",
+			BackingStore: store,
+			ModTime:      now.Add(-age).Unix(),
+		}, now)
+		require.Equal(t, expect, to)
+		require.Equal(t, expect != "", ok)
+	}
+
+	// Fragments which are younger than their store's threshold aren't tiered.
+	verify("file:///hot/", time.Minute, "")
+	verify("s3://warm/", time.Hour, "")
+	// Fragments are tiered to the next store, or beyond, by their age.
+	verify("file:///hot/", 2*time.Hour, "s3://warm/")
+	verify("file:///hot/", 48*time.Hour, "s3://cold/")
+	verify("s3://warm/", 48*time.Hour, "s3://cold/")
+	// Fragments of the last store aren't tiered.
+	verify("s3://cold/", 1000*time.Hour, "")
+	// Fragments which aren't persisted, or are of an unknown store, aren't tiered.
+	verify("", 48*time.Hour, "")
+	verify("s3://other/", 48*time.Hour, "")
+
+	// A zero threshold disables tiering from its store.
+	spec.Fragment.TierAfter[0] = 0
+	verify("file:///hot/", 48*time.Hour, "")
+	verify("s3://warm/", 48*time.Hour, "s3://cold/")
+
+	// Fragments beyond the journal's retention aren't tiered.
+	spec.Fragment.Retention = 36 * time.Hour
+	verify("s3://warm/", 48*time.Hour, "")
+}
@@ -0,0 +1,164 @@
+package gazctlcmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/client"
+	"go.gazette.dev/core/broker/codecs"
+	"go.gazette.dev/core/broker/fragment"
+	pb "go.gazette.dev/core/broker/protocol"
+	mbp "go.gazette.dev/core/mainboilerplate"
+)
+
+type cmdJournalsTrainDict struct {
+	Selector   string `long:"selector" short:"l" required:"true" description:"Label Selector query to filter on"`
+	SampleSize int64  `long:"sample-size" default:"16777216" description:"Bytes of recent journal content from which each dictionary is trained"`
+	DictSize   int    `long:"dict-size" default:"112640" description:"Maximum size of each trained dictionary, in bytes"`
+	DryRun     bool   `long:"dry-run" description:"Train and log dictionaries, without persisting them or updating JournalSpecs"`
+	MaxTxnSize int    `long:"max-txn-size" default:"0" description:"maximum number of Etcd operations within an apply transaction. Each change uses two operations. If 0, the default, all changes are issued in a single transaction"`
+}
+
+func init() {
+	CommandRegistry.AddCommand("journals", "train-dict", "Train compression dictionaries from recent journal content", `
+Train a ZStandard compression dictionary for each matching journal, and
+update the journal to compress new fragments with it.
+
+Journals of many small and similar records, such as JSON documents, compress
+far better with a dictionary of the substrings which are common across their
+records. A dictionary is trained from the most recent --sample-size bytes of
+each journal, where each newline-delimited record is one training sample.
+
+Each dictionary is persisted under a content-addressed name to the first of
+the journal's fragment stores, and the journal's JournalSpec is then updated
+to use the ZSTANDARD_DICT compression codec with that dictionary. Fragments
+record the dictionary with which they were compressed, and dictionaries are
+never removed, so fragments written under a prior dictionary remain readable.
+As fragments are persisted to, copied to, or merged into other stores, their
+dictionary is copied alongside them.
+
+Training may be re-run as journal content evolves. A re-trained dictionary
+applies only to fragments which are written thereafter.
+
+Use --selector to supply a LabelSelector to select journals to train.
+See "journals list --help" for details and examples.
+`+maxTxnSizeWarning, &cmdJournalsTrainDict{})
+}
+
+func (cmd *cmdJournalsTrainDict) Execute([]string) error {
+	startup(JournalsCfg.BaseConfig)
+
+	var ctx = context.Background()
+	var rjc = JournalsCfg.Broker.MustRoutedJournalClient(ctx)
+	var resp = listJournals(cmd.Selector)
+	if len(resp.Journals) == 0 {
+		log.WithField("selector", cmd.Selector).Panic("no journals match selector")
+	}
+
+	var req = new(pb.ApplyRequest)
+	for _, j := range resp.Journals {
+		if len(j.Spec.Fragment.Stores) == 0 {
+			log.WithField("journal", j.Spec.Name).Warn("journal has no fragment stores (skipping)")
+			continue
+		}
+		var samples = readSamples(ctx, rjc, j.Spec.Name, cmd.SampleSize)
+		var dict = codecs.TrainDictionary(samples, cmd.DictSize)
+
+		if len(dict) == 0 {
+			log.WithFields(log.Fields{
+				"journal": j.Spec.Name,
+				"samples": len(samples),
+			}).Warn("journal has too little repetitive content to train a dictionary (skipping)")
+			continue
+		}
+		log.WithFields(log.Fields{
+			"journal":    j.Spec.Name,
+			"samples":    len(samples),
+			"size":       len(dict),
+			"dictionary": codecs.DictionaryName(dict),
+		}).Info("trained dictionary")
+
+		if cmd.DryRun {
+			continue
+		}
+		var name, err = fragment.PersistDictionary(ctx, j.Spec.Fragment.Stores[0], dict)
+		mbp.Must(err, "failed to persist dictionary", "journal", j.Spec.Name)
+
+		var spec = j.Spec
+		spec.Fragment.CompressionCodec = pb.CompressionCodec_ZSTANDARD_DICT
+		spec.Fragment.CompressionDictionary = name
+
+		req.Changes = append(req.Changes, pb.ApplyRequest_Change{
+			ExpectModRevision: j.ModRevision,
+			Upsert:            &spec,
+		})
+	}
+
+	if len(req.Changes) == 0 {
+		return nil
+	}
+	mbp.Must(req.Validate(), "failed to validate ApplyRequest")
+
+	var applyResp, err = client.ApplyJournalsInBatches(ctx, JournalsCfg.Broker.MustJournalClient(ctx), req, changesPerTxn(cmd.MaxTxnSize))
+	mbp.Must(err, "failed to apply journals")
+	log.WithField("revision", applyResp.Header.Etcd.Revision).Info("successfully applied")
+
+	return nil
+}
+
+// readSamples reads up to |size| bytes of the most recent content of the
+// journal, and returns its newline-delimited records.
+func readSamples(ctx context.Context, rjc pb.RoutedJournalClient, journal pb.Journal, size int64) [][]byte {
+	// Query the current write head of the journal.
+	var r = client.NewReader(ctx, rjc, pb.ReadRequest{
+		Journal:      journal,
+		Offset:       -1,
+		Block:        false,
+		MetadataOnly: true,
+	})
+	if _, err := r.Read(nil); err != client.ErrOffsetNotYetAvailable {
+		mbp.Must(err, "failed to read head of journal", "journal", journal)
+	}
+
+	var offset = r.Response.Offset - size
+	if offset < 0 {
+		offset = 0
+	}
+	r = client.NewReader(ctx, rjc, pb.ReadRequest{
+		Journal: journal,
+		Offset:  offset,
+		Block:   false,
+	})
+
+	var samples, err = splitSamples(bufio.NewReader(r), offset != 0)
+	mbp.Must(err, "failed to read journal", "journal", journal)
+	return samples
+}
+
+// splitSamples returns the newline-delimited records read from |br|, through
+// the current end of the journal. If |partial|, reading began at an arbitrary
+// offset and the leading (likely partial) record is discarded.
+func splitSamples(br *bufio.Reader, partial bool) ([][]byte, error) {
+	var samples [][]byte
+
+	for {
+		var line, err = br.ReadBytes('\n')
+
+		if err == client.ErrOffsetJump {
+			partial = true // Content was skipped, and the next record may be partial.
+			continue
+		} else if err != nil && err != client.ErrOffsetNotYetAvailable && err != io.EOF {
+			return nil, err
+		}
+		if len(line) != 0 && !partial {
+			samples = append(samples, bytes.TrimSuffix(line, []byte{'\n'}))
+		}
+		if err != nil {
+			return samples, nil
+		}
+		partial = false
+	}
+}
@@ -0,0 +1,62 @@
+package gazctlcmd
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.gazette.dev/core/broker/client"
+)
+
+func TestSplitSamples(t *testing.T) {
+	// sequence is an io.Reader which returns each of its chunks in turn,
+	// followed by the paired error.
+	type chunk struct {
+		s   string
+		err error
+	}
+	var sequence = func(chunks ...chunk) *bufio.Reader {
+		return bufio.NewReader(readerFunc(func(p []byte) (int, error) {
+			if len(chunks) == 0 {
+				return 0, io.EOF
+			}
+			var c = chunks[0]
+			chunks = chunks[1:]
+			return copy(p, c.s), c.err
+		}))
+	}
+	var strs = func(b [][]byte) (out []string) {
+		for _, s := range b {
+			out = append(out, string(s))
+		}
+		return
+	}
+
+	// Reading from offset zero retains the first record, and a trailing
+	// record without a newline.
+	var samples, err = splitSamples(sequence(
+		chunk{"one\ntwo\nthr", nil},
+		chunk{"ee\nfour", client.ErrOffsetNotYetAvailable},
+	), false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"one", "two", "three", "four"}, strs(samples))
+
+	// A partial leading record is skipped, as is a record interrupted by an
+	// offset jump, and the record which follows it.
+	samples, err = splitSamples(sequence(
+		chunk{"ne\ntwo\nthr", client.ErrOffsetJump},
+		chunk{"ve\nsix\n", nil},
+	), true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"two", "six"}, strs(samples))
+
+	// Other errors are returned.
+	_, err = splitSamples(sequence(chunk{"one\nt", errors.New("whoops")}), false)
+	require.EqualError(t, err, "whoops")
+}
+
+type readerFunc func([]byte) (int, error)
+
+func (f readerFunc) Read(p []byte) (int, error) { return f(p) }
@@ -0,0 +1,56 @@
+package gazctlcmd
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/client"
+	pb "go.gazette.dev/core/broker/protocol"
+	mbp "go.gazette.dev/core/mainboilerplate"
+)
+
+type cmdJournalsTruncate struct {
+	Journal string `long:"journal" short:"j" required:"true" description:"Name of the journal to truncate"`
+	Offset  int64  `long:"offset" required:"true" description:"New minimum readable offset of the journal"`
+}
+
+func init() {
+	CommandRegistry.AddCommand("journals", "truncate", "Truncate a journal below an offset", `
+Truncate a journal, durably advancing its minimum readable offset.
+
+Once truncated, brokers no longer serve reads of content below --offset:
+reads of a lesser offset are refused with status OFFSET_TRUNCATED, which
+also tells the reader the minimum readable offset. The minimum readable offset is recorded in the journal's
+JournalSpec as "min_offset", and is never decreased. It may be advanced
+further by later truncations, but may not exceed the journal's write head.
+
+Truncation does not itself remove fragments from their backing stores.
+Run "journals prune" to delete fragments which are wholly below the
+minimum readable offset. A fragment which straddles the offset is retained
+(and its content below the offset is not served) until it ages out of the
+journal's retention.
+
+For example, to truncate a journal through offset 123456 and then delete
+its truncated fragments:
+
+>  gazctl journals truncate --journal my/journal --offset 123456
+>  gazctl journals prune --selector name=my/journal
+`, &cmdJournalsTruncate{})
+}
+
+func (cmd *cmdJournalsTruncate) Execute([]string) error {
+	startup(JournalsCfg.BaseConfig)
+
+	var ctx = context.Background()
+	var rjc = JournalsCfg.Broker.MustRoutedJournalClient(ctx)
+
+	var offset, err = client.TruncateJournal(ctx, rjc, pb.Journal(cmd.Journal), cmd.Offset)
+	mbp.Must(err, "failed to truncate journal", "journal", cmd.Journal)
+
+	log.WithFields(log.Fields{
+		"journal":   cmd.Journal,
+		"minOffset": offset,
+	}).Info("truncated journal")
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package gazctlcmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/fragment"
+	pb "go.gazette.dev/core/broker/protocol"
+	mbp "go.gazette.dev/core/mainboilerplate"
+)
+
+type cmdJournalsVerify struct {
+	Selector   string `long:"selector" short:"l" required:"true" description:"Label Selector query to filter on"`
+	Quarantine bool   `long:"quarantine" description:"Move corrupt fragments to a quarantine prefix of their store"`
+}
+
+func init() {
+	CommandRegistry.AddCommand("journals", "verify", "Verify the integrity of persisted journal fragments", `
+Verify persisted fragments of matching journals across all configured
+fragment stores, and write a report of each journal as a line of JSON.
+
+Fragments of all stores are listed, and are checked for:
+
+ * Gaps: offset ranges of the journal which no fragment covers. Gaps which
+   are wholly below the journal's minimum readable offset are not reported.
+ * Overlaps: offset ranges covered by more than one fragment, where neither
+   fragment fully covers the other.
+ * Orphans: fragments whose offsets are wholly covered by another fragment,
+   and which are never read (for example, a fragment which remains after a
+   "journals compact" or "journals tier" was interrupted).
+ * Corruption: fragments whose decompressed content doesn't match the length
+   and SHA1 sum encoded in the fragment name.
+
+Each fragment is read in full to verify its content. If --quarantine is given,
+the stored bytes of corrupt fragments are moved as-is to the "quarantine~/"
+prefix of their store, where they retain their journal path and name but are
+no longer listed by brokers.
+
+The command exits with a non-zero status if any gaps or corrupt fragments are
+found. Overlaps and orphans are reported, but are not errors.
+
+Example report line (formatted for readability):
+
+>  {"journal": "a/journal", "fragments": 3, "begin": 0, "end": 3072,
+>   "gaps": [{"begin": 1024, "end": 2048}],
+>   "corrupt": [{"fragment": { ... }, "error": "invalid content SHA1 (...)"}]}
+
+Use --selector to supply a LabelSelector to select journals to verify.
+See "journals list --help" for details and examples.
+`, &cmdJournalsVerify{})
+}
+
+// journalVerifyReport is the report of a verified journal.
+type journalVerifyReport struct {
+	Journal     pb.Journal        `json:"journal"`
+	Fragments   int               `json:"fragments"`
+	Begin       pb.Offset         `json:"begin"`
+	End         pb.Offset         `json:"end"`
+	Gaps        []offsetRange     `json:"gaps,omitempty"`
+	Overlaps    []offsetRange     `json:"overlaps,omitempty"`
+	Orphans     []pb.Fragment     `json:"orphans,omitempty"`
+	Corrupt     []corruptFragment `json:"corrupt,omitempty"`
+	Quarantined []pb.Fragment     `json:"quarantined,omitempty"`
+}
+
+type offsetRange struct {
+	Begin pb.Offset `json:"begin"`
+	End   pb.Offset `json:"end"`
+}
+
+type corruptFragment struct {
+	Fragment pb.Fragment `json:"fragment"`
+	Error    string      `json:"error"`
+}
+
+func (cmd *cmdJournalsVerify) Execute([]string) error {
+	startup(JournalsCfg.BaseConfig)
+
+	var ctx = context.Background()
+	var resp = listJournals(cmd.Selector)
+	if len(resp.Journals) == 0 {
+		log.WithField("selector", cmd.Selector).Panic("no journals match selector")
+	}
+
+	var enc = json.NewEncoder(os.Stdout)
+	var failed int
+
+	for _, j := range resp.Journals {
+		var set, err = fragment.WalkAllStores(ctx, j.Spec.Name, j.Spec.Fragment.Stores)
+		mbp.Must(err, "failed to walk fragment stores", "journal", j.Spec.Name)
+
+		var all []pb.Fragment
+		for _, store := range j.Spec.Fragment.Stores {
+			err = fragment.List(ctx, store, j.Spec.Name, func(f pb.Fragment) { all = append(all, f) })
+			mbp.Must(err, "failed to list fragment store", "journal", j.Spec.Name, "store", store)
+		}
+		var report = checkFragmentCoverage(&j.Spec, set, all)
+
+		for _, f := range all {
+			if err = fragment.Verify(ctx, f); err == nil {
+				continue
+			}
+			log.WithFields(log.Fields{
+				"journal": f.Journal,
+				"path":    f.ContentPath(),
+				"store":   f.BackingStore,
+				"err":     err,
+			}).Warn("fragment is corrupt")
+
+			report.Corrupt = append(report.Corrupt, corruptFragment{Fragment: f, Error: err.Error()})
+
+			if cmd.Quarantine {
+				moved, err := fragment.Quarantine(ctx, f)
+				mbp.Must(err, "failed to quarantine fragment", "path", f.ContentPath(), "store", f.BackingStore)
+				report.Quarantined = append(report.Quarantined, moved)
+			}
+		}
+
+		if len(report.Gaps) != 0 || len(report.Corrupt) != 0 {
+			failed++
+		}
+		mbp.Must(enc.Encode(report), "failed to write report")
+	}
+
+	if failed != 0 {
+		return fmt.Errorf("verification failed for %d of %d journals", failed, len(resp.Journals))
+	}
+	return nil
+}
+
+// checkFragmentCoverage returns a journalVerifyReport of the gaps and
+// overlaps of the journal's CoverSet, and of the fragments of |all|
+// which are orphaned because the CoverSet doesn't include them.
+func checkFragmentCoverage(spec *pb.JournalSpec, set fragment.CoverSet, all []pb.Fragment) journalVerifyReport {
+	var report = journalVerifyReport{
+		Journal:   spec.Name,
+		Fragments: len(all),
+		Begin:     set.BeginOffset(),
+		End:       set.EndOffset(),
+	}
+
+	for i := 1; i < len(set); i++ {
+		var prev, cur = set[i-1], set[i]
+
+		if cur.Begin > prev.End && cur.Begin > spec.MinOffset {
+			report.Gaps = append(report.Gaps, offsetRange{Begin: prev.End, End: cur.Begin})
+		} else if cur.Begin < prev.End {
+			report.Overlaps = append(report.Overlaps, offsetRange{Begin: cur.Begin, End: prev.End})
+		}
+	}
+
+	var covering = make(map[pb.Fragment]struct{}, len(set))
+	for _, f := range set {
+		var key = f.Fragment
+		key.ModTime = 0
+		covering[key] = struct{}{}
+	}
+	for _, f := range all {
+		var key = f
+		key.ModTime = 0
+
+		if _, ok := covering[key]; !ok {
+			report.Orphans = append(report.Orphans, f)
+		}
+	}
+	return report
+}
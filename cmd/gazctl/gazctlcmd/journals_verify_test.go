@@ -0,0 +1,65 @@
+package gazctlcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.gazette.dev/core/broker/fragment"
+	pb "go.gazette.dev/core/broker/protocol"
+)
+
+func TestCheckFragmentCoverage(t *testing.T) {
+	var fixture = func(begin, end int64, store pb.FragmentStore, mod int64) pb.Fragment {
+		return pb.Fragment{
+			Journal:      "a/journal",
+			Begin:        begin,
+			End:          end,
+			BackingStore: store,
+			ModTime:      mod,
+		}
+	}
+	var all = []pb.Fragment{
+		fixture(0, 10, "file:///hot/", 1),
+		fixture(10, 20, "file:///hot/", 2),
+		fixture(30, 40, "file:///hot/", 3), // Gap [20, 30).
+		fixture(35, 50, "file:///hot/", 4), // Overlap [35, 40).
+		fixture(50, 60, "file:///hot/", 5), // Tiered copy of the warm fragment.
+		fixture(50, 60, "s3://warm/", 6),   // Copy of the hot fragment.
+		fixture(60, 70, "s3://warm/", 7),   // Replaced by a compacted fragment.
+		fixture(70, 80, "s3://warm/", 8),   // Replaced by a compacted fragment.
+		fixture(60, 80, "s3://warm/", 9),   // Compacted fragment.
+		fixture(90, 100, "s3://warm/", 10), // Gap [80, 90).
+	}
+	// Build a CoverSet as WalkAllStores would: fragments are added in store
+	// order, and are listed by brokers with their ModTime.
+	var set fragment.CoverSet
+	for _, f := range all {
+		set, _ = set.Add(fragment.Fragment{Fragment: f})
+	}
+
+	var spec = pb.JournalSpec{Name: "a/journal"}
+	var report = checkFragmentCoverage(&spec, set, all)
+
+	require.Equal(t, journalVerifyReport{
+		Journal:   "a/journal",
+		Fragments: 10,
+		Begin:     0,
+		End:       100,
+		Gaps:      []offsetRange{{Begin: 20, End: 30}, {Begin: 80, End: 90}},
+		Overlaps:  []offsetRange{{Begin: 35, End: 40}},
+		Orphans:   []pb.Fragment{all[4], all[6], all[7]},
+	}, report)
+
+	// Gaps below the journal's minimum offset are not reported.
+	spec.MinOffset = 30
+	report = checkFragmentCoverage(&spec, set, all)
+	require.Equal(t, []offsetRange{{Begin: 80, End: 90}}, report.Gaps)
+
+	// An empty CoverSet has no gaps or overlaps, and all fragments are orphans.
+	report = checkFragmentCoverage(&spec, nil, all[:2])
+	require.Equal(t, journalVerifyReport{
+		Journal:   "a/journal",
+		Fragments: 2,
+		Orphans:   all[:2],
+	}, report)
+}
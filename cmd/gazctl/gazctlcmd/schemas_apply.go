@@ -0,0 +1,147 @@
+package gazctlcmd
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+
+	"github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/client"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/broker/schema"
+	mbp "go.gazette.dev/core/mainboilerplate"
+)
+
+type cmdSchemasApply struct {
+	SpecsPath string `long:"specs" default:"-" description:"Input specifications path to apply. Use '-' for stdin"`
+	DryRun    bool   `long:"dry-run" description:"Perform a dry-run of the apply"`
+}
+
+// schemasDoc is the YAML document of "schemas apply" and "schemas list".
+type schemasDoc struct {
+	Schemas []schemaNode `yaml:"schemas"`
+}
+
+// schemaNode is the YAML representation of a SchemaSpec.
+type schemaNode struct {
+	Name          string                      `yaml:"name"`
+	Type          pb.SchemaSpec_Type          `yaml:"type,omitempty"`
+	Message       string                      `yaml:"message,omitempty"`
+	Compatibility pb.SchemaSpec_Compatibility `yaml:"compatibility,omitempty"`
+	Content       string                      `yaml:"content,omitempty"`
+	ContentFile   string                      `yaml:"content_file,omitempty"`
+	Revision      int64                       `yaml:"revision,omitempty"`
+	Delete        bool                        `yaml:"delete,omitempty"`
+}
+
+func init() {
+	CommandRegistry.AddCommand("schemas", "apply", "Apply schema specifications", `
+Apply a collection of SchemaSpec creations, updates, or deletions.
+
+SchemaSpecs are provided as a YAML document of "schemas", the format produced
+by "gazctl schemas list --format yaml". For example:
+
+schemas:
+  - name: acme/events
+    type: JSON_SCHEMA
+    compatibility: BACKWARD
+    content: |
+      {"type": "object", "required": ["id"]}
+  - name: acme/orders
+    type: PROTOBUF
+    message: acme.Order
+    compatibility: FULL
+    content_file: orders.pb
+    revision: 1234
+
+Journals refer to a schema through their "app.gazette.dev/message-type" label,
+and set "validate_schema" to have brokers validate appended messages. A
+JSON_SCHEMA content is a JSON schema document, which may use keywords "type",
+"properties", "required", "additionalProperties", "items", "enum", "minimum",
+"maximum", "minLength" and "maxLength" as well as annotations like "title" and
+"description". Other keywords (such as "$ref", "oneOf", "anyOf", "pattern" or
+"format") are not supported, and schemas using them are rejected. A PROTOBUF
+content is a serialized FileDescriptorSet, as produced by "protoc
+--descriptor_set_out", and "message" is the fully-qualified name of its
+message type. Use "content_file" to read content from a file rather than
+inline.
+
+Brokers refuse an update of a schema which doesn't satisfy the compatibility
+of the updated SchemaSpec with respect to its current SchemaSpec:
+
+BACKWARD: The updated schema must read messages written under the prior one.
+FORWARD:  The prior schema must read messages written under the updated one.
+FULL:     Both BACKWARD and FORWARD.
+NONE:     Any update is allowed.
+
+As with journals, brokers verify that the etcd "revision" of each SchemaSpec is
+correct. SchemaSpecs are created by setting "revision" to zero or omitting it
+altogether, and a revision of -1 applies a SchemaSpec regardless of its
+current revision. SchemaSpecs are deleted by setting "delete" to true.
+`, &cmdSchemasApply{})
+}
+
+func (cmd *cmdSchemasApply) Execute([]string) error {
+	startup(SchemasCfg.BaseConfig)
+
+	var doc schemasDoc
+	mbp.Must(ApplyConfig{SpecsPath: cmd.SpecsPath}.decode(&doc), "failed to decode schemas")
+
+	var req, err = newSchemasApplyRequest(doc)
+	mbp.Must(err, "failed to build ApplySchemasRequest")
+	mbp.Must(req.Validate(), "failed to validate ApplySchemasRequest")
+
+	if cmd.DryRun {
+		_ = proto.MarshalText(os.Stdout, req)
+		return nil
+	}
+
+	var ctx = context.Background()
+	resp, err := client.ApplySchemas(ctx, SchemasCfg.Broker.MustJournalClient(ctx), req)
+	mbp.Must(err, "failed to apply schemas")
+	log.WithField("revision", resp.Header.Etcd.Revision).Info("successfully applied")
+
+	return nil
+}
+
+// newSchemasApplyRequest builds the ApplySchemasRequest of |doc|,
+// reading the content files of its schemas.
+func newSchemasApplyRequest(doc schemasDoc) (*pb.ApplySchemasRequest, error) {
+	var req = new(pb.ApplySchemasRequest)
+
+	for _, node := range doc.Schemas {
+		var change = pb.ApplySchemasRequest_Change{ExpectModRevision: node.Revision}
+
+		if node.Delete {
+			change.Delete = node.Name
+			req.Changes = append(req.Changes, change)
+			continue
+		}
+		var content = []byte(node.Content)
+
+		if node.ContentFile != "" {
+			if node.Content != "" {
+				return nil, pb.NewValidationError("schema %s has both content and content_file", node.Name)
+			}
+			var err error
+			if content, err = ioutil.ReadFile(node.ContentFile); err != nil {
+				return nil, err
+			}
+		}
+		change.Upsert = &pb.SchemaSpec{
+			Name:          node.Name,
+			Type:          node.Type,
+			Content:       content,
+			Message:       node.Message,
+			Compatibility: node.Compatibility,
+		}
+		// Compile the schema, so that malformed content or unsupported
+		// keywords are rejected before the apply (or by a --dry-run).
+		if _, err := schema.Compile(change.Upsert); err != nil {
+			return nil, err
+		}
+		req.Changes = append(req.Changes, change)
+	}
+	return req, nil
+}
@@ -0,0 +1,79 @@
+package gazctlcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/olekukonko/tablewriter"
+	"go.gazette.dev/core/broker/client"
+	mbp "go.gazette.dev/core/mainboilerplate"
+	"gopkg.in/yaml.v2"
+)
+
+type cmdSchemasList struct {
+	Names  []string `long:"name" short:"n" description:"Name of a schema to list. May be repeated. If omitted, all schemas are listed"`
+	Format string   `long:"format" short:"o" choice:"table" choice:"yaml" choice:"json" choice:"proto" default:"table" description:"Output format"`
+}
+
+func init() {
+	CommandRegistry.AddCommand("schemas", "list", "List schemas", `
+List registered message schemas.
+
+Use --name to list only the named schemas.
+
+Results can be output in a variety of --format options:
+yaml:  Prints a YAML document of schemas, compatible with "schemas apply"
+json:  Prints SchemaSpecs encoded as JSON
+proto: Prints SchemaSpecs encoded in protobuf text format
+table: Prints as a table
+`, &cmdSchemasList{})
+}
+
+func (cmd *cmdSchemasList) Execute([]string) error {
+	startup(SchemasCfg.BaseConfig)
+
+	var ctx = context.Background()
+	var resp, err = client.ListSchemas(ctx, SchemasCfg.Broker.MustJournalClient(ctx), cmd.Names...)
+	mbp.Must(err, "failed to list schemas")
+
+	switch cmd.Format {
+	case "table":
+		var table = tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Name", "Type", "Message", "Compatibility", "Revision"})
+
+		for _, s := range resp.Schemas {
+			table.Append([]string{
+				s.Spec.Name,
+				s.Spec.Type.String(),
+				s.Spec.Message,
+				s.Spec.Compatibility.String(),
+				fmt.Sprintf("%d", s.ModRevision),
+			})
+		}
+		table.Render()
+	case "yaml":
+		var doc schemasDoc
+		for _, s := range resp.Schemas {
+			doc.Schemas = append(doc.Schemas, schemaNode{
+				Name:          s.Spec.Name,
+				Type:          s.Spec.Type,
+				Message:       s.Spec.Message,
+				Compatibility: s.Spec.Compatibility,
+				Content:       string(s.Spec.Content),
+				Revision:      s.ModRevision,
+			})
+		}
+		var b, err = yaml.Marshal(doc)
+		mbp.Must(err, "failed to encode to yaml")
+		_, _ = os.Stdout.Write(b)
+	case "json":
+		var m = jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+		mbp.Must(m.Marshal(os.Stdout, resp), "failed to encode to json")
+	case "proto":
+		mbp.Must(proto.MarshalText(os.Stdout, resp), "failed to write output")
+	}
+	return nil
+}
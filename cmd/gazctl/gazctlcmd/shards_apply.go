@@ -2,6 +2,7 @@ package gazctlcmd
 
 import (
 	"context"
+	"io"
 	"os"
 
 	"github.com/gogo/protobuf/proto"
@@ -34,6 +35,11 @@ source of truth.
 ShardSpecs may be created by setting "revision" to zero or omitting it altogether.
 
 ShardSpecs may be deleted by setting their field "delete" to true.
+
+Use --diff to print a per-field diff of the current ShardSpecs and those of
+the YAML list, without applying any changes. Use --dry-run to print the
+ApplyRequest and have consumers validate it, including its expected revisions,
+without committing it.
 `+maxTxnSizeWarning, &cmdShardsApply{})
 }
 
@@ -44,6 +50,10 @@ func (cmd *cmdShardsApply) Execute([]string) error {
 	mbp.Must(cmd.decode(&set), "failed to decode shardspace from YAML")
 
 	var ctx = context.Background()
+	if cmd.Diff {
+		writeShardSpecDiffs(os.Stdout, set)
+		return nil
+	}
 	var req = newShardSpecApplyRequest(set)
 
 	mbp.Must(req.Validate(), "failed to validate ApplyRequest")
@@ -52,16 +62,38 @@ func (cmd *cmdShardsApply) Execute([]string) error {
 
 	if cmd.DryRun {
 		_ = proto.MarshalText(os.Stdout, req)
-		return nil
+		req.DryRun = true
 	}
 
-	var resp, err = consumer.ApplyShardsInBatches(ctx, ShardsCfg.Consumer.MustShardClient(ctx), req, cmd.MaxTxnSize)
+	var resp, err = consumer.ApplyShardsInBatches(ctx, ShardsCfg.Consumer.MustShardClient(ctx), req, changesPerTxn(cmd.MaxTxnSize))
 	mbp.Must(err, "failed to apply shards")
-	log.WithField("rev", resp.Header.Etcd.Revision).Info("successfully applied")
 
+	if cmd.DryRun {
+		log.WithField("rev", resp.Header.Etcd.Revision).Info("dry-run succeeded")
+	} else {
+		log.WithField("rev", resp.Header.Etcd.Revision).Info("successfully applied")
+	}
 	return nil
 }
 
+// writeShardSpecDiffs lists the current ShardSpecs of shards in the Set,
+// and writes their diffs with the Set.
+func writeShardSpecDiffs(w io.Writer, set shardspace.Set) {
+	var req pc.ListRequest
+	for i := range set.Shards {
+		req.Selector.Include.AddValue("id", set.Shards[i].Spec.Id.String())
+	}
+
+	var ctx = context.Background()
+	var resp, err = consumer.ListShards(ctx, ShardsCfg.Consumer.MustShardClient(ctx), &req)
+	mbp.Must(err, "failed to list shards")
+
+	for _, diff := range shardspace.DiffSets(shardspace.FromListResponse(resp), set) {
+		writeSpecDiff(w, diff.ID.String(), flattenSpecFields(diff.Current),
+			flattenSpecFields(diff.Applied), diff.Revision, diff.ExpectRevision)
+	}
+}
+
 // newShardSpecApplyRequest builds the ApplyRequest.
 func newShardSpecApplyRequest(set shardspace.Set) *pc.ApplyRequest {
 	set.PushDown()
@@ -59,7 +59,7 @@ func (cmd *cmdShardsEdit) applyShardSpecYAML(b []byte) error {
 		return errors.WithMessage(err, "verifying referenced journals")
 	}
 
-	var resp, err = consumer.ApplyShardsInBatches(ctx, ShardsCfg.Consumer.MustShardClient(ctx), req, cmd.MaxTxnSize)
+	var resp, err = consumer.ApplyShardsInBatches(ctx, ShardsCfg.Consumer.MustShardClient(ctx), req, changesPerTxn(cmd.MaxTxnSize))
 	mbp.Must(err, "failed to apply shards")
 	log.WithField("rev", resp.Header.Etcd.Revision).Info("successfully applied")
 	return nil
@@ -0,0 +1,85 @@
+package gazctlcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/olekukonko/tablewriter"
+	"go.gazette.dev/core/consumer"
+	pc "go.gazette.dev/core/consumer/protocol"
+	mbp "go.gazette.dev/core/mainboilerplate"
+)
+
+type cmdShardsHistory struct {
+	Shard  string `long:"shard" short:"s" description:"ID of the shard. If omitted, revisions of all shards are shown"`
+	Format string `long:"format" short:"o" choice:"table" choice:"diff" choice:"json" choice:"proto" default:"table" description:"Output format"`
+}
+
+func init() {
+	CommandRegistry.AddCommand("shards", "history", "Show the revision history of shard specifications", `
+Show recorded revisions of ShardSpecs. Each time a ShardSpec is created,
+updated, or deleted through the Apply API, consumers record the change together
+with its Etcd revision, the time at which it was applied, the subject of the
+authorizing claims, and the address of the applying client.
+
+Use --shard to show only revisions of the identified shard. Revisions are shown
+in the order in which they were applied.
+
+Results can be output in a variety of --format options:
+table: Prints as a table.
+diff:  Prints the per-field changes of each revision.
+json:  Prints the HistoryResponse encoded as JSON.
+proto: Prints the HistoryResponse in protobuf text format.
+
+The Revision of a recorded change may be passed to "shards rollback" to
+restore the shard to its specification as of that revision.
+
+For example:
+
+>  gazctl shards history --shard my-shard --format diff
+`, &cmdShardsHistory{})
+}
+
+func (cmd *cmdShardsHistory) Execute([]string) error {
+	startup(ShardsCfg.BaseConfig)
+
+	var ctx = context.Background()
+	var resp, err = consumer.FetchShardHistory(ctx, ShardsCfg.Consumer.MustShardClient(ctx), pc.ShardID(cmd.Shard))
+	mbp.Must(err, "failed to fetch shard history", "shard", cmd.Shard)
+
+	switch cmd.Format {
+	case "table":
+		var table = tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Revision", "Shard", "Change", "Applied", "Applied By", "Client"})
+
+		for _, r := range resp.Revisions {
+			table.Append([]string{
+				fmt.Sprintf("%d", r.Revision),
+				r.Shard.String(),
+				revisionChange(r.Before == nil, r.After == nil),
+				humanize.Time(time.Unix(r.ApplyTime, 0)),
+				r.AppliedBy,
+				r.Client,
+			})
+		}
+		table.Render()
+	case "diff":
+		for _, r := range resp.Revisions {
+			fmt.Fprintf(os.Stdout, "# revision %d applied %s by %q from %s\n", r.Revision,
+				time.Unix(r.ApplyTime, 0).UTC().Format(time.RFC3339), r.AppliedBy, r.Client)
+			writeSpecDiff(os.Stdout, r.Shard.String(), flattenSpecFields(r.Before),
+				flattenSpecFields(r.After), r.BeforeRevision, -1)
+		}
+	case "json":
+		var m = jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+		mbp.Must(m.Marshal(os.Stdout, resp), "failed to encode to json")
+	case "proto":
+		mbp.Must(proto.MarshalText(os.Stdout, resp), "failed to write output")
+	}
+	return nil
+}
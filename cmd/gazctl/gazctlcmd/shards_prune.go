@@ -65,7 +65,7 @@ func (cmd *cmdShardsPrune) Execute([]string) error {
 	}
 
 	for journal, segments := range logSegmentSets {
-		for _, f := range fetchFragments(ctx, journal) {
+		for _, f := range fetchFragments(ctx, journal, true) {
 			var spec = f.Spec
 
 			m.fragmentsTotal++
@@ -114,10 +114,14 @@ func fetchOldestHints(ctx context.Context, id pc.ShardID) *recoverylog.FSMHints
 	return nil
 }
 
-func fetchFragments(ctx context.Context, journal pb.Journal) []pb.FragmentsResponse__Fragment {
+// fetchFragments returns all Fragments of the journal. Fragments which are
+// wholly below the journal's minimum readable offset are included only if
+// |includeTruncated|.
+func fetchFragments(ctx context.Context, journal pb.Journal, includeTruncated bool) []pb.FragmentsResponse__Fragment {
 	var err error
 	var req = pb.FragmentsRequest{
-		Journal: journal,
+		Journal:          journal,
+		IncludeTruncated: includeTruncated,
 	}
 	var brokerClient = JournalsCfg.Broker.MustRoutedJournalClient(ctx)
 
@@ -0,0 +1,104 @@
+package gazctlcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/consumer"
+	pc "go.gazette.dev/core/consumer/protocol"
+	mbp "go.gazette.dev/core/mainboilerplate"
+)
+
+type cmdShardsRollback struct {
+	Shard      string `long:"shard" short:"s" required:"true" description:"ID of the shard to roll back"`
+	ToRevision int64  `long:"to-revision" required:"true" description:"Etcd revision of the shard specification to restore"`
+	DryRun     bool   `long:"dry-run" description:"Perform a dry-run of the rollback"`
+}
+
+func init() {
+	CommandRegistry.AddCommand("shards", "rollback", "Roll back a shard specification to a prior revision", `
+Restore the ShardSpec of a shard to its specification as of a prior revision,
+as recorded in the shard's history (see "shards history").
+
+The ShardSpec in effect at --to-revision is applied, with an expectation of
+the shard's current revision. If the shard didn't exist at --to-revision,
+it's deleted. A diff of the current and restored specification is printed
+before the rollback is applied.
+
+Use --dry-run to print the diff and verify the rollback, without applying it.
+
+For example:
+
+>  gazctl shards rollback --shard my-shard --to-revision 123456
+`, &cmdShardsRollback{})
+}
+
+func (cmd *cmdShardsRollback) Execute([]string) error {
+	startup(ShardsCfg.BaseConfig)
+
+	var ctx = context.Background()
+	var sc = ShardsCfg.Consumer.MustShardClient(ctx)
+
+	var history, err = consumer.FetchShardHistory(ctx, sc, pc.ShardID(cmd.Shard))
+	mbp.Must(err, "failed to fetch shard history", "shard", cmd.Shard)
+
+	target, err := shardSpecAtRevision(history.Revisions, cmd.ToRevision)
+	mbp.Must(err, "failed to resolve shard specification", "shard", cmd.Shard)
+
+	var listReq pc.ListRequest
+	listReq.Selector.Include.AddValue("id", cmd.Shard)
+
+	listResp, err := consumer.ListShards(ctx, sc, &listReq)
+	mbp.Must(err, "failed to list shards")
+
+	var current *pc.ShardSpec
+	var revision int64
+	if len(listResp.Shards) != 0 {
+		current, revision = &listResp.Shards[0].Spec, listResp.Shards[0].ModRevision
+	}
+	writeSpecDiff(os.Stdout, cmd.Shard, flattenSpecFields(current),
+		flattenSpecFields(target), revision, -1)
+
+	var change = pc.ApplyRequest_Change{ExpectModRevision: revision}
+	if target != nil {
+		change.Upsert = target
+	} else if current != nil {
+		change.Delete = pc.ShardID(cmd.Shard)
+	} else {
+		log.Info("shard does not exist, and did not exist at revision")
+		return nil
+	}
+
+	var req = &pc.ApplyRequest{Changes: []pc.ApplyRequest_Change{change}, DryRun: cmd.DryRun}
+	mbp.Must(req.Validate(), "failed to validate ApplyRequest")
+	mbp.Must(consumer.VerifyReferencedJournals(ctx, ShardsCfg.Broker.MustJournalClient(ctx), req),
+		"failed to validate journals of the ApplyRequest")
+
+	resp, err := consumer.ApplyShards(ctx, sc, req)
+	mbp.Must(err, "failed to apply shards")
+
+	if cmd.DryRun {
+		log.WithField("rev", resp.Header.Etcd.Revision).Info("dry-run succeeded")
+	} else {
+		log.WithField("rev", resp.Header.Etcd.Revision).Info("successfully rolled back")
+	}
+	return nil
+}
+
+// shardSpecAtRevision returns the ShardSpec which was in effect at Etcd
+// |revision|, given the ordered ShardSpecRevisions of a shard. It returns
+// nil if the shard didn't exist at |revision|, and an error if |revisions|
+// don't record its specification at |revision|.
+func shardSpecAtRevision(revisions []pc.ShardSpecRevision, revision int64) (*pc.ShardSpec, error) {
+	for i := len(revisions) - 1; i >= 0; i-- {
+		if revisions[i].Revision <= revision {
+			return revisions[i].After, nil
+		}
+	}
+	if len(revisions) != 0 && revisions[0].BeforeRevision <= revision {
+		return revisions[0].Before, nil
+	}
+	return nil, fmt.Errorf("no recorded revision at or before %d", revision)
+}
@@ -23,8 +23,10 @@ func main() {
 	the tool's current configuration.
 	`
 
-	// Create these journals and shards commands to contain sub-commands
+	// Create these auth, journals, schemas, and shards commands to contain sub-commands
+	_ = mustAddCmd(parser.Command, "auth", "Issue authorizations of brokers and consumers", "", gazctlcmd.AuthCfg)
 	_ = mustAddCmd(parser.Command, "journals", "Interact with broker journals", "", gazctlcmd.JournalsCfg)
+	_ = mustAddCmd(parser.Command, "schemas", "Interact with registered message schemas", "", gazctlcmd.SchemasCfg)
 	_ = mustAddCmd(parser.Command, "shards", "Interact with consumer shards", "", gazctlcmd.ShardsCfg)
 
 	// Add all registered commands to the root parser.Command
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/jessevdk/go-flags"
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/envelope"
+	"go.gazette.dev/core/broker/mirror"
+	pb "go.gazette.dev/core/broker/protocol"
+	mbp "go.gazette.dev/core/mainboilerplate"
+)
+
+const iniFilename = "gazette-mirror.ini"
+
+// Config is the top-level configuration object of a Gazette mirror.
+var Config = new(struct {
+	Mirror struct {
+		Selector     string        `long:"selector" env:"SELECTOR" required:"true" description:"Label selector of source journals to mirror"`
+		Stores       []string      `long:"store" env:"STORES" env-delim:"," description:"Fragment stores of mirrored destination journals, which replace those of source journals (optional, repeatable)"`
+		ListInterval time.Duration `long:"list-interval" env:"LIST_INTERVAL" default:"1m" description:"Interval with which source journals are listed, and their specs are mirrored"`
+		SeedTimeout  time.Duration `long:"seed-timeout" env:"SEED_TIMEOUT" default:"10m" description:"Maximum duration to wait for destination brokers to index a seeded fragment"`
+		Keyring      string        `long:"keyring" env:"KEYRING" description:"Path to a keyring file of the keys which encrypt and decrypt seeded fragments (optional)"`
+		Port         string        `long:"port" env:"PORT" default:"8080" description:"Port on which metrics and debugging services are served"`
+	} `group:"Mirror" namespace:"mirror" env-namespace:"MIRROR"`
+
+	Source      mbp.ClientConfig `group:"Source" namespace:"source" env-namespace:"SOURCE"`
+	Destination mbp.ClientConfig `group:"Destination" namespace:"destination" env-namespace:"DESTINATION"`
+
+	Log         mbp.LogConfig         `group:"Logging" namespace:"log" env-namespace:"LOG"`
+	Diagnostics mbp.DiagnosticsConfig `group:"Debug" namespace:"debug" env-namespace:"DEBUG"`
+})
+
+type cmdServe struct{}
+
+func (cmdServe) Execute(args []string) error {
+	defer mbp.InitDiagnosticsAndRecover(Config.Diagnostics)()
+	mbp.InitLog(Config.Log)
+
+	log.WithFields(log.Fields{
+		"config":    Config,
+		"version":   mbp.Version,
+		"buildDate": mbp.BuildDate,
+	}).Info("mirror configuration")
+
+	var selector, err = pb.ParseLabelSelector(Config.Mirror.Selector)
+	mbp.Must(err, "failed to parse label selector", "selector", Config.Mirror.Selector)
+
+	var stores []pb.FragmentStore
+	for _, s := range Config.Mirror.Stores {
+		var store = pb.FragmentStore(s)
+		mbp.Must(store.Validate(), "invalid fragment store", "store", s)
+		stores = append(stores, store)
+	}
+	// If a keyring was provided, use it to encrypt and decrypt seeded fragments.
+	if Config.Mirror.Keyring != "" {
+		kr, err := envelope.NewKeyring(Config.Mirror.Keyring)
+		mbp.Must(err, "failed to load keyring")
+		envelope.DefaultKMS = kr
+	}
+
+	var ctx, cancel = context.WithCancel(context.Background())
+	var m = &mirror.Mirror{
+		Source:       Config.Source.MustRoutedJournalClient(ctx),
+		Destination:  Config.Destination.MustRoutedJournalClient(ctx),
+		Selector:     selector,
+		Stores:       stores,
+		ListInterval: Config.Mirror.ListInterval,
+		SeedTimeout:  Config.Mirror.SeedTimeout,
+	}
+
+	// Serve metrics and debugging services registered on the default HTTPMux.
+	go func() {
+		mbp.Must(http.ListenAndServe(":"+Config.Mirror.Port, nil), "failed to serve HTTP")
+	}()
+
+	// Cancel the mirror upon a signal.
+	var signalCh = make(chan os.Signal, 1)
+	signal.Notify(signalCh, syscall.SIGTERM, syscall.SIGINT)
+	go func() {
+		<-signalCh
+		log.Info("caught signal; stopping mirror")
+		cancel()
+	}()
+
+	log.WithField("selector", selector.String()).Info("starting mirror")
+	mbp.Must(m.Serve(ctx), "mirror failed")
+	log.Info("goodbye")
+
+	return nil
+}
+
+func main() {
+	var parser = flags.NewParser(Config, flags.Default)
+
+	_, _ = parser.AddCommand("serve", "Serve as Gazette journal mirror", `
+Mirror journals of a source Gazette cluster into a destination cluster, until
+signaled to exit (via SIGTERM).
+
+Source journals matching --mirror.selector are listed every
+--mirror.list-interval, and their JournalSpecs are applied to the destination
+cluster, which creates journals as needed. Destination journals are never
+deleted. If --mirror.store is given, mirrored JournalSpecs use those fragment
+stores in place of the stores of their source journals.
+
+Each journal's content is read from the source beginning at the write head of
+the destination journal, and is appended to the destination at the very same
+offsets. Consumer checkpoints of source journals therefore remain valid against
+destination journals, as after a failover to the destination cluster. Appends
+are made with explicit offsets, and are refused by the destination if it has
+been written by another client.
+
+Should the source no longer have content at the destination's write head (for
+example, because it was pruned, or because the destination journal is new),
+the destination is seeded with the next persisted fragment of the source, which
+is copied into the destination's first fragment store. Seeding requires access
+to the fragment stores of both clusters.
+
+Per-journal mirroring progress is available as Prometheus metrics:
+gazette_mirror_bytes_total, gazette_mirror_lag_bytes, and
+gazette_mirror_errors_total.
+`, &cmdServe{})
+
+	mbp.AddPrintConfigCmd(parser, iniFilename)
+	mbp.MustParseConfig(parser, iniFilename)
+}
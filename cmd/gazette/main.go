@@ -11,11 +11,13 @@ import (
 	log "github.com/sirupsen/logrus"
 	"go.gazette.dev/core/allocator"
 	"go.gazette.dev/core/broker"
+	"go.gazette.dev/core/broker/envelope"
 	"go.gazette.dev/core/broker/fragment"
 	"go.gazette.dev/core/broker/http_gateway"
+	"go.gazette.dev/core/broker/http_gateway/rest"
 	pb "go.gazette.dev/core/broker/protocol"
 	mbp "go.gazette.dev/core/mainboilerplate"
-	"go.gazette.dev/core/server"
+	"go.gazette.dev/core/message"
 	"go.gazette.dev/core/task"
 )
 
@@ -25,13 +27,18 @@ const iniFilename = "gazette.ini"
 var Config = new(struct {
 	Broker struct {
 		mbp.ServiceConfig
-		Limit          uint32        `long:"limit" env:"LIMIT" default:"1024" description:"Maximum number of Journals the broker will allocate"`
-		FileRoot       string        `long:"file-root" env:"FILE_ROOT" description:"Local path which roots file:// fragment stores (optional)"`
-		MaxAppendRate  uint32        `long:"max-append-rate" env:"MAX_APPEND_RATE" default:"0" description:"Max rate (in bytes-per-sec) that any one journal may be appended to. If zero, there is no max rate"`
-		MaxReplication uint32        `long:"max-replication" env:"MAX_REPLICATION" default:"9" description:"Maximum effective replication of any one journal, which upper-bounds its stated replication."`
-		MinAppendRate  uint32        `long:"min-append-rate" env:"MIN_APPEND_RATE" default:"65536" description:"Min rate (in bytes-per-sec) at which a client may stream Append RPC content. RPCs unable to sustain this rate are aborted"`
-		DisableStores  bool          `long:"disable-stores" env:"DISABLE_STORES" description:"Disable use of any configured journal fragment stores. The broker will neither list or persist remote fragments, and all data is discarded on broker exit."`
-		WatchDelay     time.Duration `long:"watch-delay" env:"WATCH_DELAY" default:"30ms" description:"Delay applied to the application of watched Etcd events. Larger values amortize the processing of fast-changing Etcd keys."`
+		Limit            uint32        `long:"limit" env:"LIMIT" default:"1024" description:"Maximum number of Journals the broker will allocate"`
+		FileRoot         string        `long:"file-root" env:"FILE_ROOT" description:"Local path which roots file:// fragment stores (optional)"`
+		Keyring          string        `long:"keyring" env:"KEYRING" description:"Path to a keyring file of the keys which encrypt and decrypt journal fragments (optional)"`
+		MaxAppendRate    uint32        `long:"max-append-rate" env:"MAX_APPEND_RATE" default:"0" description:"Max rate (in bytes-per-sec) that any one journal may be appended to. If zero, there is no max rate"`
+		MaxReplication   uint32        `long:"max-replication" env:"MAX_REPLICATION" default:"9" description:"Maximum effective replication of any one journal, which upper-bounds its stated replication."`
+		MinAppendRate    uint32        `long:"min-append-rate" env:"MIN_APPEND_RATE" default:"65536" description:"Min rate (in bytes-per-sec) at which a client may stream Append RPC content. RPCs unable to sustain this rate are aborted"`
+		SpoolDir         string        `long:"spool-dir" env:"SPOOL_DIR" description:"Local directory in which journal content is spooled prior to its persistence. Spools which weren't persisted when the broker last exited are recovered and persisted on startup (optional). If empty, anonymous temporary files are used and unpersisted content is lost on broker exit"`
+		DisableStores    bool          `long:"disable-stores" env:"DISABLE_STORES" description:"Disable use of any configured journal fragment stores. The broker will neither list or persist remote fragments, and all data is discarded on broker exit."`
+		HistoryRetention time.Duration `long:"history-retention" env:"HISTORY_RETENTION" default:"2160h" description:"Duration for which recorded revisions of JournalSpecs are retained. If zero, revisions are retained indefinitely."`
+		SuspendAfter     time.Duration `long:"suspend-after" env:"SUSPEND_AFTER" default:"0s" description:"Suspend journals having no appends for this duration, releasing their assignments until their next Append or Read. Journals without fragment stores are never suspended. If zero, journals are never suspended."`
+		WatchDelay       time.Duration `long:"watch-delay" env:"WATCH_DELAY" default:"30ms" description:"Delay applied to the application of watched Etcd events. Larger values amortize the processing of fast-changing Etcd keys."`
+		CORSOrigins      []string      `long:"cors-origin" env:"CORS_ORIGINS" env-delim:"," description:"Origin which may make cross-origin requests of the HTTP gateway, or '*' for any origin (optional, repeatable)"`
 	} `group:"Broker" namespace:"broker" env-namespace:"BROKER"`
 
 	Etcd struct {
@@ -57,18 +64,31 @@ func (cmdServe) Execute(args []string) error {
 	pb.RegisterGRPCDispatcher(Config.Broker.Zone)
 
 	// Bind our server listener, grabbing a random available port if Port is zero.
-	var srv, err = server.New("", Config.Broker.Port)
+	var srv, err = Config.Broker.BuildServer()
 	mbp.Must(err, "building Server instance")
 
+	// If authorization keys were provided, all RPCs must be authorized.
+	auth, err := Config.Broker.BuildAuth()
+	mbp.Must(err, "building authorization")
+
 	// If a file:// root was provided, ensure it exists and apply it.
 	if Config.Broker.FileRoot != "" {
 		_, err = os.Stat(Config.Broker.FileRoot)
 		mbp.Must(err, "configured local file:// root failed")
 		fragment.FileSystemStoreRoot = Config.Broker.FileRoot
 	}
+	// If a keyring was provided, use it to encrypt and decrypt fragments.
+	if Config.Broker.Keyring != "" {
+		kr, err := envelope.NewKeyring(Config.Broker.Keyring)
+		mbp.Must(err, "failed to load keyring")
+		envelope.DefaultKMS = kr
+	}
 
 	broker.MinAppendRate = int64(Config.Broker.MinAppendRate)
 	broker.MaxAppendRate = int64(Config.Broker.MaxAppendRate)
+	broker.SuspendAfter = Config.Broker.SuspendAfter
+	broker.HistoryRetention = Config.Broker.HistoryRetention
+	http_gateway.NewMessageUnpacker = message.NewMessageUnpacker
 	pb.MaxReplication = int32(Config.Broker.MaxReplication)
 	fragment.DisableStores = Config.Broker.DisableStores
 
@@ -85,11 +105,16 @@ func (cmdServe) Execute(args []string) error {
 			broker.JournalIsConsistent)
 		service  = broker.NewService(allocState, lo, etcd)
 		rjc      = pb.NewRoutedJournalClient(lo, service)
+		gateway  = http_gateway.NewGateway(rjc)
 		tasks    = task.NewGroup(context.Background())
 		signalCh = make(chan os.Signal, 1)
 	)
 	pb.RegisterJournalServer(srv.GRPCServer, service)
-	srv.HTTPMux.Handle("/", http_gateway.NewGateway(rjc))
+	service.Auth = auth
+	service.NewFrameValidator = newFrameValidator
+	gateway.AllowedOrigins = Config.Broker.CORSOrigins
+	srv.HTTPMux.Handle("/", gateway)
+	srv.HTTPMux.Handle(rest.Prefix, gateway.CORS(rest.NewAPI(rjc, nil)))
 	ks.WatchApplyDelay = Config.Broker.WatchDelay
 
 	log.WithFields(log.Fields{
@@ -110,6 +135,15 @@ func (cmdServe) Execute(args []string) error {
 	var persister = fragment.NewPersister(ks)
 	broker.SetSharedPersister(persister)
 
+	// If a spool directory was provided, recover any Spools which weren't
+	// persisted prior to a crash, before journals are served.
+	if Config.Broker.SpoolDir != "" {
+		_, err = os.Stat(Config.Broker.SpoolDir)
+		mbp.Must(err, "configured spool directory failed")
+		mbp.Must(persister.Recover(Config.Broker.SpoolDir), "failed to recover spools")
+		fragment.SpoolDirectory = Config.Broker.SpoolDir
+	}
+
 	tasks.Queue("persister.Serve", func() error {
 		persister.Serve()
 		return nil
@@ -140,3 +174,12 @@ its responsible journals and will exit only when it can safely do so.
 	mbp.AddPrintConfigCmd(parser, iniFilename)
 	mbp.MustParseConfig(parser, iniFilename)
 }
+
+// newFrameValidator adapts message.NewFrameValidator to a broker.FrameValidator.
+func newFrameValidator(contentType string, validate func([]byte) error) (broker.FrameValidator, error) {
+	if v, err := message.NewFrameValidator(contentType, validate); err != nil {
+		return nil, err
+	} else {
+		return v, nil
+	}
+}
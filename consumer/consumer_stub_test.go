@@ -19,7 +19,9 @@ type shardServerStub struct {
 
 	StatFunc     func(context.Context, *pc.StatRequest) (*pc.StatResponse, error)
 	ListFunc     func(context.Context, *pc.ListRequest) (*pc.ListResponse, error)
+	WatchFunc    func(*pc.ListRequest, pc.Shard_WatchServer) error
 	ApplyFunc    func(context.Context, *pc.ApplyRequest) (*pc.ApplyResponse, error)
+	HistoryFunc  func(context.Context, *pc.HistoryRequest) (*pc.HistoryResponse, error)
 	GetHintsFunc func(context.Context, *pc.GetHintsRequest) (*pc.GetHintsResponse, error)
 	UnassignFunc func(context.Context, *pc.UnassignRequest) (*pc.UnassignResponse, error)
 }
@@ -61,11 +63,21 @@ func (s *shardServerStub) List(ctx context.Context, req *pc.ListRequest) (*pc.Li
 	return s.ListFunc(ctx, req)
 }
 
+// Watch implements the shardServerStub interface by proxying through WatchFunc.
+func (s *shardServerStub) Watch(req *pc.ListRequest, stream pc.Shard_WatchServer) error {
+	return s.WatchFunc(req, stream)
+}
+
 // Apply implements the shardServerStub interface by proxying through ApplyFunc.
 func (s *shardServerStub) Apply(ctx context.Context, req *pc.ApplyRequest) (*pc.ApplyResponse, error) {
 	return s.ApplyFunc(ctx, req)
 }
 
+// History implements the shardServerStub interface by proxying through HistoryFunc.
+func (s *shardServerStub) History(ctx context.Context, req *pc.HistoryRequest) (*pc.HistoryResponse, error) {
+	return s.HistoryFunc(ctx, req)
+}
+
 // GetHints implements the shardServerStub interface by proxying through GetHintsFunc.
 func (s *shardServerStub) GetHints(ctx context.Context, req *pc.GetHintsRequest) (*pc.GetHintsResponse, error) {
 	return s.GetHintsFunc(ctx, req)
@@ -62,6 +62,8 @@ const (
 	// For example, a Stat RPC will return SHARD_STOPPED if the StatRequest
 	// cannot be satisfied.
 	Status_SHARD_STOPPED Status = 5
+	// The request is not authorized for the shard.
+	Status_NOT_ALLOWED Status = 6
 )
 
 var Status_name = map[int32]string{
@@ -71,6 +73,7 @@ var Status_name = map[int32]string{
 	3: "NOT_SHARD_PRIMARY",
 	4: "ETCD_TRANSACTION_FAILED",
 	5: "SHARD_STOPPED",
+	6: "NOT_ALLOWED",
 }
 
 var Status_value = map[string]int32{
@@ -80,6 +83,7 @@ var Status_value = map[string]int32{
 	"NOT_SHARD_PRIMARY":       3,
 	"ETCD_TRANSACTION_FAILED": 4,
 	"SHARD_STOPPED":           5,
+	"NOT_ALLOWED":             6,
 }
 
 func (x Status) String() string {
@@ -729,8 +733,63 @@ func (m *ListResponse_Shard) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListResponse_Shard proto.InternalMessageInfo
 
+// WatchResponse is the streamed response message of the Watch RPC. The first
+// WatchResponse of a Watch is a snapshot of all shards matching the
+// ListRequest, and subsequent WatchResponses are incremental changes to that
+// set.
+type WatchResponse struct {
+	// Status of the Watch RPC.
+	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=consumer.Status" json:"status,omitempty"`
+	// Header of the response.
+	Header protocol.Header `protobuf:"bytes,2,opt,name=header,proto3" json:"header"`
+	// Shards which were created or updated (including updates of their Routes
+	// or replica statuses), or which began to match the ListRequest selector.
+	Upserts []ListResponse_Shard `protobuf:"bytes,3,rep,name=upserts,proto3" json:"upserts"`
+	// Shards which were deleted, or which no longer match the selector.
+	Deletes []ShardID `protobuf:"bytes,4,rep,name=deletes,proto3,casttype=ShardID" json:"deletes,omitempty"`
+	// Optional extension of the WatchResponse.
+	Extension []byte `protobuf:"bytes,100,opt,name=extension,proto3" json:"extension,omitempty"`
+}
+
+func (m *WatchResponse) Reset()         { *m = WatchResponse{} }
+func (m *WatchResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchResponse) ProtoMessage()    {}
+func (*WatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6491fb50a1cefedd, []int{6}
+}
+func (m *WatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchResponse.Merge(m, src)
+}
+func (m *WatchResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *WatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchResponse proto.InternalMessageInfo
+
 type ApplyRequest struct {
 	Changes []ApplyRequest_Change `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	// If dry_run is true, changes are validated and their expected ModRevisions
+	// are checked, but changes are not committed. The response Status reflects
+	// whether the changes would have applied.
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	// Optional extension of the ApplyRequest.
 	Extension []byte `protobuf:"bytes,100,opt,name=extension,proto3" json:"extension,omitempty"`
 }
@@ -739,7 +798,7 @@ func (m *ApplyRequest) Reset()         { *m = ApplyRequest{} }
 func (m *ApplyRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplyRequest) ProtoMessage()    {}
 func (*ApplyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6491fb50a1cefedd, []int{6}
+	return fileDescriptor_6491fb50a1cefedd, []int{7}
 }
 func (m *ApplyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -785,7 +844,7 @@ func (m *ApplyRequest_Change) Reset()         { *m = ApplyRequest_Change{} }
 func (m *ApplyRequest_Change) String() string { return proto.CompactTextString(m) }
 func (*ApplyRequest_Change) ProtoMessage()    {}
 func (*ApplyRequest_Change) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6491fb50a1cefedd, []int{6, 0}
+	return fileDescriptor_6491fb50a1cefedd, []int{7, 0}
 }
 func (m *ApplyRequest_Change) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -827,7 +886,7 @@ func (m *ApplyResponse) Reset()         { *m = ApplyResponse{} }
 func (m *ApplyResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplyResponse) ProtoMessage()    {}
 func (*ApplyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6491fb50a1cefedd, []int{7}
+	return fileDescriptor_6491fb50a1cefedd, []int{8}
 }
 func (m *ApplyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,6 +915,159 @@ func (m *ApplyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplyResponse proto.InternalMessageInfo
 
+// ShardSpecRevision is an immutable record of a change to a ShardSpec,
+// which consumers record with each applied change of an Apply RPC.
+type ShardSpecRevision struct {
+	// Shard which was changed.
+	Shard ShardID `protobuf:"bytes,1,opt,name=shard,proto3,casttype=ShardID" json:"shard,omitempty"`
+	// Etcd revision at which the change was applied. It's the ModRevision of
+	// the shard's ShardSpec, or of its deletion.
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Time at which the change was applied, represented as seconds since the
+	// epoch.
+	ApplyTime int64 `protobuf:"varint,3,opt,name=apply_time,json=applyTime,proto3" json:"apply_time,omitempty"`
+	// Subject of the authorization which applied the change. Empty if
+	// consumers don't verify authorizations.
+	AppliedBy string `protobuf:"bytes,4,opt,name=applied_by,json=appliedBy,proto3" json:"applied_by,omitempty"`
+	// Network address of the client which applied the change.
+	Client string `protobuf:"bytes,5,opt,name=client,proto3" json:"client,omitempty"`
+	// ShardSpec of the shard prior to the change, as observed by the applying
+	// consumer, or nil if the shard didn't exist.
+	Before *ShardSpec `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	// ModRevision of the |before| ShardSpec.
+	BeforeRevision int64 `protobuf:"varint,7,opt,name=before_revision,json=beforeRevision,proto3" json:"before_revision,omitempty"`
+	// ShardSpec of the shard after the change, or nil if the shard was deleted.
+	After *ShardSpec `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (m *ShardSpecRevision) Reset()         { *m = ShardSpecRevision{} }
+func (m *ShardSpecRevision) String() string { return proto.CompactTextString(m) }
+func (*ShardSpecRevision) ProtoMessage()    {}
+func (*ShardSpecRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6491fb50a1cefedd, []int{9}
+}
+func (m *ShardSpecRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardSpecRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardSpecRevision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardSpecRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardSpecRevision.Merge(m, src)
+}
+func (m *ShardSpecRevision) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ShardSpecRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardSpecRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardSpecRevision proto.InternalMessageInfo
+
+type HistoryRequest struct {
+	// Shard whose revisions are to be listed. If empty, revisions of all
+	// shards are listed.
+	Shard ShardID `protobuf:"bytes,1,opt,name=shard,proto3,casttype=ShardID" json:"shard,omitempty"`
+	// The NextPageToken value returned from a previous, continued
+	// HistoryRequest, if any.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// PageLimit is an optional field specifying how many revisions to return
+	// with the response. The default value for PageLimit is 1000.
+	PageLimit int32 `protobuf:"varint,3,opt,name=page_limit,json=pageLimit,proto3" json:"page_limit,omitempty"`
+	// Optional extension of the HistoryRequest.
+	Extension []byte `protobuf:"bytes,100,opt,name=extension,proto3" json:"extension,omitempty"`
+}
+
+func (m *HistoryRequest) Reset()         { *m = HistoryRequest{} }
+func (m *HistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*HistoryRequest) ProtoMessage()    {}
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6491fb50a1cefedd, []int{10}
+}
+func (m *HistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryRequest.Merge(m, src)
+}
+func (m *HistoryRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *HistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryRequest proto.InternalMessageInfo
+
+type HistoryResponse struct {
+	// Status of the History RPC.
+	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=consumer.Status" json:"status,omitempty"`
+	// Header of the response.
+	Header protocol.Header `protobuf:"bytes,2,opt,name=header,proto3" json:"header"`
+	// Revisions of the response, ordered on shard ID and then on
+	// ascending revision.
+	Revisions []ShardSpecRevision `protobuf:"bytes,3,rep,name=revisions,proto3" json:"revisions"`
+	// The NextPageToken value to be returned on subsequent History requests.
+	// If empty, there are no more revisions to be returned.
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Optional extension of the HistoryResponse.
+	Extension []byte `protobuf:"bytes,100,opt,name=extension,proto3" json:"extension,omitempty"`
+}
+
+func (m *HistoryResponse) Reset()         { *m = HistoryResponse{} }
+func (m *HistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*HistoryResponse) ProtoMessage()    {}
+func (*HistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6491fb50a1cefedd, []int{11}
+}
+func (m *HistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryResponse.Merge(m, src)
+}
+func (m *HistoryResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *HistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryResponse proto.InternalMessageInfo
+
 type StatRequest struct {
 	// Header may be attached by a proxying consumer peer.
 	Header *protocol.Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
@@ -873,7 +1085,7 @@ func (m *StatRequest) Reset()         { *m = StatRequest{} }
 func (m *StatRequest) String() string { return proto.CompactTextString(m) }
 func (*StatRequest) ProtoMessage()    {}
 func (*StatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6491fb50a1cefedd, []int{8}
+	return fileDescriptor_6491fb50a1cefedd, []int{12}
 }
 func (m *StatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -930,7 +1142,7 @@ func (m *StatResponse) Reset()         { *m = StatResponse{} }
 func (m *StatResponse) String() string { return proto.CompactTextString(m) }
 func (*StatResponse) ProtoMessage()    {}
 func (*StatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6491fb50a1cefedd, []int{9}
+	return fileDescriptor_6491fb50a1cefedd, []int{13}
 }
 func (m *StatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -970,7 +1182,7 @@ func (m *GetHintsRequest) Reset()         { *m = GetHintsRequest{} }
 func (m *GetHintsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetHintsRequest) ProtoMessage()    {}
 func (*GetHintsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6491fb50a1cefedd, []int{10}
+	return fileDescriptor_6491fb50a1cefedd, []int{14}
 }
 func (m *GetHintsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1018,7 +1230,7 @@ func (m *GetHintsResponse) Reset()         { *m = GetHintsResponse{} }
 func (m *GetHintsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetHintsResponse) ProtoMessage()    {}
 func (*GetHintsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6491fb50a1cefedd, []int{11}
+	return fileDescriptor_6491fb50a1cefedd, []int{15}
 }
 func (m *GetHintsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1056,7 +1268,7 @@ func (m *GetHintsResponse_ResponseHints) Reset()         { *m = GetHintsResponse
 func (m *GetHintsResponse_ResponseHints) String() string { return proto.CompactTextString(m) }
 func (*GetHintsResponse_ResponseHints) ProtoMessage()    {}
 func (*GetHintsResponse_ResponseHints) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6491fb50a1cefedd, []int{11, 0}
+	return fileDescriptor_6491fb50a1cefedd, []int{15, 0}
 }
 func (m *GetHintsResponse_ResponseHints) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1099,7 +1311,7 @@ func (m *UnassignRequest) Reset()         { *m = UnassignRequest{} }
 func (m *UnassignRequest) String() string { return proto.CompactTextString(m) }
 func (*UnassignRequest) ProtoMessage()    {}
 func (*UnassignRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6491fb50a1cefedd, []int{12}
+	return fileDescriptor_6491fb50a1cefedd, []int{16}
 }
 func (m *UnassignRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1139,7 +1351,7 @@ func (m *UnassignResponse) Reset()         { *m = UnassignResponse{} }
 func (m *UnassignResponse) String() string { return proto.CompactTextString(m) }
 func (*UnassignResponse) ProtoMessage()    {}
 func (*UnassignResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6491fb50a1cefedd, []int{13}
+	return fileDescriptor_6491fb50a1cefedd, []int{17}
 }
 func (m *UnassignResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1199,12 +1411,20 @@ func init() {
 	golang_proto.RegisterType((*ListResponse)(nil), "consumer.ListResponse")
 	proto.RegisterType((*ListResponse_Shard)(nil), "consumer.ListResponse.Shard")
 	golang_proto.RegisterType((*ListResponse_Shard)(nil), "consumer.ListResponse.Shard")
+	proto.RegisterType((*WatchResponse)(nil), "consumer.WatchResponse")
+	golang_proto.RegisterType((*WatchResponse)(nil), "consumer.WatchResponse")
 	proto.RegisterType((*ApplyRequest)(nil), "consumer.ApplyRequest")
 	golang_proto.RegisterType((*ApplyRequest)(nil), "consumer.ApplyRequest")
 	proto.RegisterType((*ApplyRequest_Change)(nil), "consumer.ApplyRequest.Change")
 	golang_proto.RegisterType((*ApplyRequest_Change)(nil), "consumer.ApplyRequest.Change")
 	proto.RegisterType((*ApplyResponse)(nil), "consumer.ApplyResponse")
 	golang_proto.RegisterType((*ApplyResponse)(nil), "consumer.ApplyResponse")
+	proto.RegisterType((*ShardSpecRevision)(nil), "consumer.ShardSpecRevision")
+	golang_proto.RegisterType((*ShardSpecRevision)(nil), "consumer.ShardSpecRevision")
+	proto.RegisterType((*HistoryRequest)(nil), "consumer.HistoryRequest")
+	golang_proto.RegisterType((*HistoryRequest)(nil), "consumer.HistoryRequest")
+	proto.RegisterType((*HistoryResponse)(nil), "consumer.HistoryResponse")
+	golang_proto.RegisterType((*HistoryResponse)(nil), "consumer.HistoryResponse")
 	proto.RegisterType((*StatRequest)(nil), "consumer.StatRequest")
 	golang_proto.RegisterType((*StatRequest)(nil), "consumer.StatRequest")
 	proto.RegisterMapType((map[go_gazette_dev_core_broker_protocol.Journal]go_gazette_dev_core_broker_protocol.Offset)(nil), "consumer.StatRequest.ReadThroughEntry")
@@ -1233,130 +1453,148 @@ func init() {
 }
 
 var fileDescriptor_6491fb50a1cefedd = []byte{
-	// 1964 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xd6, 0xf2, 0x4f, 0xe4, 0x23, 0x25, 0x51, 0x63, 0x3b, 0xa6, 0x69, 0x87, 0x94, 0x18, 0xcb,
-	0x65, 0xfe, 0x56, 0xa9, 0x82, 0x00, 0xa9, 0x91, 0x04, 0x25, 0x45, 0xc9, 0x56, 0xa3, 0xbf, 0x2e,
-	0x15, 0xa4, 0x09, 0x50, 0x2c, 0x96, 0xbb, 0x23, 0x6a, 0xab, 0xe5, 0xce, 0x76, 0x77, 0xa8, 0x8a,
-	0x3e, 0x1a, 0x28, 0x0a, 0xa4, 0x97, 0xdc, 0xda, 0x63, 0xd0, 0x5e, 0x5a, 0xa0, 0xd7, 0xf6, 0x56,
-	0xa0, 0xb7, 0xfa, 0xe8, 0x53, 0xd1, 0x13, 0x8d, 0x46, 0x97, 0x1e, 0x0b, 0xf5, 0x52, 0xf8, 0x54,
-	0xcc, 0xcf, 0x72, 0x97, 0x34, 0xa5, 0x54, 0x05, 0xdc, 0x5e, 0x84, 0xd1, 0x7b, 0xdf, 0xfb, 0xde,
-	0xcf, 0xbc, 0x79, 0x33, 0x4b, 0x58, 0x32, 0x89, 0x1b, 0xf4, 0x7b, 0xd8, 0x5f, 0xf5, 0x7c, 0x42,
-	0x89, 0x49, 0x9c, 0xd1, 0x42, 0xe5, 0x0b, 0x94, 0x0d, 0x11, 0xe5, 0x4a, 0xc7, 0x27, 0xc7, 0x17,
-	0x23, 0xcb, 0xf7, 0x46, 0x5c, 0x3e, 0x36, 0xc9, 0x09, 0xf6, 0x07, 0x0e, 0xe9, 0xf2, 0xb5, 0x6f,
-	0x61, 0x4b, 0x27, 0x9e, 0xc4, 0x5d, 0xef, 0x92, 0x2e, 0xe1, 0xcb, 0x55, 0xb6, 0x92, 0xd2, 0x4a,
-	0x97, 0x90, 0xae, 0x83, 0x05, 0x69, 0xa7, 0x7f, 0xb8, 0x6a, 0xf5, 0x7d, 0x83, 0xda, 0xc4, 0x15,
-	0xfa, 0xda, 0x3f, 0x73, 0x90, 0x6b, 0x1f, 0x19, 0xbe, 0xd5, 0xf6, 0xb0, 0x89, 0xde, 0x81, 0x84,
-	0x6d, 0x95, 0x94, 0x25, 0xa5, 0x9e, 0x6b, 0x2e, 0x9d, 0x0f, 0xab, 0x8b, 0x03, 0xa3, 0xe7, 0xdc,
-	0xaf, 0xbd, 0x45, 0x7a, 0x36, 0xc5, 0x3d, 0x8f, 0x0e, 0x6a, 0xcf, 0x87, 0xd5, 0x59, 0x8e, 0xdf,
-	0x6a, 0x69, 0x09, 0xdb, 0x42, 0x7b, 0x30, 0x1b, 0x90, 0xbe, 0x6f, 0xe2, 0xa0, 0x94, 0x58, 0x4a,
-	0xd6, 0xf3, 0x6b, 0x65, 0x35, 0x8c, 0x57, 0x1d, 0xf1, 0xaa, 0x6d, 0x0e, 0x69, 0xde, 0x7a, 0x32,
-	0xac, 0xce, 0x4c, 0xa5, 0xd5, 0x42, 0x16, 0xf4, 0x03, 0xb8, 0x16, 0xe6, 0xa9, 0x3b, 0xa4, 0xab,
-	0x7b, 0x3e, 0x3e, 0xb4, 0x4f, 0x4b, 0x49, 0x1e, 0x53, 0xfd, 0x7c, 0x58, 0xbd, 0x2b, 0x8c, 0xa7,
-	0x80, 0xe2, 0x7c, 0x8b, 0xa1, 0x7e, 0x9b, 0x74, 0xf7, 0xb9, 0x16, 0x35, 0x20, 0x7f, 0x64, 0xbb,
-	0x34, 0x64, 0x4c, 0x8d, 0xb2, 0xbc, 0x23, 0x18, 0x63, 0xca, 0x38, 0x13, 0x30, 0xb9, 0xa4, 0x68,
-	0x41, 0x81, 0xa3, 0x3a, 0x86, 0x79, 0xdc, 0xf7, 0x82, 0x52, 0x7a, 0x49, 0xa9, 0xa7, 0x9b, 0xcb,
-	0xe7, 0xc3, 0xea, 0xab, 0x31, 0x0e, 0xa9, 0x8d, 0x93, 0x70, 0xcf, 0x4d, 0x21, 0x47, 0x3e, 0x14,
-	0x7b, 0xc6, 0xa9, 0x4e, 0x4f, 0x5d, 0x3d, 0xdc, 0x8d, 0x52, 0x66, 0x49, 0xa9, 0xe7, 0xd7, 0x6e,
-	0xa9, 0x62, 0xbb, 0xd4, 0x70, 0xbb, 0xd4, 0x96, 0x04, 0x34, 0xdf, 0x96, 0xb5, 0x5b, 0x16, 0x8e,
-	0x26, 0x09, 0x62, 0xce, 0x7e, 0xf9, 0xac, 0xaa, 0x68, 0xf3, 0x3d, 0xe3, 0xf4, 0xe0, 0xd4, 0x0d,
-	0xcd, 0xb9, 0x4f, 0xdb, 0x1d, 0xf7, 0x39, 0x7b, 0x55, 0x9f, 0x13, 0x04, 0x2f, 0xfa, 0xb4, 0xdd,
-	0xb8, 0xcf, 0x55, 0x98, 0xb5, 0xec, 0xc0, 0xe8, 0x38, 0xb8, 0x94, 0x5d, 0x52, 0xea, 0xd9, 0xe6,
-	0x8d, 0x0b, 0xf6, 0x5e, 0xa2, 0x78, 0x79, 0x09, 0xd5, 0x03, 0x6a, 0xb8, 0x56, 0x67, 0x10, 0x94,
-	0x72, 0x4b, 0x4a, 0x7d, 0x6e, 0xac, 0xbc, 0x31, 0xed, 0x78, 0x79, 0x09, 0x6d, 0x4b, 0x39, 0xda,
-	0x87, 0x8c, 0x63, 0x74, 0xb0, 0x13, 0x94, 0x80, 0x27, 0x88, 0xd4, 0xd1, 0x89, 0xda, 0x66, 0xf2,
-	0x36, 0xa6, 0xcd, 0xbb, 0x2c, 0xb3, 0xa7, 0xc3, 0xaa, 0x72, 0x3e, 0xac, 0x96, 0x26, 0x23, 0x7a,
-	0xcb, 0x76, 0x1d, 0xdb, 0xc5, 0x35, 0x4d, 0xf2, 0xa0, 0xcf, 0xe1, 0xba, 0x0c, 0x51, 0xff, 0x89,
-	0x61, 0x53, 0xfd, 0x90, 0xf8, 0xba, 0x61, 0x1e, 0x97, 0xf2, 0x3c, 0xab, 0xd7, 0xcf, 0x87, 0xd5,
-	0x15, 0xc1, 0x31, 0x0d, 0x35, 0xd6, 0x95, 0x12, 0xf0, 0xa9, 0x61, 0xd3, 0x4d, 0xe2, 0x37, 0xcc,
-	0x63, 0xb4, 0x07, 0x45, 0xdf, 0x76, 0xbb, 0x7a, 0xa7, 0x7f, 0x78, 0x88, 0x7d, 0x3d, 0xb0, 0x1f,
-	0xe1, 0x52, 0x81, 0xe7, 0xbd, 0x12, 0x55, 0x7e, 0x12, 0x11, 0xe7, 0x9c, 0x67, 0xca, 0x26, 0xd7,
-	0xb5, 0xed, 0x47, 0x18, 0x69, 0xb0, 0xe8, 0x63, 0xc3, 0xd2, 0xcd, 0x23, 0xc3, 0x75, 0xb1, 0x23,
-	0x18, 0xe7, 0x38, 0xe3, 0xbd, 0xf3, 0x61, 0xb5, 0x16, 0x1e, 0x9f, 0x09, 0x48, 0x9c, 0x72, 0x81,
-	0x69, 0xd7, 0x85, 0x92, 0x71, 0x96, 0xff, 0xac, 0x40, 0x46, 0x9c, 0x61, 0xb4, 0x05, 0xb3, 0x3f,
-	0x22, 0x7d, 0xdf, 0x35, 0x1c, 0x39, 0x27, 0x56, 0x9f, 0x0f, 0xab, 0x6f, 0x76, 0x89, 0xda, 0x35,
-	0x1e, 0x61, 0x4a, 0xb1, 0x6a, 0xe1, 0x93, 0x55, 0x93, 0xf8, 0x78, 0x75, 0x62, 0xae, 0xa9, 0xdf,
-	0x13, 0x66, 0x5a, 0x68, 0x8f, 0x1c, 0x00, 0xd6, 0x52, 0xe4, 0xf0, 0x30, 0xc0, 0x94, 0x9f, 0xf0,
-	0x64, 0x73, 0xe7, 0x7c, 0x58, 0xbd, 0x1d, 0xb5, 0x9b, 0xd0, 0x8d, 0xcf, 0x9f, 0x37, 0xfe, 0x13,
-	0x67, 0x7b, 0xdc, 0x50, 0xcb, 0xf5, 0x6c, 0x57, 0x2c, 0xef, 0xa7, 0xfe, 0xfe, 0x55, 0x55, 0x11,
-	0x7f, 0x6b, 0x3f, 0x55, 0xa0, 0xb0, 0x2e, 0xc7, 0x14, 0x1f, 0x7c, 0x07, 0x50, 0xf0, 0x7c, 0x62,
-	0xe2, 0x20, 0xd0, 0x03, 0x0f, 0x9b, 0x3c, 0xb5, 0xfc, 0xda, 0x8d, 0xa8, 0x73, 0xf6, 0x85, 0x96,
-	0x81, 0x9b, 0xe5, 0x58, 0xf3, 0xcc, 0xcb, 0xe6, 0x09, 0x5b, 0x26, 0xef, 0x45, 0x40, 0x54, 0x85,
-	0x7c, 0xc0, 0x66, 0xa0, 0xee, 0xd8, 0x3d, 0x9b, 0x96, 0x12, 0x6c, 0x13, 0x34, 0xe0, 0xa2, 0x6d,
-	0x26, 0xa9, 0xfd, 0x4a, 0x81, 0x39, 0x0d, 0x7b, 0x8e, 0x6d, 0x1a, 0x6d, 0x6a, 0xd0, 0x7e, 0x80,
-	0xde, 0x81, 0x94, 0x49, 0x2c, 0xcc, 0x03, 0x98, 0x5f, 0xbb, 0x13, 0x0d, 0xd3, 0x31, 0x98, 0xba,
-	0x4e, 0x2c, 0xac, 0x71, 0x24, 0x7a, 0x05, 0x32, 0xd8, 0xf7, 0x89, 0x2f, 0x06, 0x70, 0x4e, 0x93,
-	0xff, 0xd5, 0x1e, 0x40, 0x8a, 0xa1, 0x50, 0x16, 0x52, 0x5b, 0xad, 0xed, 0x8d, 0xe2, 0x0c, 0x2a,
-	0x40, 0xb6, 0xd9, 0x58, 0xff, 0x78, 0x73, 0x6b, 0x7b, 0xbb, 0x68, 0xa1, 0x02, 0xcc, 0xb6, 0x0f,
-	0x1a, 0xbb, 0xad, 0xe6, 0x67, 0xc5, 0x27, 0x0a, 0xfb, 0x6f, 0x5f, 0xdb, 0xda, 0x69, 0x68, 0x9f,
-	0x15, 0x7f, 0x97, 0x40, 0x79, 0xc8, 0x6c, 0x36, 0xb6, 0xb6, 0x37, 0x5a, 0xc5, 0x2f, 0x93, 0xb5,
-	0x3f, 0x64, 0x00, 0xd6, 0x8f, 0xb0, 0x79, 0xec, 0x11, 0xdb, 0xa5, 0xc8, 0x8b, 0x26, 0xbe, 0xc2,
-	0x27, 0xfe, 0x72, 0x14, 0x64, 0x04, 0x93, 0x23, 0x3f, 0xd8, 0x70, 0xa9, 0x3f, 0x68, 0xbe, 0xcb,
-	0x2a, 0xf6, 0xf8, 0xd9, 0x15, 0xfb, 0x24, 0xbc, 0x12, 0x4e, 0x20, 0x6f, 0x98, 0xc7, 0xba, 0xed,
-	0x52, 0xec, 0xd2, 0xf0, 0x9e, 0xb9, 0x3b, 0xd5, 0x6b, 0xc3, 0x3c, 0xde, 0x12, 0x30, 0xe1, 0x78,
-	0xf5, 0xaa, 0x4e, 0xc1, 0x18, 0x31, 0x94, 0x7f, 0x9e, 0x18, 0x75, 0xfd, 0xf7, 0xa1, 0xc0, 0x4f,
-	0x0c, 0x3d, 0xf2, 0x49, 0xbf, 0x7b, 0xc4, 0xb7, 0x27, 0xd9, 0x54, 0xaf, 0xd8, 0x8d, 0x79, 0xc6,
-	0x71, 0x20, 0x28, 0xd0, 0x0e, 0xe4, 0x3c, 0x9f, 0x58, 0x7d, 0x13, 0xfb, 0x61, 0x4e, 0xaf, 0x5f,
-	0x52, 0x49, 0xd6, 0x81, 0x1c, 0x2c, 0x12, 0x4b, 0xb1, 0x8a, 0x6a, 0x11, 0x43, 0x59, 0x87, 0xb9,
-	0x31, 0x04, 0x9a, 0x1f, 0xdd, 0xe5, 0x05, 0x7e, 0x53, 0x7f, 0x04, 0xe9, 0x80, 0x1a, 0x14, 0xf3,
-	0x36, 0xcc, 0xaf, 0xd5, 0xa6, 0xfa, 0x0a, 0x29, 0x58, 0x9b, 0x61, 0xe9, 0x44, 0x98, 0x95, 0x7f,
-	0xa1, 0x44, 0x1e, 0xb8, 0x1a, 0x7d, 0x17, 0xb2, 0x8e, 0x11, 0x50, 0x3e, 0x0a, 0x99, 0x9f, 0x4c,
-	0x73, 0xe5, 0xf9, 0xb0, 0xba, 0x3c, 0xad, 0x20, 0x3d, 0x1c, 0x04, 0x46, 0x17, 0xab, 0xeb, 0x0e,
-	0x31, 0x8f, 0xb5, 0x59, 0x66, 0xc6, 0x86, 0x5f, 0x0b, 0xd2, 0x1d, 0xdc, 0xb5, 0x5d, 0x1e, 0xd3,
-	0xd5, 0xeb, 0x29, 0x8c, 0xcb, 0x9f, 0x42, 0x21, 0xde, 0x6d, 0xa8, 0x08, 0xc9, 0x63, 0x3c, 0x10,
-	0xe3, 0x49, 0x63, 0x4b, 0xf4, 0x6d, 0x48, 0x9f, 0x18, 0x4e, 0x3f, 0xcc, 0xfd, 0xf6, 0x25, 0x75,
-	0xd6, 0x04, 0xf2, 0x7e, 0xe2, 0x7d, 0xa5, 0xfc, 0x21, 0x2c, 0x4c, 0x34, 0xd4, 0x14, 0xee, 0xeb,
-	0x71, 0xee, 0x42, 0xcc, 0xbc, 0x76, 0x08, 0xf9, 0x6d, 0x3b, 0xa0, 0x1a, 0xfe, 0x71, 0x1f, 0x07,
-	0x14, 0x7d, 0x07, 0xb2, 0x01, 0x76, 0xb0, 0x49, 0x89, 0x2f, 0xe7, 0xcb, 0xcd, 0x17, 0x6e, 0x26,
-	0xa1, 0x96, 0x85, 0x1f, 0xc1, 0xd1, 0x1d, 0xc8, 0xe1, 0x53, 0x8a, 0xdd, 0x80, 0x5d, 0xdb, 0x16,
-	0xf7, 0x13, 0x09, 0x6a, 0x8f, 0x93, 0x50, 0x10, 0x8e, 0x02, 0x8f, 0xb8, 0x01, 0x46, 0x75, 0xc8,
-	0x04, 0x7c, 0x4e, 0xc8, 0x31, 0x52, 0x8c, 0xbd, 0xc9, 0xb8, 0x5c, 0x93, 0x7a, 0xa4, 0x42, 0xe6,
-	0x08, 0x1b, 0x16, 0xf6, 0x65, 0x65, 0x8a, 0x51, 0x44, 0x0f, 0xb9, 0x5c, 0x86, 0x22, 0x51, 0xe8,
-	0x3e, 0x64, 0xf8, 0xf8, 0x0a, 0x4a, 0x49, 0xde, 0xb1, 0xb1, 0x01, 0x15, 0x8f, 0x40, 0x3c, 0xfd,
-	0x42, 0x5b, 0x61, 0x71, 0x79, 0x12, 0xe5, 0x3f, 0x2a, 0x90, 0xe6, 0x56, 0xe8, 0x6d, 0x48, 0xc5,
-	0x66, 0xf0, 0xb5, 0x29, 0xef, 0x49, 0x49, 0xcc, 0x61, 0x68, 0x19, 0x0a, 0x3d, 0x62, 0xe9, 0x3e,
-	0x3e, 0xb1, 0x39, 0x33, 0x6f, 0x25, 0x2d, 0xdf, 0x23, 0x96, 0x26, 0x45, 0xe8, 0x4d, 0x48, 0xfb,
-	0xa4, 0x4f, 0x31, 0xbf, 0x63, 0xf2, 0x6b, 0x0b, 0x51, 0x92, 0x1a, 0x13, 0x87, 0x7d, 0xce, 0x31,
-	0xe8, 0xbd, 0x51, 0xf1, 0x52, 0x3c, 0xc5, 0x9b, 0x17, 0xcc, 0xe0, 0x51, 0x76, 0xfc, 0xbf, 0xda,
-	0xbf, 0x14, 0x28, 0x34, 0x3c, 0xcf, 0x19, 0x84, 0xdb, 0xfd, 0x21, 0xcc, 0xb2, 0xfb, 0xb5, 0x3b,
-	0x9a, 0x93, 0xaf, 0x46, 0x44, 0x71, 0xa0, 0xba, 0xce, 0x51, 0x92, 0x2e, 0xb4, 0xf9, 0x86, 0x6a,
-	0x7d, 0xa1, 0x40, 0x46, 0xd8, 0x21, 0x15, 0xae, 0xe1, 0x53, 0x0f, 0x9b, 0x54, 0x1f, 0x2b, 0x03,
-	0x9f, 0x50, 0xda, 0xa2, 0x50, 0xed, 0x8c, 0x15, 0x23, 0xd3, 0xf7, 0x02, 0xec, 0x53, 0xb9, 0xe5,
-	0xd3, 0x0a, 0xac, 0x49, 0x08, 0x7a, 0x0d, 0x32, 0x16, 0x76, 0xb0, 0x2c, 0x5d, 0xae, 0x99, 0x8f,
-	0xbf, 0xff, 0xa5, 0xaa, 0xf6, 0x33, 0x05, 0xe6, 0x64, 0x46, 0x2f, 0xbd, 0x01, 0x2f, 0x3f, 0x09,
-	0x67, 0x09, 0xc8, 0x33, 0x07, 0xe1, 0x1e, 0xd4, 0x47, 0xec, 0xca, 0x74, 0xf6, 0x11, 0xef, 0x32,
-	0xa4, 0x79, 0x9b, 0xf2, 0x30, 0x26, 0xf2, 0x14, 0x1a, 0xf4, 0x1b, 0x65, 0xe2, 0x12, 0x10, 0x47,
-	0xe0, 0xde, 0x78, 0x6e, 0xe1, 0xae, 0x6a, 0xd1, 0xa8, 0x17, 0x13, 0xfb, 0x87, 0x57, 0xbc, 0x8a,
-	0xbe, 0x78, 0xf6, 0xdf, 0xdf, 0x2d, 0x97, 0x37, 0xcf, 0x47, 0x50, 0x9c, 0x8c, 0xee, 0x9b, 0xe6,
-	0x5a, 0x32, 0x3e, 0xd7, 0xfe, 0x92, 0x82, 0x82, 0x48, 0xf5, 0xa5, 0x6f, 0xf7, 0x6f, 0xa7, 0xd7,
-	0xfc, 0x5b, 0x93, 0x35, 0x97, 0x63, 0xe7, 0xff, 0x5a, 0xf4, 0x5f, 0x2b, 0x00, 0x5e, 0xbf, 0xe3,
-	0xd8, 0xc1, 0x91, 0x6e, 0x50, 0x39, 0x3d, 0x56, 0x2e, 0x88, 0x74, 0x5f, 0x00, 0x1b, 0xf4, 0x7f,
-	0x12, 0x67, 0xce, 0x0b, 0xdd, 0xbd, 0xdc, 0xd6, 0x28, 0x7f, 0x00, 0xf3, 0xe3, 0x99, 0x5d, 0xa9,
-	0xb1, 0x34, 0x58, 0x78, 0x80, 0xe9, 0x43, 0xdb, 0xa5, 0x41, 0x78, 0x82, 0x47, 0xe7, 0x52, 0xb9,
-	0xf0, 0x5c, 0x5e, 0x3e, 0x12, 0xfe, 0x91, 0x80, 0x62, 0x44, 0xfa, 0xd2, 0x1b, 0xb6, 0x0d, 0x73,
-	0x9e, 0x6f, 0xf7, 0x0c, 0x7f, 0xa0, 0xb3, 0x4f, 0xfe, 0x40, 0x5e, 0x39, 0xf5, 0xc8, 0xc1, 0x64,
-	0x30, 0x6a, 0xb8, 0xe0, 0x52, 0x49, 0x57, 0x90, 0x24, 0x5c, 0xc6, 0x5e, 0x9f, 0xe2, 0x37, 0x05,
-	0xc9, 0x29, 0x5a, 0xeb, 0xaa, 0x9c, 0x79, 0xc1, 0x21, 0x28, 0x2f, 0x6f, 0x83, 0x0f, 0xd8, 0x67,
-	0x49, 0x8c, 0x81, 0xdd, 0xa0, 0xc2, 0x75, 0xf8, 0x61, 0x14, 0xfb, 0x2d, 0x4a, 0xdd, 0x6c, 0xef,
-	0x08, 0xef, 0x02, 0x53, 0xf3, 0x60, 0xe1, 0x13, 0xd7, 0x08, 0x02, 0xbb, 0xeb, 0x86, 0xdb, 0xf8,
-	0xda, 0xe8, 0xdd, 0xc0, 0xee, 0xc2, 0xc9, 0x7b, 0x44, 0x3e, 0x10, 0xaa, 0x90, 0x27, 0xae, 0x33,
-	0xd0, 0x0f, 0x0d, 0xdb, 0xc1, 0x62, 0x12, 0x67, 0x35, 0x60, 0xa2, 0x4d, 0x2e, 0x41, 0x37, 0x61,
-	0xd6, 0xf2, 0x07, 0xba, 0xdf, 0x77, 0x79, 0x59, 0xb3, 0x5a, 0xc6, 0xf2, 0x07, 0x5a, 0xdf, 0xad,
-	0x19, 0x50, 0x8c, 0x3c, 0x5e, 0x79, 0x8f, 0xa3, 0xe0, 0x12, 0x17, 0x06, 0xf7, 0xc6, 0x63, 0xf6,
-	0x09, 0x2c, 0xf0, 0x19, 0x48, 0xec, 0x7d, 0x5c, 0x9c, 0x41, 0xd7, 0x60, 0xa1, 0xfd, 0xb0, 0xa1,
-	0xb5, 0xf4, 0xdd, 0xbd, 0x03, 0x7d, 0x73, 0xef, 0x93, 0xdd, 0x56, 0x51, 0x41, 0xd7, 0xa1, 0xb8,
-	0xbb, 0xa7, 0x0b, 0x79, 0xf8, 0x45, 0x95, 0x40, 0x37, 0x60, 0x91, 0x81, 0xc6, 0xc5, 0x49, 0x74,
-	0x1b, 0x6e, 0x6e, 0x1c, 0xac, 0xb7, 0xf4, 0x03, 0xad, 0xb1, 0xdb, 0x6e, 0xac, 0x1f, 0x6c, 0xed,
-	0xed, 0xea, 0xf2, 0xc3, 0x2b, 0x85, 0x16, 0x61, 0x4e, 0xe0, 0xdb, 0x07, 0x7b, 0xfb, 0xfb, 0x1b,
-	0xad, 0x62, 0x7a, 0xed, 0xf7, 0x89, 0xf0, 0x91, 0xf4, 0x1e, 0xa4, 0x58, 0x34, 0xe8, 0xc6, 0xd4,
-	0xdb, 0xa7, 0xfc, 0xca, 0xf4, 0xb1, 0xc3, 0xcc, 0xd8, 0x3b, 0x2d, 0x6e, 0x16, 0x7b, 0xa2, 0xc6,
-	0xcd, 0xc6, 0x1e, 0x94, 0xef, 0x43, 0x9a, 0x5f, 0xf0, 0xe8, 0x95, 0xe9, 0x6f, 0x98, 0xf2, 0xcd,
-	0x17, 0xe4, 0xd2, 0xb2, 0x01, 0xd9, 0xb0, 0x39, 0xd1, 0xad, 0x69, 0x0d, 0x2b, 0xec, 0xcb, 0x17,
-	0xf7, 0x32, 0xa3, 0x08, 0x37, 0x37, 0x4e, 0x31, 0xd1, 0x62, 0x71, 0x8a, 0xc9, 0x5e, 0x68, 0x3e,
-	0x78, 0xf2, 0xb7, 0xca, 0xcc, 0x93, 0xaf, 0x2b, 0xca, 0xd3, 0xaf, 0x2b, 0xca, 0x97, 0x67, 0x95,
-	0x99, 0xaf, 0xce, 0x2a, 0xca, 0x9f, 0xce, 0x2a, 0xca, 0xd3, 0xb3, 0xca, 0xcc, 0x5f, 0xcf, 0x2a,
-	0x33, 0x9f, 0xaf, 0x4c, 0x9b, 0xa6, 0x2f, 0xfc, 0x8a, 0xdb, 0xc9, 0xf0, 0xd5, 0xbb, 0xff, 0x0e,
-	0x00, 0x00, 0xff, 0xff, 0x2d, 0x38, 0x62, 0xfa, 0xe1, 0x15, 0x00, 0x00,
+	// 2242 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x49, 0x6c, 0x1b, 0xd7,
+	0x19, 0xd6, 0x70, 0xe7, 0x4f, 0x52, 0xa2, 0x9e, 0x37, 0x9a, 0xb6, 0x45, 0x89, 0xb1, 0x1c, 0x39,
+	0x0b, 0xe5, 0x2a, 0x08, 0x90, 0x18, 0x8e, 0x5b, 0x52, 0x94, 0x6c, 0x35, 0xb2, 0xa4, 0x0e, 0x19,
+	0xb8, 0x09, 0x50, 0x0c, 0x86, 0x33, 0x8f, 0xd4, 0x54, 0xc3, 0x99, 0xe9, 0xcc, 0xa3, 0x2b, 0xfa,
+	0x18, 0xa0, 0x28, 0x90, 0x1e, 0x9a, 0xa2, 0x87, 0xf6, 0x18, 0xb4, 0x97, 0x06, 0xe8, 0xa1, 0xa7,
+	0xde, 0x0a, 0xf4, 0x56, 0x1f, 0x7d, 0x2a, 0x7a, 0xa2, 0xd1, 0xe8, 0xd2, 0x43, 0x0f, 0x85, 0x7a,
+	0xf3, 0xa9, 0x78, 0xcb, 0x2c, 0xa4, 0x29, 0x39, 0x0a, 0xe0, 0xf4, 0x22, 0x0c, 0xff, 0xe5, 0xfb,
+	0xd7, 0xf7, 0xbf, 0x7f, 0x46, 0xb0, 0xa8, 0xd9, 0x96, 0x37, 0xe8, 0x63, 0x77, 0xd5, 0x71, 0x6d,
+	0x62, 0x6b, 0xb6, 0x19, 0x3c, 0xd4, 0xd8, 0x03, 0xca, 0xf8, 0x12, 0xe5, 0x85, 0x8e, 0x6b, 0x1f,
+	0x9c, 0x2c, 0x59, 0xbe, 0x11, 0x60, 0xb9, 0x58, 0xb3, 0x1f, 0x61, 0x77, 0x68, 0xda, 0x3d, 0xf6,
+	0xec, 0xea, 0x58, 0x57, 0x6c, 0x47, 0xc8, 0x9d, 0xef, 0xd9, 0x3d, 0x9b, 0x3d, 0xae, 0xd2, 0x27,
+	0x41, 0x5d, 0xe8, 0xd9, 0x76, 0xcf, 0xc4, 0x1c, 0xb4, 0x33, 0xe8, 0xae, 0xea, 0x03, 0x57, 0x25,
+	0x86, 0x6d, 0x71, 0x7e, 0xf5, 0xbf, 0x59, 0xc8, 0xb6, 0xf6, 0x55, 0x57, 0x6f, 0x39, 0x58, 0x43,
+	0xb7, 0x20, 0x66, 0xe8, 0x25, 0x69, 0x51, 0x5a, 0xc9, 0x36, 0x16, 0x8f, 0x47, 0x95, 0xf9, 0xa1,
+	0xda, 0x37, 0x6f, 0x57, 0xdf, 0xb2, 0xfb, 0x06, 0xc1, 0x7d, 0x87, 0x0c, 0xab, 0xcf, 0x47, 0x95,
+	0x34, 0x93, 0xdf, 0x6a, 0xca, 0x31, 0x43, 0x47, 0xbb, 0x90, 0xf6, 0xec, 0x81, 0xab, 0x61, 0xaf,
+	0x14, 0x5b, 0x8c, 0xaf, 0xe4, 0xd6, 0xca, 0x35, 0xdf, 0xdf, 0x5a, 0x80, 0x5b, 0x6b, 0x31, 0x91,
+	0xc6, 0xe5, 0x27, 0xa3, 0xca, 0xcc, 0x54, 0x58, 0xd9, 0x47, 0x41, 0x3f, 0x84, 0x73, 0x7e, 0x9c,
+	0x8a, 0x69, 0xf7, 0x14, 0xc7, 0xc5, 0x5d, 0xe3, 0xb0, 0x14, 0x67, 0x3e, 0xad, 0x1c, 0x8f, 0x2a,
+	0xd7, 0xb9, 0xf2, 0x14, 0xa1, 0x28, 0xde, 0xbc, 0xcf, 0xdf, 0xb6, 0x7b, 0x7b, 0x8c, 0x8b, 0xea,
+	0x90, 0xdb, 0x37, 0x2c, 0xe2, 0x23, 0x26, 0x82, 0x28, 0xaf, 0x72, 0xc4, 0x08, 0x33, 0x8a, 0x04,
+	0x94, 0x2e, 0x20, 0x9a, 0x90, 0x67, 0x52, 0x1d, 0x55, 0x3b, 0x18, 0x38, 0x5e, 0x29, 0xb9, 0x28,
+	0xad, 0x24, 0x1b, 0x4b, 0xc7, 0xa3, 0xca, 0xb5, 0x08, 0x86, 0xe0, 0x46, 0x41, 0x98, 0xe5, 0x06,
+	0xa7, 0x23, 0x17, 0x8a, 0x7d, 0xf5, 0x50, 0x21, 0x87, 0x96, 0xe2, 0x57, 0xa3, 0x94, 0x5a, 0x94,
+	0x56, 0x72, 0x6b, 0x97, 0x6b, 0xbc, 0x5c, 0x35, 0xbf, 0x5c, 0xb5, 0xa6, 0x10, 0x68, 0xbc, 0x2d,
+	0x72, 0xb7, 0xc4, 0x0d, 0x4d, 0x02, 0x44, 0x8c, 0xfd, 0xf6, 0x59, 0x45, 0x92, 0x67, 0xfb, 0xea,
+	0x61, 0xfb, 0xd0, 0xf2, 0xd5, 0x99, 0x4d, 0xc3, 0x1a, 0xb7, 0x99, 0x3e, 0xab, 0x4d, 0xc3, 0x7a,
+	0x89, 0x4d, 0xc3, 0x8a, 0xda, 0x5c, 0x85, 0xb4, 0x6e, 0x78, 0x6a, 0xc7, 0xc4, 0xa5, 0xcc, 0xa2,
+	0xb4, 0x92, 0x69, 0x5c, 0x38, 0xa1, 0xf6, 0x42, 0x8a, 0xa5, 0xd7, 0x26, 0x8a, 0x47, 0x54, 0x4b,
+	0xef, 0x0c, 0xbd, 0x52, 0x76, 0x51, 0x5a, 0x29, 0x8c, 0xa5, 0x37, 0xc2, 0x1d, 0x4f, 0xaf, 0x4d,
+	0x5a, 0x82, 0x8e, 0xf6, 0x20, 0x65, 0xaa, 0x1d, 0x6c, 0x7a, 0x25, 0x60, 0x01, 0xa2, 0x5a, 0x70,
+	0xa2, 0xb6, 0x29, 0xbd, 0x85, 0x49, 0xe3, 0x3a, 0x8d, 0xec, 0xe9, 0xa8, 0x22, 0x1d, 0x8f, 0x2a,
+	0xa5, 0x49, 0x8f, 0xde, 0x32, 0x2c, 0xd3, 0xb0, 0x70, 0x55, 0x16, 0x38, 0xe8, 0x13, 0x38, 0x2f,
+	0x5c, 0x54, 0x7e, 0xaa, 0x1a, 0x44, 0xe9, 0xda, 0xae, 0xa2, 0x6a, 0x07, 0xa5, 0x1c, 0x8b, 0xea,
+	0xe6, 0xf1, 0xa8, 0xb2, 0xcc, 0x31, 0xa6, 0x49, 0x8d, 0x75, 0xa5, 0x10, 0x78, 0xa8, 0x1a, 0x64,
+	0xd3, 0x76, 0xeb, 0xda, 0x01, 0xda, 0x85, 0xa2, 0x6b, 0x58, 0x3d, 0xa5, 0x33, 0xe8, 0x76, 0xb1,
+	0xab, 0x78, 0xc6, 0x63, 0x5c, 0xca, 0xb3, 0xb8, 0x97, 0xc3, 0xcc, 0x4f, 0x4a, 0x44, 0x31, 0x67,
+	0x29, 0xb3, 0xc1, 0x78, 0x2d, 0xe3, 0x31, 0x46, 0x32, 0xcc, 0xbb, 0x58, 0xd5, 0x15, 0x6d, 0x5f,
+	0xb5, 0x2c, 0x6c, 0x72, 0xc4, 0x02, 0x43, 0xbc, 0x71, 0x3c, 0xaa, 0x54, 0xfd, 0xe3, 0x33, 0x21,
+	0x12, 0x85, 0x9c, 0xa3, 0xdc, 0x75, 0xce, 0xa4, 0x98, 0xe5, 0xbf, 0x49, 0x90, 0xe2, 0x67, 0x18,
+	0x6d, 0x41, 0xfa, 0xc7, 0xf6, 0xc0, 0xb5, 0x54, 0x53, 0xcc, 0x89, 0xd5, 0xe7, 0xa3, 0xca, 0x9b,
+	0x3d, 0xbb, 0xd6, 0x53, 0x1f, 0x63, 0x42, 0x70, 0x4d, 0xc7, 0x8f, 0x56, 0x35, 0xdb, 0xc5, 0xab,
+	0x13, 0x73, 0xad, 0xf6, 0x7d, 0xae, 0x26, 0xfb, 0xfa, 0xc8, 0x04, 0xa0, 0x2d, 0x65, 0x77, 0xbb,
+	0x1e, 0x26, 0xec, 0x84, 0xc7, 0x1b, 0x0f, 0x8e, 0x47, 0x95, 0x2b, 0x61, 0xbb, 0x71, 0xde, 0xf8,
+	0xfc, 0x79, 0xe3, 0xeb, 0x18, 0xdb, 0x65, 0x8a, 0x72, 0xb6, 0x6f, 0x58, 0xfc, 0xf1, 0x76, 0xe2,
+	0x5f, 0x5f, 0x54, 0x24, 0xfe, 0xb7, 0xfa, 0x33, 0x09, 0xf2, 0xeb, 0x62, 0x4c, 0xb1, 0xc1, 0xd7,
+	0x86, 0xbc, 0xe3, 0xda, 0x1a, 0xf6, 0x3c, 0xc5, 0x73, 0xb0, 0xc6, 0x42, 0xcb, 0xad, 0x5d, 0x08,
+	0x3b, 0x67, 0x8f, 0x73, 0xa9, 0x70, 0xa3, 0x1c, 0x69, 0x9e, 0x59, 0xd1, 0x3c, 0x7e, 0xcb, 0xe4,
+	0x9c, 0x50, 0x10, 0x55, 0x20, 0xe7, 0xd1, 0x19, 0xa8, 0x98, 0x46, 0xdf, 0x20, 0xa5, 0x18, 0x2d,
+	0x82, 0x0c, 0x8c, 0xb4, 0x4d, 0x29, 0xd5, 0xdf, 0x49, 0x50, 0x90, 0xb1, 0x63, 0x1a, 0x9a, 0xda,
+	0x22, 0x2a, 0x19, 0x78, 0xe8, 0x16, 0x24, 0x34, 0x5b, 0xc7, 0xcc, 0x81, 0xd9, 0xb5, 0xab, 0xe1,
+	0x30, 0x1d, 0x13, 0xab, 0xad, 0xdb, 0x3a, 0x96, 0x99, 0x24, 0xba, 0x08, 0x29, 0xec, 0xba, 0xb6,
+	0xcb, 0x07, 0x70, 0x56, 0x16, 0xbf, 0xaa, 0xf7, 0x20, 0x41, 0xa5, 0x50, 0x06, 0x12, 0x5b, 0xcd,
+	0xed, 0x8d, 0xe2, 0x0c, 0xca, 0x43, 0xa6, 0x51, 0x5f, 0xff, 0x70, 0x73, 0x6b, 0x7b, 0xbb, 0xa8,
+	0xa3, 0x3c, 0xa4, 0x5b, 0xed, 0xfa, 0x4e, 0xb3, 0xf1, 0x71, 0xf1, 0x89, 0x44, 0x7f, 0xed, 0xc9,
+	0x5b, 0x0f, 0xea, 0xf2, 0xc7, 0xc5, 0x3f, 0xc6, 0x50, 0x0e, 0x52, 0x9b, 0xf5, 0xad, 0xed, 0x8d,
+	0x66, 0xf1, 0xf3, 0x78, 0xf5, 0xcf, 0x29, 0x80, 0xf5, 0x7d, 0xac, 0x1d, 0x38, 0xb6, 0x61, 0x11,
+	0xe4, 0x84, 0x13, 0x5f, 0x62, 0x13, 0x7f, 0x29, 0x74, 0x32, 0x14, 0x13, 0x23, 0xdf, 0xdb, 0xb0,
+	0x88, 0x3b, 0x6c, 0xbc, 0x43, 0x33, 0xf6, 0xe9, 0xb3, 0x33, 0xf6, 0x89, 0x7f, 0x25, 0x3c, 0x82,
+	0x9c, 0xaa, 0x1d, 0x28, 0x86, 0x45, 0xb0, 0x45, 0xfc, 0x7b, 0xe6, 0xfa, 0x54, 0xab, 0x75, 0xed,
+	0x60, 0x8b, 0x8b, 0x71, 0xc3, 0xab, 0x67, 0x35, 0x0a, 0x6a, 0x80, 0x50, 0xfe, 0x45, 0x2c, 0xe8,
+	0xfa, 0x1f, 0x40, 0x9e, 0x9d, 0x18, 0xb2, 0xef, 0xda, 0x83, 0xde, 0x3e, 0x2b, 0x4f, 0xbc, 0x51,
+	0x3b, 0x63, 0x37, 0xe6, 0x28, 0x46, 0x9b, 0x43, 0xa0, 0x07, 0x90, 0x75, 0x5c, 0x5b, 0x1f, 0x68,
+	0xd8, 0xf5, 0x63, 0xba, 0x79, 0x4a, 0x26, 0x6b, 0x7b, 0x42, 0x98, 0x07, 0x96, 0xa0, 0x19, 0x95,
+	0x43, 0x84, 0xb2, 0x02, 0x85, 0x31, 0x09, 0x34, 0x1b, 0xdc, 0xe5, 0x79, 0x76, 0x53, 0xdf, 0x85,
+	0xa4, 0x47, 0x54, 0x82, 0x59, 0x1b, 0xe6, 0xd6, 0xaa, 0x53, 0x6d, 0xf9, 0x10, 0xb4, 0xcd, 0xb0,
+	0x30, 0xc2, 0xd5, 0xca, 0xbf, 0x91, 0xa0, 0x30, 0xc6, 0x46, 0xdf, 0x83, 0x8c, 0xa9, 0x7a, 0x84,
+	0x8d, 0x42, 0x6a, 0x27, 0xd5, 0x58, 0x7e, 0x3e, 0xaa, 0x2c, 0x4d, 0x4b, 0x48, 0x1f, 0x7b, 0x9e,
+	0xda, 0xc3, 0xb5, 0x75, 0xd3, 0xd6, 0x0e, 0xe4, 0x34, 0x55, 0xa3, 0xc3, 0xaf, 0x09, 0xc9, 0x0e,
+	0xee, 0x19, 0x56, 0x29, 0xf6, 0x8d, 0xf2, 0xc9, 0x95, 0xcb, 0x0f, 0x21, 0x1f, 0xed, 0x36, 0x54,
+	0x84, 0xf8, 0x01, 0x1e, 0xf2, 0xf1, 0x24, 0xd3, 0x47, 0xf4, 0x1d, 0x48, 0x3e, 0x52, 0xcd, 0x81,
+	0x1f, 0xfb, 0x95, 0x53, 0xf2, 0x2c, 0x73, 0xc9, 0xdb, 0xb1, 0xf7, 0xa4, 0xf2, 0x07, 0x30, 0x37,
+	0xd1, 0x50, 0x53, 0xb0, 0xcf, 0x47, 0xb1, 0xf3, 0x11, 0xf5, 0x6a, 0x17, 0x72, 0xdb, 0x86, 0x47,
+	0x64, 0xfc, 0x93, 0x01, 0xf6, 0x08, 0x7a, 0x1f, 0x32, 0x1e, 0x36, 0xb1, 0x46, 0x6c, 0x57, 0xcc,
+	0x97, 0x4b, 0x2f, 0xdc, 0x4c, 0x9c, 0x2d, 0x12, 0x1f, 0x88, 0xa3, 0xab, 0x90, 0xc5, 0x87, 0x04,
+	0x5b, 0x1e, 0xbd, 0xb6, 0x75, 0x66, 0x27, 0x24, 0x54, 0x3f, 0x8d, 0x43, 0x9e, 0x1b, 0xf2, 0x1c,
+	0xdb, 0xf2, 0x30, 0x5a, 0x81, 0x94, 0xc7, 0xe6, 0x84, 0x18, 0x23, 0xc5, 0xc8, 0x4e, 0xc6, 0xe8,
+	0xb2, 0xe0, 0xa3, 0x1a, 0xa4, 0xf6, 0xb1, 0xaa, 0x63, 0x57, 0x64, 0xa6, 0x18, 0x7a, 0x74, 0x9f,
+	0xd1, 0x85, 0x2b, 0x42, 0x0a, 0xdd, 0x86, 0x14, 0x1b, 0x5f, 0x5e, 0x29, 0xce, 0x3a, 0x36, 0x32,
+	0xa0, 0xa2, 0x1e, 0xf0, 0xd5, 0xcf, 0xd7, 0xe5, 0x1a, 0xa7, 0x07, 0x51, 0xfe, 0x8b, 0x04, 0x49,
+	0xa6, 0x85, 0xde, 0x86, 0x44, 0x64, 0x06, 0x9f, 0x9b, 0xb2, 0x4f, 0x0a, 0x60, 0x26, 0x86, 0x96,
+	0x20, 0xdf, 0xb7, 0x75, 0xc5, 0xc5, 0x8f, 0x0c, 0x86, 0xcc, 0x5a, 0x49, 0xce, 0xf5, 0x6d, 0x5d,
+	0x16, 0x24, 0xf4, 0x26, 0x24, 0x5d, 0x7b, 0x40, 0x30, 0xbb, 0x63, 0x72, 0x6b, 0x73, 0x61, 0x90,
+	0x32, 0x25, 0xfb, 0x7d, 0xce, 0x64, 0xd0, 0xbb, 0x41, 0xf2, 0x12, 0x2c, 0xc4, 0x4b, 0x27, 0xcc,
+	0xe0, 0x20, 0x3a, 0xf6, 0xab, 0xfa, 0x6f, 0x09, 0x0a, 0x0f, 0x55, 0xa2, 0xed, 0x7f, 0x0b, 0x55,
+	0xb8, 0x03, 0xe9, 0x81, 0xe3, 0x61, 0x97, 0x9c, 0xa5, 0x0c, 0xbe, 0x0a, 0x5a, 0x86, 0xb4, 0x8e,
+	0x4d, 0x4c, 0x30, 0x8f, 0x30, 0xdb, 0xc8, 0x45, 0x97, 0x7a, 0x9f, 0xf7, 0x92, 0x9e, 0xfb, 0x55,
+	0x0c, 0xf2, 0x75, 0xc7, 0x31, 0x87, 0x7e, 0x77, 0x7f, 0x00, 0x69, 0xba, 0x4e, 0xf4, 0x82, 0x6b,
+	0xe1, 0x5a, 0xe8, 0x53, 0x54, 0xb0, 0xb6, 0xce, 0xa4, 0x7c, 0xa7, 0x84, 0x0e, 0xba, 0x04, 0x69,
+	0xdd, 0x1d, 0x2a, 0xee, 0x80, 0x17, 0x30, 0x23, 0xa7, 0x74, 0x77, 0x28, 0x0f, 0xac, 0x97, 0x74,
+	0xcd, 0x67, 0x12, 0xa4, 0x38, 0x20, 0xaa, 0xc1, 0x39, 0x7c, 0xe8, 0x60, 0x8d, 0x28, 0x63, 0xed,
+	0xc0, 0x26, 0xb5, 0x3c, 0xcf, 0x59, 0x0f, 0xc6, 0x9a, 0x22, 0xc5, 0x33, 0x52, 0x8a, 0x9d, 0xd8,
+	0x68, 0xb2, 0x10, 0x41, 0xaf, 0x41, 0x8a, 0xe7, 0x45, 0xbc, 0x88, 0x8c, 0xa5, 0x4c, 0xb0, 0xaa,
+	0x3f, 0x97, 0xa0, 0x20, 0x42, 0x7d, 0xe5, 0x2d, 0x70, 0x7a, 0x75, 0xbe, 0x8c, 0xc1, 0x7c, 0x18,
+	0x84, 0x1f, 0xf1, 0x12, 0x24, 0xd9, 0x51, 0x2c, 0x49, 0x2f, 0xc6, 0xc0, 0x39, 0xa8, 0x0c, 0x99,
+	0x89, 0x83, 0x14, 0xfc, 0x46, 0xd7, 0x00, 0x54, 0x1a, 0x9d, 0x42, 0x8c, 0x3e, 0xcf, 0x43, 0x5c,
+	0xce, 0x32, 0x4a, 0xdb, 0xe8, 0x63, 0x9f, 0x6d, 0x60, 0x5d, 0xe9, 0x0c, 0xf9, 0xdb, 0x15, 0x67,
+	0x1b, 0x58, 0x6f, 0x0c, 0xe9, 0x9a, 0xa2, 0x99, 0x06, 0xb6, 0x08, 0x7b, 0x69, 0xca, 0xca, 0xe2,
+	0x17, 0x2d, 0x43, 0x07, 0x77, 0x6d, 0x17, 0x97, 0x52, 0xa7, 0x94, 0x81, 0x8b, 0xa0, 0xd7, 0x61,
+	0x8e, 0x3f, 0x85, 0xf5, 0x4d, 0x33, 0x3f, 0x66, 0x39, 0x39, 0x08, 0xf5, 0x26, 0x24, 0xd5, 0x2e,
+	0xc1, 0x6e, 0x29, 0x73, 0x32, 0x28, 0x97, 0xa8, 0xfe, 0x52, 0x82, 0xd9, 0xfb, 0x86, 0x47, 0x6c,
+	0x37, 0xe8, 0xe5, 0xaf, 0x91, 0xa8, 0x6b, 0x00, 0x8e, 0xda, 0xc3, 0x0a, 0xb1, 0x0f, 0x30, 0x4f,
+	0x55, 0x56, 0xce, 0x52, 0x4a, 0x9b, 0x12, 0x02, 0x36, 0x5f, 0xfc, 0x68, 0xae, 0x92, 0x9c, 0xcd,
+	0xf6, 0xbe, 0x97, 0x54, 0xef, 0x58, 0x82, 0xb9, 0xc0, 0xa3, 0x57, 0xde, 0x49, 0xdf, 0x85, 0xac,
+	0x9f, 0x4c, 0x7f, 0x9c, 0x5c, 0x99, 0x96, 0x2e, 0x21, 0xe3, 0x6f, 0x1e, 0x81, 0x0e, 0xba, 0x01,
+	0x73, 0x16, 0x3e, 0x24, 0x4a, 0x24, 0x1f, 0xbc, 0xfa, 0x05, 0x4a, 0xde, 0x0b, 0x72, 0x72, 0x7a,
+	0xd0, 0x47, 0x31, 0xc8, 0xd1, 0x48, 0xfc, 0x1a, 0xac, 0x04, 0x61, 0x48, 0xd3, 0xc3, 0x08, 0x02,
+	0x08, 0xaa, 0x15, 0x3b, 0xb1, 0x5a, 0x7f, 0x90, 0x26, 0xf6, 0x37, 0x1e, 0xe7, 0x8d, 0xf1, 0x24,
+	0xfa, 0x13, 0x4a, 0x0e, 0xb7, 0x34, 0xbe, 0x6c, 0xfd, 0xe8, 0x8c, 0x5b, 0xe4, 0x67, 0xcf, 0xbe,
+	0xf9, 0x5a, 0x78, 0xfa, 0xbc, 0xbb, 0x0b, 0xc5, 0x49, 0xef, 0x5e, 0xb6, 0x92, 0xc4, 0xa3, 0x2b,
+	0xc9, 0xdf, 0x13, 0x90, 0xe7, 0xa1, 0xbe, 0xf2, 0xbe, 0xfa, 0x72, 0x7a, 0xce, 0x5f, 0x9f, 0xcc,
+	0xb9, 0xb8, 0xaa, 0xfe, 0xaf, 0x49, 0xff, 0xbd, 0x04, 0xe0, 0x0c, 0x3a, 0xa6, 0xe1, 0xed, 0x2b,
+	0x2a, 0x11, 0x17, 0xff, 0xf2, 0x09, 0x9e, 0xee, 0x71, 0xc1, 0x3a, 0xf9, 0x56, 0xfc, 0xcc, 0x3a,
+	0xbe, 0xb9, 0x57, 0xdb, 0x1a, 0xe5, 0x3b, 0x30, 0x3b, 0x1e, 0xd9, 0x99, 0x1a, 0x4b, 0x86, 0xb9,
+	0x7b, 0x98, 0xdc, 0x37, 0x2c, 0xe2, 0x9d, 0x61, 0x8a, 0x9e, 0x3e, 0x12, 0xfe, 0x13, 0x83, 0x62,
+	0x08, 0xfa, 0xca, 0x1b, 0xb6, 0x05, 0x05, 0xc7, 0x35, 0xfa, 0xaa, 0x3b, 0x54, 0xe8, 0xd7, 0x3a,
+	0x4f, 0x6c, 0x8b, 0x2b, 0xa1, 0x81, 0x49, 0x67, 0x6a, 0xfe, 0x03, 0xa3, 0x0a, 0xb8, 0xbc, 0x00,
+	0x61, 0x34, 0xfa, 0xe2, 0xc8, 0x3f, 0x07, 0x0a, 0x4c, 0xde, 0x5a, 0x67, 0xc5, 0xcc, 0x71, 0x0c,
+	0x0e, 0x79, 0x7a, 0x1b, 0xdc, 0x81, 0xc2, 0x18, 0x02, 0x5d, 0x7e, 0xb9, 0x69, 0xff, 0x9b, 0x46,
+	0xe4, 0x33, 0x72, 0x6d, 0xb3, 0xf5, 0x80, 0x5b, 0xe7, 0x32, 0x55, 0x07, 0xe6, 0x3e, 0xb2, 0x54,
+	0xcf, 0x33, 0x7a, 0x96, 0x5f, 0xc6, 0xd7, 0x82, 0x95, 0x5f, 0x7a, 0x71, 0x5b, 0x14, 0x2c, 0xfa,
+	0xa5, 0xc3, 0xb6, 0xcc, 0xa1, 0xd2, 0x55, 0x0d, 0x13, 0xeb, 0x62, 0x85, 0x03, 0x4a, 0xda, 0x64,
+	0x94, 0xe8, 0x7e, 0x17, 0x8f, 0xee, 0x77, 0x55, 0x15, 0x8a, 0xa1, 0xc5, 0x33, 0xd7, 0x38, 0x74,
+	0x2e, 0x76, 0xa2, 0x73, 0x6f, 0xfc, 0x9a, 0x7e, 0xbd, 0xe2, 0xf2, 0x29, 0x88, 0xed, 0x7e, 0x58,
+	0x9c, 0x41, 0xe7, 0x60, 0xae, 0x75, 0xbf, 0x2e, 0x37, 0x95, 0x9d, 0xdd, 0xb6, 0xb2, 0xb9, 0xfb,
+	0xd1, 0x4e, 0xb3, 0x28, 0xa1, 0xf3, 0x50, 0xdc, 0xd9, 0x55, 0x38, 0xdd, 0xff, 0x18, 0x12, 0x43,
+	0x17, 0x60, 0x9e, 0x0a, 0x8d, 0x93, 0xe3, 0xe8, 0x0a, 0x5c, 0xda, 0x68, 0xaf, 0x37, 0x95, 0xb6,
+	0x5c, 0xdf, 0x69, 0xd5, 0xd7, 0xdb, 0x5b, 0xbb, 0x3b, 0x8a, 0xf8, 0x66, 0x92, 0x40, 0xf3, 0x50,
+	0xe0, 0xf2, 0xad, 0xf6, 0xee, 0xde, 0xde, 0x46, 0xb3, 0x98, 0x44, 0x73, 0x90, 0xa3, 0x30, 0xf5,
+	0xed, 0xed, 0xdd, 0x87, 0x1b, 0xcd, 0x62, 0x6a, 0xed, 0x4f, 0x71, 0xff, 0x85, 0xe7, 0x5d, 0x48,
+	0x50, 0xf7, 0xd0, 0x85, 0xa9, 0xd7, 0x51, 0xf9, 0xe2, 0xf4, 0x39, 0x44, 0xd5, 0xe8, 0xb2, 0x1f,
+	0x55, 0x8b, 0xbc, 0x6e, 0x96, 0x2f, 0x4e, 0x92, 0x85, 0xda, 0xfb, 0x90, 0x64, 0xef, 0x29, 0x27,
+	0xe9, 0x45, 0xde, 0x77, 0xc6, 0xde, 0x67, 0x6e, 0x49, 0xe8, 0x3d, 0x48, 0xb2, 0xfd, 0x16, 0x5d,
+	0x9c, 0xbe, 0xdb, 0x97, 0x2f, 0xbd, 0x40, 0x17, 0x46, 0xef, 0x42, 0x5a, 0x6c, 0x34, 0xa8, 0x14,
+	0xca, 0x8c, 0xaf, 0x5d, 0xe5, 0xcb, 0x53, 0x38, 0x42, 0xbf, 0x0e, 0x19, 0xff, 0xa0, 0xa0, 0xcb,
+	0xd3, 0x0e, 0x0f, 0x47, 0x28, 0x9f, 0x7c, 0xae, 0x28, 0x84, 0xdf, 0x68, 0x51, 0x88, 0x89, 0x76,
+	0x2f, 0x97, 0xa7, 0xb1, 0x38, 0x44, 0xe3, 0xde, 0x93, 0x7f, 0x2e, 0xcc, 0x3c, 0xf9, 0x6a, 0x41,
+	0x7a, 0xfa, 0xd5, 0x82, 0xf4, 0xf9, 0xd1, 0xc2, 0xcc, 0x17, 0x47, 0x0b, 0xd2, 0x5f, 0x8f, 0x16,
+	0xa4, 0xa7, 0x47, 0x0b, 0x33, 0xff, 0x38, 0x5a, 0x98, 0xf9, 0x64, 0x79, 0xda, 0x64, 0x7f, 0xe1,
+	0x9f, 0x41, 0x9d, 0x14, 0x7b, 0x7a, 0xe7, 0x7f, 0x03, 0x00, 0x5c, 0xae, 0x1c, 0xe7, 0x28, 0x1a,
+	0x00, 0x00,
 }
 
 func (this *ShardSpec) Equal(that interface{}) bool {
@@ -1468,8 +1706,13 @@ type ShardClient interface {
 	Stat(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*StatResponse, error)
 	// List Shards, their ShardSpecs and their processing status.
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	// Watch Shards, their ShardSpecs and their processing status. Watch streams
+	// a snapshot of matched Shards, followed by incremental changes.
+	Watch(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (Shard_WatchClient, error)
 	// Apply changes to the collection of Shards managed by the consumer.
 	Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyResponse, error)
+	// History lists recorded revisions of ShardSpecs, as changed by Apply.
+	History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
 	// GetHints fetches hints for a shard.
 	GetHints(ctx context.Context, in *GetHintsRequest, opts ...grpc.CallOption) (*GetHintsResponse, error)
 	// Unassign a Shard.
@@ -1502,6 +1745,38 @@ func (c *shardClient) List(ctx context.Context, in *ListRequest, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *shardClient) Watch(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (Shard_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Shard_serviceDesc.Streams[0], "/consumer.Shard/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &shardWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Shard_WatchClient interface {
+	Recv() (*WatchResponse, error)
+	grpc.ClientStream
+}
+
+type shardWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *shardWatchClient) Recv() (*WatchResponse, error) {
+	m := new(WatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *shardClient) Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyResponse, error) {
 	out := new(ApplyResponse)
 	err := c.cc.Invoke(ctx, "/consumer.Shard/Apply", in, out, opts...)
@@ -1511,6 +1786,15 @@ func (c *shardClient) Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.
 	return out, nil
 }
 
+func (c *shardClient) History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, "/consumer.Shard/History", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shardClient) GetHints(ctx context.Context, in *GetHintsRequest, opts ...grpc.CallOption) (*GetHintsResponse, error) {
 	out := new(GetHintsResponse)
 	err := c.cc.Invoke(ctx, "/consumer.Shard/GetHints", in, out, opts...)
@@ -1535,8 +1819,13 @@ type ShardServer interface {
 	Stat(context.Context, *StatRequest) (*StatResponse, error)
 	// List Shards, their ShardSpecs and their processing status.
 	List(context.Context, *ListRequest) (*ListResponse, error)
+	// Watch Shards, their ShardSpecs and their processing status. Watch streams
+	// a snapshot of matched Shards, followed by incremental changes.
+	Watch(*ListRequest, Shard_WatchServer) error
 	// Apply changes to the collection of Shards managed by the consumer.
 	Apply(context.Context, *ApplyRequest) (*ApplyResponse, error)
+	// History lists recorded revisions of ShardSpecs, as changed by Apply.
+	History(context.Context, *HistoryRequest) (*HistoryResponse, error)
 	// GetHints fetches hints for a shard.
 	GetHints(context.Context, *GetHintsRequest) (*GetHintsResponse, error)
 	// Unassign a Shard.
@@ -1553,9 +1842,15 @@ func (*UnimplementedShardServer) Stat(ctx context.Context, req *StatRequest) (*S
 func (*UnimplementedShardServer) List(ctx context.Context, req *ListRequest) (*ListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (*UnimplementedShardServer) Watch(req *ListRequest, srv Shard_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (*UnimplementedShardServer) Apply(ctx context.Context, req *ApplyRequest) (*ApplyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Apply not implemented")
 }
+func (*UnimplementedShardServer) History(ctx context.Context, req *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
 func (*UnimplementedShardServer) GetHints(ctx context.Context, req *GetHintsRequest) (*GetHintsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHints not implemented")
 }
@@ -1603,6 +1898,27 @@ func _Shard_List_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Shard_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ShardServer).Watch(m, &shardWatchServer{stream})
+}
+
+type Shard_WatchServer interface {
+	Send(*WatchResponse) error
+	grpc.ServerStream
+}
+
+type shardWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *shardWatchServer) Send(m *WatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Shard_Apply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplyRequest)
 	if err := dec(in); err != nil {
@@ -1621,6 +1937,24 @@ func _Shard_Apply_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Shard_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShardServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/consumer.Shard/History",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShardServer).History(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Shard_GetHints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetHintsRequest)
 	if err := dec(in); err != nil {
@@ -1673,6 +2007,10 @@ var _Shard_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Apply",
 			Handler:    _Shard_Apply_Handler,
 		},
+		{
+			MethodName: "History",
+			Handler:    _Shard_History_Handler,
+		},
 		{
 			MethodName: "GetHints",
 			Handler:    _Shard_GetHints_Handler,
@@ -1682,7 +2020,13 @@ var _Shard_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Shard_Unassign_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Shard_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "consumer/protocol/protocol.proto",
 }
 
@@ -2269,6 +2613,76 @@ func (m *ListResponse_Shard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.Deletes) > 0 {
+		for iNdEx := len(m.Deletes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Deletes[iNdEx])
+			copy(dAtA[i:], m.Deletes[iNdEx])
+			i = encodeVarintProtocol(dAtA, i, uint64(len(m.Deletes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Upserts) > 0 {
+		for iNdEx := len(m.Upserts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Upserts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtocol(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2298,6 +2712,16 @@ func (m *ApplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa2
 	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Changes) > 0 {
 		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2409,7 +2833,7 @@ func (m *ApplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *StatRequest) Marshal() (dAtA []byte, err error) {
+func (m *ShardSpecRevision) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2419,34 +2843,236 @@ func (m *StatRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StatRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ShardSpecRevision) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ShardSpecRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Extension) > 0 {
-		i -= len(m.Extension)
-		copy(dAtA[i:], m.Extension)
-		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Extension)))
+	if m.After != nil {
+		{
+			size, err := m.After.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x6
+		dAtA[i] = 0x42
+	}
+	if m.BeforeRevision != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.BeforeRevision))
 		i--
-		dAtA[i] = 0xa2
+		dAtA[i] = 0x38
 	}
-	if len(m.ReadThrough) > 0 {
-		for k := range m.ReadThrough {
-			v := m.ReadThrough[k]
-			baseI := i
-			i = encodeVarintProtocol(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
+	if m.Before != nil {
+		{
+			size, err := m.Before.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AppliedBy) > 0 {
+		i -= len(m.AppliedBy)
+		copy(dAtA[i:], m.AppliedBy)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.AppliedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ApplyTime != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.ApplyTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Revision != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Shard) > 0 {
+		i -= len(m.Shard)
+		copy(dAtA[i:], m.Shard)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Shard)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.PageLimit != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.PageLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Shard) > 0 {
+		i -= len(m.Shard)
+		copy(dAtA[i:], m.Shard)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Shard)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtocol(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtocol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintProtocol(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintProtocol(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.ReadThrough) > 0 {
+		for k := range m.ReadThrough {
+			v := m.ReadThrough[k]
+			baseI := i
+			i = encodeVarintProtocol(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
 			i = encodeVarintProtocol(dAtA, i, uint64(len(k)))
 			i--
 			dAtA[i] = 0xa
@@ -3041,6 +3667,36 @@ func (m *ListResponse_Shard) ProtoSize() (n int) {
 	return n
 }
 
+func (m *WatchResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovProtocol(uint64(m.Status))
+	}
+	l = m.Header.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if len(m.Upserts) > 0 {
+		for _, e := range m.Upserts {
+			l = e.ProtoSize()
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	if len(m.Deletes) > 0 {
+		for _, s := range m.Deletes {
+			l = len(s)
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	l = len(m.Extension)
+	if l > 0 {
+		n += 2 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
 func (m *ApplyRequest) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -3053,6 +3709,9 @@ func (m *ApplyRequest) ProtoSize() (n int) {
 			n += 1 + l + sovProtocol(uint64(l))
 		}
 	}
+	if m.DryRun {
+		n += 2
+	}
 	l = len(m.Extension)
 	if l > 0 {
 		n += 2 + l + sovProtocol(uint64(l))
@@ -3098,70 +3757,45 @@ func (m *ApplyResponse) ProtoSize() (n int) {
 	return n
 }
 
-func (m *StatRequest) ProtoSize() (n int) {
+func (m *ShardSpecRevision) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.ProtoSize()
-		n += 1 + l + sovProtocol(uint64(l))
-	}
 	l = len(m.Shard)
 	if l > 0 {
 		n += 1 + l + sovProtocol(uint64(l))
 	}
-	if len(m.ReadThrough) > 0 {
-		for k, v := range m.ReadThrough {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovProtocol(uint64(len(k))) + 1 + sovProtocol(uint64(v))
-			n += mapEntrySize + 1 + sovProtocol(uint64(mapEntrySize))
-		}
+	if m.Revision != 0 {
+		n += 1 + sovProtocol(uint64(m.Revision))
 	}
-	l = len(m.Extension)
-	if l > 0 {
-		n += 2 + l + sovProtocol(uint64(l))
+	if m.ApplyTime != 0 {
+		n += 1 + sovProtocol(uint64(m.ApplyTime))
 	}
-	return n
-}
-
-func (m *StatResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.AppliedBy)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovProtocol(uint64(m.Status))
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
 	}
-	l = m.Header.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	if len(m.ReadThrough) > 0 {
-		for k, v := range m.ReadThrough {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovProtocol(uint64(len(k))) + 1 + sovProtocol(uint64(v))
-			n += mapEntrySize + 1 + sovProtocol(uint64(mapEntrySize))
-		}
+	if m.Before != nil {
+		l = m.Before.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
 	}
-	if len(m.PublishAt) > 0 {
-		for k, v := range m.PublishAt {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovProtocol(uint64(len(k))) + 1 + sovProtocol(uint64(v))
-			n += mapEntrySize + 1 + sovProtocol(uint64(mapEntrySize))
-		}
+	if m.BeforeRevision != 0 {
+		n += 1 + sovProtocol(uint64(m.BeforeRevision))
 	}
-	l = len(m.Extension)
-	if l > 0 {
-		n += 2 + l + sovProtocol(uint64(l))
+	if m.After != nil {
+		l = m.After.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
 	}
 	return n
 }
 
-func (m *GetHintsRequest) ProtoSize() (n int) {
+func (m *HistoryRequest) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3171,6 +3805,13 @@ func (m *GetHintsRequest) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProtocol(uint64(l))
 	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if m.PageLimit != 0 {
+		n += 1 + sovProtocol(uint64(m.PageLimit))
+	}
 	l = len(m.Extension)
 	if l > 0 {
 		n += 2 + l + sovProtocol(uint64(l))
@@ -3178,7 +3819,7 @@ func (m *GetHintsRequest) ProtoSize() (n int) {
 	return n
 }
 
-func (m *GetHintsResponse) ProtoSize() (n int) {
+func (m *HistoryResponse) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3189,10 +3830,118 @@ func (m *GetHintsResponse) ProtoSize() (n int) {
 	}
 	l = m.Header.ProtoSize()
 	n += 1 + l + sovProtocol(uint64(l))
-	l = m.PrimaryHints.ProtoSize()
-	n += 1 + l + sovProtocol(uint64(l))
-	if len(m.BackupHints) > 0 {
-		for _, e := range m.BackupHints {
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.ProtoSize()
+			n += 1 + l + sovProtocol(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.Extension)
+	if l > 0 {
+		n += 2 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *StatRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.ProtoSize()
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.Shard)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	if len(m.ReadThrough) > 0 {
+		for k, v := range m.ReadThrough {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovProtocol(uint64(len(k))) + 1 + sovProtocol(uint64(v))
+			n += mapEntrySize + 1 + sovProtocol(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Extension)
+	if l > 0 {
+		n += 2 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *StatResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovProtocol(uint64(m.Status))
+	}
+	l = m.Header.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if len(m.ReadThrough) > 0 {
+		for k, v := range m.ReadThrough {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovProtocol(uint64(len(k))) + 1 + sovProtocol(uint64(v))
+			n += mapEntrySize + 1 + sovProtocol(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PublishAt) > 0 {
+		for k, v := range m.PublishAt {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovProtocol(uint64(len(k))) + 1 + sovProtocol(uint64(v))
+			n += mapEntrySize + 1 + sovProtocol(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Extension)
+	if l > 0 {
+		n += 2 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *GetHintsRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Shard)
+	if l > 0 {
+		n += 1 + l + sovProtocol(uint64(l))
+	}
+	l = len(m.Extension)
+	if l > 0 {
+		n += 2 + l + sovProtocol(uint64(l))
+	}
+	return n
+}
+
+func (m *GetHintsResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovProtocol(uint64(m.Status))
+	}
+	l = m.Header.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	l = m.PrimaryHints.ProtoSize()
+	n += 1 + l + sovProtocol(uint64(l))
+	if len(m.BackupHints) > 0 {
+		for _, e := range m.BackupHints {
 			l = e.ProtoSize()
 			n += 1 + l + sovProtocol(uint64(l))
 		}
@@ -5023,7 +5772,7 @@ func (m *ListResponse_Shard) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplyRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5046,15 +5795,34 @@ func (m *ApplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5081,11 +5849,76 @@ func (m *ApplyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Changes = append(m.Changes, ApplyRequest_Change{})
-			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upserts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Upserts = append(m.Upserts, ListResponse_Shard{})
+			if err := m.Upserts[len(m.Upserts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deletes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deletes = append(m.Deletes, ShardID(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
@@ -5141,7 +5974,7 @@ func (m *ApplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplyRequest_Change) Unmarshal(dAtA []byte) error {
+func (m *ApplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5164,34 +5997,15 @@ func (m *ApplyRequest_Change) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Change: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Change: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectModRevision", wireType)
-			}
-			m.ExpectModRevision = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProtocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpectModRevision |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5218,18 +6032,16 @@ func (m *ApplyRequest_Change) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Upsert == nil {
-				m.Upsert = &ShardSpec{}
-			}
-			if err := m.Upsert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Changes = append(m.Changes, ApplyRequest_Change{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtocol
@@ -5239,24 +6051,183 @@ func (m *ApplyRequest_Change) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProtocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DryRun = bool(v != 0)
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
 			}
-			m.Delete = ShardID(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = append(m.Extension[:0], dAtA[iNdEx:postIndex]...)
+			if m.Extension == nil {
+				m.Extension = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplyRequest_Change) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Change: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Change: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectModRevision", wireType)
+			}
+			m.ExpectModRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectModRevision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Upsert == nil {
+				m.Upsert = &ShardSpec{}
+			}
+			if err := m.Upsert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delete = ShardID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtocol(dAtA[iNdEx:])
@@ -5414,6 +6385,650 @@ func (m *ApplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ShardSpecRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardSpecRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardSpecRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shard = ShardID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyTime", wireType)
+			}
+			m.ApplyTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplyTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppliedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Before == nil {
+				m.Before = &ShardSpec{}
+			}
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeRevision", wireType)
+			}
+			m.BeforeRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeforeRevision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.After == nil {
+				m.After = &ShardSpec{}
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shard = ShardID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageLimit", wireType)
+			}
+			m.PageLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = append(m.Extension[:0], dAtA[iNdEx:postIndex]...)
+			if m.Extension == nil {
+				m.Extension = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, ShardSpecRevision{})
+			if err := m.Revisions[len(m.Revisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = append(m.Extension[:0], dAtA[iNdEx:postIndex]...)
+			if m.Extension == nil {
+				m.Extension = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *StatRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
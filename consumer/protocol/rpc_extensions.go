@@ -77,6 +77,26 @@ func (m *ListResponse_Shard) Validate() error {
 	return nil
 }
 
+// Validate returns an error if the WatchResponse is not well-formed.
+func (m *WatchResponse) Validate() error {
+	if err := m.Status.Validate(); err != nil {
+		return pb.ExtendContext(err, "Status")
+	} else if err = m.Header.Validate(); err != nil {
+		return pb.ExtendContext(err, "Header")
+	}
+	for i, shard := range m.Upserts {
+		if err := shard.Validate(); err != nil {
+			return pb.ExtendContext(err, "Upserts[%d]", i)
+		}
+	}
+	for i, id := range m.Deletes {
+		if err := id.Validate(); err != nil {
+			return pb.ExtendContext(err, "Deletes[%d]", i)
+		}
+	}
+	return nil
+}
+
 // Validate returns an error if the ApplyRequest is not well-formed.
 func (m *ApplyRequest) Validate() error {
 	for i, change := range m.Changes {
@@ -119,6 +139,58 @@ func (m *ApplyResponse) Validate() error {
 	return nil
 }
 
+// Validate returns an error if the HistoryRequest is not well-formed.
+func (m *HistoryRequest) Validate() error {
+	if m.Shard != "" {
+		if err := m.Shard.Validate(); err != nil {
+			return pb.ExtendContext(err, "Shard")
+		}
+	}
+	if m.PageLimit < 0 || m.PageLimit > 10000 {
+		return pb.NewValidationError("invalid PageLimit (%v; must be >= 0 and <= 10000)", m.PageLimit)
+	}
+	return nil
+}
+
+// Validate returns an error if the HistoryResponse is not well-formed.
+func (m *HistoryResponse) Validate() error {
+	if err := m.Status.Validate(); err != nil {
+		return pb.ExtendContext(err, "Status")
+	} else if err = m.Header.Validate(); err != nil {
+		return pb.ExtendContext(err, "Header")
+	}
+	for i, r := range m.Revisions {
+		if err := r.Validate(); err != nil {
+			return pb.ExtendContext(err, "Revisions[%d]", i)
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the ShardSpecRevision is not well-formed.
+func (m *ShardSpecRevision) Validate() error {
+	if err := m.Shard.Validate(); err != nil {
+		return pb.ExtendContext(err, "Shard")
+	} else if m.Revision <= 0 {
+		return pb.NewValidationError("invalid Revision (%d; expected > 0)", m.Revision)
+	} else if m.BeforeRevision < 0 {
+		return pb.NewValidationError("invalid BeforeRevision (%d; expected >= 0)", m.BeforeRevision)
+	}
+	for _, spec := range []struct {
+		field string
+		spec  *ShardSpec
+	}{{"Before", m.Before}, {"After", m.After}} {
+		if spec.spec == nil {
+			continue
+		} else if err := spec.spec.Validate(); err != nil {
+			return pb.ExtendContext(err, spec.field)
+		} else if spec.spec.Id != m.Shard {
+			return pb.NewValidationError("%s.Id doesn't match Shard (%s vs %s)", spec.field, spec.spec.Id, m.Shard)
+		}
+	}
+	return nil
+}
+
 // Validate returns an error if the HintsRequest is not well-formed.
 func (m *GetHintsRequest) Validate() error {
 	if err := m.Shard.Validate(); err != nil {
@@ -93,6 +93,38 @@ func (s *RPCSuite) TestListResponseValidationCases(c *gc.C) {
 	c.Check(resp.Validate(), gc.IsNil)
 }
 
+func (s *RPCSuite) TestWatchResponseValidationCases(c *gc.C) {
+	var resp = WatchResponse{
+		Status: 9101,
+		Header: *badHeaderFixture(),
+		Upserts: []ListResponse_Shard{
+			{
+				ModRevision: 0,
+				Spec: ShardSpec{
+					Id:                "a-valid-id",
+					Sources:           []ShardSpec_Source{{Journal: "a/journal"}},
+					RecoveryLogPrefix: "a/log/prefix",
+					HintPrefix:        "/a/hint/prefix",
+					MaxTxnDuration:    1,
+				},
+				Route: pb.Route{Primary: -1},
+			},
+		},
+		Deletes: []ShardID{"another-id", "invalid id"},
+	}
+
+	c.Check(resp.Validate(), gc.ErrorMatches, `Status: invalid status \(9101\)`)
+	resp.Status = Status_OK
+	c.Check(resp.Validate(), gc.ErrorMatches, `Header.Etcd: invalid ClusterId .*`)
+	resp.Header.Etcd.ClusterId = 1234
+	c.Check(resp.Validate(), gc.ErrorMatches, `Upserts\[0\]: invalid ModRevision \(0; expected > 0\)`)
+	resp.Upserts[0].ModRevision = 1
+	c.Check(resp.Validate(), gc.ErrorMatches, `Deletes\[1\]: not a valid token \(.*\)`)
+	resp.Deletes[1] = "a-valid-id"
+
+	c.Check(resp.Validate(), gc.IsNil)
+}
+
 func (s *RPCSuite) TestApplyRequestValidationCases(c *gc.C) {
 	var req = ApplyRequest{
 		Changes: []ApplyRequest_Change{
@@ -147,6 +179,60 @@ func (s *RPCSuite) TestApplyResponseValidationCases(c *gc.C) {
 	c.Check(resp.Validate(), gc.IsNil)
 }
 
+func (s *RPCSuite) TestHistoryRequestValidationCases(c *gc.C) {
+	var req = HistoryRequest{Shard: "invalid shard"}
+
+	c.Check(req.Validate(), gc.ErrorMatches, `Shard: not a valid token \(invalid shard\)`)
+	req.Shard = "valid-shard"
+	c.Check(req.Validate(), gc.IsNil)
+	req.Shard = "" // All shards.
+	c.Check(req.Validate(), gc.IsNil)
+	req.PageLimit = -1
+	c.Check(req.Validate(), gc.ErrorMatches, `invalid PageLimit \(-1; must be >= 0 and <= 10000\)`)
+}
+
+func (s *RPCSuite) TestHistoryResponseValidationCases(c *gc.C) {
+	var spec = ShardSpec{
+		Id:                "a-shard",
+		Sources:           []ShardSpec_Source{{Journal: "a/journal"}},
+		RecoveryLogPrefix: "a/log/prefix",
+		HintPrefix:        "/a/hint/prefix",
+		MaxTxnDuration:    1,
+	}
+	var resp = HistoryResponse{
+		Status: 9101,
+		Header: *badHeaderFixture(),
+		Revisions: []ShardSpecRevision{
+			{Shard: "a-shard", Revision: 10, After: &spec},
+			{
+				Shard:          "invalid shard",
+				Revision:       0,
+				Before:         &ShardSpec{Id: "a-shard"},
+				BeforeRevision: -1,
+			},
+		},
+	}
+
+	c.Check(resp.Validate(), gc.ErrorMatches, `Status: invalid status \(9101\)`)
+	resp.Status = Status_OK
+	c.Check(resp.Validate(), gc.ErrorMatches, `Header.Etcd: invalid ClusterId .*`)
+	resp.Header.Etcd.ClusterId = 1234
+	c.Check(resp.Validate(), gc.ErrorMatches, `Revisions\[1\].Shard: not a valid token \(invalid shard\)`)
+	resp.Revisions[1].Shard = "a-shard"
+	c.Check(resp.Validate(), gc.ErrorMatches, `Revisions\[1\]: invalid Revision \(0; expected > 0\)`)
+	resp.Revisions[1].Revision = 12
+	c.Check(resp.Validate(), gc.ErrorMatches, `Revisions\[1\]: invalid BeforeRevision \(-1; expected >= 0\)`)
+	resp.Revisions[1].BeforeRevision = 10
+	c.Check(resp.Validate(), gc.ErrorMatches, `Revisions\[1\].Before: invalid MaxTxnDuration \(0; expected > 0\)`)
+	var other = spec
+	other.Id = "other-shard"
+	resp.Revisions[1].Before = &other
+	c.Check(resp.Validate(), gc.ErrorMatches, `Revisions\[1\]: Before.Id doesn't match Shard \(other-shard vs a-shard\)`)
+	resp.Revisions[1].Before = &spec
+
+	c.Check(resp.Validate(), gc.IsNil)
+}
+
 func (s *RPCSuite) TestUnassignRequestValidationCases(c *gc.C) {
 	var req = UnassignRequest{
 		Shards: []ShardID{"/good/shard/id", ""},
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"path"
+	"strings"
 
 	pb "go.gazette.dev/core/broker/protocol"
 )
@@ -97,6 +98,15 @@ func (m *ShardSpec) MarshalString() string {
 	return string(d)
 }
 
+// MarshalString returns the marshaled encoding of the ShardSpecRevision as a string.
+func (m *ShardSpecRevision) MarshalString() string {
+	var d, err = m.Marshal()
+	if err != nil {
+		panic(err.Error()) // Cannot happen, as we use no custom marshalling.
+	}
+	return string(d)
+}
+
 // DesiredReplication is the desired number of shard replicas. allocator.ItemValue implementation.
 func (m *ShardSpec) DesiredReplication() int {
 	if m.Disable {
@@ -254,8 +264,18 @@ func SubtractShardSpecs(a, b ShardSpec) ShardSpec {
 }
 
 // ExtractShardSpecMetaLabels returns meta-labels of the ShardSpec, using |out| as a buffer.
+// Meta-labels are a singular label "id", with value of the ShardSpec Id, and
+// multi-label "prefix", having a value for each path component prefix of Id.
+// A "prefix" label of the ShardSpec itself is permitted, but is shadowed by
+// the "prefix" meta-label wherever meta-labels are matched.
 func ExtractShardSpecMetaLabels(spec *ShardSpec, out pb.LabelSet) pb.LabelSet {
-	out.Labels = append(out.Labels[:0], pb.Label{Name: "id", Value: spec.Id.String()})
+	var id = spec.Id.String()
+	out.Labels = append(out.Labels[:0], pb.Label{Name: "id", Value: id})
+
+	for i, j := 0, strings.IndexByte(id, '/'); j != -1; j = strings.IndexByte(id[i:], '/') {
+		i += j + 1
+		out.Labels = append(out.Labels, pb.Label{Name: "prefix", Value: id[:i]})
+	}
 	return out
 }
 
@@ -106,6 +106,14 @@ func (s *SpecSuite) TestShardSpecRoutines(c *gc.C) {
 
 	c.Check(ExtractShardSpecMetaLabels(&spec, pb.MustLabelSet("label", "buffer")),
 		gc.DeepEquals, pb.MustLabelSet("id", "shard-id"))
+	spec.Id = "path/to/shard-id"
+	c.Check(ExtractShardSpecMetaLabels(&spec, pb.MustLabelSet("label", "buffer")),
+		gc.DeepEquals, pb.MustLabelSet(
+			"id", "path/to/shard-id",
+			"prefix", "path/",
+			"prefix", "path/to/",
+		))
+	spec.Id = "shard-id"
 
 	c.Check(spec.HintPrimaryKey(), gc.Equals, "/a/path/shard-id.primary")
 	c.Check(spec.HintBackupKeys(), gc.DeepEquals, []string{
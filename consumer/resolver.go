@@ -73,6 +73,13 @@ type ResolveArgs struct {
 	// will block until those appends have been processed.
 	// See also: Shard.Progress.
 	ReadThrough pb.Offsets
+	// Claims of the caller, which must grant the Require'd Capability over
+	// the shard, or else Resolve returns status NOT_ALLOWED. Claims are
+	// matched against ShardSpec labels and meta-labels (see
+	// ExtractShardSpecMetaLabels). Zero-valued Claims and Require are used
+	// for resolutions made by the consumer process itself.
+	Claims  pb.Claims
+	Require pb.Capability
 }
 
 // Resolution is the result of resolving a ShardID to a responsible consumer process.
@@ -163,6 +170,8 @@ func (r *Resolver) Resolve(args ResolveArgs) (res Resolution, err error) {
 	// Select a response Status code.
 	if res.Spec == nil {
 		res.Status = pc.Status_SHARD_NOT_FOUND
+	} else if !args.Claims.Allows(args.Require, shardAuthLabels(res.Spec)) {
+		res.Status = pc.Status_NOT_ALLOWED
 	} else if res.Header.ProcessId == (pb.ProcessSpec_ID{}) {
 		res.Status = pc.Status_NO_SHARD_PRIMARY
 	} else if !args.MayProxy && res.Header.ProcessId != localID {
@@ -36,6 +36,11 @@ type Service struct {
 	Journals pb.RoutedJournalClient
 	// Etcd client for use by consumer applications.
 	Etcd *clientv3.Client
+	// Auth verifies the authorizations of ShardServer RPCs, and authorizes
+	// RPCs which are proxied to peers. If nil, RPCs are not authorized.
+	// Auth must be set before the Service is served, and not changed after.
+	// Consumer applications may also use Auth to authorize their own RPCs.
+	Auth pb.Auth
 	// Delta to apply to message.Clocks used by Shards to sequence published
 	// messages, with respect to real time. This should almost always be left
 	// as zero, but is helpful for test workflows which require fine-grain
@@ -49,7 +54,9 @@ type Service struct {
 	ShardAPI struct {
 		Stat     func(context.Context, *Service, *pc.StatRequest) (*pc.StatResponse, error)
 		List     func(context.Context, *Service, *pc.ListRequest) (*pc.ListResponse, error)
+		Watch    func(*Service, *pc.ListRequest, pc.Shard_WatchServer) error
 		Apply    func(context.Context, *Service, *pc.ApplyRequest) (*pc.ApplyResponse, error)
+		History  func(context.Context, *Service, *pc.HistoryRequest) (*pc.HistoryResponse, error)
 		GetHints func(context.Context, *Service, *pc.GetHintsRequest) (*pc.GetHintsResponse, error)
 		Unassign func(context.Context, *Service, *pc.UnassignRequest) (*pc.UnassignResponse, error)
 	}
@@ -59,6 +66,8 @@ type Service struct {
 }
 
 // NewService constructs a new Service of the Application, driven by allocator.State.
+// RPCs of the returned Service are not authorized. To require authorization,
+// set its Auth before the Service is served.
 func NewService(app Application, state *allocator.State, rjc pb.RoutedJournalClient, lo *grpc.ClientConn, etcd *clientv3.Client) *Service {
 	var svc = &Service{
 		App:        app,
@@ -73,7 +82,9 @@ func NewService(app Application, state *allocator.State, rjc pb.RoutedJournalCli
 	// Default implementations of the ShardServer API.
 	svc.ShardAPI.Stat = ShardStat
 	svc.ShardAPI.List = ShardList
+	svc.ShardAPI.Watch = ShardWatch
 	svc.ShardAPI.Apply = ShardApply
+	svc.ShardAPI.History = ShardHistory
 	svc.ShardAPI.GetHints = ShardGetHints
 	svc.ShardAPI.Unassign = ShardUnassign
 	return svc
@@ -130,6 +141,24 @@ func (svc *Service) QueueTasks(tasks *task.Group, server *server.Server) {
 // this signal to begin graceful cleanup of outstanding RPCs.
 func (svc *Service) Stopping() <-chan struct{} { return svc.stoppingCh }
 
+// Verify the authorization of an incoming RPC, returning its Claims.
+// If the Service doesn't authorize RPCs, Claims grant all capabilities.
+func (svc *Service) Verify(ctx context.Context) (pb.Claims, error) {
+	if svc.Auth == nil {
+		return pb.Claims{Capability: pb.Capability_ALL}, nil
+	}
+	return svc.Auth.Verify(ctx)
+}
+
+// Authorize an outgoing RPC to a peer, which is made on behalf of a caller
+// having |claims|. The peer will itself verify the caller's Claims.
+func (svc *Service) Authorize(ctx context.Context, claims pb.Claims) (context.Context, error) {
+	if svc.Auth == nil {
+		return ctx, nil
+	}
+	return svc.Auth.Authorize(ctx, claims, peerAuthorizationTTL)
+}
+
 func addTrace(ctx context.Context, format string, args ...interface{}) {
 	if tr, ok := trace.FromContext(ctx); ok {
 		tr.LazyPrintf(format, args...)
@@ -146,11 +175,21 @@ func (svc *Service) List(ctx context.Context, req *pc.ListRequest) (*pc.ListResp
 	return svc.ShardAPI.List(ctx, svc, req)
 }
 
+// Watch calls its ShardAPI delegate.
+func (svc *Service) Watch(req *pc.ListRequest, stream pc.Shard_WatchServer) error {
+	return svc.ShardAPI.Watch(svc, req, stream)
+}
+
 // Apply calls its ShardAPI delegate.
 func (svc *Service) Apply(ctx context.Context, req *pc.ApplyRequest) (*pc.ApplyResponse, error) {
 	return svc.ShardAPI.Apply(ctx, svc, req)
 }
 
+// History calls its ShardAPI delegate.
+func (svc *Service) History(ctx context.Context, req *pc.HistoryRequest) (*pc.HistoryResponse, error) {
+	return svc.ShardAPI.History(ctx, svc, req)
+}
+
 // GetHints calls its ShardAPI delegate.
 func (svc *Service) GetHints(ctx context.Context, req *pc.GetHintsRequest) (*pc.GetHintsResponse, error) {
 	return svc.ShardAPI.GetHints(ctx, svc, req)
@@ -163,3 +202,8 @@ func (svc *Service) Unassign(ctx context.Context, req *pc.UnassignRequest) (*pc.
 
 // Service implements the ShardServer interface.
 var _ pc.ShardServer = (*Service)(nil)
+
+// peerAuthorizationTTL is the lifetime of authorizations of RPCs made to peers.
+// Authorizations are verified only as an RPC begins, and a peer RPC is started
+// immediately after it's authorized.
+var peerAuthorizationTTL = time.Minute
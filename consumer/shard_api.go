@@ -3,9 +3,13 @@ package consumer
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.gazette.dev/core/allocator"
 	"go.gazette.dev/core/broker/client"
@@ -16,20 +20,26 @@ import (
 	"go.gazette.dev/core/labels"
 	"go.gazette.dev/core/message"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
 )
 
 // ShardStat is the default implementation of the ShardServer.Stat API.
 func ShardStat(ctx context.Context, srv *Service, req *pc.StatRequest) (*pc.StatResponse, error) {
-	var (
-		resp     = new(pc.StatResponse)
-		res, err = srv.Resolver.Resolve(ResolveArgs{
-			Context:     ctx,
-			ShardID:     req.Shard,
-			MayProxy:    req.Header == nil, // MayProxy if request hasn't already been proxied.
-			ProxyHeader: req.Header,
-			ReadThrough: req.ReadThrough,
-		})
-	)
+	var resp = new(pc.StatResponse)
+
+	var claims, err = srv.Verify(ctx)
+	if err != nil {
+		return resp, err
+	}
+	res, err := srv.Resolver.Resolve(ResolveArgs{
+		Context:     ctx,
+		ShardID:     req.Shard,
+		MayProxy:    req.Header == nil, // MayProxy if request hasn't already been proxied.
+		ProxyHeader: req.Header,
+		ReadThrough: req.ReadThrough,
+		Claims:      claims,
+		Require:     pb.Capability_READ,
+	})
 	resp.Status, resp.Header = res.Status, res.Header
 
 	if err != nil || resp.Status != pc.Status_OK {
@@ -37,6 +47,9 @@ func ShardStat(ctx context.Context, srv *Service, req *pc.StatRequest) (*pc.Stat
 	} else if res.Store == nil {
 		// Non-local Shard. Proxy to the resolved primary peer.
 		req.Header = &res.Header
+		if ctx, err = srv.Authorize(ctx, claims); err != nil {
+			return resp, err
+		}
 		return pc.NewShardClient(srv.Loopback).Stat(
 			pb.WithDispatchRoute(ctx, req.Header.Route, req.Header.ProcessId), req)
 	}
@@ -54,13 +67,86 @@ func ShardList(ctx context.Context, srv *Service, req *pc.ListRequest) (*pc.List
 		Status: pc.Status_OK,
 		Header: pbx.NewUnroutedHeader(s),
 	}
-	if err := req.Validate(); err != nil {
+	var claims pb.Claims
+	var err error
+
+	if err = req.Validate(); err != nil {
+		return resp, err
+	} else if claims, err = srv.Verify(ctx); err != nil {
 		return resp, err
+	} else if claims.Capability&pb.Capability_LIST == 0 {
+		resp.Status = pc.Status_NOT_ALLOWED
+		return resp, nil
 	}
 
 	defer s.KS.Mu.RUnlock()
 	s.KS.Mu.RLock()
 
+	resp.Shards = listShards(s, req.Selector, claims)
+	return resp, nil
+}
+
+// ShardWatch is the default implementation of the ShardServer.Watch API.
+func ShardWatch(srv *Service, req *pc.ListRequest, stream pc.Shard_WatchServer) error {
+	var s = srv.Resolver.state
+
+	var claims pb.Claims
+	var err error
+
+	if err = req.Validate(); err != nil {
+		return err
+	} else if claims, err = srv.Verify(stream.Context()); err != nil {
+		return err
+	} else if claims.Capability&pb.Capability_LIST == 0 {
+		return stream.Send(&pc.WatchResponse{
+			Status: pc.Status_NOT_ALLOWED,
+			Header: pbx.NewUnroutedHeader(s),
+		})
+	}
+
+	// Watches are long-lived, and must be stopped when the Service is stopping.
+	var ctx, cancel = context.WithCancel(stream.Context())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-srv.Stopping():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	var sent = make(map[pc.ShardID]pc.ListResponse_Shard)
+	var revision int64
+
+	for snapshot := true; true; snapshot = false {
+		var resp = &pc.WatchResponse{Status: pc.Status_OK}
+
+		s.KS.Mu.RLock()
+		if err = s.KS.WaitForRevision(ctx, revision+1); err == nil {
+			revision = s.KS.Header.Revision
+			resp.Upserts, resp.Deletes = diffShards(sent, listShards(s, req.Selector, claims))
+		}
+		s.KS.Mu.RUnlock()
+
+		if err != nil {
+			return nil // Watch was cancelled, or the Service is stopping.
+		} else if !snapshot && len(resp.Upserts) == 0 && len(resp.Deletes) == 0 {
+			continue // No changes to send.
+		}
+		resp.Header = pbx.NewUnroutedHeader(s)
+
+		if err = stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	panic("not reached")
+}
+
+// listShards returns shards of the State which match the LabelSelector
+// and are visible to the Claims. The State KeySpace must be read-locked.
+func listShards(s *allocator.State, selector pb.LabelSelector, claims pb.Claims) []pc.ListResponse_Shard {
+	var out []pc.ListResponse_Shard
 	var metaLabels, allLabels pb.LabelSet
 
 	var it = allocator.LeftJoin{
@@ -77,10 +163,12 @@ func ShardList(ctx context.Context, srv *Service, req *pc.ListRequest) (*pc.List
 			Spec: *s.Items[cur.Left].Decoded.(allocator.Item).ItemValue.(*pc.ShardSpec)}
 
 		metaLabels = pc.ExtractShardSpecMetaLabels(&shard.Spec, metaLabels)
-		allLabels = pb.UnionLabelSets(metaLabels, shard.Spec.LabelSet, allLabels)
+		allLabels = unionShardLabels(&shard.Spec, metaLabels, allLabels)
 
-		if !req.Selector.Matches(allLabels) {
+		if !selector.Matches(allLabels) {
 			continue
+		} else if !claims.Allows(pb.Capability_LIST, allLabels) {
+			continue // Shard is not visible to the caller.
 		}
 		shard.ModRevision = s.Items[cur.Left].Raw.ModRevision
 		pbx.Init(&shard.Route, s.Assignments[cur.RightBegin:cur.RightEnd])
@@ -91,9 +179,39 @@ func ShardList(ctx context.Context, srv *Service, req *pc.ListRequest) (*pc.List
 				*asn.Decoded.(allocator.Assignment).AssignmentValue.(*pc.ReplicaStatus))
 		}
 
-		resp.Shards = append(resp.Shards, shard)
+		out = append(out, shard)
 	}
-	return resp, nil
+	return out
+}
+
+// diffShards returns |shards| which were not previously |sent|, or which have
+// a different ModRevision, Route, or replica Status than when they were sent.
+// It also returns shards of |sent| which are no longer present in |shards|.
+// |sent| is updated to reflect |shards|.
+func diffShards(sent map[pc.ShardID]pc.ListResponse_Shard, shards []pc.ListResponse_Shard) (
+	upserts []pc.ListResponse_Shard, deletes []pc.ShardID) {
+
+	var present = make(map[pc.ShardID]struct{}, len(shards))
+	for _, shard := range shards {
+		present[shard.Spec.Id] = struct{}{}
+
+		if prev, ok := sent[shard.Spec.Id]; ok &&
+			prev.ModRevision == shard.ModRevision &&
+			prev.Route.Equal(shard.Route) &&
+			reflect.DeepEqual(prev.Status, shard.Status) {
+			continue // Unchanged.
+		}
+		sent[shard.Spec.Id] = shard
+		upserts = append(upserts, shard)
+	}
+	for id := range sent {
+		if _, ok := present[id]; !ok {
+			deletes = append(deletes, id)
+			delete(sent, id)
+		}
+	}
+	sort.Slice(deletes, func(i, j int) bool { return deletes[i] < deletes[j] })
+	return upserts, deletes
 }
 
 // ShardApply is the default implementation of the ShardServer.Apply API.
@@ -104,43 +222,233 @@ func ShardApply(ctx context.Context, srv *Service, req *pc.ApplyRequest) (*pc.Ap
 		Status: pc.Status_OK,
 		Header: pbx.NewUnroutedHeader(s),
 	}
-	if err := req.Validate(); err != nil {
+	var claims pb.Claims
+	var err error
+
+	if err = req.Validate(); err != nil {
 		return resp, err
+	} else if claims, err = srv.Verify(ctx); err != nil {
+		return resp, err
+	} else if !mayApplyShardChanges(s, claims, req.Changes) {
+		resp.Status = pc.Status_NOT_ALLOWED
+		return resp, nil
+	}
+	for _, change := range req.Changes {
+		if change.Upsert != nil && len(change.Upsert.LabelSet.ValuesOf("prefix")) != 0 {
+			log.WithField("shard", change.Upsert.Id).
+				Warn(`ShardSpec label "prefix" is ignored by selectors and authorizations, in favor of its "prefix" meta-label`)
+		}
 	}
 
-	var cmp []clientv3.Cmp
-	var ops []clientv3.Op
+	// Each change is recorded as a ShardSpecRevision of the shard's history.
+	var now = time.Now()
+	var addr string
+	if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+	}
 
-	for _, changes := range req.Changes {
-		var key string
+	// The recorded Before of each change is initially its ShardSpec as
+	// observed by our KeySpace. The transaction verifies it remains current
+	// and, if not, returns the actual ShardSpec with which we try again.
+	var before = make([]*pc.ShardSpec, len(req.Changes))
+	var beforeRevision = make([]int64, len(req.Changes))
 
-		if changes.Upsert != nil {
-			key = allocator.ItemKey(s.KS, changes.Upsert.Id.String())
-			ops = append(ops, clientv3.OpPut(key, changes.Upsert.MarshalString()))
-		} else {
-			key = allocator.ItemKey(s.KS, changes.Delete.String())
-			ops = append(ops, clientv3.OpDelete(key))
+	for i, change := range req.Changes {
+		before[i], beforeRevision[i] = currentShardSpec(s, changedShard(change))
+	}
+
+	for {
+		var cmp []clientv3.Cmp
+		var ops, elseOps []clientv3.Op
+		var shards []pc.ShardID
+
+		for i, change := range req.Changes {
+			var rev = pc.ShardSpecRevision{
+				Shard:          changedShard(change),
+				ApplyTime:      now.Unix(),
+				AppliedBy:      claims.Subject,
+				Client:         addr,
+				Before:         before[i],
+				BeforeRevision: beforeRevision[i],
+			}
+			var key = allocator.ItemKey(s.KS, rev.Shard.String())
+
+			if change.Upsert != nil {
+				rev.After = change.Upsert
+				ops = append(ops, clientv3.OpPut(key, change.Upsert.MarshalString()))
+			} else {
+				ops = append(ops, clientv3.OpDelete(key))
+			}
+			ops = append(ops, recordShardRevision(s.KS, rev, now))
+			elseOps = append(elseOps, clientv3.OpGet(key))
+			shards = append(shards, rev.Shard)
+
+			cmp = append(cmp, clientv3.Compare(clientv3.ModRevision(key), "=", rev.BeforeRevision))
+			// Allow caller to explicitly ignore revision comparison
+			// by passing a value of -1 for revision.
+			if change.ExpectModRevision != -1 {
+				cmp = append(cmp, clientv3.Compare(clientv3.ModRevision(key), "=", change.ExpectModRevision))
+			}
+		}
+
+		// A dry-run evaluates the comparisons of the transaction, but not its ops.
+		if req.DryRun {
+			ops = nil
+		}
+
+		var txnResp clientv3.OpResponse
+		if txnResp, err = srv.Etcd.Do(ctx, clientv3.OpTxn(cmp, ops, elseOps)); err != nil {
+			return resp, err
+		}
+		resp.Header.Etcd.Revision = txnResp.Txn().Header.Revision
+
+		if txnResp.Txn().Succeeded {
+			if len(ops) != 0 {
+				// If we made changes, delay responding until we have read our own Etcd write.
+				s.KS.Mu.RLock()
+				err = s.KS.WaitForRevision(ctx, txnResp.Txn().Header.Revision)
+				s.KS.Mu.RUnlock()
+
+				pruneShardHistory(ctx, srv, shards, now)
+			}
+			return resp, err
 		}
-		// Allow caller to explicitly ignore revision comparison
-		// by passing a value of -1 for revision.
-		if changes.ExpectModRevision != -1 {
-			cmp = append(cmp, clientv3.Compare(clientv3.ModRevision(key), "=", changes.ExpectModRevision))
+
+		// Determine whether the transaction failed due to the caller's
+		// expectations, or a raced change of a shard's current ShardSpec.
+		for i, change := range req.Changes {
+			var kvs = txnResp.Txn().Responses[i].GetResponseRange().Kvs
+
+			if len(kvs) == 0 {
+				before[i], beforeRevision[i] = nil, 0
+			} else {
+				before[i], beforeRevision[i] = new(pc.ShardSpec), kvs[0].ModRevision
+
+				if err = before[i].Unmarshal(kvs[0].Value); err != nil {
+					return resp, errors.WithMessagef(err, "decoding ShardSpec %s", kvs[0].Key)
+				}
+			}
+			if change.ExpectModRevision != -1 && change.ExpectModRevision != beforeRevision[i] {
+				resp.Status = pc.Status_ETCD_TRANSACTION_FAILED
+			}
+		}
+		if resp.Status != pc.Status_OK {
+			return resp, nil
 		}
 	}
+}
+
+// changedShard returns the ShardID of the ApplyRequest_Change.
+func changedShard(change pc.ApplyRequest_Change) pc.ShardID {
+	if change.Upsert != nil {
+		return change.Upsert.Id
+	}
+	return change.Delete
+}
+
+// HistoryRetention is the duration for which recorded revisions of
+// ShardSpecs are retained. Older revisions of a shard are removed as the
+// shard is changed. If zero, revisions are retained indefinitely.
+var HistoryRetention = 90 * 24 * time.Hour
+
+// defaultHistoryPageLimit is the PageLimit of a HistoryRequest which doesn't
+// specify one.
+const defaultHistoryPageLimit = 1000
 
-	var txnResp, err = srv.Etcd.Do(ctx, clientv3.OpTxn(cmp, ops, nil))
+// ShardHistory is the default implementation of the ShardServer.History API.
+func ShardHistory(ctx context.Context, srv *Service, req *pc.HistoryRequest) (*pc.HistoryResponse, error) {
+	var s = srv.Resolver.state
+
+	var resp = &pc.HistoryResponse{
+		Status: pc.Status_OK,
+		Header: pbx.NewUnroutedHeader(s),
+	}
+	var claims pb.Claims
+	var err error
+
+	if err = req.Validate(); err != nil {
+		return resp, err
+	} else if claims, err = srv.Verify(ctx); err != nil {
+		return resp, err
+	} else if claims.Capability&pb.Capability_LIST == 0 {
+		resp.Status = pc.Status_NOT_ALLOWED
+		return resp, nil
+	}
+
+	if req.PageLimit == 0 {
+		req.PageLimit = defaultHistoryPageLimit
+	}
+	kvs, nextPageToken, err := allocator.ListItemRevisions(ctx, srv.Etcd, s.KS,
+		req.Shard.String(), req.PageToken, int(req.PageLimit))
 	if err != nil {
-		// Pass.
-	} else if !txnResp.Txn().Succeeded {
-		resp.Status = pc.Status_ETCD_TRANSACTION_FAILED
-	} else if len(ops) != 0 {
-		// If we made changes, delay responding until we have read our own Etcd write.
-		s.KS.Mu.RLock()
-		err = s.KS.WaitForRevision(ctx, txnResp.Txn().Header.Revision)
-		s.KS.Mu.RUnlock()
+		return resp, err
 	}
-	resp.Header.Etcd.Revision = txnResp.Txn().Header.Revision
-	return resp, err
+	resp.NextPageToken = nextPageToken
+
+	for _, kv := range kvs {
+		var rev pc.ShardSpecRevision
+
+		if err = rev.Unmarshal(kv.Value); err != nil {
+			return resp, errors.WithMessagef(err, "decoding revision %s", kv.Key)
+		}
+		rev.Revision = kv.ModRevision
+
+		if !mayListShardRevision(claims, &rev) {
+			continue // Revision is not visible to the caller.
+		}
+		resp.Revisions = append(resp.Revisions, rev)
+	}
+
+	return resp, nil
+}
+
+// recordShardRevision returns an Op which records the ShardSpecRevision |rev|
+// in the history of its shard.
+func recordShardRevision(ks *keyspace.KeySpace, rev pc.ShardSpecRevision, now time.Time) clientv3.Op {
+	return clientv3.OpPut(allocator.ItemRevisionKey(ks, rev.Shard.String(), now), rev.MarshalString())
+}
+
+// currentShardSpec returns the ShardSpec of |shard| and its ModRevision,
+// as observed by the KeySpace, or nil and zero if the shard doesn't exist.
+func currentShardSpec(s *allocator.State, shard pc.ShardID) (*pc.ShardSpec, int64) {
+	defer s.KS.Mu.RUnlock()
+	s.KS.Mu.RLock()
+
+	if ind, ok := s.KS.Search(allocator.ItemKey(s.KS, shard.String())); ok {
+		var kv = s.KS.KeyValues[ind]
+		return kv.Decoded.(allocator.Item).ItemValue.(*pc.ShardSpec), kv.Raw.ModRevision
+	}
+	return nil, 0
+}
+
+// pruneShardHistory removes revisions of |shards| which were recorded
+// more than HistoryRetention before |now|. Failures are logged.
+func pruneShardHistory(ctx context.Context, srv *Service, shards []pc.ShardID, now time.Time) {
+	if HistoryRetention == 0 {
+		return
+	}
+	for _, shard := range shards {
+		if err := allocator.PruneItemRevisions(ctx, srv.Etcd, srv.State.KS,
+			shard.String(), now.Add(-HistoryRetention)); err != nil {
+			log.WithFields(log.Fields{"err": err, "shard": shard}).
+				Warn("failed to prune shard history")
+		}
+	}
+}
+
+// mayListShardRevision returns whether |claims| allow listing of the
+// ShardSpecRevision, which requires that they allow listing of both its
+// Before and After ShardSpecs.
+func mayListShardRevision(claims pb.Claims, rev *pc.ShardSpecRevision) bool {
+	for _, spec := range []*pc.ShardSpec{rev.Before, rev.After} {
+		if spec == nil {
+			spec = &pc.ShardSpec{Id: rev.Shard}
+		}
+		if !claims.Allows(pb.Capability_LIST, shardAuthLabels(spec)) {
+			return false
+		}
+	}
+	return true
 }
 
 // ShardGetHints is the default implementation of the ShardServer.Hints API.
@@ -154,6 +462,11 @@ func ShardGetHints(ctx context.Context, srv *Service, req *pc.GetHintsRequest) (
 		spec *pc.ShardSpec
 	)
 
+	var claims, err = srv.Verify(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	ks.Mu.RLock()
 	var item, ok = allocator.LookupItem(ks, req.Shard.String())
 	ks.Mu.RUnlock()
@@ -163,7 +476,12 @@ func ShardGetHints(ctx context.Context, srv *Service, req *pc.GetHintsRequest) (
 	}
 	spec = item.ItemValue.(*pc.ShardSpec)
 
-	var h, err = fetchHints(ctx, spec, srv.Etcd)
+	if !claims.Allows(pb.Capability_READ, shardAuthLabels(spec)) {
+		resp.Status = pc.Status_NOT_ALLOWED
+		return resp, nil
+	}
+
+	h, err := fetchHints(ctx, spec, srv.Etcd)
 	if err != nil {
 		return nil, err
 	}
@@ -190,7 +508,12 @@ func ShardUnassign(ctx context.Context, srv *Service, req *pc.UnassignRequest) (
 		Shards: make([]pc.ShardID, 0),
 	}
 
-	if err := req.Validate(); err != nil {
+	var claims pb.Claims
+	var err error
+
+	if err = req.Validate(); err != nil {
+		return resp, err
+	} else if claims, err = srv.Verify(ctx); err != nil {
 		return resp, err
 	}
 
@@ -198,6 +521,14 @@ func ShardUnassign(ctx context.Context, srv *Service, req *pc.UnassignRequest) (
 	defer state.KS.Mu.RUnlock()
 	state.KS.Mu.RLock()
 
+	for _, shard := range req.Shards {
+		if item, ok := allocator.LookupItem(state.KS, shard.String()); ok &&
+			!claims.Allows(pb.Capability_APPLY, shardAuthLabels(item.ItemValue.(*pc.ShardSpec))) {
+			resp.Status = pc.Status_NOT_ALLOWED
+			return resp, nil
+		}
+	}
+
 	var cmp []clientv3.Cmp
 	var ops []clientv3.Op
 
@@ -242,6 +573,55 @@ func ShardUnassign(ctx context.Context, srv *Service, req *pc.UnassignRequest) (
 	return resp, err
 }
 
+// shardAuthLabels returns the LabelSet of the ShardSpec against which
+// Claims are evaluated, which is its labels unioned with its meta-labels.
+func shardAuthLabels(spec *pc.ShardSpec) pb.LabelSet {
+	var meta = pc.ExtractShardSpecMetaLabels(spec, pb.LabelSet{})
+	return unionShardLabels(spec, meta, pb.LabelSet{})
+}
+
+// unionShardLabels returns the union of the ShardSpec labels with its
+// |meta| labels, using |out| as a buffer. A "prefix" label of the ShardSpec
+// is ignored in favor of its "prefix" meta-label, as it could otherwise
+// alter which Claims are allowed to access the shard.
+func unionShardLabels(spec *pc.ShardSpec, meta, out pb.LabelSet) pb.LabelSet {
+	var set = spec.LabelSet
+	if len(set.ValuesOf("prefix")) != 0 {
+		set = pb.LabelSet{Labels: append([]pb.Label(nil), set.Labels...)}
+		set.Remove("prefix")
+	}
+	return pb.UnionLabelSets(meta, set, out)
+}
+
+// mayApplyShardChanges returns whether |claims| allow all of the |changes|.
+// The Claims must allow the current ShardSpec of each changed shard,
+// if there is one, as well as the upserted ShardSpec. Otherwise a caller
+// could re-label a shard into, or out of, the scope of its Claims.
+func mayApplyShardChanges(s *allocator.State, claims pb.Claims, changes []pc.ApplyRequest_Change) bool {
+	defer s.KS.Mu.RUnlock()
+	s.KS.Mu.RLock()
+
+	for _, change := range changes {
+		var id = change.Delete
+		if change.Upsert != nil {
+			id = change.Upsert.Id
+
+			if !claims.Allows(pb.Capability_APPLY, shardAuthLabels(change.Upsert)) {
+				return false
+			}
+		}
+
+		var current = &pc.ShardSpec{Id: id}
+		if item, ok := allocator.LookupItem(s.KS, id.String()); ok {
+			current = item.ItemValue.(*pc.ShardSpec)
+		}
+		if !claims.Allows(pb.Capability_APPLY, shardAuthLabels(current)) {
+			return false
+		}
+	}
+	return true
+}
+
 // ListShards is a convenience for invoking the List RPC, which maps a validation or !OK status to an error.
 func ListShards(ctx context.Context, sc pc.ShardClient, req *pc.ListRequest) (*pc.ListResponse, error) {
 	if r, err := sc.List(pb.WithDispatchDefault(ctx), req, grpc.WaitForReady(true)); err != nil {
@@ -321,10 +701,53 @@ func ApplyShards(ctx context.Context, sc pc.ShardClient, req *pc.ApplyRequest) (
 	return ApplyShardsInBatches(ctx, sc, req, 0)
 }
 
+// FetchShardHistory retrieves recorded ShardSpecRevisions of the shard via the
+// consumer History RPC, or revisions of all shards if |shard| is empty. All
+// pages of revisions are retrieved, and the returned revisions are ordered on
+// ascending revision. HistoryResponse statuses other than OK are mapped to an
+// error.
+func FetchShardHistory(ctx context.Context, sc pc.ShardClient, shard pc.ShardID) (*pc.HistoryResponse, error) {
+	var req = &pc.HistoryRequest{Shard: shard}
+	var out *pc.HistoryResponse
+
+	for {
+		var resp, err = sc.History(pb.WithDispatchDefault(ctx), req, grpc.WaitForReady(true))
+
+		if err != nil {
+			return resp, err
+		} else if err = resp.Validate(); err != nil {
+			return resp, err
+		} else if resp.Status != pc.Status_OK {
+			return resp, errors.New(resp.Status.String())
+		}
+
+		if out == nil {
+			out = resp
+		} else {
+			out.Revisions = append(out.Revisions, resp.Revisions...)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	out.NextPageToken = ""
+
+	sort.SliceStable(out.Revisions, func(i, j int) bool {
+		var ri, rj = out.Revisions[i], out.Revisions[j]
+		if ri.Revision != rj.Revision {
+			return ri.Revision < rj.Revision
+		}
+		return ri.Shard < rj.Shard
+	})
+	return out, nil
+}
+
 // ApplyShardsInBatches is like ApplyShards, but chunks the ApplyRequest
-// into batches of the given size, which should be less than Etcd's maximum
-// configured transaction size (usually 128). If size is 0 all changes will
-// be attempted in a single transaction. Be aware that ApplyShardsInBatches
+// into batches of the given size. Each change is applied and recorded in the
+// shard's history as two Etcd operations, and the size should be at most half
+// of Etcd's maximum configured transaction size (usually 128). If size is 0
+// all changes will be attempted in a single transaction. Be aware that ApplyShardsInBatches
 // may only partially succeed, with some batches having applied and others not.
 // The final ApplyResponse is returned, unless an error occurs.
 // ApplyResponse statuses other than OK are mapped to an error.
@@ -337,9 +760,9 @@ func ApplyShardsInBatches(ctx context.Context, sc pc.ShardClient, req *pc.ApplyR
 	for {
 		var r *pc.ApplyRequest
 		if len(req.Changes[offset:]) > size {
-			r = &pc.ApplyRequest{Changes: req.Changes[offset : offset+size]}
+			r = &pc.ApplyRequest{Changes: req.Changes[offset : offset+size], DryRun: req.DryRun}
 		} else {
-			r = &pc.ApplyRequest{Changes: req.Changes[offset:]}
+			r = &pc.ApplyRequest{Changes: req.Changes[offset:], DryRun: req.DryRun}
 		}
 
 		var resp, err = sc.Apply(pb.WithDispatchDefault(ctx), r, grpc.WaitForReady(true))
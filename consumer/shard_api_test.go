@@ -2,13 +2,21 @@ package consumer
 
 import (
 	"context"
+	"encoding/base64"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.gazette.dev/core/allocator"
+	"go.gazette.dev/core/auth"
 	pb "go.gazette.dev/core/broker/protocol"
 	"go.gazette.dev/core/brokertest"
 	pc "go.gazette.dev/core/consumer/protocol"
 	"go.gazette.dev/core/consumer/recoverylog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func TestAPIStatCases(t *testing.T) {
@@ -114,6 +122,98 @@ func TestAPIListCases(t *testing.T) {
 	tf.allocateShard(specC)
 }
 
+func TestAPIWatchCases(t *testing.T) {
+	var tf, cleanup = newTestFixture(t)
+	defer cleanup()
+
+	var specA = makeShard(shardA)
+	specA.Labels = append(specA.Labels, pb.Label{Name: "foo", Value: "bar"})
+	var specB = makeShard(shardB)
+	var specC = makeShard(shardC)
+
+	tf.allocateShard(specA)
+	tf.allocateShard(specB, remoteID)
+
+	var ctx, cancel = context.WithCancel(context.Background())
+	var stream = &watchStream{ctx: ctx, sendCh: make(chan *pc.WatchResponse)}
+	var doneCh = make(chan error)
+
+	go func() {
+		doneCh <- tf.service.Watch(&pc.ListRequest{
+			Selector: pb.LabelSelector{Exclude: pb.MustLabelSet("foo", "")},
+		}, stream)
+	}()
+
+	var recv = func() *pc.WatchResponse {
+		var resp = <-stream.sendCh
+		require.NoError(t, resp.Validate())
+		require.Equal(t, pc.Status_OK, resp.Status)
+		return resp
+	}
+
+	// Expect an initial snapshot of matched shards, with their status.
+	var resp = recv()
+	require.Len(t, resp.Upserts, 1)
+	require.Equal(t, *specB, resp.Upserts[0].Spec)
+	require.Len(t, resp.Upserts[0].Status, 1)
+	require.Empty(t, resp.Deletes)
+
+	// Case: a created shard is upserted.
+	tf.allocateShard(specC, remoteID)
+
+	resp = recv()
+	require.Len(t, resp.Upserts, 1)
+	require.Equal(t, *specC, resp.Upserts[0].Spec)
+
+	// Case: changes of shards which aren't matched are not sent, but changed
+	// assignments of a matched shard are.
+	specA.HotStandbys = 1
+	tf.allocateShard(specA)
+	tf.allocateShard(specB)
+
+	resp = recv()
+	require.Len(t, resp.Upserts, 1)
+	require.Equal(t, *specB, resp.Upserts[0].Spec)
+	require.Empty(t, resp.Upserts[0].Status)
+
+	// Case: a deleted shard is sent as a deletion.
+	tf.allocateShard(specC)
+	require.Len(t, recv().Upserts, 1)
+
+	var del, err = tf.etcd.Delete(context.Background(), allocator.ItemKey(tf.ks, shardC))
+	require.NoError(t, err)
+	tf.ks.Mu.RLock()
+	require.NoError(t, tf.ks.WaitForRevision(context.Background(), del.Header.Revision))
+	tf.ks.Mu.RUnlock()
+
+	resp = recv()
+	require.Empty(t, resp.Upserts)
+	require.Equal(t, []pc.ShardID{shardC}, resp.Deletes)
+
+	// Cancellation ends the Watch.
+	cancel()
+	require.NoError(t, <-doneCh)
+
+	// Case: Errors on request validation error.
+	require.EqualError(t, tf.service.Watch(&pc.ListRequest{
+		Selector: pb.LabelSelector{Include: pb.LabelSet{Labels: []pb.Label{{Name: "invalid label"}}}},
+	}, stream), `Selector.Include.Labels[0].Name: not a valid token (invalid label)`)
+}
+
+// watchStream is a pc.Shard_WatchServer which sends to a channel.
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	sendCh chan *pc.WatchResponse
+}
+
+func (s *watchStream) Context() context.Context { return s.ctx }
+
+func (s *watchStream) Send(resp *pc.WatchResponse) error {
+	s.sendCh <- resp
+	return nil
+}
+
 func TestAPIApplyCases(t *testing.T) {
 	var tf, cleanup = newTestFixture(t)
 	defer cleanup()
@@ -161,6 +261,25 @@ func TestAPIApplyCases(t *testing.T) {
 		},
 	}).Status)
 
+	// Case: A dry-run of a deletion at the correct revision succeeds,
+	// but doesn't commit the deletion.
+	var revB = verifyAndFetchRev(shardB, *specB)
+	require.Equal(t, pc.Status_OK, apply(&pc.ApplyRequest{
+		Changes: []pc.ApplyRequest_Change{
+			{Delete: shardB, ExpectModRevision: revB},
+		},
+		DryRun: true,
+	}).Status)
+	require.Equal(t, revB, verifyAndFetchRev(shardB, *specB))
+
+	// Case: A dry-run at the wrong revision fails.
+	require.Equal(t, pc.Status_ETCD_TRANSACTION_FAILED, apply(&pc.ApplyRequest{
+		Changes: []pc.ApplyRequest_Change{
+			{Upsert: specB, ExpectModRevision: revB - 1},
+		},
+		DryRun: true,
+	}).Status)
+
 	// Case: Deletion at wrong revision fails.
 	require.Equal(t, pc.Status_ETCD_TRANSACTION_FAILED, apply(&pc.ApplyRequest{
 		Changes: []pc.ApplyRequest_Change{
@@ -196,6 +315,83 @@ func TestAPIApplyCases(t *testing.T) {
 	require.EqualError(t, err, `Changes[0].Delete: not a valid token (invalid shard id)`)
 }
 
+func TestAPIHistoryCases(t *testing.T) {
+	var tf, cleanup = newTestFixture(t)
+	defer cleanup()
+
+	var specA = makeShard(shardA)
+	var specB = makeShard(shardB)
+	var updatedA = *specA
+	updatedA.HotStandbys = 1
+
+	var apply = func(dryRun bool, changes ...pc.ApplyRequest_Change) int64 {
+		var resp, err = tf.service.Apply(context.Background(), &pc.ApplyRequest{Changes: changes, DryRun: dryRun})
+		require.NoError(t, err)
+		require.Equal(t, pc.Status_OK, resp.Status)
+		return resp.Header.Etcd.Revision
+	}
+	var history = func(id pc.ShardID) []pc.ShardSpecRevision {
+		var resp, err = tf.service.History(context.Background(), &pc.HistoryRequest{Shard: id})
+		require.NoError(t, err)
+		require.Equal(t, pc.Status_OK, resp.Status)
+
+		for i := range resp.Revisions {
+			require.NotZero(t, resp.Revisions[i].ApplyTime)
+			resp.Revisions[i].ApplyTime = 0
+		}
+		return resp.Revisions
+	}
+
+	// Create A & B, update and then delete A, and dry-run an update of B.
+	var rev1 = apply(false, pc.ApplyRequest_Change{Upsert: specA}, pc.ApplyRequest_Change{Upsert: specB})
+	var rev2 = apply(false, pc.ApplyRequest_Change{Upsert: &updatedA, ExpectModRevision: rev1})
+	var rev3 = apply(false, pc.ApplyRequest_Change{Delete: shardA, ExpectModRevision: rev2})
+	_ = apply(true, pc.ApplyRequest_Change{Upsert: &updatedA, ExpectModRevision: -1})
+
+	// Case: History of a single shard.
+	require.Equal(t, []pc.ShardSpecRevision{
+		{Shard: shardA, Revision: rev1, After: specA},
+		{Shard: shardA, Revision: rev2, Before: specA, BeforeRevision: rev1, After: &updatedA},
+		{Shard: shardA, Revision: rev3, Before: &updatedA, BeforeRevision: rev2},
+	}, history(shardA))
+
+	// Case: History of all shards, ordered on shard ID.
+	require.Equal(t, []pc.ShardSpecRevision{
+		{Shard: shardA, Revision: rev1, After: specA},
+		{Shard: shardA, Revision: rev2, Before: specA, BeforeRevision: rev1, After: &updatedA},
+		{Shard: shardA, Revision: rev3, Before: &updatedA, BeforeRevision: rev2},
+		{Shard: shardB, Revision: rev1, After: specB},
+	}, history(""))
+
+	// Case: History is paged.
+	resp, err := tf.service.History(context.Background(), &pc.HistoryRequest{PageLimit: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Revisions, 2)
+	require.NotEmpty(t, resp.NextPageToken)
+
+	resp, err = tf.service.History(context.Background(),
+		&pc.HistoryRequest{PageLimit: 2, PageToken: resp.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, resp.Revisions, 2)
+	require.Equal(t, shardB, resp.Revisions[1].Shard)
+	require.Empty(t, resp.NextPageToken)
+
+	// Case: a change which doesn't expect a revision records the shard's
+	// current ShardSpec as its Before.
+	var updatedB = *specB
+	updatedB.HotStandbys = 1
+	var rev4 = apply(false, pc.ApplyRequest_Change{Upsert: &updatedB, ExpectModRevision: -1})
+
+	require.Equal(t, []pc.ShardSpecRevision{
+		{Shard: shardB, Revision: rev1, After: specB},
+		{Shard: shardB, Revision: rev4, Before: specB, BeforeRevision: rev1, After: &updatedB},
+	}, history(shardB))
+
+	// Case: Invalid requests fail with an error.
+	_, err = tf.service.History(context.Background(), &pc.HistoryRequest{Shard: "invalid shard id"})
+	require.EqualError(t, err, `Shard: not a valid token (invalid shard id)`)
+}
+
 func TestAPIApplyShardsInBatches(t *testing.T) {
 	var ss = newShardServerStub(t)
 	defer ss.cleanup()
@@ -250,6 +446,22 @@ func TestAPIApplyShardsInBatches(t *testing.T) {
 	resp, err = ApplyShardsInBatches(context.Background(), client, fixture, 1)
 	require.NoError(t, err)
 	require.Equal(t, &pc.ApplyResponse{Status: pc.Status_OK, Header: *hdr}, resp)
+
+	// Case: batches of a dry-run are also dry-runs.
+	iter, fixture.DryRun = 0, true
+	ss.ApplyFunc = func(ctx context.Context, req *pc.ApplyRequest) (*pc.ApplyResponse, error) {
+		require.Equal(t, &pc.ApplyRequest{
+			Changes: []pc.ApplyRequest_Change{
+				{Upsert: fixture.Changes[iter].Upsert, ExpectModRevision: 1},
+			},
+			DryRun: true,
+		}, req)
+		iter++
+		return &pc.ApplyResponse{Status: pc.Status_OK, Header: *hdr}, nil
+	}
+	resp, err = ApplyShardsInBatches(context.Background(), client, fixture, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, iter)
 }
 
 func TestAPIHintsCases(t *testing.T) {
@@ -503,3 +715,105 @@ func TestAPIUnassignCases(t *testing.T) {
 	tf.allocateShard(specB)
 	tf.allocateShard(specC)
 }
+
+func TestAPIAuthorizationCases(t *testing.T) {
+	var tf, cleanup = newTestFixture(t)
+	defer cleanup()
+	var restoreTransitions = disableShardTransitions()
+	defer restoreTransitions()
+
+	var keyed, err = auth.NewKeyedAuth(base64.StdEncoding.EncodeToString([]byte("secret")))
+	require.NoError(t, err)
+	tf.service.Auth = keyed
+
+	var specA = makeShard(shardA)
+	specA.Labels = append(specA.Labels, pb.Label{Name: "team", Value: "a"})
+	var specB = makeShard(shardB)
+	specB.Labels = append(specB.Labels,
+		pb.Label{Name: "prefix", Value: "spoofed/"},
+		pb.Label{Name: "team", Value: "b"})
+
+	tf.allocateShard(specA, localID)
+	tf.allocateShard(specB)
+	tf.setReplicaStatus(specA, localID, 0, pc.ReplicaStatus_PRIMARY)
+
+	// authCtx returns an incoming Context bearing a token of the Claims.
+	var authCtx = func(cap pb.Capability, selector string) context.Context {
+		var sel, err = pb.ParseLabelSelector(selector)
+		require.NoError(t, err)
+		ctx, err := keyed.Authorize(context.Background(), pb.Claims{Capability: cap, Selector: sel}, time.Minute)
+		require.NoError(t, err)
+
+		var md, _ = metadata.FromOutgoingContext(ctx)
+		return metadata.NewIncomingContext(context.Background(), md)
+	}
+
+	// Case: RPCs without an authorization are refused.
+	_, err = tf.service.List(context.Background(), &pc.ListRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Case: List requires LIST, and returns only shards matched by the Claims.
+	listResp, err := tf.service.List(authCtx(pb.Capability_READ, ""), &pc.ListRequest{})
+	require.NoError(t, err)
+	require.Equal(t, pc.Status_NOT_ALLOWED, listResp.Status)
+
+	listResp, err = tf.service.List(authCtx(pb.Capability_LIST, "team=b"), &pc.ListRequest{})
+	require.NoError(t, err)
+	require.Equal(t, pc.Status_OK, listResp.Status)
+	require.Len(t, listResp.Shards, 1)
+	require.Equal(t, shardB, listResp.Shards[0].Spec.Id)
+
+	// Case: a "prefix" label of a ShardSpec doesn't confer its prefix.
+	listResp, err = tf.service.List(authCtx(pb.Capability_LIST, "prefix=spoofed/"), &pc.ListRequest{})
+	require.NoError(t, err)
+	require.Equal(t, pc.Status_OK, listResp.Status)
+	require.Len(t, listResp.Shards, 0)
+
+	// Case: Stat requires READ of the shard.
+	statResp, err := tf.service.Stat(authCtx(pb.Capability_READ, "team=b"), &pc.StatRequest{Shard: shardA})
+	require.NoError(t, err)
+	require.Equal(t, pc.Status_NOT_ALLOWED, statResp.Status)
+
+	statResp, err = tf.service.Stat(authCtx(pb.Capability_READ, "id="+shardA), &pc.StatRequest{Shard: shardA})
+	require.NoError(t, err)
+	require.Equal(t, pc.Status_OK, statResp.Status)
+
+	// Case: GetHints requires READ of the shard.
+	hintsResp, err := tf.service.GetHints(authCtx(pb.Capability_LIST, ""), &pc.GetHintsRequest{Shard: shardA})
+	require.NoError(t, err)
+	require.Equal(t, pc.Status_NOT_ALLOWED, hintsResp.Status)
+
+	hintsResp, err = tf.service.GetHints(authCtx(pb.Capability_READ, "team=a"), &pc.GetHintsRequest{Shard: shardA})
+	require.NoError(t, err)
+	require.Equal(t, pc.Status_OK, hintsResp.Status)
+
+	// Case: Apply requires APPLY of both the current and the updated spec.
+	var relabeled = *specB
+	relabeled.Labels = []pb.Label{{Name: "team", Value: "a"}}
+
+	applyResp, err := tf.service.Apply(authCtx(pb.Capability_APPLY, "team=a"), &pc.ApplyRequest{
+		Changes: []pc.ApplyRequest_Change{{Upsert: &relabeled}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, pc.Status_NOT_ALLOWED, applyResp.Status)
+
+	applyResp, err = tf.service.Apply(authCtx(pb.Capability_APPLY, "team=b"), &pc.ApplyRequest{
+		Changes: []pc.ApplyRequest_Change{{Upsert: &relabeled}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, pc.Status_NOT_ALLOWED, applyResp.Status)
+
+	// Case: Unassign requires APPLY of the shard.
+	unassignResp, err := tf.service.Unassign(authCtx(pb.Capability_APPLY, "team=b"),
+		&pc.UnassignRequest{Shards: []pc.ShardID{shardA}})
+	require.NoError(t, err)
+	require.Equal(t, pc.Status_NOT_ALLOWED, unassignResp.Status)
+
+	unassignResp, err = tf.service.Unassign(authCtx(pb.Capability_APPLY, "team=a"),
+		&pc.UnassignRequest{Shards: []pc.ShardID{shardA}})
+	require.NoError(t, err)
+	require.Equal(t, pc.Status_OK, unassignResp.Status)
+	require.Equal(t, []pc.ShardID{shardA}, unassignResp.Shards)
+
+	tf.allocateShard(specA) // Cleanup.
+}
@@ -126,3 +126,55 @@ func (s *Set) MarkUnpatchedForDeletion() {
 		}
 	}
 }
+
+// Diff is the difference of a shard between a Set of current ShardSpecs,
+// and a Set of ShardSpecs to be applied.
+type Diff struct {
+	// ID of the shard.
+	ID pc.ShardID
+	// Current ShardSpec of the shard, or nil if the shard doesn't exist.
+	Current *pc.ShardSpec
+	// Revision of the current ShardSpec within Etcd.
+	Revision int64
+	// Applied ShardSpec of the shard, or nil if it's to be deleted.
+	Applied *pc.ShardSpec
+	// Revision expected by the applied Shard.
+	ExpectRevision int64
+}
+
+// DiffSets returns Diffs of each Shard of the |applied| Set which differs from
+// its current ShardSpec in the |current| Set, ordered on shard ID. Common
+// specifications are pushed down to Shards before being compared, but neither
+// Set is modified. Shards of |current| which aren't in |applied| are
+// unaffected by the apply, and don't produce a Diff.
+func DiffSets(current, applied Set) []Diff {
+	var index = make(map[pc.ShardID]int)
+	for i := range current.Shards {
+		index[current.Shards[i].Spec.Id] = i
+	}
+	var out []Diff
+
+	for _, shard := range applied.Shards {
+		var diff = Diff{ID: shard.Spec.Id, ExpectRevision: shard.Revision}
+
+		if i, ok := index[shard.Spec.Id]; ok {
+			var spec = pc.UnionShardSpecs(current.Shards[i].Spec, current.Common)
+			diff.Current, diff.Revision = &spec, current.Shards[i].Revision
+		}
+		if shard.Delete == nil || !*shard.Delete {
+			var spec = pc.UnionShardSpecs(shard.Spec, applied.Common)
+			diff.Applied = &spec
+		}
+
+		if diff.Current != nil && diff.Applied != nil && diff.Current.Equal(diff.Applied) &&
+			(diff.ExpectRevision == -1 || diff.ExpectRevision == diff.Revision) {
+			continue // Unchanged.
+		} else if diff.Current == nil && diff.Applied == nil && diff.ExpectRevision == -1 {
+			continue // Deletion of a shard which doesn't exist.
+		}
+		out = append(out, diff)
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
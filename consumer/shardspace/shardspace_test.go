@@ -158,6 +158,38 @@ shards:
 	c.Check(bw.String(), gc.Equals, yamlFixture[1:])
 }
 
+func (s *SetSuite) TestDiffSets(c *gc.C) {
+	var current = FromListResponse(&pc.ListResponse{Shards: []pc.ListResponse_Shard{
+		{Spec: pc.ShardSpec{Id: "shard-A", HotStandbys: 1, HintPrefix: "/hints"}, ModRevision: 10},
+		{Spec: pc.ShardSpec{Id: "shard-B", HotStandbys: 1, HintPrefix: "/hints"}, ModRevision: 11},
+		{Spec: pc.ShardSpec{Id: "shard-C", HotStandbys: 1, HintPrefix: "/hints"}, ModRevision: 12},
+		{Spec: pc.ShardSpec{Id: "shard-D", HotStandbys: 1, HintPrefix: "/hints"}, ModRevision: 13},
+	}})
+	var applied = Set{
+		Common: pc.ShardSpec{HintPrefix: "/hints"},
+		Shards: []Shard{
+			{Spec: pc.ShardSpec{Id: "shard-A", HotStandbys: 1}, Revision: 10}, // Unchanged.
+			{Spec: pc.ShardSpec{Id: "shard-B", HotStandbys: 2}, Revision: 11}, // Updated.
+			{Spec: pc.ShardSpec{Id: "shard-C"}, Revision: 12, Delete: &boxedTrue},
+			{Spec: pc.ShardSpec{Id: "shard-E", HotStandbys: 1}},                   // Created.
+			{Spec: pc.ShardSpec{Id: "shard-F"}, Revision: -1, Delete: &boxedTrue}, // Doesn't exist.
+		},
+	}
+
+	var expect = func(id pc.ShardID, standbys uint32) *pc.ShardSpec {
+		return &pc.ShardSpec{Id: id, HotStandbys: standbys, HintPrefix: "/hints"}
+	}
+	c.Check(DiffSets(current, applied), gc.DeepEquals, []Diff{
+		{ID: "shard-B", Current: expect("shard-B", 1), Revision: 11, Applied: expect("shard-B", 2), ExpectRevision: 11},
+		{ID: "shard-C", Current: expect("shard-C", 1), Revision: 12, ExpectRevision: 12},
+		{ID: "shard-E", Applied: expect("shard-E", 1)},
+	})
+
+	// Neither Set was modified.
+	c.Check(applied.Shards[0].Spec.HintPrefix, gc.Equals, "")
+	c.Check(current.Shards[0].Spec.HintPrefix, gc.Equals, "")
+}
+
 func buildFlatFixture() Set {
 	return Set{
 		Shards: []Shard{
@@ -35,6 +35,10 @@ type KeySpace struct {
 	// will appear synchronously with changes to the KeySpace itself, from the
 	// perspective of a client appropriately utilizing a read-lock.
 	Observers []func()
+	// IgnorePrefix, if set, is a key prefix within Root which the KeySpace
+	// doesn't mirror. Keys having the prefix are neither decoded nor included
+	// in KeyValues, and their Watch events are ignored.
+	IgnorePrefix string
 	// WatchApplyDelay is the duration for which KeySpace should allow Etcd
 	// WatchResponses to queue before applying all responses to the KeySpace.
 	// This Nagle-like mechanism amortizes the cost of applying many
@@ -94,7 +98,9 @@ func (ks *KeySpace) Load(ctx context.Context, client *clientv3.Client, rev int64
 				return err
 			} else {
 				for _, kv := range resp.Kvs {
-					if ks.KeyValues, err = appendKeyValue(ks.KeyValues, ks.decode, kv); err != nil {
+					if ks.ignored(kv.Key) {
+						continue
+					} else if ks.KeyValues, err = appendKeyValue(ks.KeyValues, ks.decode, kv); err != nil {
 						log.WithFields(log.Fields{"key": string(kv.Key), "err": err}).
 							Error("key/value decode failed while loading")
 					}
@@ -258,7 +264,9 @@ func (ks *KeySpace) Apply(responses ...clientv3.WatchResponse) error {
 	var hdr = ks.Header
 	var wr clientv3.WatchResponse
 
-	for _, wr = range responses {
+	for i := range responses {
+		wr = responses[i]
+
 		// Do not apply progress notify events to |hdr| as they may contain
 		// a revision increase without a corresponding modification of this
 		// KeySpace, and we track only modifying revisions.
@@ -267,6 +275,16 @@ func (ks *KeySpace) Apply(responses ...clientv3.WatchResponse) error {
 				return err
 			}
 		}
+		// Filter events of ignored keys.
+		if ks.IgnorePrefix != "" {
+			var events = wr.Events[:0]
+			for _, ev := range wr.Events {
+				if !ks.ignored(ev.Kv.Key) {
+					events = append(events, ev)
+				}
+			}
+			wr.Events, responses[i].Events = events, events
+		}
 		// Events are already ordered on ascending ModRevision. Order on key, while
 		// preserving relative ModRevision order of events of the same key.
 		sort.SliceStable(wr.Events, func(i, j int) bool {
@@ -321,6 +339,11 @@ func (ks *KeySpace) Apply(responses ...clientv3.WatchResponse) error {
 	return nil
 }
 
+// ignored returns whether |key| is under the KeySpace IgnorePrefix.
+func (ks *KeySpace) ignored(key []byte) bool {
+	return ks.IgnorePrefix != "" && bytes.HasPrefix(key, []byte(ks.IgnorePrefix))
+}
+
 func (ks *KeySpace) onUpdate() {
 	for _, obv := range ks.Observers {
 		obv()
@@ -227,6 +227,33 @@ func (s *KeySpaceSuite) TestWatchResponseApply(c *gc.C) {
 		})
 }
 
+func (s *KeySpaceSuite) TestIgnoredKeysAreNotApplied(c *gc.C) {
+	var ks = NewKeySpace("/root", testDecoder)
+	ks.IgnorePrefix = "/root/ignored/"
+
+	c.Check(ks.Apply(clientv3.WatchResponse{
+		Header: epb.ResponseHeader{ClusterId: 9999, Revision: 10},
+		Events: []*clientv3.Event{
+			putEvent("/root/aaaa", "1111", 10, 10, 1),
+			putEvent("/root/ignored/key", "not-a-number", 10, 10, 1),
+			putEvent("/root/zzzz", "2222", 10, 10, 1),
+		},
+	}), gc.IsNil)
+
+	// Deletions of ignored keys are also ignored.
+	c.Check(ks.Apply(clientv3.WatchResponse{
+		Header: epb.ResponseHeader{ClusterId: 9999, Revision: 11},
+		Events: []*clientv3.Event{
+			delEvent("/root/ignored/key", 11),
+			putEvent("/root/zzzz", "3333", 10, 11, 2),
+		},
+	}), gc.IsNil)
+
+	c.Check(ks.Header.Revision, gc.Equals, int64(11))
+	verifyDecodedKeyValues(c, ks.KeyValues,
+		map[string]int{"/root/aaaa": 1111, "/root/zzzz": 3333})
+}
+
 func (s *KeySpaceSuite) TestWaitForRevision(c *gc.C) {
 	var ks = NewKeySpace("/", testDecoder)
 
@@ -5,30 +5,52 @@ import (
 	"time"
 
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"go.gazette.dev/core/auth"
 	"go.gazette.dev/core/broker/client"
 	pb "go.gazette.dev/core/broker/protocol"
 	pc "go.gazette.dev/core/consumer/protocol"
+	"go.gazette.dev/core/server"
 	"google.golang.org/grpc"
 )
 
 // AddressConfig of a remote service.
 type AddressConfig struct {
 	Address pb.Endpoint `long:"address" env:"ADDRESS" default:"http://localhost:8080" description:"Service address endpoint"`
+
+	CertFile      string `long:"cert-file" env:"CERT_FILE" description:"Path to the PEM-encoded client TLS certificate, presented to services which require one"`
+	CertKeyFile   string `long:"cert-key-file" env:"CERT_KEY_FILE" description:"Path to the PEM-encoded private key of the client TLS certificate"`
+	TrustedCAFile string `long:"trusted-ca-file" env:"TRUSTED_CA_FILE" description:"Path to PEM-encoded certificate authorities which sign service certificates. If not set, the host's trusted authorities are used"`
+	AuthTokenFile string `long:"auth-token-file" env:"AUTH_TOKEN_FILE" description:"Path to a bearer token which is presented to the service to authorize requests"`
 }
 
 // MustDial dials the server address using a protocol.Dispatcher balancer, and panics on error.
+// The service is dialed over TLS if its Address has an https:// scheme.
+// If AuthTokenFile is set, its token is presented with each request.
 func (c *AddressConfig) MustDial(ctx context.Context) *grpc.ClientConn {
-	var cc, err = grpc.DialContext(ctx,
-		c.Address.GRPCAddr(),
-		grpc.WithInsecure(),
+	var opts = []grpc.DialOption{
 		grpc.WithBalancerName(pb.DispatcherGRPCBalancerName),
 		// Use a tighter bound for the maximum back-off delay (default is 120s).
 		// TODO(johnny): Make this configurable?
-		grpc.WithBackoffMaxDelay(time.Second*5),
+		grpc.WithBackoffMaxDelay(time.Second * 5),
 		// Instrument client for gRPC metric collection.
 		grpc.WithUnaryInterceptor(grpc_prometheus.UnaryClientInterceptor),
 		grpc.WithStreamInterceptor(grpc_prometheus.StreamClientInterceptor),
-	)
+	}
+
+	if c.Address.URL().Scheme == "https" {
+		var tlsFiles, err = server.NewTLSFiles(c.CertFile, c.CertKeyFile, c.TrustedCAFile)
+		Must(err, "failed to load client TLS files")
+		opts = append(opts, grpc.WithTransportCredentials(tlsFiles.TransportCredentials()))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	if c.AuthTokenFile != "" {
+		var tokenFile, err = auth.NewTokenFile(c.AuthTokenFile)
+		Must(err, "failed to load authorization token file")
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenFile))
+	}
+
+	var cc, err = grpc.DialContext(ctx, c.Address.GRPCAddr(), opts...)
 	Must(err, "failed to dial remote service", "endpoint", c.Address)
 
 	return cc
@@ -17,6 +17,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"go.gazette.dev/core/allocator"
 	"go.gazette.dev/core/broker/client"
+	"go.gazette.dev/core/broker/http_gateway/rest"
 	pb "go.gazette.dev/core/broker/protocol"
 	"go.gazette.dev/core/consumer"
 	pc "go.gazette.dev/core/consumer/protocol"
@@ -76,9 +77,10 @@ type Config interface {
 type BaseConfig struct {
 	Consumer struct {
 		mbp.ServiceConfig
-		Limit          uint32        `long:"limit" env:"LIMIT" default:"32" description:"Maximum number of Shards this consumer process will allocate"`
-		MaxHotStandbys uint32        `long:"max-hot-standbys" env:"MAX_HOT_STANDBYS" default:"3" description:"Maximum effective hot standbys of any one shard, which upper-bounds its stated hot-standbys."`
-		WatchDelay     time.Duration `long:"watch-delay" env:"WATCH_DELAY" default:"30ms" description:"Delay applied to the application of watched Etcd events. Larger values amortize the processing of fast-changing Etcd keys."`
+		Limit            uint32        `long:"limit" env:"LIMIT" default:"32" description:"Maximum number of Shards this consumer process will allocate"`
+		MaxHotStandbys   uint32        `long:"max-hot-standbys" env:"MAX_HOT_STANDBYS" default:"3" description:"Maximum effective hot standbys of any one shard, which upper-bounds its stated hot-standbys."`
+		HistoryRetention time.Duration `long:"history-retention" env:"HISTORY_RETENTION" default:"2160h" description:"Duration for which recorded revisions of ShardSpecs are retained. If zero, revisions are retained indefinitely."`
+		WatchDelay       time.Duration `long:"watch-delay" env:"WATCH_DELAY" default:"30ms" description:"Delay applied to the application of watched Etcd events. Larger values amortize the processing of fast-changing Etcd keys."`
 	} `group:"Consumer" namespace:"consumer" env-namespace:"CONSUMER"`
 
 	Broker struct {
@@ -121,7 +123,7 @@ func (sc Cmd) Execute(args []string) error {
 	pb.RegisterGRPCDispatcher(bc.Consumer.Zone)
 
 	// Bind our server listener, grabbing a random available port if Port is zero.
-	var srv, err = server.New("", bc.Consumer.Port)
+	var srv, err = bc.Consumer.BuildServer()
 	mbp.Must(err, "building Server instance")
 
 	if bc.Broker.Cache.Size <= 0 {
@@ -138,6 +140,9 @@ func (sc Cmd) Execute(args []string) error {
 		bc.Etcd.Prefix = fmt.Sprintf("/gazette/consumers/%T", sc.App)
 	}
 
+	auth, err := bc.Consumer.BuildAuth()
+	mbp.Must(err, "building authorization")
+
 	pc.MaxHotStandbys = uint32(bc.Consumer.MaxHotStandbys)
 
 	var (
@@ -153,8 +158,11 @@ func (sc Cmd) Execute(args []string) error {
 		tasks    = task.NewGroup(context.Background())
 		signalCh = make(chan os.Signal, 1)
 	)
+	service.Auth = auth
 	pc.RegisterShardServer(srv.GRPCServer, service)
+	srv.HTTPMux.Handle(rest.Prefix, rest.NewAPI(rjc, pc.NewShardClient(srv.GRPCLoopback)))
 	ks.WatchApplyDelay = bc.Consumer.WatchDelay
+	consumer.HistoryRetention = bc.Consumer.HistoryRetention
 
 	// Register Resolver as a prometheus.Collector for tracking shard status
 	prometheus.MustRegister(service.Resolver)
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	petname "github.com/dustinkirkland/golang-petname"
+	"go.gazette.dev/core/auth"
 	"go.gazette.dev/core/broker/protocol"
 	"go.gazette.dev/core/server"
 )
@@ -23,24 +24,64 @@ type ServiceConfig struct {
 	ID   string `long:"id" env:"ID" description:"Unique ID of this process. Auto-generated if not set"`
 	Host string `long:"host" env:"HOST" description:"Addressable, advertised hostname or IP of this process. Hostname is used if not set"`
 	Port string `long:"port" env:"PORT" description:"Service port for HTTP and gRPC requests. A random port is used if not set. Port may also take the form 'unix:///path/to/socket' to use a Unix Domain Socket"`
+
+	ServerCertFile    string `long:"server-cert-file" env:"SERVER_CERT_FILE" description:"Path to the PEM-encoded server TLS certificate. If set, HTTP and gRPC requests are served over TLS"`
+	ServerCertKeyFile string `long:"server-cert-key-file" env:"SERVER_CERT_KEY_FILE" description:"Path to the PEM-encoded private key of the server TLS certificate"`
+	ServerCAFile      string `long:"server-ca-file" env:"SERVER_CA_FILE" description:"Path to PEM-encoded certificate authorities which sign client certificates. If set, clients must present a certificate (mutual TLS)"`
+	PeerCertFile      string `long:"peer-cert-file" env:"PEER_CERT_FILE" description:"Path to the PEM-encoded client TLS certificate presented to peer servers"`
+	PeerCertKeyFile   string `long:"peer-cert-key-file" env:"PEER_CERT_KEY_FILE" description:"Path to the PEM-encoded private key of the peer client TLS certificate"`
+	PeerCAFile        string `long:"peer-ca-file" env:"PEER_CA_FILE" description:"Path to PEM-encoded certificate authorities which sign peer server certificates. If not set, the host's trusted authorities are used"`
+
+	AuthKeysFile string `long:"auth-keys-file" env:"AUTH_KEYS_FILE" description:"Path to whitespace-separated, base64-encoded secret keys which sign and verify authorization tokens. If set, requests must present a bearer token which authorizes them"`
+}
+
+// BuildServer binds and returns a server.Server of the ServiceConfig.
+// Certificate files are re-read by the Server as they're modified.
+func (cfg ServiceConfig) BuildServer() (*server.Server, error) {
+	var serverTLS, err = server.NewTLSFiles(cfg.ServerCertFile, cfg.ServerCertKeyFile, cfg.ServerCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading server TLS files: %w", err)
+	}
+	peerTLS, err := server.NewTLSFiles(cfg.PeerCertFile, cfg.PeerCertKeyFile, cfg.PeerCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading peer TLS files: %w", err)
+	}
+	if serverTLS == nil && peerTLS != nil {
+		return nil, fmt.Errorf("peer TLS files require that server TLS files also be set")
+	}
+	return server.NewWithTLS("", cfg.host(), cfg.Port, serverTLS, peerTLS)
+}
+
+// BuildAuth returns a protocol.Auth of the ServiceConfig's AuthKeysFile,
+// or nil if AuthKeysFile is not set.
+func (cfg ServiceConfig) BuildAuth() (protocol.Auth, error) {
+	if cfg.AuthKeysFile == "" {
+		return nil, nil
+	}
+	var a, err = auth.NewKeyedAuthFromFile(cfg.AuthKeysFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading authorization keys: %w", err)
+	}
+	return a, nil
 }
 
 // ProcessSpec of the ServiceConfig.
 func (cfg ServiceConfig) BuildProcessSpec(srv *server.Server) protocol.ProcessSpec {
-	var err error
 	if cfg.ID == "" {
 		rand.Seed(time.Now().UnixNano()) // Seed generator for Generate's use.
 		cfg.ID = petname.Generate(2, "-")
 	}
-	if cfg.Host == "" {
-		cfg.Host, err = os.Hostname()
-		Must(err, "failed to determine hostname")
+	cfg.Host = cfg.host()
+
+	var scheme = "http"
+	if cfg.ServerCertFile != "" {
+		scheme = "https"
 	}
 
 	var endpoint string
 	switch addr := srv.RawListener.Addr().(type) {
 	case *net.TCPAddr:
-		endpoint = fmt.Sprintf("http://%s:%d", cfg.Host, addr.Port)
+		endpoint = fmt.Sprintf("%s://%s:%d", scheme, cfg.Host, addr.Port)
 	case *net.UnixAddr:
 		endpoint = fmt.Sprintf("%s://%s%s", addr.Net, cfg.Host, addr.Name)
 	}
@@ -50,3 +91,13 @@ func (cfg ServiceConfig) BuildProcessSpec(srv *server.Server) protocol.ProcessSp
 		Endpoint: protocol.Endpoint(endpoint),
 	}
 }
+
+// host returns the configured Host, or the hostname if Host is not set.
+func (cfg ServiceConfig) host() string {
+	if cfg.Host != "" {
+		return cfg.Host
+	}
+	var host, err = os.Hostname()
+	Must(err, "failed to determine hostname")
+	return host
+}
@@ -145,4 +145,27 @@ func (protoFixedFraming) NewUnmarshalFunc(r *bufio.Reader) UnmarshalFunc {
 // bufferPool pools buffers used for MarshalTo encodings.
 var bufferPool = sync.Pool{New: func() interface{} { return make([]byte, 0, 1024) }}
 
+// validateFixedFrames returns the length of the leading complete fixed frames
+// of |content|, each of which must have a well-formed header and a message
+// which passes |validate|, if non-nil.
+func validateFixedFrames(content []byte, validate func([]byte) error) (int, error) {
+	var n int
+	for len(content)-n >= FixedFrameHeaderLength {
+		if !bytes.Equal(content[n:n+4], FixedFrameWord[:]) {
+			return n, fmt.Errorf("invalid fixed frame header at byte %d", n)
+		}
+		var size = FixedFrameHeaderLength + int(binary.LittleEndian.Uint32(content[n+4:]))
+
+		if len(content)-n < size {
+			break // Partial frame.
+		} else if validate == nil {
+			// Pass.
+		} else if err := validate(content[n+FixedFrameHeaderLength : n+size]); err != nil {
+			return n, fmt.Errorf("invalid message at byte %d: %w", n, err)
+		}
+		n += size
+	}
+	return n, nil
+}
+
 func init() { RegisterFraming(new(protoFixedFraming)) }
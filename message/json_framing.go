@@ -2,7 +2,9 @@ package message
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
+	"fmt"
 
 	"go.gazette.dev/core/labels"
 )
@@ -46,4 +48,24 @@ func (*jsonFraming) NewUnmarshalFunc(r *bufio.Reader) UnmarshalFunc {
 	}
 }
 
+// validateJSONFrames returns the length of the leading complete lines of
+// |content|, each of which must be a valid JSON document which passes
+// |validate|, if non-nil.
+func validateJSONFrames(content []byte, validate func([]byte) error) (int, error) {
+	var n int
+	for {
+		var i = bytes.IndexByte(content[n:], '\n')
+		if i == -1 {
+			return n, nil
+		} else if !json.Valid(content[n : n+i]) {
+			return n, fmt.Errorf("invalid JSON document at byte %d", n)
+		} else if validate == nil {
+			// Pass.
+		} else if err := validate(content[n : n+i]); err != nil {
+			return n, fmt.Errorf("invalid message at byte %d: %w", n, err)
+		}
+		n += i + 1
+	}
+}
+
 func init() { RegisterFraming(new(jsonFraming)) }
@@ -2,6 +2,8 @@ package message
 
 import (
 	"bufio"
+	"bytes"
+	"encoding/binary"
 	"fmt"
 	"hash/fnv"
 	"io"
@@ -29,6 +31,155 @@ func FramingByContentType(contentType string) (Framing, error) {
 	}
 }
 
+// ValidateFrames returns an error if |content| isn't a well-formed sequence of
+// message frames of the Framing having |contentType|, and otherwise returns
+// the length of the leading portion of |content| which is complete frames.
+// Content beyond that length is a partial frame, which may be completed by
+// further content. Only JSON lines and fixed protobuf framings are supported:
+// JSON lines must be complete JSON documents, and fixed protobuf frames must
+// have well-formed headers (their messages are not decoded). If |validate| is
+// non-nil, it's called with the message of each complete frame (a JSON
+// document, or an encoded protobuf message) and may return an error.
+func ValidateFrames(contentType string, content []byte, validate func(message []byte) error) (int, error) {
+	var framing, err = FramingByContentType(contentType)
+	if err != nil {
+		return 0, err
+	}
+	switch framing.ContentType() {
+	case labels.ContentType_JSONLines:
+		return validateJSONFrames(content, validate)
+	case labels.ContentType_ProtoFixed:
+		return validateFixedFrames(content, validate)
+	default:
+		return 0, fmt.Errorf("validation of %s (%s) is not supported", labels.ContentType, contentType)
+	}
+}
+
+// FrameValidator incrementally validates content which is a sequence of
+// message frames, as does ValidateFrames. A trailing partial frame of
+// validated content is retained, and is examined again only once further
+// content may complete it.
+type FrameValidator struct {
+	contentType string
+	validate    func([]byte) error
+	partial     []byte
+}
+
+// NewFrameValidator returns a FrameValidator of content of the Framing having
+// |contentType|, which calls |validate| (if non-nil) with each complete message.
+// Only JSON lines and fixed protobuf framings are supported.
+func NewFrameValidator(contentType string, validate func(message []byte) error) (*FrameValidator, error) {
+	var framing, err = FramingByContentType(contentType)
+	if err != nil {
+		return nil, err
+	}
+	switch framing.ContentType() {
+	case labels.ContentType_JSONLines, labels.ContentType_ProtoFixed:
+		return &FrameValidator{contentType: framing.ContentType(), validate: validate}, nil
+	default:
+		return nil, fmt.Errorf("validation of %s (%s) is not supported", labels.ContentType, contentType)
+	}
+}
+
+// Validate |content|, which continues content previously validated by the
+// FrameValidator. If a frame is invalid, Validate returns an error and the
+// offset of the invalid frame relative to the beginning of |content|, which
+// is negative if the frame began in prior content.
+func (v *FrameValidator) Validate(content []byte) (int, error) {
+	var n int // Length of |content| which completes a retained partial frame.
+
+	if len(v.partial) != 0 {
+		if n = v.completes(content); n == -1 {
+			v.partial = append(v.partial, content...)
+			return 0, nil
+		}
+		v.partial = append(v.partial, content[:n]...)
+
+		if _, err := v.frames(v.partial); err != nil {
+			return n - len(v.partial), err
+		}
+		v.partial = v.partial[:0]
+	}
+
+	var m, err = v.frames(content[n:])
+	if err != nil {
+		return n + m, err
+	}
+	v.partial = append(v.partial, content[n+m:]...)
+	return 0, nil
+}
+
+// Partial returns the length of the retained partial frame.
+func (v *FrameValidator) Partial() int { return len(v.partial) }
+
+// frames validates |content| and returns the length of its complete frames.
+func (v *FrameValidator) frames(content []byte) (int, error) {
+	if v.contentType == labels.ContentType_JSONLines {
+		return validateJSONFrames(content, v.validate)
+	}
+	return validateFixedFrames(content, v.validate)
+}
+
+// completes returns the length of |content| which, appended to the retained
+// partial frame, makes it complete (or malformed), or -1 if |content| doesn't.
+func (v *FrameValidator) completes(content []byte) int {
+	if v.contentType == labels.ContentType_JSONLines {
+		if i := bytes.IndexByte(content, '\n'); i != -1 {
+			return i + 1
+		}
+		return -1
+	}
+
+	var have = len(v.partial)
+	if have+len(content) < FixedFrameHeaderLength {
+		return -1
+	}
+	var header [FixedFrameHeaderLength]byte
+	copy(header[copy(header[:], v.partial):], content)
+
+	if !bytes.Equal(header[:4], FixedFrameWord[:]) {
+		// A retained header of FixedFrameHeaderLength was already verified,
+		// so |have| is shorter than a header.
+		return FixedFrameHeaderLength - have // Malformed.
+	}
+	var size = FixedFrameHeaderLength + int(binary.LittleEndian.Uint32(header[4:]))
+
+	if have+len(content) < size {
+		return -1
+	}
+	return size - have
+}
+
+// NewMessageUnpacker returns a function which unpacks the next frame from a
+// bufio.Reader of content of the Framing having |contentType|, and returns its
+// message: a JSON document without its trailing newline, or an encoded
+// protobuf message without its fixed frame header. As with UnpackLine, the
+// returned message may reference the Reader's internal buffer. Only JSON lines
+// and fixed protobuf framings are supported.
+func NewMessageUnpacker(contentType string) (func(*bufio.Reader) ([]byte, error), error) {
+	var framing, err = FramingByContentType(contentType)
+	if err != nil {
+		return nil, err
+	}
+	switch framing.ContentType() {
+	case labels.ContentType_JSONLines:
+		return func(r *bufio.Reader) ([]byte, error) {
+			var line, err = UnpackLine(r)
+			return bytes.TrimRight(line, "\r\n"), err
+		}, nil
+	case labels.ContentType_ProtoFixed:
+		return func(r *bufio.Reader) ([]byte, error) {
+			var frame, err = UnpackFixedFrame(r)
+			if err != nil {
+				return nil, err
+			}
+			return frame[FixedFrameHeaderLength:], nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("unpacking of %s (%s) is not supported", labels.ContentType, contentType)
+	}
+}
+
 // UnpackLine returns bytes through to the first encountered newline "\n". If
 // the complete line is available in the Reader buffer, it is returned directly
 // without a copy or allocation, and the next call to the Reader's Read will
@@ -23,6 +23,157 @@ func TestFramingDetermination(t *testing.T) {
 	require.EqualError(t, err, `unrecognized `+labels.ContentType+` (`+labels.ContentType_RecoveryLog+`)`)
 }
 
+func TestFrameValidationCases(t *testing.T) {
+	// JSON lines: complete lines must be JSON documents.
+	var n, err = ValidateFrames(labels.ContentType_JSONLines, []byte(`{"a":1}`+"\n"+`[2, 3]`+"\r\n"+`{"b"`), nil)
+	require.NoError(t, err)
+	require.Equal(t, 16, n) // Trailing partial line is not validated.
+
+	n, err = ValidateFrames(labels.ContentType_JSONLines, []byte(`{"a":1}`+"\n"+`{"b":`+"\n"), nil)
+	require.EqualError(t, err, "invalid JSON document at byte 8")
+	require.Equal(t, 8, n)
+
+	_, err = ValidateFrames(labels.ContentType_JSONLines, []byte("\n"), nil)
+	require.EqualError(t, err, "invalid JSON document at byte 0")
+
+	// Fixed protobuf frames: headers must be well-formed.
+	var frames, _ = EncodeFixedProtoFrame(&pb.Fragment{Journal: "a/journal"}, nil)
+	frames, _ = EncodeFixedProtoFrame(&pb.Fragment{Journal: "other/journal", End: 12}, frames)
+	var first = len(frames)
+
+	frames, _ = EncodeFixedProtoFrame(&pb.Fragment{Journal: "partial"}, frames)
+	n, err = ValidateFrames(labels.ContentType_ProtoFixed, frames[:len(frames)-1], nil)
+	require.NoError(t, err)
+	require.Equal(t, first, n)
+
+	n, err = ValidateFrames(labels.ContentType_ProtoFixed, frames[:first+3], nil) // Partial header.
+	require.NoError(t, err)
+	require.Equal(t, first, n)
+
+	n, err = ValidateFrames(labels.ContentType_ProtoFixed, frames, nil)
+	require.NoError(t, err)
+	require.Equal(t, len(frames), n)
+
+	frames[first+1] = 'x' // Corrupt the header of the last frame.
+	n, err = ValidateFrames(labels.ContentType_ProtoFixed, frames, nil)
+	require.EqualError(t, err, fmt.Sprintf("invalid fixed frame header at byte %d", first))
+	require.Equal(t, first, n)
+
+	// Messages of complete frames are passed to a validation callback.
+	var validate = func(message []byte) error {
+		var frag pb.Fragment
+		if err := frag.Unmarshal(message); err != nil {
+			return err
+		} else if frag.End != 0 {
+			return fmt.Errorf("unexpected End %d", frag.End)
+		}
+		return nil
+	}
+	var one, _ = EncodeFixedProtoFrame(&pb.Fragment{Journal: "a/journal"}, nil)
+	n, err = ValidateFrames(labels.ContentType_ProtoFixed, frames[:len(one)], validate)
+	require.NoError(t, err)
+	require.Equal(t, len(one), n)
+
+	n, err = ValidateFrames(labels.ContentType_ProtoFixed, frames[:first], validate)
+	require.EqualError(t, err, fmt.Sprintf("invalid message at byte %d: unexpected End 12", len(one)))
+	require.Equal(t, len(one), n)
+
+	n, err = ValidateFrames(labels.ContentType_JSONLines, []byte(`{"a":1}`+"\n"+`{"b":2}`+"\n"),
+		func(message []byte) error {
+			if bytes.Contains(message, []byte(`"b"`)) {
+				return fmt.Errorf("unexpected b")
+			}
+			return nil
+		})
+	require.EqualError(t, err, "invalid message at byte 8: unexpected b")
+	require.Equal(t, 8, n)
+
+	// Other content types are not supported.
+	_, err = ValidateFrames(labels.ContentType_CSV, []byte("a,b\n"), nil)
+	require.EqualError(t, err, "validation of content-type (text/csv) is not supported")
+	_, err = ValidateFrames("", nil, nil)
+	require.EqualError(t, err, "unrecognized content-type ()")
+}
+
+func TestFrameValidatorCases(t *testing.T) {
+	var calls int
+	var validate = func([]byte) error { calls++; return nil }
+
+	// JSON lines: a partial line is retained until a newline completes it.
+	var v, err = NewFrameValidator(labels.ContentType_JSONLines, validate)
+	require.NoError(t, err)
+
+	for _, chunk := range []string{`{"a":`, `1`, `}` + "\n" + `{"b"`, `:2}` + "\n" + `[3]`} {
+		_, err = v.Validate([]byte(chunk))
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, calls)
+	require.Equal(t, 3, v.Partial())
+
+	// The offset of an invalid document which began in prior content is negative.
+	n, err := v.Validate([]byte(`]` + "\n"))
+	require.EqualError(t, err, "invalid JSON document at byte 0")
+	require.Equal(t, -3, n)
+
+	// Fixed protobuf frames: frames may be split within their headers or messages.
+	var frames, _ = EncodeFixedProtoFrame(&pb.Fragment{Journal: "a/journal"}, nil)
+	frames, _ = EncodeFixedProtoFrame(&pb.Fragment{Journal: "other/journal"}, frames)
+	frames, _ = EncodeFixedProtoFrame(&pb.Fragment{Journal: "partial"}, frames)
+
+	calls = 0
+	v, err = NewFrameValidator(labels.ContentType_ProtoFixed, validate)
+	require.NoError(t, err)
+
+	for i := 0; i < len(frames); i += 3 {
+		var end = i + 3
+		if end > len(frames) {
+			end = len(frames)
+		}
+		_, err = v.Validate(frames[i:end])
+		require.NoError(t, err)
+	}
+	require.Equal(t, 3, calls)
+	require.Equal(t, 0, v.Partial())
+
+	// A malformed header is detected once the partial frame has a complete header.
+	_, err = v.Validate(frames[:3])
+	require.NoError(t, err)
+	n, err = v.Validate([]byte("xxxxx"))
+	require.EqualError(t, err, "invalid fixed frame header at byte 0")
+	require.Equal(t, -3, n)
+
+	// Other content types are not supported.
+	_, err = NewFrameValidator(labels.ContentType_CSV, nil)
+	require.EqualError(t, err, "validation of content-type (text/csv) is not supported")
+}
+
+func TestMessageUnpackerCases(t *testing.T) {
+	var unpack, err = NewMessageUnpacker(labels.ContentType_JSONLines)
+	require.NoError(t, err)
+
+	var br = bufio.NewReader(strings.NewReader(`{"a":1}` + "\r\n" + `{"b":2}` + "\n"))
+	var msg, _ = unpack(br)
+	require.Equal(t, `{"a":1}`, string(msg))
+	msg, _ = unpack(br)
+	require.Equal(t, `{"b":2}`, string(msg))
+	_, err = unpack(br)
+	require.Equal(t, io.EOF, err)
+
+	// Fixed frames are unpacked into their encoded messages.
+	unpack, err = NewMessageUnpacker(labels.ContentType_ProtoFixed)
+	require.NoError(t, err)
+
+	var frame, _ = EncodeFixedProtoFrame(&pb.Fragment{Journal: "a/journal"}, nil)
+	var expect, _ = (&pb.Fragment{Journal: "a/journal"}).Marshal()
+
+	msg, err = unpack(bufio.NewReader(bytes.NewReader(frame)))
+	require.NoError(t, err)
+	require.Equal(t, expect, msg)
+
+	_, err = NewMessageUnpacker(labels.ContentType_CSV)
+	require.EqualError(t, err, "unpacking of content-type (text/csv) is not supported")
+}
+
 func TestLineUnpackingCases(t *testing.T) {
 	const bsize = 16
 	var buf = bytes.NewBufferString("a line\n" + strings.Repeat("x", bsize*3/2) + "\nextra")
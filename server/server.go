@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
@@ -40,11 +41,21 @@ type Server struct {
 	GRPCLoopback *grpc.ClientConn
 
 	httpServer http.Server
+	tls        bool
 }
 
 // New builds and returns a Server of the given TCP network interface |iface|
 // and |port|. |port| may be empty, in which case a random free port is assigned.
 func New(iface string, port string) (*Server, error) {
+	return NewWithTLS(iface, "", port, nil, nil)
+}
+
+// NewWithTLS builds and returns a Server as does New. If |serverTLS| is
+// non-nil, all connections of the Server are served over TLS. The GRPCLoopback
+// then also dials over TLS, presenting and verifying certificates of peer
+// servers using |peerTLS| (which may be nil), and verifying this Server by
+// its advertised |host|.
+func NewWithTLS(iface, host, port string, serverTLS, peerTLS *TLSFiles) (*Server, error) {
 	var network, addr string
 	if port == "" {
 		network, addr = "tcp", fmt.Sprintf("%s:0", iface) // Assign a random free port.
@@ -66,8 +77,16 @@ func New(iface string, port string) (*Server, error) {
 			grpc.UnaryInterceptor(grpc_prometheus.UnaryServerInterceptor),
 		),
 		RawListener: raw,
+		tls:         serverTLS != nil,
+	}
+
+	// If TLS is enabled, it's terminated prior to CMux,
+	// which then sniffs the protocol of the decrypted connection.
+	if serverTLS != nil {
+		srv.CMux = cmux.New(tls.NewListener(srv.RawListener, serverTLS.ServerConfig()))
+	} else {
+		srv.CMux = cmux.New(srv.RawListener)
 	}
-	srv.CMux = cmux.New(srv.RawListener)
 
 	srv.CMux.HandleError(func(err error) bool {
 		if _, ok := err.(net.Error); !ok {
@@ -88,10 +107,7 @@ func New(iface string, port string) (*Server, error) {
 	srv.GRPCListener = srv.CMux.MatchWithWriters(
 		cmux.HTTP2MatchHeaderFieldSendSettings("content-type", "application/grpc"))
 
-	srv.GRPCLoopback, err = grpc.DialContext(
-		context.Background(),
-		srv.RawListener.Addr().String(),
-		grpc.WithInsecure(),
+	var dialOpts = []grpc.DialOption{
 		grpc.WithBalancerName(pb.DispatcherGRPCBalancerName),
 		// This grpc.ClientConn connects to this server's loopback, and also
 		// to peer server addresses via the dispatch balancer. It has particular
@@ -99,10 +115,28 @@ func New(iface string, port string) (*Server, error) {
 		// advertisements). Use an aggressive back-off for server-to-server
 		// connections, as it's crucial for quick cluster recovery from
 		// partitions, etc.
-		grpc.WithBackoffMaxDelay(time.Millisecond*500),
+		grpc.WithBackoffMaxDelay(time.Millisecond * 500),
 		// Instrument client for gRPC metric collection.
 		grpc.WithUnaryInterceptor(grpc_prometheus.UnaryClientInterceptor),
 		grpc.WithStreamInterceptor(grpc_prometheus.StreamClientInterceptor),
+	}
+	if serverTLS != nil {
+		// Peers are expected to also serve TLS. The dispatch balancer verifies
+		// each peer by the host of its advertised Endpoint, while the loopback
+		// itself (being dialed by its bound address) is verified by |host|.
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(peerTLS.TransportCredentials()))
+
+		if host != "" {
+			dialOpts = append(dialOpts, grpc.WithAuthority(host))
+		}
+	} else {
+		dialOpts = append(dialOpts, grpc.WithInsecure())
+	}
+
+	srv.GRPCLoopback, err = grpc.DialContext(
+		context.Background(),
+		srv.RawListener.Addr().String(),
+		dialOpts...,
 	)
 
 	if err != nil {
@@ -128,6 +162,9 @@ func MustLoopback() *Server {
 
 // Endpoint of the Server.
 func (s *Server) Endpoint() pb.Endpoint {
+	if s.tls {
+		return pb.Endpoint("https://" + s.RawListener.Addr().String())
+	}
 	return pb.Endpoint("http://" + s.RawListener.Addr().String())
 }
 
@@ -0,0 +1,198 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/credentials"
+)
+
+// TLSFiles are paths of PEM-encoded files holding a certificate, its private
+// key, and a bundle of trusted certificate authorities. Files are re-read as
+// they're modified, which allows certificates to be rotated without a restart.
+//
+// When used by a server, CertFile and KeyFile are required. If CAFile is set,
+// then clients must present a certificate signed by one of its authorities
+// (mutual TLS).
+//
+// When used by a client, CertFile and KeyFile are optional and are presented
+// to servers which request a client certificate. If CAFile is set, servers
+// are verified against its authorities instead of those of the host.
+type TLSFiles struct {
+	CertFile string
+	KeyFile  string
+	CAFile   string
+
+	mu     sync.Mutex
+	loaded tlsMaterial
+}
+
+// tlsMaterial is a loaded snapshot of TLSFiles.
+type tlsMaterial struct {
+	modTimes [3]time.Time
+	cert     *tls.Certificate
+	caPool   *x509.CertPool
+}
+
+// NewTLSFiles returns TLSFiles of the given paths, or nil if all paths are empty.
+// It returns an error if files cannot be loaded.
+func NewTLSFiles(certFile, keyFile, caFile string) (*TLSFiles, error) {
+	if certFile == "" && keyFile == "" && caFile == "" {
+		return nil, nil
+	}
+	var f = &TLSFiles{CertFile: certFile, KeyFile: keyFile, CAFile: caFile}
+
+	if (certFile == "") != (keyFile == "") {
+		return nil, fmt.Errorf("certificate and key files must be provided together")
+	} else if _, err := f.load(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// ServerConfig returns a *tls.Config for serving connections. Each accepted
+// connection uses the current content of the TLSFiles.
+func (f *TLSFiles) ServerConfig() *tls.Config {
+	return &tls.Config{
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			var m, err = f.load()
+			if err != nil {
+				return nil, err
+			} else if m.cert == nil {
+				return nil, fmt.Errorf("TLSFiles of a server must have a certificate")
+			}
+			var cfg = &tls.Config{
+				Certificates: []tls.Certificate{*m.cert},
+				MinVersion:   tls.VersionTLS12,
+				// Connections are multiplexed to gRPC (HTTP/2) and HTTP/1 servers.
+				NextProtos: []string{"h2", "http/1.1"},
+			}
+			if m.caPool != nil {
+				cfg.ClientCAs = m.caPool
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+			return cfg, nil
+		},
+	}
+}
+
+// ClientConfig returns a *tls.Config for dialing a server having |serverName|
+// (which may be empty), built from the current content of the TLSFiles.
+// A nil *TLSFiles is valid, and verifies servers using the host's trusted
+// authorities.
+func (f *TLSFiles) ClientConfig(serverName string) (*tls.Config, error) {
+	var cfg = &tls.Config{
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if f == nil {
+		return cfg, nil
+	}
+	var m, err = f.load()
+	if err != nil {
+		return nil, err
+	}
+	if m.cert != nil {
+		cfg.Certificates = []tls.Certificate{*m.cert}
+	}
+	cfg.RootCAs = m.caPool
+	return cfg, nil
+}
+
+// TransportCredentials returns gRPC credentials which dial servers over TLS.
+// Each new connection uses the current content of the TLSFiles.
+// A nil *TLSFiles is valid, and verifies servers using the host's trusted
+// authorities.
+func (f *TLSFiles) TransportCredentials() credentials.TransportCredentials {
+	return &tlsCredentials{files: f}
+}
+
+// load returns the current tlsMaterial, first re-reading TLSFiles if any
+// have been modified since they were last loaded.
+func (f *TLSFiles) load() (tlsMaterial, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var next tlsMaterial
+	for i, path := range []string{f.CertFile, f.KeyFile, f.CAFile} {
+		if path == "" {
+			continue
+		} else if info, err := os.Stat(path); err != nil {
+			return tlsMaterial{}, errors.WithMessage(err, "stat of TLS file")
+		} else {
+			next.modTimes[i] = info.ModTime()
+		}
+	}
+	if next.modTimes == f.loaded.modTimes && (f.loaded.cert != nil || f.loaded.caPool != nil) {
+		return f.loaded, nil // Unchanged since last load.
+	}
+
+	if f.CertFile != "" {
+		var cert, err = tls.LoadX509KeyPair(f.CertFile, f.KeyFile)
+		if err != nil {
+			return tlsMaterial{}, errors.WithMessagef(err,
+				"loading TLS certificate %s and key %s", f.CertFile, f.KeyFile)
+		}
+		next.cert = &cert
+	}
+	if f.CAFile != "" {
+		var pem, err = ioutil.ReadFile(f.CAFile)
+		if err != nil {
+			return tlsMaterial{}, errors.WithMessage(err, "reading TLS CA file")
+		}
+		next.caPool = x509.NewCertPool()
+
+		if !next.caPool.AppendCertsFromPEM(pem) {
+			return tlsMaterial{}, fmt.Errorf("no certificates found in TLS CA file %s", f.CAFile)
+		}
+	}
+	f.loaded = next
+	return next, nil
+}
+
+// tlsCredentials implements credentials.TransportCredentials by building
+// a new tls.Config from TLSFiles for each client handshake.
+type tlsCredentials struct {
+	files      *TLSFiles
+	serverName string
+}
+
+func (c *tlsCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	// If |serverName| is empty, gRPC's TLS credentials will verify the server
+	// against the hostname of the dialed |authority|.
+	var cfg, err = c.files.ClientConfig(c.serverName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return credentials.NewTLS(cfg).ClientHandshake(ctx, authority, conn)
+}
+
+func (c *tlsCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return credentials.NewTLS(c.files.ServerConfig()).ServerHandshake(conn)
+}
+
+// Info returns ProtocolInfo of the credentials. The TLS version is negotiated
+// with each handshake, and SecurityVersion is left empty.
+func (c *tlsCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{
+		SecurityProtocol: "tls",
+		ServerName:       c.serverName,
+	}
+}
+
+func (c *tlsCredentials) Clone() credentials.TransportCredentials {
+	return &tlsCredentials{files: c.files, serverName: c.serverName}
+}
+
+func (c *tlsCredentials) OverrideServerName(serverName string) error {
+	c.serverName = serverName
+	return nil
+}
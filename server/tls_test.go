@@ -0,0 +1,194 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/task"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestTLSFilesValidation(t *testing.T) {
+	var dir = t.TempDir()
+	var ca = newTestCA(t)
+	var certPath, keyPath = ca.issue(t, dir, "server", "localhost")
+
+	// No files at all is not an error, and results in nil TLSFiles.
+	var f, err = NewTLSFiles("", "", "")
+	require.NoError(t, err)
+	require.Nil(t, f)
+
+	_, err = NewTLSFiles(certPath, "", "")
+	require.EqualError(t, err, "certificate and key files must be provided together")
+	_, err = NewTLSFiles(certPath, filepath.Join(dir, "missing.key"), "")
+	require.Error(t, err)
+	_, err = NewTLSFiles("", "", keyPath) // Not a certificate.
+	require.Regexp(t, "no certificates found in TLS CA file .*", err)
+
+	f, err = NewTLSFiles(certPath, keyPath, ca.write(t, dir))
+	require.NoError(t, err)
+	require.NotNil(t, f)
+}
+
+func TestTLSFilesAreReloaded(t *testing.T) {
+	var dir = t.TempDir()
+	var ca = newTestCA(t)
+	var certPath, keyPath = ca.issue(t, dir, "first", "localhost")
+
+	var f, err = NewTLSFiles(certPath, keyPath, "")
+	require.NoError(t, err)
+
+	var handshake = func() string {
+		var cfg, err = f.ServerConfig().GetConfigForClient(nil)
+		require.NoError(t, err)
+		leaf, err := x509.ParseCertificate(cfg.Certificates[0].Certificate[0])
+		require.NoError(t, err)
+		return leaf.Subject.CommonName
+	}
+	require.Equal(t, "first", handshake())
+
+	// Rotate the certificate. Ensure its modification time is observably different.
+	var next, nextKey = ca.issue(t, t.TempDir(), "second", "localhost")
+	require.NoError(t, os.Rename(next, certPath))
+	require.NoError(t, os.Rename(nextKey, keyPath))
+	var later = time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certPath, later, later))
+
+	require.Equal(t, "second", handshake())
+}
+
+func TestServingWithMutualTLS(t *testing.T) {
+	var dir = t.TempDir()
+	var ca = newTestCA(t)
+	var caPath = ca.write(t, dir)
+	var serverCert, serverKey = ca.issue(t, dir, "server", "localhost")
+	var clientCert, clientKey = ca.issue(t, dir, "client", "localhost")
+
+	serverTLS, err := NewTLSFiles(serverCert, serverKey, caPath)
+	require.NoError(t, err)
+	peerTLS, err := NewTLSFiles(clientCert, clientKey, caPath)
+	require.NoError(t, err)
+
+	pb.RegisterGRPCDispatcher("local")
+	srv, err := NewWithTLS("127.0.0.1", "localhost", "", serverTLS, peerTLS)
+	require.NoError(t, err)
+	grpc_health_v1.RegisterHealthServer(srv.GRPCServer, health.NewServer())
+
+	require.Regexp(t, `^https://127\.0\.0\.1:\d+$`, srv.Endpoint())
+
+	var tasks = task.NewGroup(context.Background())
+	srv.QueueTasks(tasks)
+	tasks.GoRun()
+
+	var ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// The loopback presents its peer certificate, and verifies the server.
+	var hc = grpc_health_v1.NewHealthClient(srv.GRPCLoopback)
+	resp, err := hc.Check(pb.WithDispatchDefault(ctx), &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+
+	// A client which presents no certificate is rejected.
+	cc, err := grpc.DialContext(ctx, srv.RawListener.Addr().String(),
+		grpc.WithTransportCredentials((&TLSFiles{CAFile: caPath}).TransportCredentials()),
+		grpc.WithAuthority("localhost"),
+	)
+	require.NoError(t, err)
+	_, err = grpc_health_v1.NewHealthClient(cc).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	require.Error(t, err)
+	require.NoError(t, cc.Close())
+
+	// A client which doesn't trust the server's authority is also rejected.
+	conn, err := tls.Dial("tcp", srv.RawListener.Addr().String(), &tls.Config{ServerName: "localhost"})
+	if err == nil {
+		conn.Close()
+	}
+	require.Error(t, err)
+
+	tasks.Cancel()
+	srv.BoundedGracefulStop()
+	require.NoError(t, tasks.Wait())
+}
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) testCA {
+	var key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	var tmpl = &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return testCA{cert: cert, key: key}
+}
+
+// write the CA certificate under |dir|, returning its path.
+func (ca testCA) write(t *testing.T, dir string) string {
+	var path = filepath.Join(dir, "ca.crt")
+	writePEM(t, path, "CERTIFICATE", ca.cert.Raw)
+	return path
+}
+
+// issue a certificate of |name| for |host| under |dir|,
+// returning paths of the certificate and its key.
+func (ca testCA) issue(t *testing.T, dir, name, host string) (string, string) {
+	var key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	var tmpl = &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{host},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	var certPath, keyPath = filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+	writePEM(t, certPath, "CERTIFICATE", der)
+	writePEM(t, keyPath, "EC PRIVATE KEY", keyDER)
+	return certPath, keyPath
+}
+
+func writePEM(t *testing.T, path, typ string, der []byte) {
+	require.NoError(t, ioutil.WriteFile(path,
+		pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0600))
+}